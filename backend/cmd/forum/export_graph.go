@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/config"
+	analyticsRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/analytics"
+	userRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/user"
+	analyticsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/analytics"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+// exportGraph is "forum export-graph" subcommand, it writes interaction graph of users to file
+// or standard output. Returns exit code.
+func exportGraph(args []string) int {
+	fs := flag.NewFlagSet("export-graph", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: forum export-graph [flags]")
+		fmt.Fprintln(fs.Output(),
+			"Export interaction graph of users as GraphML, GEXF or zip archive of CSV node and edge lists.")
+		fs.PrintDefaults()
+	}
+	cmdConfig := config.CmdFlags(fs)
+	format := fs.String("format", model.GraphFormatGraphML,
+		"export format: "+strings.Join(analyticsService.GraphFormats, ", "))
+	output := fs.String("output", "", "output file, standard output by default")
+	from := fs.String("from", "", "count interactions at or after this time (RFC 3339 or YYYY-MM-DD)")
+	to := fs.String("to", "", "count interactions before this time (RFC 3339 or YYYY-MM-DD)")
+	community := fs.Int("community", -1,
+		"count only interactions in threads of community, 0 - threads without community")
+	kinds := fs.String("kinds", "", "comma separated interaction kinds: reply, mention, vote (all by default)")
+	minWeight := fs.Int("min-weight", 1, "skip edges lighter than this")
+	fs.Parse(args)
+
+	if !slices.Contains(analyticsService.GraphFormats, *format) {
+		fmt.Fprintln(os.Stderr, analyticsService.ErrUnknownFormat)
+		return 2
+	}
+	filter := model.GraphFilter{MinWeight: *minWeight}
+	var err error
+	if filter.From, err = parseTimeFlag(*from); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid -from: %v\n", err)
+		return 2
+	}
+	if filter.To, err = parseTimeFlag(*to); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid -to: %v\n", err)
+		return 2
+	}
+	if *community >= 0 {
+		filter.CommunityID = community
+	}
+	if *kinds != "" {
+		filter.Kinds = strings.Split(*kinds, ",")
+	}
+
+	appConfig := config.MustReadAppConfig(cmdConfig)
+	analyticsR, err := analyticsRepo.NewAnalyticsRepo(appConfig.Database.DSN())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create analytics repo: %v\n", err)
+		return 1
+	}
+	userR, err := userRepo.NewUserRepo(appConfig.Database.DSN())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create storage: %v\n", err)
+		return 1
+	}
+	analyticsS := analyticsService.NewAnalyticsService(analyticsR, userR)
+
+	var export model.GraphExport
+	if *output == "" {
+		export, err = analyticsS.WriteGraph(context.Background(), os.Stdout, filter, *format)
+	} else {
+		export, err = writeGraphFile(analyticsS, *output, filter, *format)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to export graph: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(os.Stderr, "exported %d users and %d edges\n", export.Nodes, export.Edges)
+	return 0
+}
+
+func writeGraphFile(analyticsS *analyticsService.AnalyticsService,
+	path string, filter model.GraphFilter, format string) (model.GraphExport, error) {
+
+	file, err := os.Create(path)
+	if err != nil {
+		return model.GraphExport{}, err
+	}
+	export, err := analyticsS.WriteGraph(context.Background(), file, filter, format)
+	if err != nil {
+		file.Close()
+		return export, err
+	}
+	return export, file.Close()
+}
+
+// parseTimeFlag parses RFC 3339 time or date (midnight UTC), empty value is no time
+func parseTimeFlag(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t, err = time.Parse(time.DateOnly, value)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("expected RFC 3339 time or YYYY-MM-DD date: %q", value)
+	}
+	return &t, nil
+}
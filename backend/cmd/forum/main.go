@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
@@ -16,20 +17,45 @@ import (
 	"time"
 
 	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler"
+	attachmentsHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/attachments"
 	authHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/auth"
+	liveHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/live"
 	userHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/user"
 	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/config"
 
 	jwtService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/jwt"
 
+	analyticsRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/analytics"
+	attachmentsRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/attachments"
 	authRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/auth"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/blobstore"
+	filterRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/filter"
+	floodRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/flood"
+	pollsRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/polls"
+	pubsubRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/pubsub"
+	reputationRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/reputation"
+	threadsRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/threads"
 	userRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/user"
 
+	analyticsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/analytics"
+	attachmentsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/attachments"
 	authService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/auth"
+	filterService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/filter"
+	floodService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/flood"
+	liveService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/live"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	pollsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/polls"
+	reputationService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/reputation"
+	threadsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/threads"
 	userService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/user"
 )
 
 func main() {
+	// subcommands run instead of server
+	if len(os.Args) > 1 && os.Args[1] == "export-graph" {
+		os.Exit(exportGraph(os.Args[2:]))
+	}
+
 	cfg := config.CmdParse()
 
 	appConfig := config.MustReadAppConfig(cfg)
@@ -46,26 +72,158 @@ func main() {
 		fmt.Printf("Failed to create storage: %v\n", err)
 		return
 	}
+	reputationR, err := reputationRepo.NewReputationRepo(appConfig.Database.DSN())
+	if err != nil {
+		fmt.Printf("Failed to create reputation repo: %v\n", err)
+		return
+	}
 
-	userS := userService.NewUserService(userR, authR)
+	var pubsub liveService.PubSub = pubsubRepo.NewMemoryPubSub()
+	if appConfig.Live.PubSub == "postgres" {
+		pubsub, err = pubsubRepo.NewPubSubRepo(appConfig.Database.DSN())
+		if err != nil {
+			fmt.Printf("Failed to create pubsub repo: %v\n", err)
+			return
+		}
+	}
+
+	attachmentsR, err := attachmentsRepo.NewAttachmentsRepo(appConfig.Database.DSN())
+	if err != nil {
+		fmt.Printf("Failed to create attachments repo: %v\n", err)
+		return
+	}
+	threadsR, err := threadsRepo.NewThreadsRepo(appConfig.Database.DSN())
+	if err != nil {
+		fmt.Printf("Failed to create threads repo: %v\n", err)
+		return
+	}
+	pollsR, err := pollsRepo.NewPollsRepo(appConfig.Database.DSN())
+	if err != nil {
+		fmt.Printf("Failed to create polls repo: %v\n", err)
+		return
+	}
+	filterR, err := filterRepo.NewFilterRepo(appConfig.Database.DSN())
+	if err != nil {
+		fmt.Printf("Failed to create filter repo: %v\n", err)
+		return
+	}
+	floodR, err := floodRepo.NewFloodRepo(appConfig.Database.DSN())
+	if err != nil {
+		fmt.Printf("Failed to create flood repo: %v\n", err)
+		return
+	}
+	analyticsR, err := analyticsRepo.NewAnalyticsRepo(appConfig.Database.DSN())
+	if err != nil {
+		fmt.Printf("Failed to create analytics repo: %v\n", err)
+		return
+	}
+	blobStore, err := newBlobStore(appConfig.Attachments)
+	if err != nil {
+		fmt.Printf("Failed to create attachments storage: %v\n", err)
+		return
+	}
+
+	reputationS := reputationService.NewReputationService(reputationR, rankRules(appConfig.Reputation))
+	userS := userService.NewUserService(userR, authR, reputationS)
 	authS := authService.NewAuthService(authR)
+	liveS := liveService.NewLiveService(pubsub, appConfig.Live.HistorySize, appConfig.Live.SubscriberBuffer)
+	attachmentsS := attachmentsService.NewAttachmentsService(attachmentsR, blobStore, attachmentsService.Limits{
+		MaxSize:      int64(appConfig.Attachments.MaxSizeMB) << 20,
+		AllowedTypes: appConfig.Attachments.AllowedTypes,
+		MaxPerTarget: appConfig.Attachments.MaxPerPost,
+	}, attachmentsService.ImageOptions{
+		Workers:        appConfig.Attachments.Images.Workers,
+		QueueSize:      appConfig.Attachments.Images.QueueSize,
+		MaxPixels:      appConfig.Attachments.Images.MaxPixels,
+		JPEGQuality:    appConfig.Attachments.Images.JPEGQuality,
+		ThumbnailSizes: appConfig.Attachments.Images.ThumbnailSizes,
+	}, appConfig.Server.JwtSecret, time.Duration(appConfig.Attachments.URLTTLMinutes)*time.Minute)
+	pollsS := pollsService.NewPollsService(pollsR, pollsService.Options{
+		MaxOptions:        appConfig.Polls.MaxOptions,
+		ResultsBeforeVote: appConfig.Polls.ResultsBeforeVote,
+	})
+	floodS := floodService.NewFloodService(floodR, userR, floodOptions(appConfig.Flood))
+	filterS, err := newContentFilter(appConfig.Filter, filterR)
+	if err != nil {
+		fmt.Printf("Failed to create content filter: %v\n", err)
+		return
+	}
+
+	analyticsS := analyticsService.NewAnalyticsService(analyticsR, userR)
+	influenceS := analyticsService.NewInfluenceService(analyticsR, analyticsS, userR, analyticsService.InfluenceOptions{
+		Window:             time.Duration(appConfig.Analytics.InfluenceWindowDays) * 24 * time.Hour,
+		BetweennessSamples: appConfig.Analytics.BetweennessSamples,
+		KeepSnapshots:      appConfig.Analytics.KeepSnapshots,
+	})
 
 	authH := authHandler.NewAuthHandler(authS)
 	userH := userHandler.NewUserHandler(userS, jwtS)
+	liveH := liveHandler.NewLiveHandler(liveS, time.Duration(appConfig.Live.HeartbeatSeconds)*time.Second)
+	attachmentsH := attachmentsHandler.NewAttachmentsHandler(attachmentsS)
 
 	addr := net.JoinHostPort(appConfig.Server.Host, strconv.Itoa(appConfig.Server.Port))
 	if addr == "" {
 		addr = ":8080"
 	}
 
+	liveCtx, stopLive := context.WithCancel(context.Background())
+	defer stopLive()
+	go func() {
+		if err := liveS.Run(liveCtx); err != nil && !errors.Is(err, context.Canceled) {
+			log.Printf("live events stopped: %v", err)
+		}
+	}()
+	attachmentsCtx, stopAttachments := context.WithCancel(context.Background())
+	defer stopAttachments()
+	go func() {
+		err := attachmentsS.RunGC(attachmentsCtx,
+			time.Duration(appConfig.Attachments.GCIntervalMinutes)*time.Minute,
+			time.Duration(appConfig.Attachments.UnlinkedTTLHours)*time.Hour)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			log.Printf("attachments garbage collector stopped: %v", err)
+		}
+	}()
+	go func() {
+		if err := attachmentsS.RunProcessing(attachmentsCtx); err != nil && !errors.Is(err, context.Canceled) {
+			log.Printf("attachments processing stopped: %v", err)
+		}
+	}()
+
+	archiveCtx, stopArchive := context.WithCancel(context.Background())
+	defer stopArchive()
+	if appConfig.Threads.AutoArchiveDays > 0 {
+		archiver := threadsService.NewAutoArchiver(threadsR,
+			time.Duration(appConfig.Threads.AutoArchiveDays)*24*time.Hour)
+		go func() {
+			err := archiver.Run(archiveCtx, time.Duration(appConfig.Threads.AutoArchiveIntervalMinutes)*time.Minute)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				log.Printf("threads auto-archiving stopped: %v", err)
+			}
+		}()
+	}
+
+	influenceCtx, stopInfluence := context.WithCancel(context.Background())
+	defer stopInfluence()
+	if appConfig.Analytics.InfluenceIntervalMinutes > 0 {
+		go func() {
+			err := influenceS.Run(influenceCtx, time.Duration(appConfig.Analytics.InfluenceIntervalMinutes)*time.Minute)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				log.Printf("influence snapshots stopped: %v", err)
+			}
+		}()
+	}
+
 	mux := http.NewServeMux()
-	handler.RegisterRoutes(mux, userH, authH)
-	handler.RegisterOgenRoutes(mux, appConfig.Database.DSN(), userR)
+	handler.RegisterRoutes(mux, userH, authH, liveH, attachmentsH)
+	handler.RegisterOgenRoutes(mux, appConfig.Database.DSN(), userR, userS, jwtS, reputationS, liveS, attachmentsS,
+		pollsS, filterS, floodS, analyticsS, influenceS)
 
 	srv := &http.Server{
 		Addr:    addr,
 		Handler: mux,
 	}
+	// live streams never end by themselves
+	srv.RegisterOnShutdown(stopLive)
 
 	go func() {
 		log.Printf("starting server on %s", addr)
@@ -86,3 +244,81 @@ func main() {
 	}
 	log.Println("server stopped")
 }
+
+// rank rules for reputation service from config
+func rankRules(cfg config.ReputationConfig) []model.RankRule {
+	rules := make([]model.RankRule, len(cfg.Ranks))
+	for i, rank := range cfg.Ranks {
+		rules[i] = model.RankRule{
+			Name:               rank.Name,
+			MinKarma:           rank.MinKarma,
+			MinPosts:           rank.MinPosts,
+			MinAcceptedAnswers: rank.MinAcceptedAnswers,
+			MinAccountAge:      time.Duration(rank.MinAccountAgeDays) * 24 * time.Hour,
+		}
+	}
+	return rules
+}
+
+// blob store of attachment files from config
+func newBlobStore(cfg config.AttachmentsConfig) (attachmentsService.BlobStore, error) {
+	if cfg.Storage == "s3" {
+		return blobstore.NewS3Store(blobstore.S3Config{
+			Endpoint:        cfg.S3.Endpoint,
+			Region:          cfg.S3.Region,
+			Bucket:          cfg.S3.Bucket,
+			AccessKeyID:     cfg.S3.AccessKeyID,
+			SecretAccessKey: cfg.S3.SecretAccessKey,
+			PathStyle:       cfg.S3.PathStyle,
+		})
+	}
+	return blobstore.NewLocalStore(cfg.LocalDir)
+}
+
+// posting rate limits from config, negative values disable limits
+func floodOptions(cfg config.FloodConfig) floodService.Options {
+	seconds := func(s int) time.Duration {
+		return time.Duration(max(s, 0)) * time.Second
+	}
+	return floodService.Options{
+		Limits: floodService.Limits{
+			PostInterval:        seconds(cfg.PostIntervalSeconds),
+			ThreadsPerDay:       max(cfg.ThreadsPerDay, 0),
+			MessagesPerMinute:   max(cfg.MessagesPerMinute, 0),
+			ConversationsPerDay: max(cfg.ConversationsPerDay, 0),
+		},
+		NewAccountAge: time.Duration(max(cfg.NewAccountDays, 0)) * 24 * time.Hour,
+		NewAccountLimits: floodService.Limits{
+			PostInterval:        seconds(cfg.NewAccountPostIntervalSeconds),
+			ThreadsPerDay:       max(cfg.NewAccountThreadsPerDay, 0),
+			MessagesPerMinute:   max(cfg.NewAccountMessagesPerMinute, 0),
+			ConversationsPerDay: max(cfg.NewAccountConversationsPerDay, 0),
+		},
+	}
+}
+
+// content filter chain from config, filters disabled by negative values are skipped
+func newContentFilter(cfg config.FilterConfig, repo *filterRepo.FilterRepo) (*filterService.FilterService, error) {
+	var filters []filterService.Filter
+	if len(cfg.BlockedWords) > 0 || len(cfg.BlockedPatterns) > 0 {
+		blocklist, err := filterService.NewBlocklist(cfg.BlockedWords, cfg.BlockedPatterns, cfg.BlocklistMode)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, blocklist)
+	}
+	if cfg.NewAccountDays > 0 && cfg.NewAccountMaxLinks >= 0 {
+		filters = append(filters, filterService.NewLinkLimit(repo,
+			time.Duration(cfg.NewAccountDays)*24*time.Hour, cfg.NewAccountMaxLinks))
+	}
+	if cfg.DuplicateWindowMinutes > 0 {
+		filters = append(filters, filterService.NewDuplicates(repo,
+			time.Duration(cfg.DuplicateWindowMinutes)*time.Minute, max(cfg.DuplicateMinLength, 0)))
+	}
+	// classifier is trained even if it is disabled for checks
+	bayes := filterService.NewBayes(repo, cfg.SpamThreshold, cfg.SpamMinTraining)
+	if cfg.SpamThreshold > 0 {
+		filters = append(filters, bayes)
+	}
+	return filterService.NewFilterService(bayes, filters...), nil
+}
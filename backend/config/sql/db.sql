@@ -2,9 +2,40 @@ CREATE TABLE IF NOT EXISTS users (
     id SERIAL PRIMARY KEY,
     name TEXT NOT NULL UNIQUE,
     email TEXT NOT NULL UNIQUE,
+    -- sum of votes for user threads and posts
+    karma INTEGER NOT NULL DEFAULT 0,
+    -- created threads and posts
+    posts_count INTEGER NOT NULL DEFAULT 0,
+    accepted_answers INTEGER NOT NULL DEFAULT 0,
+    -- reputation rank name, recalculated on user activity
+    rank TEXT NOT NULL DEFAULT '',
+    -- moderators and admins manage content of other users, role is granted in database
+    role TEXT NOT NULL DEFAULT 'user' CHECK (role IN ('user', 'moderator', 'admin')),
+    -- suspended user can not create threads and posts until this time
+    suspended_until TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    -- public profile filled by user
+    avatar_url TEXT NOT NULL DEFAULT '',
+    bio TEXT NOT NULL DEFAULT '',
+    location TEXT NOT NULL DEFAULT '',
+    website TEXT NOT NULL DEFAULT '',
+    -- the last authenticated request, updated not more often than once in a few minutes
+    last_seen_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT NULL
 );
+-- columns added after the first release, CREATE TABLE above does not change existing table
+ALTER TABLE users ADD COLUMN IF NOT EXISTS karma INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS posts_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS accepted_answers INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS rank TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'user'
+    CHECK (role IN ('user', 'moderator', 'admin'));
+ALTER TABLE users ADD COLUMN IF NOT EXISTS suspended_until TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS avatar_url TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS bio TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS location TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS website TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMP WITH TIME ZONE DEFAULT NULL;
 -- used for password authentication
 CREATE TABLE IF NOT EXISTS auth_passwords (
     user_id INTEGER PRIMARY KEY,
@@ -15,20 +46,507 @@ CREATE TABLE IF NOT EXISTS sessions (
     jwt_id UUID PRIMARY KEY,
     user_id INTEGER NOT NULL
 );
+CREATE TABLE IF NOT EXISTS communities (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
 CREATE TABLE IF NOT EXISTS threads (
     id SERIAL PRIMARY KEY,
     title TEXT NOT NULL,
     content TEXT NOT NULL,
+    -- content rendered from markdown to sanitized html
+    content_html TEXT NOT NULL DEFAULT '',
     user_id INTEGER NOT NULL,
+    community_id INTEGER DEFAULT NULL,
     posts_count INTEGER NOT NULL DEFAULT 1,
+    score INTEGER NOT NULL DEFAULT 0,
+    -- post accepted by thread author as answer
+    accepted_post_id INTEGER DEFAULT NULL,
+    -- 'global' - listed first in all thread lists, 'community' - in thread list of its community
+    pinned TEXT DEFAULT NULL CHECK (pinned IN ('global', 'community')),
+    pinned_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    -- locked thread does not accept new posts
+    locked BOOLEAN NOT NULL DEFAULT FALSE,
+    -- archived thread is read-only, threads without new posts are archived automatically
+    archived_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    -- slow mode set by moderator, user can post to thread once in this many seconds
+    slow_mode_seconds INTEGER NOT NULL DEFAULT 0,
+    -- time of the last post or unarchiving
+    last_activity_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    -- hidden by moderator, not shown in lists, search and by id
+    hidden_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    -- held by content filter, shown after moderator approval
+    held_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NULL
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    -- full-text search, generated column is recalculated by postgres on every insert and update
+    search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', title), 'A') ||
+        setweight(to_tsvector('english', title), 'A') ||
+        setweight(to_tsvector('russian', content), 'B') ||
+        setweight(to_tsvector('english', content), 'B')
+    ) STORED
 );
+-- columns added after the first release
+ALTER TABLE threads ADD COLUMN IF NOT EXISTS content_html TEXT NOT NULL DEFAULT '';
+ALTER TABLE threads ADD COLUMN IF NOT EXISTS community_id INTEGER DEFAULT NULL;
+ALTER TABLE threads ADD COLUMN IF NOT EXISTS score INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE threads ADD COLUMN IF NOT EXISTS accepted_post_id INTEGER DEFAULT NULL;
+ALTER TABLE threads ADD COLUMN IF NOT EXISTS pinned TEXT DEFAULT NULL CHECK (pinned IN ('global', 'community'));
+ALTER TABLE threads ADD COLUMN IF NOT EXISTS pinned_at TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+ALTER TABLE threads ADD COLUMN IF NOT EXISTS locked BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE threads ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+ALTER TABLE threads ADD COLUMN IF NOT EXISTS slow_mode_seconds INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE threads ADD COLUMN IF NOT EXISTS last_activity_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE threads ADD COLUMN IF NOT EXISTS hidden_at TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+ALTER TABLE threads ADD COLUMN IF NOT EXISTS held_at TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+ALTER TABLE threads ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', title), 'A') ||
+    setweight(to_tsvector('english', title), 'A') ||
+    setweight(to_tsvector('russian', content), 'B') ||
+    setweight(to_tsvector('english', content), 'B')
+) STORED;
+CREATE INDEX IF NOT EXISTS threads_search_idx ON threads USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS threads_community_idx ON threads (community_id, id);
+-- feed reads the latest threads of every subscribed community
+CREATE INDEX IF NOT EXISTS threads_community_created_idx ON threads (community_id, created_at);
+CREATE INDEX IF NOT EXISTS threads_pinned_idx ON threads (pinned_at) WHERE pinned IS NOT NULL;
+-- auto-archive of inactive threads, pinned threads are never archived automatically
+CREATE INDEX IF NOT EXISTS threads_inactive_idx ON threads (last_activity_at) WHERE archived_at IS NULL AND pinned IS NULL;
+-- flood control counts threads of user created during the last day
+CREATE INDEX IF NOT EXISTS threads_user_created_idx ON threads (user_id, created_at);
+-- threads in user profile
+CREATE INDEX IF NOT EXISTS threads_user_idx ON threads (user_id, id);
 CREATE TABLE IF NOT EXISTS posts (
     id SERIAL PRIMARY KEY,
     thread_id INTEGER NOT NULL,
     user_id INTEGER NOT NULL,
+    -- post of the same thread this post replies to
+    reply_to_id INTEGER DEFAULT NULL,
     content TEXT NOT NULL,
+    content_html TEXT NOT NULL DEFAULT '',
+    score INTEGER NOT NULL DEFAULT 0,
+    -- hidden by moderator, not shown in thread and search
+    hidden_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    -- held by content filter, shown after moderator approval
+    held_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NULL
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    search_vector TSVECTOR GENERATED ALWAYS AS (
+        to_tsvector('russian', content) || to_tsvector('english', content)
+    ) STORED
+);
+-- columns added after the first release
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS reply_to_id INTEGER DEFAULT NULL;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS content_html TEXT NOT NULL DEFAULT '';
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS score INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS hidden_at TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS held_at TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+    to_tsvector('russian', content) || to_tsvector('english', content)
+) STORED;
+CREATE INDEX IF NOT EXISTS posts_search_idx ON posts USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS posts_thread_idx ON posts (thread_id, id);
+-- posts in user profile
+CREATE INDEX IF NOT EXISTS posts_user_idx ON posts (user_id, id);
+-- feed reads the latest posts of every followed user
+CREATE INDEX IF NOT EXISTS posts_user_created_idx ON posts (user_id, created_at);
+-- one vote per user for thread or post, score and karma columns are updated in the same transaction
+CREATE TABLE IF NOT EXISTS votes (
+    target_type TEXT NOT NULL CHECK (target_type IN ('thread', 'post')),
+    target_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    -- author of voted thread or post
+    target_user_id INTEGER NOT NULL,
+    value SMALLINT NOT NULL CHECK (value IN (-1, 1)),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (target_type, target_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS votes_target_user_idx ON votes (target_user_id);
+CREATE INDEX IF NOT EXISTS votes_user_idx ON votes (user_id);
+CREATE TABLE IF NOT EXISTS rank_history (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    old_rank TEXT NOT NULL,
+    new_rank TEXT NOT NULL,
+    changed_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS rank_history_user_idx ON rank_history (user_id, id);
+CREATE TABLE IF NOT EXISTS bookmark_collections (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, name)
+);
+-- bookmark of thread or post, thread_id is thread of bookmarked post (or thread itself)
+CREATE TABLE IF NOT EXISTS bookmarks (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    target_type TEXT NOT NULL CHECK (target_type IN ('thread', 'post')),
+    target_id INTEGER NOT NULL,
+    thread_id INTEGER NOT NULL,
+    collection_id INTEGER DEFAULT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, target_type, target_id)
+);
+CREATE INDEX IF NOT EXISTS bookmarks_user_idx ON bookmarks (user_id, id);
+CREATE TABLE IF NOT EXISTS notifications (
+    id SERIAL PRIMARY KEY,
+    -- recipient
+    user_id INTEGER NOT NULL,
+    type TEXT NOT NULL,
+    -- user who caused notification, NULL for system notifications
+    actor_id INTEGER DEFAULT NULL,
+    thread_id INTEGER DEFAULT NULL,
+    post_id INTEGER DEFAULT NULL,
+    vote_value SMALLINT NOT NULL DEFAULT 0,
+    message TEXT NOT NULL DEFAULT '',
+    is_read BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS notifications_user_idx ON notifications (user_id, id);
+CREATE INDEX IF NOT EXISTS notifications_unread_idx ON notifications (user_id) WHERE NOT is_read;
+-- notification types disabled (or explicitly enabled) by user, types without row are delivered
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id INTEGER NOT NULL,
+    type TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    PRIMARY KEY (user_id, type)
+);
+-- ids of messages delivered between forum instances with LISTEN/NOTIFY
+CREATE SEQUENCE IF NOT EXISTS pubsub_message_id_seq;
+-- users mentioned as @name in thread or post content, author to user pairs are interactions
+CREATE TABLE IF NOT EXISTS mentions (
+    target_type TEXT NOT NULL CHECK (target_type IN ('thread', 'post')),
+    target_id INTEGER NOT NULL,
+    thread_id INTEGER NOT NULL,
+    -- author of thread or post
+    author_id INTEGER NOT NULL,
+    -- mentioned user
+    user_id INTEGER NOT NULL,
+    -- name as it was written in content
+    name TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (target_type, target_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS mentions_user_idx ON mentions (user_id);
+CREATE INDEX IF NOT EXISTS mentions_author_idx ON mentions (author_id);
+CREATE INDEX IF NOT EXISTS users_name_prefix_idx ON users (lower(name) text_pattern_ops);
+-- uploaded files, attachment is unlinked (thread_id is NULL) until thread or post with it is created,
+-- unlinked attachments are removed with files by garbage collector
+CREATE TABLE IF NOT EXISTS attachments (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    -- thread of attachment, for post attachment - thread of post
+    thread_id INTEGER DEFAULT NULL,
+    post_id INTEGER DEFAULT NULL,
+    -- key of file in blob store
+    storage_key TEXT NOT NULL UNIQUE,
+    file_name TEXT NOT NULL,
+    -- type detected by file content
+    content_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    -- images are processed in background: pending, processing, ready or failed
+    status TEXT NOT NULL DEFAULT 'ready',
+    -- image dimensions and blurhash placeholder, set by processing
+    width INTEGER NOT NULL DEFAULT 0,
+    height INTEGER NOT NULL DEFAULT 0,
+    blurhash TEXT NOT NULL DEFAULT '',
+    processing_started_at TIMESTAMP WITH TIME ZONE DEFAULT NULL
+);
+-- columns added with image processing
+ALTER TABLE attachments ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'ready';
+ALTER TABLE attachments ADD COLUMN IF NOT EXISTS width INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE attachments ADD COLUMN IF NOT EXISTS height INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE attachments ADD COLUMN IF NOT EXISTS blurhash TEXT NOT NULL DEFAULT '';
+ALTER TABLE attachments ADD COLUMN IF NOT EXISTS processing_started_at TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+CREATE INDEX IF NOT EXISTS attachments_thread_idx ON attachments (thread_id, id);
+CREATE INDEX IF NOT EXISTS attachments_unlinked_idx ON attachments (created_at) WHERE thread_id IS NULL;
+CREATE INDEX IF NOT EXISTS attachments_unprocessed_idx ON attachments (id) WHERE status IN ('pending', 'processing');
+-- resized copies (thumbnails) of image attachments
+CREATE TABLE IF NOT EXISTS attachment_variants (
+    attachment_id INTEGER NOT NULL,
+    -- max width and height of variant, e.g. '320'
+    name TEXT NOT NULL,
+    storage_key TEXT NOT NULL UNIQUE,
+    content_type TEXT NOT NULL,
+    width INTEGER NOT NULL,
+    height INTEGER NOT NULL,
+    size BIGINT NOT NULL,
+    PRIMARY KEY (attachment_id, name)
+);
+-- threads followed by users: watching - notified about every post, tracking - followed without
+-- notifications about every post, muted - no notifications about thread at all
+CREATE TABLE IF NOT EXISTS thread_subscriptions (
+    user_id INTEGER NOT NULL,
+    thread_id INTEGER NOT NULL,
+    level TEXT NOT NULL CHECK (level IN ('watching', 'tracking', 'muted')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, thread_id)
+);
+-- actions of user counted by flood control during limit window (private messages, conversations).
+-- Action is recorded before it is done, rows older than window are removed by the next action.
+CREATE TABLE IF NOT EXISTS flood_actions (
+    user_id INTEGER NOT NULL,
+    kind TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS flood_actions_user_idx ON flood_actions (user_id, kind, created_at);
+-- notification fan-out to watchers of thread
+CREATE INDEX IF NOT EXISTS thread_subscriptions_watchers_idx ON thread_subscriptions (thread_id, user_id)
+    WHERE level = 'watching';
+-- append-only history of thread (title and content) and post (content) edits,
+-- revision 1 is the original content stored on the first edit
+CREATE TABLE IF NOT EXISTS post_revisions (
+    id SERIAL PRIMARY KEY,
+    target_type TEXT NOT NULL CHECK (target_type IN ('thread', 'post')),
+    target_id INTEGER NOT NULL,
+    -- number of revision of target starting from 1
+    revision INTEGER NOT NULL,
+    -- empty for posts
+    title TEXT NOT NULL DEFAULT '',
+    content TEXT NOT NULL,
+    editor_id INTEGER NOT NULL,
+    -- revision restored by moderator
+    restored_from INTEGER DEFAULT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (target_type, target_id, revision)
+);
+-- poll of thread, created with thread
+CREATE TABLE IF NOT EXISTS polls (
+    id SERIAL PRIMARY KEY,
+    thread_id INTEGER NOT NULL UNIQUE,
+    question TEXT NOT NULL,
+    -- user can choose several options
+    multiple BOOLEAN NOT NULL DEFAULT FALSE,
+    -- voters are not shown, only counts
+    anonymous BOOLEAN NOT NULL DEFAULT FALSE,
+    -- NULL - poll is open forever
+    closes_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TABLE IF NOT EXISTS poll_options (
+    id SERIAL PRIMARY KEY,
+    poll_id INTEGER NOT NULL,
+    -- display order starting from 1
+    position INTEGER NOT NULL,
+    text TEXT NOT NULL,
+    UNIQUE (poll_id, position)
+);
+-- one row per voted user, primary key allows single vote in poll
+CREATE TABLE IF NOT EXISTS poll_voters (
+    poll_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (poll_id, user_id)
+);
+CREATE TABLE IF NOT EXISTS poll_votes (
+    poll_id INTEGER NOT NULL,
+    option_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (option_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS poll_votes_poll_user_idx ON poll_votes (poll_id, user_id);
+-- moderator actions, every resolution of reports is recorded
+CREATE TABLE IF NOT EXISTS moderation_log (
+    id SERIAL PRIMARY KEY,
+    moderator_id INTEGER NOT NULL,
+    -- 'approve' publishes content held by content filter, 'reject' discards held edit
+    action TEXT NOT NULL CHECK (action IN ('dismiss', 'hide', 'warn', 'suspend', 'approve', 'reject')),
+    target_type TEXT NOT NULL CHECK (target_type IN ('thread', 'post', 'user')),
+    target_id INTEGER NOT NULL,
+    -- author of thread or post, reported user
+    target_user_id INTEGER NOT NULL,
+    -- open reports resolved by action
+    reports_count INTEGER NOT NULL DEFAULT 0,
+    comment TEXT NOT NULL DEFAULT '',
+    suspended_until TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+-- 'approve' and 'reject' actions added with content filter
+ALTER TABLE moderation_log DROP CONSTRAINT IF EXISTS moderation_log_action_check;
+ALTER TABLE moderation_log ADD CONSTRAINT moderation_log_action_check
+    CHECK (action IN ('dismiss', 'hide', 'warn', 'suspend', 'approve', 'reject'));
+CREATE INDEX IF NOT EXISTS moderation_log_target_user_idx ON moderation_log (target_user_id, id);
+-- reports of users about threads, posts and users, open until resolved by moderator
+CREATE TABLE IF NOT EXISTS reports (
+    id SERIAL PRIMARY KEY,
+    reporter_id INTEGER NOT NULL,
+    target_type TEXT NOT NULL CHECK (target_type IN ('thread', 'post', 'user')),
+    target_id INTEGER NOT NULL,
+    reason TEXT NOT NULL CHECK (reason IN ('spam', 'abuse', 'off_topic', 'illegal', 'other')),
+    text TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    resolved_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    -- moderation log record of resolution
+    resolution_id INTEGER DEFAULT NULL
+);
+-- one open report of user for target, repeated reports are rejected
+CREATE UNIQUE INDEX IF NOT EXISTS reports_open_reporter_idx ON reports (reporter_id, target_type, target_id)
+    WHERE resolved_at IS NULL;
+CREATE INDEX IF NOT EXISTS reports_open_target_idx ON reports (target_type, target_id) WHERE resolved_at IS NULL;
+-- threads and posts held by content filter until moderator decision, row is removed on decision
+CREATE TABLE IF NOT EXISTS held_content (
+    id SERIAL PRIMARY KEY,
+    target_type TEXT NOT NULL CHECK (target_type IN ('thread', 'post')),
+    target_id INTEGER NOT NULL,
+    thread_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+-- edits of visible threads and posts held by content filter, current content is not changed until
+-- approval. Approved edit is applied as new revision, row is removed with its held_content row.
+CREATE TABLE IF NOT EXISTS held_edits (
+    held_id INTEGER PRIMARY KEY,
+    -- empty for posts
+    title TEXT NOT NULL DEFAULT '',
+    content TEXT NOT NULL,
+    content_html TEXT NOT NULL,
+    editor_id INTEGER NOT NULL,
+    restored_from INTEGER DEFAULT NULL
+);
+-- hashes of recently posted content for duplicate detection, old rows are removed by filter
+CREATE TABLE IF NOT EXISTS content_fingerprints (
+    fingerprint TEXT NOT NULL,
+    user_id INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS content_fingerprints_idx ON content_fingerprints (fingerprint, created_at);
+CREATE INDEX IF NOT EXISTS content_fingerprints_created_idx ON content_fingerprints (created_at);
+-- naive Bayes spam classifier trained by moderator decisions on held content
+CREATE TABLE IF NOT EXISTS spam_classes (
+    class TEXT PRIMARY KEY CHECK (class IN ('spam', 'ham')),
+    -- trained documents
+    docs INTEGER NOT NULL DEFAULT 0
+);
+CREATE TABLE IF NOT EXISTS spam_tokens (
+    token TEXT PRIMARY KEY,
+    -- trained documents of class with token
+    spam_count INTEGER NOT NULL DEFAULT 0,
+    ham_count INTEGER NOT NULL DEFAULT 0
+);
+-- the earliest time of the next post of user (flood control), thread_id 0 - in any thread,
+-- otherwise in thread with slow mode. Rows are reused by the next posts.
+CREATE TABLE IF NOT EXISTS posting_slots (
+    user_id INTEGER NOT NULL,
+    thread_id INTEGER NOT NULL DEFAULT 0,
+    next_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (user_id, thread_id)
+);
+-- block and ignore lists of users, one relation of user to target. Blocked user can not reply to or mention
+-- the user, content and notifications of blocked and ignored users are collapsed or filtered for the user.
+CREATE TABLE IF NOT EXISTS user_relations (
+    user_id INTEGER NOT NULL,
+    target_id INTEGER NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('block', 'ignore')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, target_id)
+);
+CREATE INDEX IF NOT EXISTS user_relations_target_idx ON user_relations (target_id, kind);
+-- private conversations of two or more users, visible only to members
+CREATE TABLE IF NOT EXISTS conversations (
+    id SERIAL PRIMARY KEY,
+    title TEXT NOT NULL DEFAULT '',
+    creator_id INTEGER NOT NULL,
+    members_count INTEGER NOT NULL,
+    -- conversations are listed by the latest message
+    last_message_id INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TABLE IF NOT EXISTS conversation_members (
+    conversation_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    -- messages with greater id are unread by member
+    last_read_message_id INTEGER NOT NULL DEFAULT 0,
+    joined_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (conversation_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS conversation_members_user_idx ON conversation_members (user_id, conversation_id);
+CREATE TABLE IF NOT EXISTS direct_messages (
+    id SERIAL PRIMARY KEY,
+    conversation_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    content TEXT NOT NULL,
+    -- content rendered from markdown to sanitized html
+    content_html TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS direct_messages_conversation_idx ON direct_messages (conversation_id, id);
+-- user follows target user, threads and posts of followed users are in feed of user
+CREATE TABLE IF NOT EXISTS user_follows (
+    -- cursor of followers and following lists
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    target_id INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, target_id)
+);
+CREATE INDEX IF NOT EXISTS user_follows_target_idx ON user_follows (target_id, id);
+CREATE INDEX IF NOT EXISTS user_follows_user_idx ON user_follows (user_id, id);
+-- new threads of subscribed communities are in feed of user
+CREATE TABLE IF NOT EXISTS community_subscriptions (
+    user_id INTEGER NOT NULL,
+    community_id INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, community_id)
+);
+-- directed interaction graph of users aggregated by day: replies, mentions and votes of source user
+-- to target user. Updated on every new interaction, rebuilt from posts, mentions and votes by admin.
+CREATE TABLE IF NOT EXISTS interaction_edges (
+    source_id INTEGER NOT NULL,
+    target_id INTEGER NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('reply', 'mention', 'vote')),
+    -- community of thread, 0 for threads without community
+    community_id INTEGER NOT NULL DEFAULT 0,
+    -- UTC day of interactions
+    day DATE NOT NULL,
+    weight INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (source_id, target_id, kind, community_id, day)
+);
+-- community_id added with graph export, it is a part of primary key
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'interaction_edges' AND column_name = 'community_id') THEN
+        ALTER TABLE interaction_edges ADD COLUMN community_id INTEGER NOT NULL DEFAULT 0;
+        ALTER TABLE interaction_edges DROP CONSTRAINT interaction_edges_pkey,
+            ADD PRIMARY KEY (source_id, target_id, kind, community_id, day);
+    END IF;
+END $$;
+-- time window of graph
+CREATE INDEX IF NOT EXISTS interaction_edges_day_idx ON interaction_edges (day);
+-- influence metrics of users computed periodically from interaction graph of forum or community
+CREATE TABLE IF NOT EXISTS influence_snapshots (
+    id SERIAL PRIMARY KEY,
+    -- NULL - graph of whole forum
+    community_id INTEGER DEFAULT NULL,
+    -- start of graph time window, NULL - all interactions
+    window_from TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    users_count INTEGER NOT NULL,
+    edges_count INTEGER NOT NULL,
+    duration_ms INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+-- the latest snapshot of community
+CREATE INDEX IF NOT EXISTS influence_snapshots_community_idx ON influence_snapshots (community_id, id);
+CREATE TABLE IF NOT EXISTS influence_scores (
+    snapshot_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    -- position by pagerank, from 1
+    rank INTEGER NOT NULL,
+    pagerank DOUBLE PRECISION NOT NULL,
+    -- normalized to [0, 1], estimated from sampled shortest paths on big graphs
+    betweenness DOUBLE PRECISION NOT NULL,
+    in_degree INTEGER NOT NULL,
+    out_degree INTEGER NOT NULL,
+    in_weight INTEGER NOT NULL,
+    out_weight INTEGER NOT NULL,
+    PRIMARY KEY (snapshot_id, user_id)
 );
+-- analytics profile of user
+CREATE INDEX IF NOT EXISTS influence_scores_user_idx ON influence_scores (user_id, snapshot_id);
@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/authctx"
+	analyticsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/analytics"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+type AnalyticsHandler struct {
+	analyticsService *analyticsService.AnalyticsService
+	influenceService *analyticsService.InfluenceService
+}
+
+func NewAnalyticsHandler(
+	analyticsService *analyticsService.AnalyticsService,
+	influenceService *analyticsService.InfluenceService) *AnalyticsHandler {
+
+	return &AnalyticsHandler{analyticsService: analyticsService, influenceService: influenceService}
+}
+
+func (h *AnalyticsHandler) AnalyticsGraph(
+	ctx context.Context, params forumApi.AnalyticsGraphParams) (forumApi.AnalyticsGraphRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.AnalyticsGraphUnauthorized("not authenticated")
+		return &res, nil
+	}
+	graph, err := h.analyticsService.Graph(ctx, userId, graphFilter(params))
+	switch {
+	case err == nil:
+		return convertGraph(graph), nil
+	case errors.Is(err, analyticsService.ErrUnknownKind), errors.Is(err, analyticsService.ErrInvalidWindow):
+		res := forumApi.AnalyticsGraphBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, analyticsService.ErrNotAdmin):
+		res := forumApi.AnalyticsGraphForbidden(err.Error())
+		return &res, nil
+	}
+	return nil, err
+}
+
+// AnalyticsGraphExport streams graph file as it is read from database, request is checked before
+// response to report errors by status
+func (h *AnalyticsHandler) AnalyticsGraphExport(
+	ctx context.Context, params forumApi.AnalyticsGraphExportParams) (forumApi.AnalyticsGraphExportRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.AnalyticsGraphExportUnauthorized("not authenticated")
+		return &res, nil
+	}
+	filter := exportFilter(params)
+	format := string(params.Format)
+	err := h.analyticsService.CheckExport(ctx, userId, filter, format)
+	switch {
+	case err == nil:
+	case errors.Is(err, analyticsService.ErrUnknownKind), errors.Is(err, analyticsService.ErrInvalidWindow),
+		errors.Is(err, analyticsService.ErrUnknownFormat):
+		res := forumApi.AnalyticsGraphExportBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, analyticsService.ErrNotAdmin):
+		res := forumApi.AnalyticsGraphExportForbidden(err.Error())
+		return &res, nil
+	default:
+		return nil, err
+	}
+
+	// generated server closes reader after response, it stops export on client disconnect.
+	// Failed export is a truncated file, status is already sent.
+	reader, writer := io.Pipe()
+	go func() {
+		_, err := h.analyticsService.WriteGraph(ctx, writer, filter, format)
+		if err != nil && ctx.Err() == nil && !errors.Is(err, io.ErrClosedPipe) {
+			log.Printf("failed to export interaction graph: %v", err)
+		}
+		writer.CloseWithError(err)
+	}()
+	extension := format
+	if format == model.GraphFormatCSV {
+		extension = "zip"
+	}
+	disposition := fmt.Sprintf(`attachment; filename="interactions-%s.%s"`,
+		time.Now().UTC().Format("20060102-150405"), extension)
+	switch params.Format {
+	case forumApi.GraphFormatGexf:
+		return &forumApi.AnalyticsGraphExportOKApplicationGexfXMLHeaders{
+			ContentDisposition: disposition,
+			Response:           forumApi.AnalyticsGraphExportOKApplicationGexfXML{Data: reader},
+		}, nil
+	case forumApi.GraphFormatCsv:
+		return &forumApi.AnalyticsGraphExportOKApplicationZipHeaders{
+			ContentDisposition: disposition,
+			Response:           forumApi.AnalyticsGraphExportOKApplicationZip{Data: reader},
+		}, nil
+	}
+	return &forumApi.AnalyticsGraphExportOKApplicationGraphmlXMLHeaders{
+		ContentDisposition: disposition,
+		Response:           forumApi.AnalyticsGraphExportOKApplicationGraphmlXML{Data: reader},
+	}, nil
+}
+
+func (h *AnalyticsHandler) AnalyticsGraphRebuild(ctx context.Context) (forumApi.AnalyticsGraphRebuildRes, error) {
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.AnalyticsGraphRebuildUnauthorized("not authenticated")
+		return &res, nil
+	}
+	rebuild, err := h.analyticsService.Rebuild(ctx, userId)
+	if errors.Is(err, analyticsService.ErrNotAdmin) {
+		res := forumApi.AnalyticsGraphRebuildForbidden(err.Error())
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &forumApi.GraphRebuildResponse{
+		Edges:      rebuild.Edges,
+		DurationMs: int(rebuild.Duration.Milliseconds()),
+	}, nil
+}
+
+func (h *AnalyticsHandler) AnalyticsLeaderboard(
+	ctx context.Context, params forumApi.AnalyticsLeaderboardParams) (forumApi.AnalyticsLeaderboardRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.AnalyticsLeaderboardUnauthorized("not authenticated")
+		return &res, nil
+	}
+	var communityId *int
+	if id, ok := params.CommunityID.Get(); ok {
+		communityId = &id
+	}
+	leaderboard, err := h.influenceService.Leaderboard(ctx, userId, communityId,
+		string(params.Metric.Or(forumApi.InfluenceMetricPagerank)),
+		params.Limit.Or(analyticsService.DefaultLeaderboardLimit))
+	switch {
+	case err == nil:
+		res := &forumApi.LeaderboardResponse{
+			Snapshot: convertSnapshot(leaderboard.Snapshot),
+			Metric:   forumApi.InfluenceMetric(leaderboard.Metric),
+			Scores:   make([]forumApi.InfluenceScore, len(leaderboard.Scores)),
+		}
+		for i, score := range leaderboard.Scores {
+			res.Scores[i] = convertScore(score)
+		}
+		return res, nil
+	case errors.Is(err, analyticsService.ErrUnknownMetric):
+		res := forumApi.AnalyticsLeaderboardBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, analyticsService.ErrNotAdmin):
+		res := forumApi.AnalyticsLeaderboardForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.AnalyticsLeaderboardNotFound("no influence snapshot yet")
+		return &res, nil
+	}
+	return nil, err
+}
+
+// AnalyticsUserInfluence is analytics profile of user
+func (h *AnalyticsHandler) AnalyticsUserInfluence(
+	ctx context.Context, params forumApi.AnalyticsUserInfluenceParams) (forumApi.AnalyticsUserInfluenceRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.AnalyticsUserInfluenceUnauthorized("not authenticated")
+		return &res, nil
+	}
+	influence, err := h.influenceService.UserInfluence(ctx, userId, params.UserId)
+	if errors.Is(err, analyticsService.ErrNotAdmin) {
+		res := forumApi.AnalyticsUserInfluenceForbidden(err.Error())
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := &forumApi.UserInfluenceResponse{Influence: make([]forumApi.UserInfluence, len(influence))}
+	for i, item := range influence {
+		res.Influence[i] = forumApi.UserInfluence{
+			Snapshot: convertSnapshot(item.Snapshot),
+			Score:    convertScore(item.Score),
+		}
+	}
+	return res, nil
+}
+
+func (h *AnalyticsHandler) AnalyticsInfluenceSnapshot(
+	ctx context.Context) (forumApi.AnalyticsInfluenceSnapshotRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.AnalyticsInfluenceSnapshotUnauthorized("not authenticated")
+		return &res, nil
+	}
+	snapshots, err := h.influenceService.Snapshot(ctx, userId)
+	if errors.Is(err, analyticsService.ErrNotAdmin) {
+		res := forumApi.AnalyticsInfluenceSnapshotForbidden(err.Error())
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := &forumApi.InfluenceSnapshotsResponse{Snapshots: make([]forumApi.InfluenceSnapshot, len(snapshots))}
+	for i, snapshot := range snapshots {
+		res.Snapshots[i] = convertSnapshot(snapshot)
+	}
+	return res, nil
+}
+
+func graphFilter(params forumApi.AnalyticsGraphParams) model.GraphFilter {
+	filter := model.GraphFilter{
+		MinWeight: params.MinWeight.Or(1),
+		Limit:     params.Limit.Or(analyticsService.DefaultEdgesLimit),
+	}
+	if from, ok := params.From.Get(); ok {
+		filter.From = &from
+	}
+	if to, ok := params.To.Get(); ok {
+		filter.To = &to
+	}
+	if communityId, ok := params.CommunityID.Get(); ok {
+		filter.CommunityID = &communityId
+	}
+	for _, kind := range params.Kinds {
+		filter.Kinds = append(filter.Kinds, string(kind))
+	}
+	return filter
+}
+
+func exportFilter(params forumApi.AnalyticsGraphExportParams) model.GraphFilter {
+	return graphFilter(forumApi.AnalyticsGraphParams{
+		From:        params.From,
+		To:          params.To,
+		Kinds:       params.Kinds,
+		CommunityID: params.CommunityID,
+		MinWeight:   params.MinWeight,
+	})
+}
+
+func convertGraph(graph model.InteractionGraph) *forumApi.InteractionGraphResponse {
+	res := &forumApi.InteractionGraphResponse{
+		Nodes:     make([]forumApi.GraphNode, len(graph.Nodes)),
+		Edges:     make([]forumApi.GraphEdge, len(graph.Edges)),
+		Truncated: graph.Truncated,
+	}
+	for i, node := range graph.Nodes {
+		res.Nodes[i] = forumApi.GraphNode{
+			UserID:     node.UserID,
+			Name:       node.Name,
+			Karma:      node.Karma,
+			PostsCount: node.PostsCount,
+			InDegree:   node.InDegree,
+			OutDegree:  node.OutDegree,
+			InWeight:   node.InWeight,
+			OutWeight:  node.OutWeight,
+		}
+	}
+	for i, edge := range graph.Edges {
+		res.Edges[i] = forumApi.GraphEdge{
+			Source:   edge.SourceID,
+			Target:   edge.TargetID,
+			Weight:   edge.Weight,
+			Replies:  edge.Replies,
+			Mentions: edge.Mentions,
+			Votes:    edge.Votes,
+		}
+	}
+	return res
+}
+
+func convertSnapshot(snapshot model.InfluenceSnapshot) forumApi.InfluenceSnapshot {
+	res := forumApi.InfluenceSnapshot{
+		ID:         snapshot.ID,
+		UsersCount: snapshot.UsersCount,
+		EdgesCount: snapshot.EdgesCount,
+		DurationMs: int(snapshot.Duration.Milliseconds()),
+		CreatedAt:  snapshot.CreatedAt,
+	}
+	if snapshot.CommunityID != nil {
+		res.CommunityID = forumApi.NewOptInt(*snapshot.CommunityID)
+	}
+	if snapshot.From != nil {
+		res.WindowFrom = forumApi.NewOptDateTime(*snapshot.From)
+	}
+	return res
+}
+
+func convertScore(score model.InfluenceScore) forumApi.InfluenceScore {
+	return forumApi.InfluenceScore{
+		UserID:         score.UserID,
+		Name:           score.Name,
+		Rank:           score.Rank,
+		Pagerank:       score.PageRank,
+		Betweenness:    score.Betweenness,
+		InDegree:       score.InDegree,
+		OutDegree:      score.OutDegree,
+		InWeight:       score.InWeight,
+		OutWeight:      score.OutWeight,
+		WeightedDegree: score.InWeight + score.OutWeight,
+	}
+}
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package attachments
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/authctx"
+	attachmentsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/attachments"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+type AttachmentsHandler struct {
+	attachmentsService *attachmentsService.AttachmentsService
+}
+
+func NewAttachmentsHandler(attachmentsService *attachmentsService.AttachmentsService) *AttachmentsHandler {
+	return &AttachmentsHandler{attachmentsService: attachmentsService}
+}
+
+func (h *AttachmentsHandler) AttachmentUpload(
+	ctx context.Context, req *forumApi.AttachmentUploadRequestMultipart) (forumApi.AttachmentUploadRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.AttachmentUploadUnauthorized("not authenticated")
+		return &res, nil
+	}
+	attachment, err := h.attachmentsService.Upload(ctx, userId, req.File.Name, req.File.File)
+	switch {
+	case err == nil:
+		res := ConvertAttachment(attachment)
+		return &res, nil
+	case errors.Is(err, attachmentsService.ErrEmptyFile):
+		res := forumApi.AttachmentUploadBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, attachmentsService.ErrTooLarge):
+		res := forumApi.AttachmentUploadRequestEntityTooLarge(
+			err.Error() + ", max size " + strconv.FormatInt(h.attachmentsService.MaxSize(), 10) + " bytes")
+		return &res, nil
+	case errors.Is(err, attachmentsService.ErrTypeNotAllowed):
+		res := forumApi.AttachmentUploadUnsupportedMediaType(err.Error())
+		return &res, nil
+	}
+	return nil, err
+}
+
+func (h *AttachmentsHandler) AttachmentGet(
+	ctx context.Context, params forumApi.AttachmentGetParams) (forumApi.AttachmentGetRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.AttachmentGetUnauthorized("not authenticated")
+		return &res, nil
+	}
+	attachment, err := h.attachmentsService.Get(ctx, userId, params.AttachmentId)
+	if errors.Is(err, model.ErrNotFound) {
+		res := forumApi.AttachmentGetNotFound("attachment not found")
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := ConvertAttachment(attachment)
+	return &res, nil
+}
+
+// Download streams file by signed url. It is plain http handler, generated server can not
+// set content type of response from stored file.
+func (h *AttachmentsHandler) Download(w http.ResponseWriter, r *http.Request) {
+	attachmentId, err := strconv.Atoi(r.PathValue("attachmentId"))
+	if err != nil || attachmentId <= 0 {
+		http.Error(w, "attachmentId is not a valid integer", http.StatusBadRequest)
+		return
+	}
+	expires, err := strconv.ParseInt(r.URL.Query().Get("expires"), 10, 64)
+	if err != nil {
+		http.Error(w, "expires is not a valid unix time", http.StatusBadRequest)
+		return
+	}
+	attachment, content, err := h.attachmentsService.Open(r.Context(), attachmentId,
+		r.URL.Query().Get("variant"), expires, r.URL.Query().Get("signature"))
+	switch {
+	case errors.Is(err, attachmentsService.ErrInvalidSignature):
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	case errors.Is(err, attachmentsService.ErrNotReady):
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case errors.Is(err, model.ErrNotFound):
+		http.Error(w, "attachment not found", http.StatusNotFound)
+		return
+	case err != nil:
+		log.Printf("failed to open attachment %d: %v", attachmentId, err)
+		http.Error(w, "failed to open attachment", http.StatusInternalServerError)
+		return
+	}
+	defer content.Close()
+
+	disposition := "attachment"
+	if strings.HasPrefix(attachment.ContentType, "image/") {
+		disposition = "inline"
+	}
+	maxAge := max(expires-time.Now().Unix(), 0)
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("Content-Disposition",
+		mime.FormatMediaType(disposition, map[string]string{"filename": attachment.FileName}))
+	w.Header().Set("Cache-Control", "private, max-age="+strconv.FormatInt(maxAge, 10))
+	// uploaded content is never interpreted as page of the site
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; sandbox")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, content); err != nil {
+		log.Printf("failed to send attachment %d: %v", attachmentId, err)
+	}
+}
+
+// ConvertAttachment converts attachment for api responses
+func ConvertAttachment(attachment model.Attachment) forumApi.Attachment {
+	res := forumApi.Attachment{
+		ID:           attachment.ID,
+		FileName:     attachment.FileName,
+		ContentType:  attachment.ContentType,
+		Size:         attachment.Size,
+		URL:          attachment.URL,
+		URLExpiresAt: attachment.URLExpiresAt,
+		CreatedAt:    attachment.CreatedAt,
+		Status:       forumApi.AttachmentStatus(attachment.Status),
+		Variants:     make([]forumApi.AttachmentVariant, len(attachment.Variants)),
+	}
+	if attachment.Width > 0 {
+		res.Width.SetTo(attachment.Width)
+		res.Height.SetTo(attachment.Height)
+	}
+	if attachment.Blurhash != "" {
+		res.Blurhash.SetTo(attachment.Blurhash)
+	}
+	for i, variant := range attachment.Variants {
+		res.Variants[i] = forumApi.AttachmentVariant{
+			Name:        variant.Name,
+			ContentType: variant.ContentType,
+			Width:       variant.Width,
+			Height:      variant.Height,
+			Size:        variant.Size,
+			URL:         variant.URL,
+		}
+	}
+	if attachment.ThreadID != nil {
+		res.ThreadID.SetTo(*attachment.ThreadID)
+	}
+	if attachment.PostID != nil {
+		res.PostID.SetTo(*attachment.PostID)
+	}
+	return res
+}
+
+func ConvertAttachments(attachments []model.Attachment) []forumApi.Attachment {
+	res := make([]forumApi.Attachment, len(attachments))
+	for i, attachment := range attachments {
+		res[i] = ConvertAttachment(attachment)
+	}
+	return res
+}
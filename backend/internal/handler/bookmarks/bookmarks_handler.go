@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package bookmarks
+
+import (
+	"context"
+	"errors"
+
+	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/authctx"
+	bookmarksService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/bookmarks"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+type BookmarksHandler struct {
+	bookmarksService *bookmarksService.BookmarksService
+}
+
+func NewBookmarksHandler(bookmarksService *bookmarksService.BookmarksService) *BookmarksHandler {
+	return &BookmarksHandler{bookmarksService: bookmarksService}
+}
+
+func (h *BookmarksHandler) BookmarkCreate(
+	ctx context.Context, req *forumApi.BookmarkCreateRequest) (forumApi.BookmarkCreateRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.BookmarkCreateUnauthorized("not authenticated")
+		return &res, nil
+	}
+	bookmarkCreate := model.BookmarkCreate{
+		UserID:     userId,
+		TargetType: string(req.TargetType),
+		TargetID:   req.TargetID,
+	}
+	if collectionID, ok := req.CollectionID.Get(); ok {
+		bookmarkCreate.CollectionID = &collectionID
+	}
+
+	bookmark, err := h.bookmarksService.Create(ctx, bookmarkCreate)
+	switch {
+	case err == nil:
+		res := convertBookmark(bookmark)
+		return &res, nil
+	case errors.Is(err, bookmarksService.ErrInvalidTarget):
+		res := forumApi.BookmarkCreateBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, bookmarksService.ErrNotOwnedByUser):
+		res := forumApi.BookmarkCreateForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.BookmarkCreateNotFound("bookmarked " + string(req.TargetType) + " or collection not found")
+		return &res, nil
+	}
+	return nil, err
+}
+
+func (h *BookmarksHandler) BookmarkDelete(
+	ctx context.Context, params forumApi.BookmarkDeleteParams) (forumApi.BookmarkDeleteRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.BookmarkDeleteUnauthorized("not authenticated")
+		return &res, nil
+	}
+	err := h.bookmarksService.Delete(ctx, userId, params.BookmarkId)
+	if errors.Is(err, model.ErrNotFound) {
+		res := forumApi.BookmarkDeleteNotFound("bookmark not found")
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &forumApi.BookmarkDeleteNoContent{}, nil
+}
+
+func (h *BookmarksHandler) BookmarksList(
+	ctx context.Context, params forumApi.BookmarksListParams) (forumApi.BookmarksListRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.BookmarksListUnauthorized("not authenticated")
+		return &res, nil
+	}
+	var collectionId *int
+	if id, ok := params.CollectionID.Get(); ok {
+		collectionId = &id
+	}
+
+	list, err := h.bookmarksService.List(ctx, userId, collectionId,
+		params.Before.Or(0), params.Limit.Or(bookmarksService.DefaultLimit))
+	switch {
+	case errors.Is(err, bookmarksService.ErrNotOwnedByUser):
+		res := forumApi.BookmarksListForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.BookmarksListNotFound("collection not found")
+		return &res, nil
+	case err != nil:
+		return nil, err
+	}
+
+	bookmarks := make([]forumApi.BookmarkListItem, len(list.Bookmarks))
+	for i, item := range list.Bookmarks {
+		thread := forumApi.ThreadListItem{
+			ID:           item.Thread.ID,
+			Title:        item.Thread.Title,
+			Content:      item.Thread.Content,
+			ContentHTML:  item.Thread.ContentHTML,
+			AuthorID:     item.Thread.AuthorID,
+			AuthorName:   item.Thread.AuthorName,
+			AuthorRank:   item.Thread.AuthorRank,
+			PostsCount:   item.Thread.PostsCount,
+			Score:        item.Thread.Score,
+			IsBookmarked: item.Thread.IsBookmarked,
+			CreatedAt:    item.Thread.CreatedAt,
+		}
+		if item.Thread.CommunityID != nil {
+			thread.CommunityID.SetTo(*item.Thread.CommunityID)
+		}
+		bookmarks[i] = forumApi.BookmarkListItem{
+			Bookmark: convertBookmark(item.Bookmark),
+			Thread:   thread,
+		}
+	}
+	return &forumApi.BookmarkListResponse{
+		Bookmarks: bookmarks,
+		HaveNext:  list.HaveNext,
+	}, nil
+}
+
+func (h *BookmarksHandler) BookmarkCollectionCreate(
+	ctx context.Context, req *forumApi.BookmarkCollectionCreateRequest) (forumApi.BookmarkCollectionCreateRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.BookmarkCollectionCreateUnauthorized("not authenticated")
+		return &res, nil
+	}
+	collection, err := h.bookmarksService.CreateCollection(ctx, userId, req.Name)
+	if errors.Is(err, bookmarksService.ErrEmptyName) {
+		res := forumApi.BookmarkCollectionCreateBadRequest(err.Error())
+		return &res, nil
+	}
+	if errors.Is(err, bookmarksService.ErrNameTaken) {
+		res := forumApi.BookmarkCollectionCreateConflict(err.Error())
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := convertCollection(collection)
+	return &res, nil
+}
+
+func (h *BookmarksHandler) BookmarkCollectionsList(ctx context.Context) (forumApi.BookmarkCollectionsListRes, error) {
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.BookmarkCollectionsListUnauthorized("not authenticated")
+		return &res, nil
+	}
+	collections, err := h.bookmarksService.ListCollections(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	res := make(forumApi.BookmarkCollectionsListOKApplicationJSON, len(collections))
+	for i, collection := range collections {
+		res[i] = convertCollection(collection)
+	}
+	return &res, nil
+}
+
+func (h *BookmarksHandler) BookmarkCollectionDelete(
+	ctx context.Context, params forumApi.BookmarkCollectionDeleteParams) (forumApi.BookmarkCollectionDeleteRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.BookmarkCollectionDeleteUnauthorized("not authenticated")
+		return &res, nil
+	}
+	err := h.bookmarksService.DeleteCollection(ctx, userId, params.CollectionId)
+	if errors.Is(err, model.ErrNotFound) {
+		res := forumApi.BookmarkCollectionDeleteNotFound("collection not found")
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &forumApi.BookmarkCollectionDeleteNoContent{}, nil
+}
+
+func convertBookmark(bookmark model.Bookmark) forumApi.Bookmark {
+	res := forumApi.Bookmark{
+		ID:         bookmark.ID,
+		TargetType: forumApi.BookmarkTargetType(bookmark.TargetType),
+		TargetID:   bookmark.TargetID,
+		ThreadID:   bookmark.ThreadID,
+		CreatedAt:  bookmark.CreatedAt,
+	}
+	if bookmark.CollectionID != nil {
+		res.CollectionID.SetTo(*bookmark.CollectionID)
+	}
+	return res
+}
+
+func convertCollection(collection model.BookmarkCollection) forumApi.BookmarkCollection {
+	return forumApi.BookmarkCollection{
+		ID:        collection.ID,
+		Name:      collection.Name,
+		CreatedAt: collection.CreatedAt,
+	}
+}
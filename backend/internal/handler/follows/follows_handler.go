@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package follows
+
+import (
+	"context"
+	"errors"
+
+	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/authctx"
+	followsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/follows"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+type FollowsHandler struct {
+	followsService *followsService.FollowsService
+}
+
+func NewFollowsHandler(followsService *followsService.FollowsService) *FollowsHandler {
+	return &FollowsHandler{followsService: followsService}
+}
+
+func (h *FollowsHandler) UserFollow(
+	ctx context.Context, params forumApi.UserFollowParams) (forumApi.UserFollowRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.UserFollowUnauthorized("not authenticated")
+		return &res, nil
+	}
+	follow, err := h.followsService.Follow(ctx, userId, params.UserId)
+	switch {
+	case err == nil:
+		res := convertFollow(follow)
+		return &res, nil
+	case errors.Is(err, followsService.ErrFollowSelf):
+		res := forumApi.UserFollowBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrBlockedByUser):
+		res := forumApi.UserFollowForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.UserFollowNotFound("user not found")
+		return &res, nil
+	}
+	return nil, err
+}
+
+func (h *FollowsHandler) UserUnfollow(
+	ctx context.Context, params forumApi.UserUnfollowParams) (forumApi.UserUnfollowRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.UserUnfollowUnauthorized("not authenticated")
+		return &res, nil
+	}
+	err := h.followsService.Unfollow(ctx, userId, params.UserId)
+	if errors.Is(err, model.ErrNotFound) {
+		res := forumApi.UserUnfollowNotFound("user is not followed")
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &forumApi.UserUnfollowNoContent{}, nil
+}
+
+func (h *FollowsHandler) UserFollowers(
+	ctx context.Context, params forumApi.UserFollowersParams) (forumApi.UserFollowersRes, error) {
+
+	list, err := h.followsService.Followers(ctx, params.UserId,
+		params.Before.Or(0), params.Limit.Or(followsService.DefaultLimit))
+	if err != nil {
+		return nil, err
+	}
+	return convertFollowList(list), nil
+}
+
+func (h *FollowsHandler) UserFollowing(
+	ctx context.Context, params forumApi.UserFollowingParams) (forumApi.UserFollowingRes, error) {
+
+	list, err := h.followsService.Following(ctx, params.UserId,
+		params.Before.Or(0), params.Limit.Or(followsService.DefaultLimit))
+	if err != nil {
+		return nil, err
+	}
+	return convertFollowList(list), nil
+}
+
+func (h *FollowsHandler) CommunitySubscriptionsList(
+	ctx context.Context) (forumApi.CommunitySubscriptionsListRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.CommunitySubscriptionsListUnauthorized("not authenticated")
+		return &res, nil
+	}
+	subscriptions, err := h.followsService.CommunitySubscriptions(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	res := &forumApi.CommunitySubscriptionListResponse{
+		Subscriptions: make([]forumApi.CommunitySubscription, len(subscriptions)),
+	}
+	for i, subscription := range subscriptions {
+		res.Subscriptions[i] = convertCommunitySubscription(subscription)
+	}
+	return res, nil
+}
+
+func (h *FollowsHandler) CommunitySubscribe(
+	ctx context.Context, params forumApi.CommunitySubscribeParams) (forumApi.CommunitySubscribeRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.CommunitySubscribeUnauthorized("not authenticated")
+		return &res, nil
+	}
+	subscription, err := h.followsService.SubscribeCommunity(ctx, userId, params.CommunityId)
+	if errors.Is(err, model.ErrNotFound) {
+		res := forumApi.CommunitySubscribeNotFound("community not found")
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := convertCommunitySubscription(subscription)
+	return &res, nil
+}
+
+func (h *FollowsHandler) CommunityUnsubscribe(
+	ctx context.Context, params forumApi.CommunityUnsubscribeParams) (forumApi.CommunityUnsubscribeRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.CommunityUnsubscribeUnauthorized("not authenticated")
+		return &res, nil
+	}
+	err := h.followsService.UnsubscribeCommunity(ctx, userId, params.CommunityId)
+	if errors.Is(err, model.ErrNotFound) {
+		res := forumApi.CommunityUnsubscribeNotFound("community is not subscribed")
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &forumApi.CommunityUnsubscribeNoContent{}, nil
+}
+
+func (h *FollowsHandler) FeedGet(ctx context.Context, params forumApi.FeedGetParams) (forumApi.FeedGetRes, error) {
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.FeedGetUnauthorized("not authenticated")
+		return &res, nil
+	}
+	feed, err := h.followsService.Feed(ctx, userId, params.Cursor.Or(""), params.Limit.Or(followsService.DefaultLimit))
+	if errors.Is(err, followsService.ErrInvalidCursor) {
+		res := forumApi.FeedGetBadRequest(err.Error())
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := &forumApi.FeedResponse{Items: make([]forumApi.FeedItem, len(feed.Items))}
+	for i, item := range feed.Items {
+		res.Items[i] = forumApi.FeedItem{
+			Kind:        forumApi.FeedItemKind(item.Kind),
+			ID:          item.ID,
+			ThreadID:    item.ThreadID,
+			ThreadTitle: item.ThreadTitle,
+			ContentHTML: item.ContentHTML,
+			AuthorID:    item.Author.ID,
+			AuthorName:  item.Author.Name,
+			AuthorRank:  item.Author.Rank,
+			CreatedAt:   item.CreatedAt,
+		}
+		if item.CommunityID != nil {
+			res.Items[i].CommunityID = forumApi.NewOptInt(*item.CommunityID)
+		}
+	}
+	if feed.Next != nil {
+		res.NextCursor = forumApi.NewOptString(followsService.FormatCursor(*feed.Next))
+	}
+	return res, nil
+}
+
+func convertFollow(follow model.Follow) forumApi.FollowUser {
+	return forumApi.FollowUser{
+		ID:         follow.ID,
+		UserID:     follow.User.ID,
+		UserName:   follow.User.Name,
+		UserRank:   follow.User.Rank,
+		FollowedAt: follow.FollowedAt,
+	}
+}
+
+func convertFollowList(list model.FollowList) *forumApi.FollowListResponse {
+	res := &forumApi.FollowListResponse{
+		Users:    make([]forumApi.FollowUser, len(list.Follows)),
+		Total:    list.Total,
+		HaveNext: list.HaveNext,
+	}
+	for i, follow := range list.Follows {
+		res.Users[i] = convertFollow(follow)
+	}
+	return res
+}
+
+func convertCommunitySubscription(subscription model.CommunitySubscription) forumApi.CommunitySubscription {
+	return forumApi.CommunitySubscription{
+		CommunityID:   subscription.CommunityID,
+		CommunityName: subscription.CommunityName,
+		CreatedAt:     subscription.CreatedAt,
+	}
+}
@@ -28,9 +28,104 @@ func trimTrailingSlashes(u *url.URL) {
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	AnalyticsInvoker
+	AttachmentsInvoker
 	AuthInvoker
+	BookmarksInvoker
+	FollowsInvoker
+	LiveInvoker
+	MentionsInvoker
+	MessagesInvoker
+	ModerationInvoker
+	NotificationsInvoker
+	PollsInvoker
+	RelationsInvoker
+	RevisionsInvoker
+	SearchInvoker
+	SubscriptionsInvoker
 	ThreadsInvoker
 	UserInvoker
+	VotesInvoker
+}
+
+// AnalyticsInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Analytics
+type AnalyticsInvoker interface {
+	// AnalyticsGraph invokes analyticsGraph operation.
+	//
+	// Directed weighted graph: edge goes from user who replied, mentioned or voted to user
+	// who received it, weight is number of interactions. Time window is counted in whole UTC days.
+	// The heaviest edges are returned, `truncated` is set when some edges were cut by `limit`.
+	//
+	// GET /api/analytics/graph
+	AnalyticsGraph(ctx context.Context, params AnalyticsGraphParams) (AnalyticsGraphRes, error)
+	// AnalyticsGraphExport invokes analyticsGraphExport operation.
+	//
+	// Whole graph by filter in GraphML, GEXF or zip archive with `nodes.csv` and `edges.csv`.
+	// Nodes carry user name, karma, posts count, degrees and weights, edges carry total weight
+	// and weights by interaction kind. The same export is made by `forum export-graph` command.
+	// File is streamed as graph is read from database, graph size is not limited.
+	// User name in `nodes.csv` starting with `=`, `+`, `-` or `@` is prefixed with `'`,
+	// so spreadsheets show it as text and do not evaluate it as formula.
+	//
+	// GET /api/analytics/graph/export
+	AnalyticsGraphExport(ctx context.Context, params AnalyticsGraphExportParams) (AnalyticsGraphExportRes, error)
+	// AnalyticsGraphRebuild invokes analyticsGraphRebuild operation.
+	//
+	// Graph is updated incrementally as users post and vote, rebuild also drops interactions
+	// with removed votes and hidden content.
+	//
+	// POST /api/analytics/graph/rebuild
+	AnalyticsGraphRebuild(ctx context.Context) (AnalyticsGraphRebuildRes, error)
+	// AnalyticsInfluenceSnapshot invokes analyticsInfluenceSnapshot operation.
+	//
+	// Compute influence snapshots of forum and communities now (admins only).
+	//
+	// POST /api/analytics/influence/snapshots
+	AnalyticsInfluenceSnapshot(ctx context.Context) (AnalyticsInfluenceSnapshotRes, error)
+	// AnalyticsLeaderboard invokes analyticsLeaderboard operation.
+	//
+	// Snapshots of forum and of every community are computed periodically, see
+	// `/api/analytics/influence/snapshots` to compute them now.
+	//
+	// GET /api/analytics/leaderboard
+	AnalyticsLeaderboard(ctx context.Context, params AnalyticsLeaderboardParams) (AnalyticsLeaderboardRes, error)
+	// AnalyticsUserInfluence invokes analyticsUserInfluence operation.
+	//
+	// Influence of user in the latest snapshots of forum and communities (admins only).
+	//
+	// GET /api/analytics/users/{userId}
+	AnalyticsUserInfluence(ctx context.Context, params AnalyticsUserInfluenceParams) (AnalyticsUserInfluenceRes, error)
+}
+
+// AttachmentsInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Attachments
+type AttachmentsInvoker interface {
+	// AttachmentDownload invokes attachmentDownload operation.
+	//
+	// Url with `expires` and `signature` is returned in attachment `url`, no other authorization
+	// is needed, so it can be used in `<img src>`. Images are shown inline, other files are downloaded.
+	// Images are downloadable after background processing (metadata removal, thumbnails).
+	//
+	// GET /api/attachments/{attachmentId}/download
+	AttachmentDownload(ctx context.Context, params AttachmentDownloadParams) (AttachmentDownloadRes, error)
+	// AttachmentGet invokes attachmentGet operation.
+	//
+	// Not attached file is visible only to user uploaded it.
+	//
+	// GET /api/attachments/{attachmentId}
+	AttachmentGet(ctx context.Context, params AttachmentGetParams) (AttachmentGetRes, error)
+	// AttachmentUpload invokes attachmentUpload operation.
+	//
+	// File type is detected by content, not by name or declared type, and must be allowed by server
+	// configuration. Uploaded file is attached by passing its id in `attachment_ids` of created thread
+	// or post, files not attached during a day (configurable) are removed.
+	// Download `url` is signed and valid until `url_expires_at`.
+	//
+	// POST /api/attachments
+	AttachmentUpload(ctx context.Context, request *AttachmentUploadRequestMultipart) (AttachmentUploadRes, error)
 }
 
 // AuthInvoker invokes operations described by OpenAPI v3 specification.
@@ -57,10 +152,434 @@ type AuthInvoker interface {
 	AuthRefresh(ctx context.Context) (AuthRefreshRes, error)
 }
 
+// BookmarksInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Bookmarks
+type BookmarksInvoker interface {
+	// BookmarkCollectionCreate invokes bookmarkCollectionCreate operation.
+	//
+	// Collection names of user are unique, existing name is a conflict (409).
+	//
+	// POST /api/bookmarks/collections
+	BookmarkCollectionCreate(ctx context.Context, request *BookmarkCollectionCreateRequest) (BookmarkCollectionCreateRes, error)
+	// BookmarkCollectionDelete invokes bookmarkCollectionDelete operation.
+	//
+	// Delete bookmark collection, its bookmarks are kept without collection.
+	//
+	// DELETE /api/bookmarks/collections/{collectionId}
+	BookmarkCollectionDelete(ctx context.Context, params BookmarkCollectionDeleteParams) (BookmarkCollectionDeleteRes, error)
+	// BookmarkCollectionsList invokes bookmarkCollectionsList operation.
+	//
+	// List bookmark collections of current user.
+	//
+	// GET /api/bookmarks/collections
+	BookmarkCollectionsList(ctx context.Context) (BookmarkCollectionsListRes, error)
+	// BookmarkCreate invokes bookmarkCreate operation.
+	//
+	// Bookmarking already bookmarked thread or post moves bookmark to given collection
+	// (or out of collection if collection is not set).
+	//
+	// POST /api/bookmarks
+	BookmarkCreate(ctx context.Context, request *BookmarkCreateRequest) (BookmarkCreateRes, error)
+	// BookmarkDelete invokes bookmarkDelete operation.
+	//
+	// Remove bookmark.
+	//
+	// DELETE /api/bookmarks/{bookmarkId}
+	BookmarkDelete(ctx context.Context, params BookmarkDeleteParams) (BookmarkDeleteRes, error)
+	// BookmarksList invokes bookmarksList operation.
+	//
+	// Bookmarks are ordered from newest to oldest with current info of bookmarked thread
+	// (or thread of bookmarked post). For next page pass id of last bookmark as `before`.
+	//
+	// GET /api/bookmarks
+	BookmarksList(ctx context.Context, params BookmarksListParams) (BookmarksListRes, error)
+}
+
+// FollowsInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Follows
+type FollowsInvoker interface {
+	// CommunitySubscribe invokes communitySubscribe operation.
+	//
+	// New threads of subscribed communities are shown in feed. Subscribing is idempotent.
+	//
+	// PUT /api/communities/{communityId}/subscription
+	CommunitySubscribe(ctx context.Context, params CommunitySubscribeParams) (CommunitySubscribeRes, error)
+	// CommunitySubscriptionsList invokes communitySubscriptionsList operation.
+	//
+	// List communities subscribed by current user, ordered by name.
+	//
+	// GET /api/communities/subscriptions
+	CommunitySubscriptionsList(ctx context.Context) (CommunitySubscriptionsListRes, error)
+	// CommunityUnsubscribe invokes communityUnsubscribe operation.
+	//
+	// Unsubscribe from community.
+	//
+	// DELETE /api/communities/{communityId}/subscription
+	CommunityUnsubscribe(ctx context.Context, params CommunityUnsubscribeParams) (CommunityUnsubscribeRes, error)
+	// FeedGet invokes feedGet operation.
+	//
+	// Threads and posts of followed users and new threads of subscribed communities, the newest first.
+	// Own threads and content of blocked and ignored users are skipped.
+	//
+	// GET /api/feed
+	FeedGet(ctx context.Context, params FeedGetParams) (FeedGetRes, error)
+	// UserFollow invokes userFollow operation.
+	//
+	// Threads and posts of followed users are shown in feed. Following is idempotent,
+	// user blocked by target can not follow them.
+	//
+	// PUT /api/user/{userId}/follow
+	UserFollow(ctx context.Context, params UserFollowParams) (UserFollowRes, error)
+	// UserFollowers invokes userFollowers operation.
+	//
+	// List followers of user, the latest first.
+	//
+	// GET /api/user/{userId}/followers
+	UserFollowers(ctx context.Context, params UserFollowersParams) (UserFollowersRes, error)
+	// UserFollowing invokes userFollowing operation.
+	//
+	// List users followed by user, the latest first.
+	//
+	// GET /api/user/{userId}/following
+	UserFollowing(ctx context.Context, params UserFollowingParams) (UserFollowingRes, error)
+	// UserUnfollow invokes userUnfollow operation.
+	//
+	// Unfollow user.
+	//
+	// DELETE /api/user/{userId}/follow
+	UserUnfollow(ctx context.Context, params UserUnfollowParams) (UserUnfollowRes, error)
+}
+
+// LiveInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Live
+type LiveInvoker interface {
+	// LiveThread invokes liveThread operation.
+	//
+	// The same as `/api/live/threads` with events of one thread only.
+	//
+	// GET /api/live/threads/{threadId}
+	LiveThread(ctx context.Context, params LiveThreadParams) (LiveThreadRes, error)
+	// LiveThreads invokes liveThreads operation.
+	//
+	// Events `thread_created`, `thread_deleted`, `post_created`, `post_updated`, `post_deleted`
+	// and `vote_changed` are sent with increasing `id` and json data. Thread or post hidden
+	// by moderator is reported as deleted. Reconnected client sends `Last-Event-ID` header
+	// (or `last_event_id` query parameter) and gets missed events. If missed events are not kept
+	// anymore, `reset` event is sent and client should reload data.
+	// Idle connection gets `: ping` comment every heartbeat interval.
+	// Client which does not read events fast enough is disconnected and should reconnect.
+	//
+	// GET /api/live/threads
+	LiveThreads(ctx context.Context, params LiveThreadsParams) (LiveThreadsRes, error)
+}
+
+// MentionsInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Mentions
+type MentionsInvoker interface {
+	// MentionUsers invokes mentionUsers operation.
+	//
+	// Prefix is case-insensitive, leading `@` is ignored.
+	// Users with names which can not be mentioned (for example with spaces) are skipped.
+	//
+	// GET /api/mentions/users
+	MentionUsers(ctx context.Context, params MentionUsersParams) (MentionUsersRes, error)
+}
+
+// MessagesInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Messages
+type MessagesInvoker interface {
+	// ConversationCreate invokes conversationCreate operation.
+	//
+	// Message to one user without title is added to existing conversation of the two users
+	// if there is one. Users who blocked current user can not be added to conversation.
+	//
+	// POST /api/conversations
+	ConversationCreate(ctx context.Context, request *ConversationCreateRequest) (ConversationCreateRes, error)
+	// ConversationMarkRead invokes conversationMarkRead operation.
+	//
+	// Mark messages of conversation as read.
+	//
+	// POST /api/conversations/{conversationId}/read
+	ConversationMarkRead(ctx context.Context, request OptConversationMarkReadRequest, params ConversationMarkReadParams) (ConversationMarkReadRes, error)
+	// ConversationMessages invokes conversationMessages operation.
+	//
+	// Messages are ordered from newest to oldest. Conversation is visible only to its members,
+	// for others it does not exist.
+	//
+	// GET /api/conversations/{conversationId}/messages
+	ConversationMessages(ctx context.Context, params ConversationMessagesParams) (ConversationMessagesRes, error)
+	// ConversationSend invokes conversationSend operation.
+	//
+	// Message can not be sent if any other member blocked current user.
+	//
+	// POST /api/conversations/{conversationId}/messages
+	ConversationSend(ctx context.Context, request *MessageSendRequest, params ConversationSendParams) (ConversationSendRes, error)
+	// ConversationsList invokes conversationsList operation.
+	//
+	// Conversations are ordered by the latest message, newest first.
+	//
+	// GET /api/conversations
+	ConversationsList(ctx context.Context, params ConversationsListParams) (ConversationsListRes, error)
+	// ConversationsUnreadCount invokes conversationsUnreadCount operation.
+	//
+	// Count unread private messages of current user.
+	//
+	// GET /api/conversations/unread-count
+	ConversationsUnreadCount(ctx context.Context) (ConversationsUnreadCountRes, error)
+}
+
+// ModerationInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Moderation
+type ModerationInvoker interface {
+	// ModerationDecideHeld invokes moderationDecideHeld operation.
+	//
+	// Approved content is published with notifications and live events of new content, rejected
+	// content is hidden and author is notified. Approved edit becomes new revision, rejected edit
+	// is discarded. Decision is recorded in moderation log (as approve, hide or reject for edit)
+	// and trains spam classifier.
+	//
+	// POST /api/moderation/held/{heldId}
+	ModerationDecideHeld(ctx context.Context, request *HeldDecisionRequest, params ModerationDecideHeldParams) (ModerationDecideHeldRes, error)
+	// ModerationHeld invokes moderationHeld operation.
+	//
+	// Held content is visible only to moderators until decision, newest first.
+	// For next page pass id of last item as `before`.
+	//
+	// GET /api/moderation/held
+	ModerationHeld(ctx context.Context, params ModerationHeldParams) (ModerationHeldRes, error)
+	// ModerationLog invokes moderationLog operation.
+	//
+	// For next page pass id of last entry as `before`.
+	//
+	// GET /api/moderation/log
+	ModerationLog(ctx context.Context, params ModerationLogParams) (ModerationLogRes, error)
+	// ModerationQueue invokes moderationQueue operation.
+	//
+	// Groups are ordered by the latest report, newest first.
+	// For next page pass `last_report_id` of last group as `before`.
+	//
+	// GET /api/moderation/reports
+	ModerationQueue(ctx context.Context, params ModerationQueueParams) (ModerationQueueRes, error)
+	// ModerationResolve invokes moderationResolve operation.
+	//
+	// All open reports of target are resolved and action is recorded in moderation log.
+	// Hide is allowed for threads and posts, warn and suspend apply to content author (or reported user)
+	// and are not allowed for moderators. Author is notified about every action except dismiss.
+	//
+	// POST /api/moderation/reports/{targetType}/{targetId}/resolve
+	ModerationResolve(ctx context.Context, request *ModerationResolveRequest, params ModerationResolveParams) (ModerationResolveRes, error)
+	// ReportCreate invokes reportCreate operation.
+	//
+	// User has one open report of the same target, repeated report is rejected until moderator resolves
+	// it.
+	// Reason other requires text. Own threads and posts can not be reported.
+	//
+	// POST /api/reports
+	ReportCreate(ctx context.Context, request *ReportRequest) (ReportCreateRes, error)
+}
+
+// NotificationsInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Notifications
+type NotificationsInvoker interface {
+	// NotificationPreferencesGet invokes notificationPreferencesGet operation.
+	//
+	// Delivery settings of every notification type.
+	//
+	// GET /api/notifications/preferences
+	NotificationPreferencesGet(ctx context.Context) (NotificationPreferencesGetRes, error)
+	// NotificationPreferencesUpdate invokes notificationPreferencesUpdate operation.
+	//
+	// Types missing in request keep current setting. Notifications of disabled types are not created.
+	// Response has settings of all types.
+	//
+	// PUT /api/notifications/preferences
+	NotificationPreferencesUpdate(ctx context.Context, request []NotificationPreference) (NotificationPreferencesUpdateRes, error)
+	// NotificationsList invokes notificationsList operation.
+	//
+	// Notifications are ordered from newest to oldest.
+	// For next page pass id of last notification as `before`.
+	//
+	// GET /api/notifications
+	NotificationsList(ctx context.Context, params NotificationsListParams) (NotificationsListRes, error)
+	// NotificationsMarkRead invokes notificationsMarkRead operation.
+	//
+	// Mark notifications as read.
+	//
+	// POST /api/notifications/read
+	NotificationsMarkRead(ctx context.Context, request *NotificationMarkReadRequest) (NotificationsMarkReadRes, error)
+	// NotificationsReadAll invokes notificationsReadAll operation.
+	//
+	// Mark all notifications of current user as read.
+	//
+	// POST /api/notifications/read-all
+	NotificationsReadAll(ctx context.Context) (NotificationsReadAllRes, error)
+	// NotificationsUnreadCount invokes notificationsUnreadCount operation.
+	//
+	// Number of unread notifications of current user.
+	//
+	// GET /api/notifications/unread-count
+	NotificationsUnreadCount(ctx context.Context) (NotificationsUnreadCountRes, error)
+}
+
+// PollsInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Polls
+type PollsInvoker interface {
+	// PollVote invokes pollVote operation.
+	//
+	// User votes once, vote can not be changed. Single choice poll accepts exactly one option.
+	//
+	// POST /api/threads/{threadId}/poll/vote
+	PollVote(ctx context.Context, request *PollVoteRequest, params PollVoteParams) (PollVoteRes, error)
+}
+
+// RelationsInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Relations
+type RelationsInvoker interface {
+	// RelationDelete invokes relationDelete operation.
+	//
+	// Unblock or unignore user.
+	//
+	// DELETE /api/relations/{userId}
+	RelationDelete(ctx context.Context, params RelationDeleteParams) (RelationDeleteRes, error)
+	// RelationSet invokes relationSet operation.
+	//
+	// Previous relation to user is replaced. Moderators can be ignored, but not blocked.
+	//
+	// PUT /api/relations/{userId}
+	RelationSet(ctx context.Context, request *RelationSetRequest, params RelationSetParams) (RelationSetRes, error)
+	// RelationsList invokes relationsList operation.
+	//
+	// Blocked users can not reply to threads and posts of current user or mention them,
+	// content of blocked and ignored users is marked with `author_ignored` and their
+	// notifications are not shown.
+	// Relations are ordered from newest to oldest.
+	//
+	// GET /api/relations
+	RelationsList(ctx context.Context, params RelationsListParams) (RelationsListRes, error)
+}
+
+// RevisionsInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Revisions
+type RevisionsInvoker interface {
+	// PostEdit invokes postEdit operation.
+	//
+	// Post author and moderators can edit. Every edit is kept as revision of post.
+	// Edit is checked by content filters as new post: it may be censored, rejected (400)
+	// or held for moderator review. Held edit does not change post, it becomes new revision
+	// after approval. Suspended users can not edit.
+	//
+	// PATCH /api/posts/{postId}
+	PostEdit(ctx context.Context, request *PostEditRequest, params PostEditParams) (PostEditRes, error)
+	// PostRevisionRestore invokes postRevisionRestore operation.
+	//
+	// Only moderators can restore. Content of old revision becomes current as new revision.
+	// Restored content is checked by content filters as edit.
+	//
+	// POST /api/posts/{postId}/revisions/{revision}/restore
+	PostRevisionRestore(ctx context.Context, params PostRevisionRestoreParams) (PostRevisionRestoreRes, error)
+	// PostRevisions invokes postRevisions operation.
+	//
+	// Revisions from the oldest, revision 1 is the original post. Never edited post has only revision 1.
+	//
+	// GET /api/posts/{postId}/revisions
+	PostRevisions(ctx context.Context, params PostRevisionsParams) (PostRevisionsRes, error)
+	// PostRevisionsDiff invokes postRevisionsDiff operation.
+	//
+	// Difference between two revisions of post.
+	//
+	// GET /api/posts/{postId}/revisions/diff
+	PostRevisionsDiff(ctx context.Context, params PostRevisionsDiffParams) (PostRevisionsDiffRes, error)
+	// ThreadRevisionRestore invokes threadRevisionRestore operation.
+	//
+	// Only moderators can restore. Content of old revision becomes current as new revision.
+	// Restored content is checked by content filters as edit.
+	//
+	// POST /api/threads/{threadId}/revisions/{revision}/restore
+	ThreadRevisionRestore(ctx context.Context, params ThreadRevisionRestoreParams) (ThreadRevisionRestoreRes, error)
+	// ThreadRevisions invokes threadRevisions operation.
+	//
+	// Revisions from the oldest, revision 1 is the original thread. Never edited thread has only
+	// revision 1.
+	//
+	// GET /api/threads/{threadId}/revisions
+	ThreadRevisions(ctx context.Context, params ThreadRevisionsParams) (ThreadRevisionsRes, error)
+	// ThreadRevisionsDiff invokes threadRevisionsDiff operation.
+	//
+	// Difference between two revisions of thread.
+	//
+	// GET /api/threads/{threadId}/revisions/diff
+	ThreadRevisionsDiff(ctx context.Context, params ThreadRevisionsDiffParams) (ThreadRevisionsDiffRes, error)
+}
+
+// SearchInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Search
+type SearchInvoker interface {
+	// Search invokes search operation.
+	//
+	// Search threads (title and content) and posts (content). Query uses web search syntax
+	// (`"quoted phrase"`, `or`, `-excluded`) and is matched with russian and english configurations.
+	// Results are ordered by rank, matched words in snippet are wrapped with `<mark>` tags,
+	// the rest of snippet is html escaped.
+	// For next page pass `next_cursor` from response as `cursor` with the same query and filters.
+	//
+	// GET /api/search
+	Search(ctx context.Context, params SearchParams) (SearchRes, error)
+}
+
+// SubscriptionsInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Subscriptions
+type SubscriptionsInvoker interface {
+	// SubscriptionsList invokes subscriptionsList operation.
+	//
+	// Subscriptions are ordered from newest thread to oldest with current thread info.
+	// For next page pass thread id of last subscription as `before`.
+	//
+	// GET /api/subscriptions
+	SubscriptionsList(ctx context.Context, params SubscriptionsListParams) (SubscriptionsListRes, error)
+	// ThreadSubscribe invokes threadSubscribe operation.
+	//
+	// Thread author is subscribed as `watching` on thread creation, poster - on the first post
+	// in thread. Level set by user is kept by automatic subscription.
+	//
+	// PUT /api/threads/{threadId}/subscription
+	ThreadSubscribe(ctx context.Context, request *ThreadSubscriptionRequest, params ThreadSubscribeParams) (ThreadSubscribeRes, error)
+	// ThreadSubscriptionGet invokes threadSubscriptionGet operation.
+	//
+	// Subscription of current user to thread.
+	//
+	// GET /api/threads/{threadId}/subscription
+	ThreadSubscriptionGet(ctx context.Context, params ThreadSubscriptionGetParams) (ThreadSubscriptionGetRes, error)
+	// ThreadUnsubscribe invokes threadUnsubscribe operation.
+	//
+	// User is subscribed again on next post in thread, set `muted` level to stop notifications.
+	//
+	// DELETE /api/threads/{threadId}/subscription
+	ThreadUnsubscribe(ctx context.Context, params ThreadUnsubscribeParams) (ThreadUnsubscribeRes, error)
+}
+
 // ThreadsInvoker invokes operations described by OpenAPI v3 specification.
 //
 // x-gen-operation-group: Threads
 type ThreadsInvoker interface {
+	// ThreadAcceptAnswer invokes threadAcceptAnswer operation.
+	//
+	// Only thread author can accept answer, own posts can not be accepted.
+	// Previously accepted post of thread is unaccepted. Accepted answers count in author rank.
+	//
+	// POST /api/threads/{threadId}/accept
+	ThreadAcceptAnswer(ctx context.Context, request *ThreadAcceptAnswerRequest, params ThreadAcceptAnswerParams) (ThreadAcceptAnswerRes, error)
 	// ThreadAddPost invokes threadAddPost operation.
 	//
 	// Add a new post to thread.
@@ -73,26 +592,73 @@ type ThreadsInvoker interface {
 	//
 	// POST /api/threads
 	ThreadCreate(ctx context.Context, request *ThreadCreateRequest) (ThreadCreateRes, error)
+	// ThreadEdit invokes threadEdit operation.
+	//
+	// Thread author and moderators can edit. Every edit is kept as revision of thread.
+	// Edit is checked by content filters as new thread: it may be censored, rejected (400)
+	// or held for moderator review. Held edit does not change thread, it becomes new revision
+	// after approval. Suspended users can not edit.
+	//
+	// PATCH /api/threads/{threadId}
+	ThreadEdit(ctx context.Context, request *ThreadEditRequest, params ThreadEditParams) (ThreadEditRes, error)
 	// ThreadGet invokes threadGet operation.
 	//
-	// Get single thread with all posts by thread id.
+	// Authorization is optional, authorized user also gets own poll vote, bookmark mark and ignored
+	// authors.
 	//
 	// GET /api/threads/{threadId}
 	ThreadGet(ctx context.Context, params ThreadGetParams) (ThreadGetRes, error)
+	// ThreadSetState invokes threadSetState operation.
+	//
+	// Only moderators can change thread state. Absent fields keep current value.
+	// Locked thread does not accept new posts, archived thread is read-only.
+	// In slow mode user can post to thread once in `slow_mode_seconds` (0 turns slow mode off).
+	// Threads without new posts are archived automatically after period set in server config.
+	//
+	// PATCH /api/threads/{threadId}/state
+	ThreadSetState(ctx context.Context, request *ThreadStateRequest, params ThreadSetStateParams) (ThreadSetStateRes, error)
 	// ThreadsList invokes threadsList operation.
 	//
-	// Get list of threads with pagination. You can use either page-based pagination (page + limit)
-	// or cursor-based pagination (after or before). The response includes an estimated total count
-	// of threads for better performance.
-	// Only one pagination method should be used at a time.
-	// If both are provided, page-based pagination will be used.
-	// `page` - will return that page
-	// `before` - return older threads with lower id than provided (provided id will not be included in
-	// response),
-	// `after` - return newer threads with higher id than provided (provided id will not be included in
-	// response).
-	// If no pagination parameters are provided, the first page will be returned.
-	// If no limit is provided, the default limit is used.
+	// Получить список веток с пагинацией. Можно
+	// использовать либо постраничную пагинацию (page + limit),
+	// либо курсорную пагинацию (after или before). Нужно
+	// использовать только один параметр.
+	// after, before или page с номером страницы. Если ни один не
+	// указан - выводятся самые свежие сообщения.
+	// limit - количество сообщений на страницу, по умолчанию 20.
+	// С разделением на страницы есть неприятная
+	// особенность. При удалении или добавлении новых
+	// сообщений,
+	// страницы могут "прыгать". Т.е. у нас есть список
+	// (сообщений) и в него могут добавляться и удаляться
+	// элементы
+	// в любом месте списка. Если мы находится на странице 3 и
+	// хотим 7-ю, то в ней могут быть совсем другие элементы,
+	// чем на момент запроса страницы 3. Поэтому для более
+	// стабильной пагинации можно использовать курсорную
+	// пагинацию.
+	// Навигация по номеру страницы выберает все сообщения
+	// на момент запроса и отдает нужную страницу.
+	// Добавление
+	// или удаление сообщений сбивает это разделение.
+	// Курсорная пагинация позволяет двигаться вперед и
+	// назад по списку, учитывая изменеия в нем.
+	// Но для нее нужно указывать минимальный или
+	// максимальный id сообщения на странице, чтобы понять
+	// откуда двигаться
+	// дальше. И она не позволяет прыгать на конкретную
+	// страницу, а только двигаться вперед и назад.
+	// При этом before и after не включаются в результат, т.е. если
+	// указать before=10, то в результат не попадет
+	// сообщение с id 10, а только с id меньше 10. И аналогично для
+	// after. В них указываются id сообщения, но
+	// before - для получения более старых сообщений, а after - для
+	// получения более новых сообщений по времени.
+	// Более старым сообщениям (before) соответствует меньший id
+	// (более старые сообщения),
+	// а более новым (after) - больший id. И при этом не важно,
+	// удалены эти сообщения или нет.
+	// Authorization is optional, authorized user also gets bookmark marks and ignored authors.
 	//
 	// GET /api/threads
 	ThreadsList(ctx context.Context, params ThreadsListParams) (ThreadsListRes, error)
@@ -116,7 +682,7 @@ type UserInvoker interface {
 	UserDelete(ctx context.Context, params UserDeleteParams) error
 	// UserGet invokes userGet operation.
 	//
-	// Get user information.
+	// Authorization is optional. Private fields (email) are returned only to the user and admins.
 	//
 	// GET /api/user/{userId}
 	UserGet(ctx context.Context, params UserGetParams) (UserGetRes, error)
@@ -126,12 +692,50 @@ type UserInvoker interface {
 	//
 	// GET /api/user/me
 	UserMe(ctx context.Context) (UserMeRes, error)
+	// UserPosts invokes userPosts operation.
+	//
+	// List visible posts of user, newest first.
+	//
+	// GET /api/user/{userId}/posts
+	UserPosts(ctx context.Context, params UserPostsParams) (UserPostsRes, error)
+	// UserRankHistory invokes userRankHistory operation.
+	//
+	// Get history of user rank changes, newest first.
+	//
+	// GET /api/user/{userId}/rank-history
+	UserRankHistory(ctx context.Context, params UserRankHistoryParams) (UserRankHistoryRes, error)
+	// UserThreads invokes userThreads operation.
+	//
+	// List visible threads of user, newest first.
+	//
+	// GET /api/user/{userId}/threads
+	UserThreads(ctx context.Context, params UserThreadsParams) (UserThreadsRes, error)
 	// UserUpdate invokes userUpdate operation.
 	//
-	// Update user information.
+	// Allowed to the user and admins. All profile fields are replaced, empty value clears field.
 	//
 	// POST /api/user/{userId}
-	UserUpdate(ctx context.Context, request *UserCreateRequest, params UserUpdateParams) (*UserCreateResponseOk, error)
+	UserUpdate(ctx context.Context, request *UserUpdateRequest, params UserUpdateParams) (UserUpdateRes, error)
+}
+
+// VotesInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Votes
+type VotesInvoker interface {
+	// PostVote invokes postVote operation.
+	//
+	// Set vote of current user for post: 1 - up, -1 - down, 0 - remove vote.
+	// Repeating the same vote changes nothing. Voting for own post is not allowed.
+	//
+	// POST /api/posts/{postId}/vote
+	PostVote(ctx context.Context, request *VoteRequest, params PostVoteParams) (PostVoteRes, error)
+	// ThreadVote invokes threadVote operation.
+	//
+	// Set vote of current user for thread: 1 - up, -1 - down, 0 - remove vote.
+	// Repeating the same vote changes nothing. Voting for own thread is not allowed.
+	//
+	// POST /api/threads/{threadId}/vote
+	ThreadVote(ctx context.Context, request *VoteRequest, params ThreadVoteParams) (ThreadVoteRes, error)
 }
 
 // Client implements OAS client.
@@ -147,49 +751,9168 @@ func NewClient(serverURL string, sec SecuritySource, opts ...ClientOption) (*Cli
 	if err != nil {
 		return nil, err
 	}
-	trimTrailingSlashes(u)
+	trimTrailingSlashes(u)
+
+	c, err := newClientConfig(opts...).baseClient()
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		serverURL:  u,
+		sec:        sec,
+		baseClient: c,
+	}, nil
+}
+
+type serverURLKey struct{}
+
+// WithServerURL sets context key to override server URL.
+func WithServerURL(ctx context.Context, u *url.URL) context.Context {
+	return context.WithValue(ctx, serverURLKey{}, u)
+}
+
+func (c *Client) requestURL(ctx context.Context) *url.URL {
+	u, ok := ctx.Value(serverURLKey{}).(*url.URL)
+	if !ok {
+		return c.serverURL
+	}
+	return u
+}
+
+// AnalyticsGraph invokes analyticsGraph operation.
+//
+// Directed weighted graph: edge goes from user who replied, mentioned or voted to user
+// who received it, weight is number of interactions. Time window is counted in whole UTC days.
+// The heaviest edges are returned, `truncated` is set when some edges were cut by `limit`.
+//
+// GET /api/analytics/graph
+func (c *Client) AnalyticsGraph(ctx context.Context, params AnalyticsGraphParams) (AnalyticsGraphRes, error) {
+	res, err := c.sendAnalyticsGraph(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAnalyticsGraph(ctx context.Context, params AnalyticsGraphParams) (res AnalyticsGraphRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("analyticsGraph"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/analytics/graph"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AnalyticsGraphOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/analytics/graph"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.To.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "kinds" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "kinds",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Kinds != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Kinds {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(string(item)))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "community_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "community_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CommunityID.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "min_weight" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "min_weight",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MinWeight.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, AnalyticsGraphOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAnalyticsGraphResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AnalyticsGraphExport invokes analyticsGraphExport operation.
+//
+// Whole graph by filter in GraphML, GEXF or zip archive with `nodes.csv` and `edges.csv`.
+// Nodes carry user name, karma, posts count, degrees and weights, edges carry total weight
+// and weights by interaction kind. The same export is made by `forum export-graph` command.
+// File is streamed as graph is read from database, graph size is not limited.
+// User name in `nodes.csv` starting with `=`, `+`, `-` or `@` is prefixed with `'`,
+// so spreadsheets show it as text and do not evaluate it as formula.
+//
+// GET /api/analytics/graph/export
+func (c *Client) AnalyticsGraphExport(ctx context.Context, params AnalyticsGraphExportParams) (AnalyticsGraphExportRes, error) {
+	res, err := c.sendAnalyticsGraphExport(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAnalyticsGraphExport(ctx context.Context, params AnalyticsGraphExportParams) (res AnalyticsGraphExportRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("analyticsGraphExport"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/analytics/graph/export"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AnalyticsGraphExportOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/analytics/graph/export"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "format" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(string(params.Format)))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.To.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "kinds" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "kinds",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Kinds != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Kinds {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(string(item)))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "community_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "community_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CommunityID.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "min_weight" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "min_weight",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.MinWeight.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, AnalyticsGraphExportOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAnalyticsGraphExportResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AnalyticsGraphRebuild invokes analyticsGraphRebuild operation.
+//
+// Graph is updated incrementally as users post and vote, rebuild also drops interactions
+// with removed votes and hidden content.
+//
+// POST /api/analytics/graph/rebuild
+func (c *Client) AnalyticsGraphRebuild(ctx context.Context) (AnalyticsGraphRebuildRes, error) {
+	res, err := c.sendAnalyticsGraphRebuild(ctx)
+	return res, err
+}
+
+func (c *Client) sendAnalyticsGraphRebuild(ctx context.Context) (res AnalyticsGraphRebuildRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("analyticsGraphRebuild"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/analytics/graph/rebuild"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AnalyticsGraphRebuildOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/analytics/graph/rebuild"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, AnalyticsGraphRebuildOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAnalyticsGraphRebuildResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AnalyticsInfluenceSnapshot invokes analyticsInfluenceSnapshot operation.
+//
+// Compute influence snapshots of forum and communities now (admins only).
+//
+// POST /api/analytics/influence/snapshots
+func (c *Client) AnalyticsInfluenceSnapshot(ctx context.Context) (AnalyticsInfluenceSnapshotRes, error) {
+	res, err := c.sendAnalyticsInfluenceSnapshot(ctx)
+	return res, err
+}
+
+func (c *Client) sendAnalyticsInfluenceSnapshot(ctx context.Context) (res AnalyticsInfluenceSnapshotRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("analyticsInfluenceSnapshot"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/analytics/influence/snapshots"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AnalyticsInfluenceSnapshotOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/analytics/influence/snapshots"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, AnalyticsInfluenceSnapshotOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAnalyticsInfluenceSnapshotResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AnalyticsLeaderboard invokes analyticsLeaderboard operation.
+//
+// Snapshots of forum and of every community are computed periodically, see
+// `/api/analytics/influence/snapshots` to compute them now.
+//
+// GET /api/analytics/leaderboard
+func (c *Client) AnalyticsLeaderboard(ctx context.Context, params AnalyticsLeaderboardParams) (AnalyticsLeaderboardRes, error) {
+	res, err := c.sendAnalyticsLeaderboard(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAnalyticsLeaderboard(ctx context.Context, params AnalyticsLeaderboardParams) (res AnalyticsLeaderboardRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("analyticsLeaderboard"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/analytics/leaderboard"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AnalyticsLeaderboardOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/analytics/leaderboard"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "community_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "community_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CommunityID.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "metric" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "metric",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Metric.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, AnalyticsLeaderboardOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAnalyticsLeaderboardResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AnalyticsUserInfluence invokes analyticsUserInfluence operation.
+//
+// Influence of user in the latest snapshots of forum and communities (admins only).
+//
+// GET /api/analytics/users/{userId}
+func (c *Client) AnalyticsUserInfluence(ctx context.Context, params AnalyticsUserInfluenceParams) (AnalyticsUserInfluenceRes, error) {
+	res, err := c.sendAnalyticsUserInfluence(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAnalyticsUserInfluence(ctx context.Context, params AnalyticsUserInfluenceParams) (res AnalyticsUserInfluenceRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("analyticsUserInfluence"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/analytics/users/{userId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AnalyticsUserInfluenceOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/analytics/users/"
+	{
+		// Encode "userId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "userId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.UserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, AnalyticsUserInfluenceOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAnalyticsUserInfluenceResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AttachmentDownload invokes attachmentDownload operation.
+//
+// Url with `expires` and `signature` is returned in attachment `url`, no other authorization
+// is needed, so it can be used in `<img src>`. Images are shown inline, other files are downloaded.
+// Images are downloadable after background processing (metadata removal, thumbnails).
+//
+// GET /api/attachments/{attachmentId}/download
+func (c *Client) AttachmentDownload(ctx context.Context, params AttachmentDownloadParams) (AttachmentDownloadRes, error) {
+	res, err := c.sendAttachmentDownload(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAttachmentDownload(ctx context.Context, params AttachmentDownloadParams) (res AttachmentDownloadRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("attachmentDownload"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/attachments/{attachmentId}/download"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AttachmentDownloadOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/attachments/"
+	{
+		// Encode "attachmentId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "attachmentId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.AttachmentId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/download"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "variant" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "variant",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Variant.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "expires" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "expires",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.Int64ToString(params.Expires))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "signature" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "signature",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Signature))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAttachmentDownloadResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AttachmentGet invokes attachmentGet operation.
+//
+// Not attached file is visible only to user uploaded it.
+//
+// GET /api/attachments/{attachmentId}
+func (c *Client) AttachmentGet(ctx context.Context, params AttachmentGetParams) (AttachmentGetRes, error) {
+	res, err := c.sendAttachmentGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAttachmentGet(ctx context.Context, params AttachmentGetParams) (res AttachmentGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("attachmentGet"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/attachments/{attachmentId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AttachmentGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/attachments/"
+	{
+		// Encode "attachmentId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "attachmentId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.AttachmentId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, AttachmentGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAttachmentGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AttachmentUpload invokes attachmentUpload operation.
+//
+// File type is detected by content, not by name or declared type, and must be allowed by server
+// configuration. Uploaded file is attached by passing its id in `attachment_ids` of created thread
+// or post, files not attached during a day (configurable) are removed.
+// Download `url` is signed and valid until `url_expires_at`.
+//
+// POST /api/attachments
+func (c *Client) AttachmentUpload(ctx context.Context, request *AttachmentUploadRequestMultipart) (AttachmentUploadRes, error) {
+	res, err := c.sendAttachmentUpload(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAttachmentUpload(ctx context.Context, request *AttachmentUploadRequestMultipart) (res AttachmentUploadRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("attachmentUpload"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/attachments"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AttachmentUploadOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/attachments"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAttachmentUploadRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, AttachmentUploadOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAttachmentUploadResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AuthLogin invokes authLogin operation.
+//
+// Create access and refresh JWT tokens, send to user. The refresh token also stored in a cookie.
+//
+// POST /api/auth/login
+func (c *Client) AuthLogin(ctx context.Context, request *AuthLoginRequest) (*JwtToken, error) {
+	res, err := c.sendAuthLogin(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAuthLogin(ctx context.Context, request *AuthLoginRequest) (res *JwtToken, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("authLogin"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/auth/login"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AuthLoginOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/auth/login"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAuthLoginRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAuthLoginResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AuthLogout invokes authLogout operation.
+//
+// User logout.
+//
+// POST /api/auth/logout
+func (c *Client) AuthLogout(ctx context.Context) error {
+	_, err := c.sendAuthLogout(ctx)
+	return err
+}
+
+func (c *Client) sendAuthLogout(ctx context.Context) (res *AuthLogoutNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("authLogout"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/auth/logout"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AuthLogoutOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/auth/logout"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, AuthLogoutOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAuthLogoutResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AuthRefresh invokes authRefresh operation.
+//
+// Update access and refresh tokens, send to user. The refresh token also stored in a cookie.
+//
+// POST /api/auth/refresh
+func (c *Client) AuthRefresh(ctx context.Context) (AuthRefreshRes, error) {
+	res, err := c.sendAuthRefresh(ctx)
+	return res, err
+}
+
+func (c *Client) sendAuthRefresh(ctx context.Context) (res AuthRefreshRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("authRefresh"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/auth/refresh"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AuthRefreshOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/auth/refresh"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, AuthRefreshOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAuthRefreshResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BookmarkCollectionCreate invokes bookmarkCollectionCreate operation.
+//
+// Collection names of user are unique, existing name is a conflict (409).
+//
+// POST /api/bookmarks/collections
+func (c *Client) BookmarkCollectionCreate(ctx context.Context, request *BookmarkCollectionCreateRequest) (BookmarkCollectionCreateRes, error) {
+	res, err := c.sendBookmarkCollectionCreate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendBookmarkCollectionCreate(ctx context.Context, request *BookmarkCollectionCreateRequest) (res BookmarkCollectionCreateRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("bookmarkCollectionCreate"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/bookmarks/collections"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BookmarkCollectionCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/bookmarks/collections"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeBookmarkCollectionCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, BookmarkCollectionCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBookmarkCollectionCreateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BookmarkCollectionDelete invokes bookmarkCollectionDelete operation.
+//
+// Delete bookmark collection, its bookmarks are kept without collection.
+//
+// DELETE /api/bookmarks/collections/{collectionId}
+func (c *Client) BookmarkCollectionDelete(ctx context.Context, params BookmarkCollectionDeleteParams) (BookmarkCollectionDeleteRes, error) {
+	res, err := c.sendBookmarkCollectionDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendBookmarkCollectionDelete(ctx context.Context, params BookmarkCollectionDeleteParams) (res BookmarkCollectionDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("bookmarkCollectionDelete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/bookmarks/collections/{collectionId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BookmarkCollectionDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/bookmarks/collections/"
+	{
+		// Encode "collectionId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "collectionId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.CollectionId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, BookmarkCollectionDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBookmarkCollectionDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BookmarkCollectionsList invokes bookmarkCollectionsList operation.
+//
+// List bookmark collections of current user.
+//
+// GET /api/bookmarks/collections
+func (c *Client) BookmarkCollectionsList(ctx context.Context) (BookmarkCollectionsListRes, error) {
+	res, err := c.sendBookmarkCollectionsList(ctx)
+	return res, err
+}
+
+func (c *Client) sendBookmarkCollectionsList(ctx context.Context) (res BookmarkCollectionsListRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("bookmarkCollectionsList"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/bookmarks/collections"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BookmarkCollectionsListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/bookmarks/collections"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, BookmarkCollectionsListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBookmarkCollectionsListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BookmarkCreate invokes bookmarkCreate operation.
+//
+// Bookmarking already bookmarked thread or post moves bookmark to given collection
+// (or out of collection if collection is not set).
+//
+// POST /api/bookmarks
+func (c *Client) BookmarkCreate(ctx context.Context, request *BookmarkCreateRequest) (BookmarkCreateRes, error) {
+	res, err := c.sendBookmarkCreate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendBookmarkCreate(ctx context.Context, request *BookmarkCreateRequest) (res BookmarkCreateRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("bookmarkCreate"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/bookmarks"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BookmarkCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/bookmarks"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeBookmarkCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, BookmarkCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBookmarkCreateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BookmarkDelete invokes bookmarkDelete operation.
+//
+// Remove bookmark.
+//
+// DELETE /api/bookmarks/{bookmarkId}
+func (c *Client) BookmarkDelete(ctx context.Context, params BookmarkDeleteParams) (BookmarkDeleteRes, error) {
+	res, err := c.sendBookmarkDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendBookmarkDelete(ctx context.Context, params BookmarkDeleteParams) (res BookmarkDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("bookmarkDelete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/bookmarks/{bookmarkId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BookmarkDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/bookmarks/"
+	{
+		// Encode "bookmarkId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "bookmarkId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.BookmarkId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, BookmarkDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBookmarkDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BookmarksList invokes bookmarksList operation.
+//
+// Bookmarks are ordered from newest to oldest with current info of bookmarked thread
+// (or thread of bookmarked post). For next page pass id of last bookmark as `before`.
+//
+// GET /api/bookmarks
+func (c *Client) BookmarksList(ctx context.Context, params BookmarksListParams) (BookmarksListRes, error) {
+	res, err := c.sendBookmarksList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendBookmarksList(ctx context.Context, params BookmarksListParams) (res BookmarksListRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("bookmarksList"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/bookmarks"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BookmarksListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/bookmarks"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "collection_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "collection_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CollectionID.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Before.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, BookmarksListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBookmarksListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CommunitySubscribe invokes communitySubscribe operation.
+//
+// New threads of subscribed communities are shown in feed. Subscribing is idempotent.
+//
+// PUT /api/communities/{communityId}/subscription
+func (c *Client) CommunitySubscribe(ctx context.Context, params CommunitySubscribeParams) (CommunitySubscribeRes, error) {
+	res, err := c.sendCommunitySubscribe(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendCommunitySubscribe(ctx context.Context, params CommunitySubscribeParams) (res CommunitySubscribeRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("communitySubscribe"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/api/communities/{communityId}/subscription"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CommunitySubscribeOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/communities/"
+	{
+		// Encode "communityId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "communityId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.CommunityId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/subscription"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, CommunitySubscribeOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCommunitySubscribeResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CommunitySubscriptionsList invokes communitySubscriptionsList operation.
+//
+// List communities subscribed by current user, ordered by name.
+//
+// GET /api/communities/subscriptions
+func (c *Client) CommunitySubscriptionsList(ctx context.Context) (CommunitySubscriptionsListRes, error) {
+	res, err := c.sendCommunitySubscriptionsList(ctx)
+	return res, err
+}
+
+func (c *Client) sendCommunitySubscriptionsList(ctx context.Context) (res CommunitySubscriptionsListRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("communitySubscriptionsList"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/communities/subscriptions"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CommunitySubscriptionsListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/communities/subscriptions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, CommunitySubscriptionsListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCommunitySubscriptionsListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CommunityUnsubscribe invokes communityUnsubscribe operation.
+//
+// Unsubscribe from community.
+//
+// DELETE /api/communities/{communityId}/subscription
+func (c *Client) CommunityUnsubscribe(ctx context.Context, params CommunityUnsubscribeParams) (CommunityUnsubscribeRes, error) {
+	res, err := c.sendCommunityUnsubscribe(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendCommunityUnsubscribe(ctx context.Context, params CommunityUnsubscribeParams) (res CommunityUnsubscribeRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("communityUnsubscribe"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/communities/{communityId}/subscription"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CommunityUnsubscribeOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/communities/"
+	{
+		// Encode "communityId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "communityId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.CommunityId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/subscription"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, CommunityUnsubscribeOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCommunityUnsubscribeResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ConversationCreate invokes conversationCreate operation.
+//
+// Message to one user without title is added to existing conversation of the two users
+// if there is one. Users who blocked current user can not be added to conversation.
+//
+// POST /api/conversations
+func (c *Client) ConversationCreate(ctx context.Context, request *ConversationCreateRequest) (ConversationCreateRes, error) {
+	res, err := c.sendConversationCreate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendConversationCreate(ctx context.Context, request *ConversationCreateRequest) (res ConversationCreateRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("conversationCreate"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/conversations"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ConversationCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/conversations"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeConversationCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ConversationCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeConversationCreateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ConversationMarkRead invokes conversationMarkRead operation.
+//
+// Mark messages of conversation as read.
+//
+// POST /api/conversations/{conversationId}/read
+func (c *Client) ConversationMarkRead(ctx context.Context, request OptConversationMarkReadRequest, params ConversationMarkReadParams) (ConversationMarkReadRes, error) {
+	res, err := c.sendConversationMarkRead(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendConversationMarkRead(ctx context.Context, request OptConversationMarkReadRequest, params ConversationMarkReadParams) (res ConversationMarkReadRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("conversationMarkRead"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/conversations/{conversationId}/read"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ConversationMarkReadOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/conversations/"
+	{
+		// Encode "conversationId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "conversationId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ConversationId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/read"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeConversationMarkReadRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ConversationMarkReadOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeConversationMarkReadResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ConversationMessages invokes conversationMessages operation.
+//
+// Messages are ordered from newest to oldest. Conversation is visible only to its members,
+// for others it does not exist.
+//
+// GET /api/conversations/{conversationId}/messages
+func (c *Client) ConversationMessages(ctx context.Context, params ConversationMessagesParams) (ConversationMessagesRes, error) {
+	res, err := c.sendConversationMessages(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendConversationMessages(ctx context.Context, params ConversationMessagesParams) (res ConversationMessagesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("conversationMessages"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/conversations/{conversationId}/messages"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ConversationMessagesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/conversations/"
+	{
+		// Encode "conversationId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "conversationId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ConversationId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/messages"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Before.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ConversationMessagesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeConversationMessagesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ConversationSend invokes conversationSend operation.
+//
+// Message can not be sent if any other member blocked current user.
+//
+// POST /api/conversations/{conversationId}/messages
+func (c *Client) ConversationSend(ctx context.Context, request *MessageSendRequest, params ConversationSendParams) (ConversationSendRes, error) {
+	res, err := c.sendConversationSend(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendConversationSend(ctx context.Context, request *MessageSendRequest, params ConversationSendParams) (res ConversationSendRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("conversationSend"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/conversations/{conversationId}/messages"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ConversationSendOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/conversations/"
+	{
+		// Encode "conversationId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "conversationId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ConversationId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/messages"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeConversationSendRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ConversationSendOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeConversationSendResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ConversationsList invokes conversationsList operation.
+//
+// Conversations are ordered by the latest message, newest first.
+//
+// GET /api/conversations
+func (c *Client) ConversationsList(ctx context.Context, params ConversationsListParams) (ConversationsListRes, error) {
+	res, err := c.sendConversationsList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendConversationsList(ctx context.Context, params ConversationsListParams) (res ConversationsListRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("conversationsList"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/conversations"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ConversationsListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/conversations"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Before.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ConversationsListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeConversationsListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ConversationsUnreadCount invokes conversationsUnreadCount operation.
+//
+// Count unread private messages of current user.
+//
+// GET /api/conversations/unread-count
+func (c *Client) ConversationsUnreadCount(ctx context.Context) (ConversationsUnreadCountRes, error) {
+	res, err := c.sendConversationsUnreadCount(ctx)
+	return res, err
+}
+
+func (c *Client) sendConversationsUnreadCount(ctx context.Context) (res ConversationsUnreadCountRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("conversationsUnreadCount"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/conversations/unread-count"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ConversationsUnreadCountOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/conversations/unread-count"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ConversationsUnreadCountOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeConversationsUnreadCountResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// FeedGet invokes feedGet operation.
+//
+// Threads and posts of followed users and new threads of subscribed communities, the newest first.
+// Own threads and content of blocked and ignored users are skipped.
+//
+// GET /api/feed
+func (c *Client) FeedGet(ctx context.Context, params FeedGetParams) (FeedGetRes, error) {
+	res, err := c.sendFeedGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendFeedGet(ctx context.Context, params FeedGetParams) (res FeedGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("feedGet"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/feed"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, FeedGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/feed"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, FeedGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeFeedGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// LiveThread invokes liveThread operation.
+//
+// The same as `/api/live/threads` with events of one thread only.
+//
+// GET /api/live/threads/{threadId}
+func (c *Client) LiveThread(ctx context.Context, params LiveThreadParams) (LiveThreadRes, error) {
+	res, err := c.sendLiveThread(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendLiveThread(ctx context.Context, params LiveThreadParams) (res LiveThreadRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("liveThread"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/live/threads/{threadId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, LiveThreadOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/live/threads/"
+	{
+		// Encode "threadId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "threadId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ThreadId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "last_event_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "last_event_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.QueryLastEventID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Last-Event-Id",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.HeaderLastEventID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeLiveThreadResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// LiveThreads invokes liveThreads operation.
+//
+// Events `thread_created`, `thread_deleted`, `post_created`, `post_updated`, `post_deleted`
+// and `vote_changed` are sent with increasing `id` and json data. Thread or post hidden
+// by moderator is reported as deleted. Reconnected client sends `Last-Event-ID` header
+// (or `last_event_id` query parameter) and gets missed events. If missed events are not kept
+// anymore, `reset` event is sent and client should reload data.
+// Idle connection gets `: ping` comment every heartbeat interval.
+// Client which does not read events fast enough is disconnected and should reconnect.
+//
+// GET /api/live/threads
+func (c *Client) LiveThreads(ctx context.Context, params LiveThreadsParams) (LiveThreadsRes, error) {
+	res, err := c.sendLiveThreads(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendLiveThreads(ctx context.Context, params LiveThreadsParams) (res LiveThreadsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("liveThreads"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/live/threads"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, LiveThreadsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/live/threads"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "last_event_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "last_event_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.QueryLastEventID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Last-Event-Id",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.HeaderLastEventID.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeLiveThreadsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MentionUsers invokes mentionUsers operation.
+//
+// Prefix is case-insensitive, leading `@` is ignored.
+// Users with names which can not be mentioned (for example with spaces) are skipped.
+//
+// GET /api/mentions/users
+func (c *Client) MentionUsers(ctx context.Context, params MentionUsersParams) (MentionUsersRes, error) {
+	res, err := c.sendMentionUsers(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendMentionUsers(ctx context.Context, params MentionUsersParams) (res MentionUsersRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("mentionUsers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/mentions/users"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MentionUsersOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/mentions/users"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "prefix" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "prefix",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Prefix))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, MentionUsersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMentionUsersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ModerationDecideHeld invokes moderationDecideHeld operation.
+//
+// Approved content is published with notifications and live events of new content, rejected
+// content is hidden and author is notified. Approved edit becomes new revision, rejected edit
+// is discarded. Decision is recorded in moderation log (as approve, hide or reject for edit)
+// and trains spam classifier.
+//
+// POST /api/moderation/held/{heldId}
+func (c *Client) ModerationDecideHeld(ctx context.Context, request *HeldDecisionRequest, params ModerationDecideHeldParams) (ModerationDecideHeldRes, error) {
+	res, err := c.sendModerationDecideHeld(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendModerationDecideHeld(ctx context.Context, request *HeldDecisionRequest, params ModerationDecideHeldParams) (res ModerationDecideHeldRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("moderationDecideHeld"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/moderation/held/{heldId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ModerationDecideHeldOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/moderation/held/"
+	{
+		// Encode "heldId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "heldId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.HeldId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeModerationDecideHeldRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ModerationDecideHeldOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeModerationDecideHeldResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ModerationHeld invokes moderationHeld operation.
+//
+// Held content is visible only to moderators until decision, newest first.
+// For next page pass id of last item as `before`.
+//
+// GET /api/moderation/held
+func (c *Client) ModerationHeld(ctx context.Context, params ModerationHeldParams) (ModerationHeldRes, error) {
+	res, err := c.sendModerationHeld(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendModerationHeld(ctx context.Context, params ModerationHeldParams) (res ModerationHeldRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("moderationHeld"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/moderation/held"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ModerationHeldOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/moderation/held"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Before.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ModerationHeldOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeModerationHeldResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ModerationLog invokes moderationLog operation.
+//
+// For next page pass id of last entry as `before`.
+//
+// GET /api/moderation/log
+func (c *Client) ModerationLog(ctx context.Context, params ModerationLogParams) (ModerationLogRes, error) {
+	res, err := c.sendModerationLog(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendModerationLog(ctx context.Context, params ModerationLogParams) (res ModerationLogRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("moderationLog"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/moderation/log"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ModerationLogOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/moderation/log"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Before.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ModerationLogOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeModerationLogResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ModerationQueue invokes moderationQueue operation.
+//
+// Groups are ordered by the latest report, newest first.
+// For next page pass `last_report_id` of last group as `before`.
+//
+// GET /api/moderation/reports
+func (c *Client) ModerationQueue(ctx context.Context, params ModerationQueueParams) (ModerationQueueRes, error) {
+	res, err := c.sendModerationQueue(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendModerationQueue(ctx context.Context, params ModerationQueueParams) (res ModerationQueueRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("moderationQueue"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/moderation/reports"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ModerationQueueOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/moderation/reports"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Before.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ModerationQueueOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeModerationQueueResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ModerationResolve invokes moderationResolve operation.
+//
+// All open reports of target are resolved and action is recorded in moderation log.
+// Hide is allowed for threads and posts, warn and suspend apply to content author (or reported user)
+// and are not allowed for moderators. Author is notified about every action except dismiss.
+//
+// POST /api/moderation/reports/{targetType}/{targetId}/resolve
+func (c *Client) ModerationResolve(ctx context.Context, request *ModerationResolveRequest, params ModerationResolveParams) (ModerationResolveRes, error) {
+	res, err := c.sendModerationResolve(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendModerationResolve(ctx context.Context, request *ModerationResolveRequest, params ModerationResolveParams) (res ModerationResolveRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("moderationResolve"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/moderation/reports/{targetType}/{targetId}/resolve"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ModerationResolveOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/api/moderation/reports/"
+	{
+		// Encode "targetType" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "targetType",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(string(params.TargetType)))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "targetId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "targetId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.TargetId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/resolve"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeModerationResolveRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ModerationResolveOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeModerationResolveResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// NotificationPreferencesGet invokes notificationPreferencesGet operation.
+//
+// Delivery settings of every notification type.
+//
+// GET /api/notifications/preferences
+func (c *Client) NotificationPreferencesGet(ctx context.Context) (NotificationPreferencesGetRes, error) {
+	res, err := c.sendNotificationPreferencesGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendNotificationPreferencesGet(ctx context.Context) (res NotificationPreferencesGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("notificationPreferencesGet"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/notifications/preferences"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, NotificationPreferencesGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/notifications/preferences"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, NotificationPreferencesGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeNotificationPreferencesGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// NotificationPreferencesUpdate invokes notificationPreferencesUpdate operation.
+//
+// Types missing in request keep current setting. Notifications of disabled types are not created.
+// Response has settings of all types.
+//
+// PUT /api/notifications/preferences
+func (c *Client) NotificationPreferencesUpdate(ctx context.Context, request []NotificationPreference) (NotificationPreferencesUpdateRes, error) {
+	res, err := c.sendNotificationPreferencesUpdate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendNotificationPreferencesUpdate(ctx context.Context, request []NotificationPreference) (res NotificationPreferencesUpdateRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("notificationPreferencesUpdate"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/api/notifications/preferences"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, NotificationPreferencesUpdateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/notifications/preferences"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeNotificationPreferencesUpdateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, NotificationPreferencesUpdateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeNotificationPreferencesUpdateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// NotificationsList invokes notificationsList operation.
+//
+// Notifications are ordered from newest to oldest.
+// For next page pass id of last notification as `before`.
+//
+// GET /api/notifications
+func (c *Client) NotificationsList(ctx context.Context, params NotificationsListParams) (NotificationsListRes, error) {
+	res, err := c.sendNotificationsList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendNotificationsList(ctx context.Context, params NotificationsListParams) (res NotificationsListRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("notificationsList"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/notifications"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, NotificationsListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/notifications"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "unread_only" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "unread_only",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.UnreadOnly.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Before.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, NotificationsListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeNotificationsListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// NotificationsMarkRead invokes notificationsMarkRead operation.
+//
+// Mark notifications as read.
+//
+// POST /api/notifications/read
+func (c *Client) NotificationsMarkRead(ctx context.Context, request *NotificationMarkReadRequest) (NotificationsMarkReadRes, error) {
+	res, err := c.sendNotificationsMarkRead(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendNotificationsMarkRead(ctx context.Context, request *NotificationMarkReadRequest) (res NotificationsMarkReadRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("notificationsMarkRead"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/notifications/read"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, NotificationsMarkReadOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/notifications/read"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeNotificationsMarkReadRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, NotificationsMarkReadOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeNotificationsMarkReadResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// NotificationsReadAll invokes notificationsReadAll operation.
+//
+// Mark all notifications of current user as read.
+//
+// POST /api/notifications/read-all
+func (c *Client) NotificationsReadAll(ctx context.Context) (NotificationsReadAllRes, error) {
+	res, err := c.sendNotificationsReadAll(ctx)
+	return res, err
+}
+
+func (c *Client) sendNotificationsReadAll(ctx context.Context) (res NotificationsReadAllRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("notificationsReadAll"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/notifications/read-all"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, NotificationsReadAllOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/notifications/read-all"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, NotificationsReadAllOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeNotificationsReadAllResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// NotificationsUnreadCount invokes notificationsUnreadCount operation.
+//
+// Number of unread notifications of current user.
+//
+// GET /api/notifications/unread-count
+func (c *Client) NotificationsUnreadCount(ctx context.Context) (NotificationsUnreadCountRes, error) {
+	res, err := c.sendNotificationsUnreadCount(ctx)
+	return res, err
+}
+
+func (c *Client) sendNotificationsUnreadCount(ctx context.Context) (res NotificationsUnreadCountRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("notificationsUnreadCount"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/notifications/unread-count"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, NotificationsUnreadCountOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/notifications/unread-count"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, NotificationsUnreadCountOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeNotificationsUnreadCountResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PollVote invokes pollVote operation.
+//
+// User votes once, vote can not be changed. Single choice poll accepts exactly one option.
+//
+// POST /api/threads/{threadId}/poll/vote
+func (c *Client) PollVote(ctx context.Context, request *PollVoteRequest, params PollVoteParams) (PollVoteRes, error) {
+	res, err := c.sendPollVote(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPollVote(ctx context.Context, request *PollVoteRequest, params PollVoteParams) (res PollVoteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("pollVote"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/threads/{threadId}/poll/vote"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PollVoteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/threads/"
+	{
+		// Encode "threadId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "threadId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ThreadId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/poll/vote"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePollVoteRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, PollVoteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePollVoteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostEdit invokes postEdit operation.
+//
+// Post author and moderators can edit. Every edit is kept as revision of post.
+// Edit is checked by content filters as new post: it may be censored, rejected (400)
+// or held for moderator review. Held edit does not change post, it becomes new revision
+// after approval. Suspended users can not edit.
+//
+// PATCH /api/posts/{postId}
+func (c *Client) PostEdit(ctx context.Context, request *PostEditRequest, params PostEditParams) (PostEditRes, error) {
+	res, err := c.sendPostEdit(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPostEdit(ctx context.Context, request *PostEditRequest, params PostEditParams) (res PostEditRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("postEdit"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/api/posts/{postId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PostEditOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/posts/"
+	{
+		// Encode "postId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "postId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.PostId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostEditRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, PostEditOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePostEditResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostRevisionRestore invokes postRevisionRestore operation.
+//
+// Only moderators can restore. Content of old revision becomes current as new revision.
+// Restored content is checked by content filters as edit.
+//
+// POST /api/posts/{postId}/revisions/{revision}/restore
+func (c *Client) PostRevisionRestore(ctx context.Context, params PostRevisionRestoreParams) (PostRevisionRestoreRes, error) {
+	res, err := c.sendPostRevisionRestore(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendPostRevisionRestore(ctx context.Context, params PostRevisionRestoreParams) (res PostRevisionRestoreRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("postRevisionRestore"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/posts/{postId}/revisions/{revision}/restore"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PostRevisionRestoreOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/api/posts/"
+	{
+		// Encode "postId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "postId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.PostId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/revisions/"
+	{
+		// Encode "revision" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "revision",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.Revision))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/restore"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, PostRevisionRestoreOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePostRevisionRestoreResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostRevisions invokes postRevisions operation.
+//
+// Revisions from the oldest, revision 1 is the original post. Never edited post has only revision 1.
+//
+// GET /api/posts/{postId}/revisions
+func (c *Client) PostRevisions(ctx context.Context, params PostRevisionsParams) (PostRevisionsRes, error) {
+	res, err := c.sendPostRevisions(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendPostRevisions(ctx context.Context, params PostRevisionsParams) (res PostRevisionsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("postRevisions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/posts/{postId}/revisions"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PostRevisionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/posts/"
+	{
+		// Encode "postId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "postId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.PostId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/revisions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, PostRevisionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePostRevisionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostRevisionsDiff invokes postRevisionsDiff operation.
+//
+// Difference between two revisions of post.
+//
+// GET /api/posts/{postId}/revisions/diff
+func (c *Client) PostRevisionsDiff(ctx context.Context, params PostRevisionsDiffParams) (PostRevisionsDiffRes, error) {
+	res, err := c.sendPostRevisionsDiff(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendPostRevisionsDiff(ctx context.Context, params PostRevisionsDiffParams) (res PostRevisionsDiffRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("postRevisionsDiff"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/posts/{postId}/revisions/diff"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PostRevisionsDiffOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/posts/"
+	{
+		// Encode "postId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "postId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.PostId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/revisions/diff"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.From))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.To))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "mode" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mode",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Mode.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, PostRevisionsDiffOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePostRevisionsDiffResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostVote invokes postVote operation.
+//
+// Set vote of current user for post: 1 - up, -1 - down, 0 - remove vote.
+// Repeating the same vote changes nothing. Voting for own post is not allowed.
+//
+// POST /api/posts/{postId}/vote
+func (c *Client) PostVote(ctx context.Context, request *VoteRequest, params PostVoteParams) (PostVoteRes, error) {
+	res, err := c.sendPostVote(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPostVote(ctx context.Context, request *VoteRequest, params PostVoteParams) (res PostVoteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("postVote"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/posts/{postId}/vote"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PostVoteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/posts/"
+	{
+		// Encode "postId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "postId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.PostId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/vote"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostVoteRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, PostVoteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePostVoteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RelationDelete invokes relationDelete operation.
+//
+// Unblock or unignore user.
+//
+// DELETE /api/relations/{userId}
+func (c *Client) RelationDelete(ctx context.Context, params RelationDeleteParams) (RelationDeleteRes, error) {
+	res, err := c.sendRelationDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendRelationDelete(ctx context.Context, params RelationDeleteParams) (res RelationDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("relationDelete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/relations/{userId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RelationDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/relations/"
+	{
+		// Encode "userId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "userId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.UserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, RelationDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRelationDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RelationSet invokes relationSet operation.
+//
+// Previous relation to user is replaced. Moderators can be ignored, but not blocked.
+//
+// PUT /api/relations/{userId}
+func (c *Client) RelationSet(ctx context.Context, request *RelationSetRequest, params RelationSetParams) (RelationSetRes, error) {
+	res, err := c.sendRelationSet(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendRelationSet(ctx context.Context, request *RelationSetRequest, params RelationSetParams) (res RelationSetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("relationSet"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/api/relations/{userId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RelationSetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/relations/"
+	{
+		// Encode "userId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "userId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.UserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRelationSetRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, RelationSetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRelationSetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RelationsList invokes relationsList operation.
+//
+// Blocked users can not reply to threads and posts of current user or mention them,
+// content of blocked and ignored users is marked with `author_ignored` and their
+// notifications are not shown.
+// Relations are ordered from newest to oldest.
+//
+// GET /api/relations
+func (c *Client) RelationsList(ctx context.Context, params RelationsListParams) (RelationsListRes, error) {
+	res, err := c.sendRelationsList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendRelationsList(ctx context.Context, params RelationsListParams) (res RelationsListRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("relationsList"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/relations"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RelationsListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/relations"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "kind" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Kind.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, RelationsListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRelationsListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ReportCreate invokes reportCreate operation.
+//
+// User has one open report of the same target, repeated report is rejected until moderator resolves
+// it.
+// Reason other requires text. Own threads and posts can not be reported.
+//
+// POST /api/reports
+func (c *Client) ReportCreate(ctx context.Context, request *ReportRequest) (ReportCreateRes, error) {
+	res, err := c.sendReportCreate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendReportCreate(ctx context.Context, request *ReportRequest) (res ReportCreateRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("reportCreate"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/reports"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ReportCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/reports"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeReportCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ReportCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeReportCreateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Search invokes search operation.
+//
+// Search threads (title and content) and posts (content). Query uses web search syntax
+// (`"quoted phrase"`, `or`, `-excluded`) and is matched with russian and english configurations.
+// Results are ordered by rank, matched words in snippet are wrapped with `<mark>` tags,
+// the rest of snippet is html escaped.
+// For next page pass `next_cursor` from response as `cursor` with the same query and filters.
+//
+// GET /api/search
+func (c *Client) Search(ctx context.Context, params SearchParams) (SearchRes, error) {
+	res, err := c.sendSearch(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendSearch(ctx context.Context, params SearchParams) (res SearchRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("search"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/search"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SearchOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/search"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "q" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Q))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "author_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "author_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.AuthorID.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "community_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "community_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CommunityID.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.To.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSearchResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SubscriptionsList invokes subscriptionsList operation.
+//
+// Subscriptions are ordered from newest thread to oldest with current thread info.
+// For next page pass thread id of last subscription as `before`.
+//
+// GET /api/subscriptions
+func (c *Client) SubscriptionsList(ctx context.Context, params SubscriptionsListParams) (SubscriptionsListRes, error) {
+	res, err := c.sendSubscriptionsList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendSubscriptionsList(ctx context.Context, params SubscriptionsListParams) (res SubscriptionsListRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("subscriptionsList"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/subscriptions"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SubscriptionsListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/subscriptions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "level" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "level",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Level.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Before.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, SubscriptionsListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSubscriptionsListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ThreadAcceptAnswer invokes threadAcceptAnswer operation.
+//
+// Only thread author can accept answer, own posts can not be accepted.
+// Previously accepted post of thread is unaccepted. Accepted answers count in author rank.
+//
+// POST /api/threads/{threadId}/accept
+func (c *Client) ThreadAcceptAnswer(ctx context.Context, request *ThreadAcceptAnswerRequest, params ThreadAcceptAnswerParams) (ThreadAcceptAnswerRes, error) {
+	res, err := c.sendThreadAcceptAnswer(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendThreadAcceptAnswer(ctx context.Context, request *ThreadAcceptAnswerRequest, params ThreadAcceptAnswerParams) (res ThreadAcceptAnswerRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("threadAcceptAnswer"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/threads/{threadId}/accept"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ThreadAcceptAnswerOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/threads/"
+	{
+		// Encode "threadId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "threadId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ThreadId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/accept"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeThreadAcceptAnswerRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ThreadAcceptAnswerOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeThreadAcceptAnswerResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ThreadAddPost invokes threadAddPost operation.
+//
+// Add a new post to thread.
+//
+// POST /api/threads/{threadId}/posts
+func (c *Client) ThreadAddPost(ctx context.Context, request *ThreadCreatePostRequest, params ThreadAddPostParams) (ThreadAddPostRes, error) {
+	res, err := c.sendThreadAddPost(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendThreadAddPost(ctx context.Context, request *ThreadCreatePostRequest, params ThreadAddPostParams) (res ThreadAddPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("threadAddPost"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/threads/{threadId}/posts"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ThreadAddPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/threads/"
+	{
+		// Encode "threadId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "threadId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ThreadId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/posts"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeThreadAddPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ThreadAddPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeThreadAddPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ThreadCreate invokes threadCreate operation.
+//
+// Create a new thread.
+//
+// POST /api/threads
+func (c *Client) ThreadCreate(ctx context.Context, request *ThreadCreateRequest) (ThreadCreateRes, error) {
+	res, err := c.sendThreadCreate(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendThreadCreate(ctx context.Context, request *ThreadCreateRequest) (res ThreadCreateRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("threadCreate"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/threads"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ThreadCreateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/threads"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeThreadCreateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ThreadCreateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeThreadCreateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ThreadEdit invokes threadEdit operation.
+//
+// Thread author and moderators can edit. Every edit is kept as revision of thread.
+// Edit is checked by content filters as new thread: it may be censored, rejected (400)
+// or held for moderator review. Held edit does not change thread, it becomes new revision
+// after approval. Suspended users can not edit.
+//
+// PATCH /api/threads/{threadId}
+func (c *Client) ThreadEdit(ctx context.Context, request *ThreadEditRequest, params ThreadEditParams) (ThreadEditRes, error) {
+	res, err := c.sendThreadEdit(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendThreadEdit(ctx context.Context, request *ThreadEditRequest, params ThreadEditParams) (res ThreadEditRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("threadEdit"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/api/threads/{threadId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ThreadEditOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/threads/"
+	{
+		// Encode "threadId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "threadId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ThreadId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeThreadEditRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ThreadEditOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeThreadEditResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ThreadGet invokes threadGet operation.
+//
+// Authorization is optional, authorized user also gets own poll vote, bookmark mark and ignored
+// authors.
+//
+// GET /api/threads/{threadId}
+func (c *Client) ThreadGet(ctx context.Context, params ThreadGetParams) (ThreadGetRes, error) {
+	res, err := c.sendThreadGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendThreadGet(ctx context.Context, params ThreadGetParams) (res ThreadGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("threadGet"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/threads/{threadId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ThreadGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/threads/"
+	{
+		// Encode "threadId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "threadId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ThreadId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ThreadGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{},
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeThreadGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ThreadRevisionRestore invokes threadRevisionRestore operation.
+//
+// Only moderators can restore. Content of old revision becomes current as new revision.
+// Restored content is checked by content filters as edit.
+//
+// POST /api/threads/{threadId}/revisions/{revision}/restore
+func (c *Client) ThreadRevisionRestore(ctx context.Context, params ThreadRevisionRestoreParams) (ThreadRevisionRestoreRes, error) {
+	res, err := c.sendThreadRevisionRestore(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendThreadRevisionRestore(ctx context.Context, params ThreadRevisionRestoreParams) (res ThreadRevisionRestoreRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("threadRevisionRestore"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/threads/{threadId}/revisions/{revision}/restore"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ThreadRevisionRestoreOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/api/threads/"
+	{
+		// Encode "threadId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "threadId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ThreadId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/revisions/"
+	{
+		// Encode "revision" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "revision",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.Revision))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/restore"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ThreadRevisionRestoreOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeThreadRevisionRestoreResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ThreadRevisions invokes threadRevisions operation.
+//
+// Revisions from the oldest, revision 1 is the original thread. Never edited thread has only
+// revision 1.
+//
+// GET /api/threads/{threadId}/revisions
+func (c *Client) ThreadRevisions(ctx context.Context, params ThreadRevisionsParams) (ThreadRevisionsRes, error) {
+	res, err := c.sendThreadRevisions(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendThreadRevisions(ctx context.Context, params ThreadRevisionsParams) (res ThreadRevisionsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("threadRevisions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/threads/{threadId}/revisions"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ThreadRevisionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/threads/"
+	{
+		// Encode "threadId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "threadId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ThreadId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/revisions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ThreadRevisionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeThreadRevisionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ThreadRevisionsDiff invokes threadRevisionsDiff operation.
+//
+// Difference between two revisions of thread.
+//
+// GET /api/threads/{threadId}/revisions/diff
+func (c *Client) ThreadRevisionsDiff(ctx context.Context, params ThreadRevisionsDiffParams) (ThreadRevisionsDiffRes, error) {
+	res, err := c.sendThreadRevisionsDiff(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendThreadRevisionsDiff(ctx context.Context, params ThreadRevisionsDiffParams) (res ThreadRevisionsDiffRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("threadRevisionsDiff"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/threads/{threadId}/revisions/diff"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ThreadRevisionsDiffOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/threads/"
+	{
+		// Encode "threadId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "threadId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ThreadId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/revisions/diff"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.From))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.IntToString(params.To))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "mode" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mode",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Mode.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ThreadRevisionsDiffOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeThreadRevisionsDiffResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ThreadSetState invokes threadSetState operation.
+//
+// Only moderators can change thread state. Absent fields keep current value.
+// Locked thread does not accept new posts, archived thread is read-only.
+// In slow mode user can post to thread once in `slow_mode_seconds` (0 turns slow mode off).
+// Threads without new posts are archived automatically after period set in server config.
+//
+// PATCH /api/threads/{threadId}/state
+func (c *Client) ThreadSetState(ctx context.Context, request *ThreadStateRequest, params ThreadSetStateParams) (ThreadSetStateRes, error) {
+	res, err := c.sendThreadSetState(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendThreadSetState(ctx context.Context, request *ThreadStateRequest, params ThreadSetStateParams) (res ThreadSetStateRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("threadSetState"),
+		semconv.HTTPRequestMethodKey.String("PATCH"),
+		semconv.URLTemplateKey.String("/api/threads/{threadId}/state"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ThreadSetStateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/threads/"
+	{
+		// Encode "threadId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "threadId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ThreadId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/state"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeThreadSetStateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ThreadSetStateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeThreadSetStateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ThreadSubscribe invokes threadSubscribe operation.
+//
+// Thread author is subscribed as `watching` on thread creation, poster - on the first post
+// in thread. Level set by user is kept by automatic subscription.
+//
+// PUT /api/threads/{threadId}/subscription
+func (c *Client) ThreadSubscribe(ctx context.Context, request *ThreadSubscriptionRequest, params ThreadSubscribeParams) (ThreadSubscribeRes, error) {
+	res, err := c.sendThreadSubscribe(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendThreadSubscribe(ctx context.Context, request *ThreadSubscriptionRequest, params ThreadSubscribeParams) (res ThreadSubscribeRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("threadSubscribe"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/api/threads/{threadId}/subscription"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ThreadSubscribeOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/threads/"
+	{
+		// Encode "threadId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "threadId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ThreadId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/subscription"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeThreadSubscribeRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ThreadSubscribeOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeThreadSubscribeResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ThreadSubscriptionGet invokes threadSubscriptionGet operation.
+//
+// Subscription of current user to thread.
+//
+// GET /api/threads/{threadId}/subscription
+func (c *Client) ThreadSubscriptionGet(ctx context.Context, params ThreadSubscriptionGetParams) (ThreadSubscriptionGetRes, error) {
+	res, err := c.sendThreadSubscriptionGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendThreadSubscriptionGet(ctx context.Context, params ThreadSubscriptionGetParams) (res ThreadSubscriptionGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("threadSubscriptionGet"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/threads/{threadId}/subscription"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ThreadSubscriptionGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/threads/"
+	{
+		// Encode "threadId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "threadId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ThreadId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/subscription"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ThreadSubscriptionGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeThreadSubscriptionGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ThreadUnsubscribe invokes threadUnsubscribe operation.
+//
+// User is subscribed again on next post in thread, set `muted` level to stop notifications.
+//
+// DELETE /api/threads/{threadId}/subscription
+func (c *Client) ThreadUnsubscribe(ctx context.Context, params ThreadUnsubscribeParams) (ThreadUnsubscribeRes, error) {
+	res, err := c.sendThreadUnsubscribe(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendThreadUnsubscribe(ctx context.Context, params ThreadUnsubscribeParams) (res ThreadUnsubscribeRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("threadUnsubscribe"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/threads/{threadId}/subscription"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ThreadUnsubscribeOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/threads/"
+	{
+		// Encode "threadId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "threadId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ThreadId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/subscription"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ThreadUnsubscribeOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeThreadUnsubscribeResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ThreadVote invokes threadVote operation.
+//
+// Set vote of current user for thread: 1 - up, -1 - down, 0 - remove vote.
+// Repeating the same vote changes nothing. Voting for own thread is not allowed.
+//
+// POST /api/threads/{threadId}/vote
+func (c *Client) ThreadVote(ctx context.Context, request *VoteRequest, params ThreadVoteParams) (ThreadVoteRes, error) {
+	res, err := c.sendThreadVote(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendThreadVote(ctx context.Context, request *VoteRequest, params ThreadVoteParams) (res ThreadVoteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("threadVote"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/threads/{threadId}/vote"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ThreadVoteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/threads/"
+	{
+		// Encode "threadId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "threadId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ThreadId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/vote"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeThreadVoteRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ThreadVoteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeThreadVoteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ThreadsList invokes threadsList operation.
+//
+// Получить список веток с пагинацией. Можно
+// использовать либо постраничную пагинацию (page + limit),
+// либо курсорную пагинацию (after или before). Нужно
+// использовать только один параметр.
+// after, before или page с номером страницы. Если ни один не
+// указан - выводятся самые свежие сообщения.
+// limit - количество сообщений на страницу, по умолчанию 20.
+// С разделением на страницы есть неприятная
+// особенность. При удалении или добавлении новых
+// сообщений,
+// страницы могут "прыгать". Т.е. у нас есть список
+// (сообщений) и в него могут добавляться и удаляться
+// элементы
+// в любом месте списка. Если мы находится на странице 3 и
+// хотим 7-ю, то в ней могут быть совсем другие элементы,
+// чем на момент запроса страницы 3. Поэтому для более
+// стабильной пагинации можно использовать курсорную
+// пагинацию.
+// Навигация по номеру страницы выберает все сообщения
+// на момент запроса и отдает нужную страницу.
+// Добавление
+// или удаление сообщений сбивает это разделение.
+// Курсорная пагинация позволяет двигаться вперед и
+// назад по списку, учитывая изменеия в нем.
+// Но для нее нужно указывать минимальный или
+// максимальный id сообщения на странице, чтобы понять
+// откуда двигаться
+// дальше. И она не позволяет прыгать на конкретную
+// страницу, а только двигаться вперед и назад.
+// При этом before и after не включаются в результат, т.е. если
+// указать before=10, то в результат не попадет
+// сообщение с id 10, а только с id меньше 10. И аналогично для
+// after. В них указываются id сообщения, но
+// before - для получения более старых сообщений, а after - для
+// получения более новых сообщений по времени.
+// Более старым сообщениям (before) соответствует меньший id
+// (более старые сообщения),
+// а более новым (after) - больший id. И при этом не важно,
+// удалены эти сообщения или нет.
+// Authorization is optional, authorized user also gets bookmark marks and ignored authors.
+//
+// GET /api/threads
+func (c *Client) ThreadsList(ctx context.Context, params ThreadsListParams) (ThreadsListRes, error) {
+	res, err := c.sendThreadsList(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendThreadsList(ctx context.Context, params ThreadsListParams) (res ThreadsListRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("threadsList"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/threads"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ThreadsListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/threads"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "after" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.After.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Before.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "community_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "community_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CommunityID.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
 
-	c, err := newClientConfig(opts...).baseClient()
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
-		return nil, err
+		return res, errors.Wrap(err, "create request")
 	}
-	return &Client{
-		serverURL:  u,
-		sec:        sec,
-		baseClient: c,
-	}, nil
-}
 
-type serverURLKey struct{}
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, ThreadsListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
+			}
+		}
 
-// WithServerURL sets context key to override server URL.
-func WithServerURL(ctx context.Context, u *url.URL) context.Context {
-	return context.WithValue(ctx, serverURLKey{}, u)
-}
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{},
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
 
-func (c *Client) requestURL(ctx context.Context) *url.URL {
-	u, ok := ctx.Value(serverURLKey{}).(*url.URL)
-	if !ok {
-		return c.serverURL
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
 	}
-	return u
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeThreadsListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
 }
 
-// AuthLogin invokes authLogin operation.
+// UserCreate invokes userCreate operation.
 //
-// Create access and refresh JWT tokens, send to user. The refresh token also stored in a cookie.
+// Create a new user.
 //
-// POST /api/auth/login
-func (c *Client) AuthLogin(ctx context.Context, request *AuthLoginRequest) (*JwtToken, error) {
-	res, err := c.sendAuthLogin(ctx, request)
+// POST /api/user
+func (c *Client) UserCreate(ctx context.Context, request *UserCreateRequest) (UserCreateRes, error) {
+	res, err := c.sendUserCreate(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendAuthLogin(ctx context.Context, request *AuthLoginRequest) (res *JwtToken, err error) {
+func (c *Client) sendUserCreate(ctx context.Context, request *UserCreateRequest) (res UserCreateRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("authLogin"),
+		otelogen.OperationID("userCreate"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/auth/login"),
+		semconv.URLTemplateKey.String("/api/user"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -205,7 +9928,7 @@ func (c *Client) sendAuthLogin(ctx context.Context, request *AuthLoginRequest) (
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, AuthLoginOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UserCreateOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -223,7 +9946,7 @@ func (c *Client) sendAuthLogin(ctx context.Context, request *AuthLoginRequest) (
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/auth/login"
+	pathParts[0] = "/api/user"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -231,7 +9954,7 @@ func (c *Client) sendAuthLogin(ctx context.Context, request *AuthLoginRequest) (
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeAuthLoginRequest(request, r); err != nil {
+	if err := encodeUserCreateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -244,7 +9967,7 @@ func (c *Client) sendAuthLogin(ctx context.Context, request *AuthLoginRequest) (
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAuthLoginResponse(resp)
+	result, err := decodeUserCreateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -252,21 +9975,21 @@ func (c *Client) sendAuthLogin(ctx context.Context, request *AuthLoginRequest) (
 	return result, nil
 }
 
-// AuthLogout invokes authLogout operation.
+// UserDelete invokes userDelete operation.
 //
-// User logout.
+// Delete a user.
 //
-// POST /api/auth/logout
-func (c *Client) AuthLogout(ctx context.Context) error {
-	_, err := c.sendAuthLogout(ctx)
+// DELETE /api/user/{userId}
+func (c *Client) UserDelete(ctx context.Context, params UserDeleteParams) error {
+	_, err := c.sendUserDelete(ctx, params)
 	return err
 }
 
-func (c *Client) sendAuthLogout(ctx context.Context) (res *AuthLogoutNoContent, err error) {
+func (c *Client) sendUserDelete(ctx context.Context, params UserDeleteParams) (res *UserDeleteNoContent, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("authLogout"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/auth/logout"),
+		otelogen.OperationID("userDelete"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/user/{userId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -282,7 +10005,7 @@ func (c *Client) sendAuthLogout(ctx context.Context) (res *AuthLogoutNoContent,
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, AuthLogoutOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UserDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -299,12 +10022,30 @@ func (c *Client) sendAuthLogout(ctx context.Context) (res *AuthLogoutNoContent,
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/auth/logout"
+	var pathParts [2]string
+	pathParts[0] = "/api/user/"
+	{
+		// Encode "userId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "userId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.UserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -313,14 +10054,14 @@ func (c *Client) sendAuthLogout(ctx context.Context) (res *AuthLogoutNoContent,
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, AuthLogoutOperation, r); {
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, UserDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
 				// Skip this security.
 			default:
-				return res, errors.Wrap(err, "security \"CookieAuth\"")
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
 			}
 		}
 
@@ -351,7 +10092,7 @@ func (c *Client) sendAuthLogout(ctx context.Context) (res *AuthLogoutNoContent,
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAuthLogoutResponse(resp)
+	result, err := decodeUserDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -359,21 +10100,22 @@ func (c *Client) sendAuthLogout(ctx context.Context) (res *AuthLogoutNoContent,
 	return result, nil
 }
 
-// AuthRefresh invokes authRefresh operation.
+// UserFollow invokes userFollow operation.
 //
-// Update access and refresh tokens, send to user. The refresh token also stored in a cookie.
+// Threads and posts of followed users are shown in feed. Following is idempotent,
+// user blocked by target can not follow them.
 //
-// POST /api/auth/refresh
-func (c *Client) AuthRefresh(ctx context.Context) (AuthRefreshRes, error) {
-	res, err := c.sendAuthRefresh(ctx)
+// PUT /api/user/{userId}/follow
+func (c *Client) UserFollow(ctx context.Context, params UserFollowParams) (UserFollowRes, error) {
+	res, err := c.sendUserFollow(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendAuthRefresh(ctx context.Context) (res AuthRefreshRes, err error) {
+func (c *Client) sendUserFollow(ctx context.Context, params UserFollowParams) (res UserFollowRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("authRefresh"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/auth/refresh"),
+		otelogen.OperationID("userFollow"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/api/user/{userId}/follow"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -389,7 +10131,7 @@ func (c *Client) sendAuthRefresh(ctx context.Context) (res AuthRefreshRes, err e
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, AuthRefreshOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UserFollowOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -406,12 +10148,31 @@ func (c *Client) sendAuthRefresh(ctx context.Context) (res AuthRefreshRes, err e
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/auth/refresh"
+	var pathParts [3]string
+	pathParts[0] = "/api/user/"
+	{
+		// Encode "userId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "userId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.UserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/follow"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -420,14 +10181,14 @@ func (c *Client) sendAuthRefresh(ctx context.Context) (res AuthRefreshRes, err e
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, AuthRefreshOperation, r); {
+			stage = "Security:JwtAuth"
+			switch err := c.securityJwtAuth(ctx, UserFollowOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
 				// Skip this security.
 			default:
-				return res, errors.Wrap(err, "security \"CookieAuth\"")
+				return res, errors.Wrap(err, "security \"JwtAuth\"")
 			}
 		}
 
@@ -458,7 +10219,7 @@ func (c *Client) sendAuthRefresh(ctx context.Context) (res AuthRefreshRes, err e
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAuthRefreshResponse(resp)
+	result, err := decodeUserFollowResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -466,21 +10227,21 @@ func (c *Client) sendAuthRefresh(ctx context.Context) (res AuthRefreshRes, err e
 	return result, nil
 }
 
-// ThreadAddPost invokes threadAddPost operation.
+// UserFollowers invokes userFollowers operation.
 //
-// Add a new post to thread.
+// List followers of user, the latest first.
 //
-// POST /api/threads/{threadId}/posts
-func (c *Client) ThreadAddPost(ctx context.Context, request *ThreadCreatePostRequest, params ThreadAddPostParams) (ThreadAddPostRes, error) {
-	res, err := c.sendThreadAddPost(ctx, request, params)
+// GET /api/user/{userId}/followers
+func (c *Client) UserFollowers(ctx context.Context, params UserFollowersParams) (UserFollowersRes, error) {
+	res, err := c.sendUserFollowers(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendThreadAddPost(ctx context.Context, request *ThreadCreatePostRequest, params ThreadAddPostParams) (res ThreadAddPostRes, err error) {
+func (c *Client) sendUserFollowers(ctx context.Context, params UserFollowersParams) (res UserFollowersRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("threadAddPost"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/threads/{threadId}/posts"),
+		otelogen.OperationID("userFollowers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/user/{userId}/followers"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -496,7 +10257,7 @@ func (c *Client) sendThreadAddPost(ctx context.Context, request *ThreadCreatePos
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ThreadAddPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UserFollowersOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -514,16 +10275,16 @@ func (c *Client) sendThreadAddPost(ctx context.Context, request *ThreadCreatePos
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/api/threads/"
+	pathParts[0] = "/api/user/"
 	{
-		// Encode "threadId" parameter.
+		// Encode "userId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "threadId",
+			Param:   "userId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ThreadId))
+			return e.EncodeValue(conv.IntToString(params.UserId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -533,49 +10294,51 @@ func (c *Client) sendThreadAddPost(ctx context.Context, request *ThreadCreatePos
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/posts"
+	pathParts[2] = "/followers"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-	if err := encodeThreadAddPostRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Before.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:JwtAuth"
-			switch err := c.securityJwtAuth(ctx, ThreadAddPostOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"JwtAuth\"")
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
+	}
+	u.RawQuery = q.Values().Encode()
 
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
-		}
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
 	}
 
 	stage = "SendRequest"
@@ -587,7 +10350,7 @@ func (c *Client) sendThreadAddPost(ctx context.Context, request *ThreadCreatePos
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeThreadAddPostResponse(resp)
+	result, err := decodeUserFollowersResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -595,21 +10358,21 @@ func (c *Client) sendThreadAddPost(ctx context.Context, request *ThreadCreatePos
 	return result, nil
 }
 
-// ThreadCreate invokes threadCreate operation.
+// UserFollowing invokes userFollowing operation.
 //
-// Create a new thread.
+// List users followed by user, the latest first.
 //
-// POST /api/threads
-func (c *Client) ThreadCreate(ctx context.Context, request *ThreadCreateRequest) (ThreadCreateRes, error) {
-	res, err := c.sendThreadCreate(ctx, request)
+// GET /api/user/{userId}/following
+func (c *Client) UserFollowing(ctx context.Context, params UserFollowingParams) (UserFollowingRes, error) {
+	res, err := c.sendUserFollowing(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendThreadCreate(ctx context.Context, request *ThreadCreateRequest) (res ThreadCreateRes, err error) {
+func (c *Client) sendUserFollowing(ctx context.Context, params UserFollowingParams) (res UserFollowingRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("threadCreate"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/threads"),
+		otelogen.OperationID("userFollowing"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/user/{userId}/following"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -625,7 +10388,7 @@ func (c *Client) sendThreadCreate(ctx context.Context, request *ThreadCreateRequ
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ThreadCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UserFollowingOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -642,51 +10405,72 @@ func (c *Client) sendThreadCreate(ctx context.Context, request *ThreadCreateRequ
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/threads"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-	if err := encodeThreadCreateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
+	var pathParts [3]string
+	pathParts[0] = "/api/user/"
+	{
+		// Encode "userId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "userId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.UserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
 	}
+	pathParts[2] = "/following"
+	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:JwtAuth"
-			switch err := c.securityJwtAuth(ctx, ThreadCreateOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"JwtAuth\"")
+		// Encode "before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Before.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
 
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -697,7 +10481,7 @@ func (c *Client) sendThreadCreate(ctx context.Context, request *ThreadCreateRequ
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeThreadCreateResponse(resp)
+	result, err := decodeUserFollowingResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -705,21 +10489,21 @@ func (c *Client) sendThreadCreate(ctx context.Context, request *ThreadCreateRequ
 	return result, nil
 }
 
-// ThreadGet invokes threadGet operation.
+// UserGet invokes userGet operation.
 //
-// Get single thread with all posts by thread id.
+// Authorization is optional. Private fields (email) are returned only to the user and admins.
 //
-// GET /api/threads/{threadId}
-func (c *Client) ThreadGet(ctx context.Context, params ThreadGetParams) (ThreadGetRes, error) {
-	res, err := c.sendThreadGet(ctx, params)
+// GET /api/user/{userId}
+func (c *Client) UserGet(ctx context.Context, params UserGetParams) (UserGetRes, error) {
+	res, err := c.sendUserGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendThreadGet(ctx context.Context, params ThreadGetParams) (res ThreadGetRes, err error) {
+func (c *Client) sendUserGet(ctx context.Context, params UserGetParams) (res UserGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("threadGet"),
+		otelogen.OperationID("userGet"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/threads/{threadId}"),
+		semconv.URLTemplateKey.String("/api/user/{userId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -735,7 +10519,7 @@ func (c *Client) sendThreadGet(ctx context.Context, params ThreadGetParams) (res
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ThreadGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UserGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -753,16 +10537,16 @@ func (c *Client) sendThreadGet(ctx context.Context, params ThreadGetParams) (res
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/api/threads/"
+	pathParts[0] = "/api/user/"
 	{
-		// Encode "threadId" parameter.
+		// Encode "userId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "threadId",
+			Param:   "userId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ThreadId))
+			return e.EncodeValue(conv.IntToString(params.UserId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -785,7 +10569,7 @@ func (c *Client) sendThreadGet(ctx context.Context, params ThreadGetParams) (res
 		var satisfied bitset
 		{
 			stage = "Security:JwtAuth"
-			switch err := c.securityJwtAuth(ctx, ThreadGetOperation, r); {
+			switch err := c.securityJwtAuth(ctx, UserGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -798,6 +10582,7 @@ func (c *Client) sendThreadGet(ctx context.Context, params ThreadGetParams) (res
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
+				{},
 				{0b00000001},
 			} {
 				for i, mask := range requirement {
@@ -822,7 +10607,7 @@ func (c *Client) sendThreadGet(ctx context.Context, params ThreadGetParams) (res
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeThreadGetResponse(resp)
+	result, err := decodeUserGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -830,32 +10615,21 @@ func (c *Client) sendThreadGet(ctx context.Context, params ThreadGetParams) (res
 	return result, nil
 }
 
-// ThreadsList invokes threadsList operation.
+// UserMe invokes userMe operation.
 //
-// Get list of threads with pagination. You can use either page-based pagination (page + limit)
-// or cursor-based pagination (after or before). The response includes an estimated total count
-// of threads for better performance.
-// Only one pagination method should be used at a time.
-// If both are provided, page-based pagination will be used.
-// `page` - will return that page
-// `before` - return older threads with lower id than provided (provided id will not be included in
-// response),
-// `after` - return newer threads with higher id than provided (provided id will not be included in
-// response).
-// If no pagination parameters are provided, the first page will be returned.
-// If no limit is provided, the default limit is used.
+// Get current user information.
 //
-// GET /api/threads
-func (c *Client) ThreadsList(ctx context.Context, params ThreadsListParams) (ThreadsListRes, error) {
-	res, err := c.sendThreadsList(ctx, params)
+// GET /api/user/me
+func (c *Client) UserMe(ctx context.Context) (UserMeRes, error) {
+	res, err := c.sendUserMe(ctx)
 	return res, err
 }
 
-func (c *Client) sendThreadsList(ctx context.Context, params ThreadsListParams) (res ThreadsListRes, err error) {
+func (c *Client) sendUserMe(ctx context.Context) (res UserMeRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("threadsList"),
+		otelogen.OperationID("userMe"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/threads"),
+		semconv.URLTemplateKey.String("/api/user/me"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -871,7 +10645,7 @@ func (c *Client) sendThreadsList(ctx context.Context, params ThreadsListParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ThreadsListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UserMeOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -883,86 +10657,14 @@ func (c *Client) sendThreadsList(ctx context.Context, params ThreadsListParams)
 			span.SetStatus(codes.Error, stage)
 			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/threads"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "page" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Page.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "after" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "after",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.After.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "before" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "before",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Before.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/user/me"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -975,7 +10677,7 @@ func (c *Client) sendThreadsList(ctx context.Context, params ThreadsListParams)
 		var satisfied bitset
 		{
 			stage = "Security:JwtAuth"
-			switch err := c.securityJwtAuth(ctx, ThreadsListOperation, r); {
+			switch err := c.securityJwtAuth(ctx, UserMeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1012,7 +10714,7 @@ func (c *Client) sendThreadsList(ctx context.Context, params ThreadsListParams)
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeThreadsListResponse(resp)
+	result, err := decodeUserMeResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1020,21 +10722,21 @@ func (c *Client) sendThreadsList(ctx context.Context, params ThreadsListParams)
 	return result, nil
 }
 
-// UserCreate invokes userCreate operation.
+// UserPosts invokes userPosts operation.
 //
-// Create a new user.
+// List visible posts of user, newest first.
 //
-// POST /api/user
-func (c *Client) UserCreate(ctx context.Context, request *UserCreateRequest) (UserCreateRes, error) {
-	res, err := c.sendUserCreate(ctx, request)
+// GET /api/user/{userId}/posts
+func (c *Client) UserPosts(ctx context.Context, params UserPostsParams) (UserPostsRes, error) {
+	res, err := c.sendUserPosts(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUserCreate(ctx context.Context, request *UserCreateRequest) (res UserCreateRes, err error) {
+func (c *Client) sendUserPosts(ctx context.Context, params UserPostsParams) (res UserPostsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("userCreate"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/user"),
+		otelogen.OperationID("userPosts"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/user/{userId}/posts"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1050,7 +10752,7 @@ func (c *Client) sendUserCreate(ctx context.Context, request *UserCreateRequest)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UserCreateOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UserPostsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1067,18 +10769,72 @@ func (c *Client) sendUserCreate(ctx context.Context, request *UserCreateRequest)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/user"
+	var pathParts [3]string
+	pathParts[0] = "/api/user/"
+	{
+		// Encode "userId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "userId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.UserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/posts"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Before.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUserCreateRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -1089,7 +10845,7 @@ func (c *Client) sendUserCreate(ctx context.Context, request *UserCreateRequest)
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUserCreateResponse(resp)
+	result, err := decodeUserPostsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1097,21 +10853,21 @@ func (c *Client) sendUserCreate(ctx context.Context, request *UserCreateRequest)
 	return result, nil
 }
 
-// UserDelete invokes userDelete operation.
+// UserRankHistory invokes userRankHistory operation.
 //
-// Delete a user.
+// Get history of user rank changes, newest first.
 //
-// DELETE /api/user/{userId}
-func (c *Client) UserDelete(ctx context.Context, params UserDeleteParams) error {
-	_, err := c.sendUserDelete(ctx, params)
-	return err
+// GET /api/user/{userId}/rank-history
+func (c *Client) UserRankHistory(ctx context.Context, params UserRankHistoryParams) (UserRankHistoryRes, error) {
+	res, err := c.sendUserRankHistory(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendUserDelete(ctx context.Context, params UserDeleteParams) (res *UserDeleteNoContent, err error) {
+func (c *Client) sendUserRankHistory(ctx context.Context, params UserRankHistoryParams) (res UserRankHistoryRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("userDelete"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/api/user/{userId}"),
+		otelogen.OperationID("userRankHistory"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/user/{userId}/rank-history"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1127,7 +10883,7 @@ func (c *Client) sendUserDelete(ctx context.Context, params UserDeleteParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UserDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UserRankHistoryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1144,7 +10900,7 @@ func (c *Client) sendUserDelete(ctx context.Context, params UserDeleteParams) (r
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [3]string
 	pathParts[0] = "/api/user/"
 	{
 		// Encode "userId" parameter.
@@ -1164,47 +10920,15 @@ func (c *Client) sendUserDelete(ctx context.Context, params UserDeleteParams) (r
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/rank-history"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:JwtAuth"
-			switch err := c.securityJwtAuth(ctx, UserDeleteOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"JwtAuth\"")
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
-		}
-	}
-
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -1214,7 +10938,7 @@ func (c *Client) sendUserDelete(ctx context.Context, params UserDeleteParams) (r
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUserDeleteResponse(resp)
+	result, err := decodeUserRankHistoryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1222,21 +10946,21 @@ func (c *Client) sendUserDelete(ctx context.Context, params UserDeleteParams) (r
 	return result, nil
 }
 
-// UserGet invokes userGet operation.
+// UserThreads invokes userThreads operation.
 //
-// Get user information.
+// List visible threads of user, newest first.
 //
-// GET /api/user/{userId}
-func (c *Client) UserGet(ctx context.Context, params UserGetParams) (UserGetRes, error) {
-	res, err := c.sendUserGet(ctx, params)
+// GET /api/user/{userId}/threads
+func (c *Client) UserThreads(ctx context.Context, params UserThreadsParams) (UserThreadsRes, error) {
+	res, err := c.sendUserThreads(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUserGet(ctx context.Context, params UserGetParams) (res UserGetRes, err error) {
+func (c *Client) sendUserThreads(ctx context.Context, params UserThreadsParams) (res UserThreadsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("userGet"),
+		otelogen.OperationID("userThreads"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/user/{userId}"),
+		semconv.URLTemplateKey.String("/api/user/{userId}/threads"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1252,7 +10976,7 @@ func (c *Client) sendUserGet(ctx context.Context, params UserGetParams) (res Use
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UserGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UserThreadsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1269,7 +10993,7 @@ func (c *Client) sendUserGet(ctx context.Context, params UserGetParams) (res Use
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [3]string
 	pathParts[0] = "/api/user/"
 	{
 		// Encode "userId" parameter.
@@ -1289,46 +11013,52 @@ func (c *Client) sendUserGet(ctx context.Context, params UserGetParams) (res Use
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/threads"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:JwtAuth"
-			switch err := c.securityJwtAuth(ctx, UserGetOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"JwtAuth\"")
+		// Encode "before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Before.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
 
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -1339,7 +11069,7 @@ func (c *Client) sendUserGet(ctx context.Context, params UserGetParams) (res Use
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUserGetResponse(resp)
+	result, err := decodeUserThreadsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1347,21 +11077,21 @@ func (c *Client) sendUserGet(ctx context.Context, params UserGetParams) (res Use
 	return result, nil
 }
 
-// UserMe invokes userMe operation.
+// UserUnfollow invokes userUnfollow operation.
 //
-// Get current user information.
+// Unfollow user.
 //
-// GET /api/user/me
-func (c *Client) UserMe(ctx context.Context) (UserMeRes, error) {
-	res, err := c.sendUserMe(ctx)
+// DELETE /api/user/{userId}/follow
+func (c *Client) UserUnfollow(ctx context.Context, params UserUnfollowParams) (UserUnfollowRes, error) {
+	res, err := c.sendUserUnfollow(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendUserMe(ctx context.Context) (res UserMeRes, err error) {
+func (c *Client) sendUserUnfollow(ctx context.Context, params UserUnfollowParams) (res UserUnfollowRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("userMe"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/user/me"),
+		otelogen.OperationID("userUnfollow"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/user/{userId}/follow"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1377,7 +11107,7 @@ func (c *Client) sendUserMe(ctx context.Context) (res UserMeRes, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UserMeOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UserUnfollowOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1394,12 +11124,31 @@ func (c *Client) sendUserMe(ctx context.Context) (res UserMeRes, err error) {
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/user/me"
+	var pathParts [3]string
+	pathParts[0] = "/api/user/"
+	{
+		// Encode "userId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "userId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.UserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/follow"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1409,7 +11158,7 @@ func (c *Client) sendUserMe(ctx context.Context) (res UserMeRes, err error) {
 		var satisfied bitset
 		{
 			stage = "Security:JwtAuth"
-			switch err := c.securityJwtAuth(ctx, UserMeOperation, r); {
+			switch err := c.securityJwtAuth(ctx, UserUnfollowOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1446,7 +11195,7 @@ func (c *Client) sendUserMe(ctx context.Context) (res UserMeRes, err error) {
 	defer body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUserMeResponse(resp)
+	result, err := decodeUserUnfollowResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1456,15 +11205,15 @@ func (c *Client) sendUserMe(ctx context.Context) (res UserMeRes, err error) {
 
 // UserUpdate invokes userUpdate operation.
 //
-// Update user information.
+// Allowed to the user and admins. All profile fields are replaced, empty value clears field.
 //
 // POST /api/user/{userId}
-func (c *Client) UserUpdate(ctx context.Context, request *UserCreateRequest, params UserUpdateParams) (*UserCreateResponseOk, error) {
+func (c *Client) UserUpdate(ctx context.Context, request *UserUpdateRequest, params UserUpdateParams) (UserUpdateRes, error) {
 	res, err := c.sendUserUpdate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUserUpdate(ctx context.Context, request *UserCreateRequest, params UserUpdateParams) (res *UserCreateResponseOk, err error) {
+func (c *Client) sendUserUpdate(ctx context.Context, request *UserUpdateRequest, params UserUpdateParams) (res UserUpdateRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		otelogen.OperationID("userUpdate"),
 		semconv.HTTPRequestMethodKey.String("POST"),
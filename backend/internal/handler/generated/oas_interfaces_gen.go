@@ -1,10 +1,218 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type AnalyticsGraphExportRes interface {
+	analyticsGraphExportRes()
+}
+
+type AnalyticsGraphRebuildRes interface {
+	analyticsGraphRebuildRes()
+}
+
+type AnalyticsGraphRes interface {
+	analyticsGraphRes()
+}
+
+type AnalyticsInfluenceSnapshotRes interface {
+	analyticsInfluenceSnapshotRes()
+}
+
+type AnalyticsLeaderboardRes interface {
+	analyticsLeaderboardRes()
+}
+
+type AnalyticsUserInfluenceRes interface {
+	analyticsUserInfluenceRes()
+}
+
+type AttachmentDownloadRes interface {
+	attachmentDownloadRes()
+}
+
+type AttachmentGetRes interface {
+	attachmentGetRes()
+}
+
+type AttachmentUploadRes interface {
+	attachmentUploadRes()
+}
+
 type AuthRefreshRes interface {
 	authRefreshRes()
 }
 
+type BookmarkCollectionCreateRes interface {
+	bookmarkCollectionCreateRes()
+}
+
+type BookmarkCollectionDeleteRes interface {
+	bookmarkCollectionDeleteRes()
+}
+
+type BookmarkCollectionsListRes interface {
+	bookmarkCollectionsListRes()
+}
+
+type BookmarkCreateRes interface {
+	bookmarkCreateRes()
+}
+
+type BookmarkDeleteRes interface {
+	bookmarkDeleteRes()
+}
+
+type BookmarksListRes interface {
+	bookmarksListRes()
+}
+
+type CommunitySubscribeRes interface {
+	communitySubscribeRes()
+}
+
+type CommunitySubscriptionsListRes interface {
+	communitySubscriptionsListRes()
+}
+
+type CommunityUnsubscribeRes interface {
+	communityUnsubscribeRes()
+}
+
+type ConversationCreateRes interface {
+	conversationCreateRes()
+}
+
+type ConversationMarkReadRes interface {
+	conversationMarkReadRes()
+}
+
+type ConversationMessagesRes interface {
+	conversationMessagesRes()
+}
+
+type ConversationSendRes interface {
+	conversationSendRes()
+}
+
+type ConversationsListRes interface {
+	conversationsListRes()
+}
+
+type ConversationsUnreadCountRes interface {
+	conversationsUnreadCountRes()
+}
+
+type FeedGetRes interface {
+	feedGetRes()
+}
+
+type LiveThreadRes interface {
+	liveThreadRes()
+}
+
+type LiveThreadsRes interface {
+	liveThreadsRes()
+}
+
+type MentionUsersRes interface {
+	mentionUsersRes()
+}
+
+type ModerationDecideHeldRes interface {
+	moderationDecideHeldRes()
+}
+
+type ModerationHeldRes interface {
+	moderationHeldRes()
+}
+
+type ModerationLogRes interface {
+	moderationLogRes()
+}
+
+type ModerationQueueRes interface {
+	moderationQueueRes()
+}
+
+type ModerationResolveRes interface {
+	moderationResolveRes()
+}
+
+type NotificationPreferencesGetRes interface {
+	notificationPreferencesGetRes()
+}
+
+type NotificationPreferencesUpdateRes interface {
+	notificationPreferencesUpdateRes()
+}
+
+type NotificationsListRes interface {
+	notificationsListRes()
+}
+
+type NotificationsMarkReadRes interface {
+	notificationsMarkReadRes()
+}
+
+type NotificationsReadAllRes interface {
+	notificationsReadAllRes()
+}
+
+type NotificationsUnreadCountRes interface {
+	notificationsUnreadCountRes()
+}
+
+type PollVoteRes interface {
+	pollVoteRes()
+}
+
+type PostEditRes interface {
+	postEditRes()
+}
+
+type PostRevisionRestoreRes interface {
+	postRevisionRestoreRes()
+}
+
+type PostRevisionsDiffRes interface {
+	postRevisionsDiffRes()
+}
+
+type PostRevisionsRes interface {
+	postRevisionsRes()
+}
+
+type PostVoteRes interface {
+	postVoteRes()
+}
+
+type RelationDeleteRes interface {
+	relationDeleteRes()
+}
+
+type RelationSetRes interface {
+	relationSetRes()
+}
+
+type RelationsListRes interface {
+	relationsListRes()
+}
+
+type ReportCreateRes interface {
+	reportCreateRes()
+}
+
+type SearchRes interface {
+	searchRes()
+}
+
+type SubscriptionsListRes interface {
+	subscriptionsListRes()
+}
+
+type ThreadAcceptAnswerRes interface {
+	threadAcceptAnswerRes()
+}
+
 type ThreadAddPostRes interface {
 	threadAddPostRes()
 }
@@ -13,10 +221,46 @@ type ThreadCreateRes interface {
 	threadCreateRes()
 }
 
+type ThreadEditRes interface {
+	threadEditRes()
+}
+
 type ThreadGetRes interface {
 	threadGetRes()
 }
 
+type ThreadRevisionRestoreRes interface {
+	threadRevisionRestoreRes()
+}
+
+type ThreadRevisionsDiffRes interface {
+	threadRevisionsDiffRes()
+}
+
+type ThreadRevisionsRes interface {
+	threadRevisionsRes()
+}
+
+type ThreadSetStateRes interface {
+	threadSetStateRes()
+}
+
+type ThreadSubscribeRes interface {
+	threadSubscribeRes()
+}
+
+type ThreadSubscriptionGetRes interface {
+	threadSubscriptionGetRes()
+}
+
+type ThreadUnsubscribeRes interface {
+	threadUnsubscribeRes()
+}
+
+type ThreadVoteRes interface {
+	threadVoteRes()
+}
+
 type ThreadsListRes interface {
 	threadsListRes()
 }
@@ -25,6 +269,18 @@ type UserCreateRes interface {
 	userCreateRes()
 }
 
+type UserFollowRes interface {
+	userFollowRes()
+}
+
+type UserFollowersRes interface {
+	userFollowersRes()
+}
+
+type UserFollowingRes interface {
+	userFollowingRes()
+}
+
 type UserGetRes interface {
 	userGetRes()
 }
@@ -32,3 +288,23 @@ type UserGetRes interface {
 type UserMeRes interface {
 	userMeRes()
 }
+
+type UserPostsRes interface {
+	userPostsRes()
+}
+
+type UserRankHistoryRes interface {
+	userRankHistoryRes()
+}
+
+type UserThreadsRes interface {
+	userThreadsRes()
+}
+
+type UserUnfollowRes interface {
+	userUnfollowRes()
+}
+
+type UserUpdateRes interface {
+	userUpdateRes()
+}
@@ -6,16 +6,84 @@ package api
 type OperationName = string
 
 const (
-	AuthLoginOperation     OperationName = "AuthLogin"
-	AuthLogoutOperation    OperationName = "AuthLogout"
-	AuthRefreshOperation   OperationName = "AuthRefresh"
-	ThreadAddPostOperation OperationName = "ThreadAddPost"
-	ThreadCreateOperation  OperationName = "ThreadCreate"
-	ThreadGetOperation     OperationName = "ThreadGet"
-	ThreadsListOperation   OperationName = "ThreadsList"
-	UserCreateOperation    OperationName = "UserCreate"
-	UserDeleteOperation    OperationName = "UserDelete"
-	UserGetOperation       OperationName = "UserGet"
-	UserMeOperation        OperationName = "UserMe"
-	UserUpdateOperation    OperationName = "UserUpdate"
+	AnalyticsGraphOperation                OperationName = "AnalyticsGraph"
+	AnalyticsGraphExportOperation          OperationName = "AnalyticsGraphExport"
+	AnalyticsGraphRebuildOperation         OperationName = "AnalyticsGraphRebuild"
+	AnalyticsInfluenceSnapshotOperation    OperationName = "AnalyticsInfluenceSnapshot"
+	AnalyticsLeaderboardOperation          OperationName = "AnalyticsLeaderboard"
+	AnalyticsUserInfluenceOperation        OperationName = "AnalyticsUserInfluence"
+	AttachmentDownloadOperation            OperationName = "AttachmentDownload"
+	AttachmentGetOperation                 OperationName = "AttachmentGet"
+	AttachmentUploadOperation              OperationName = "AttachmentUpload"
+	AuthLoginOperation                     OperationName = "AuthLogin"
+	AuthLogoutOperation                    OperationName = "AuthLogout"
+	AuthRefreshOperation                   OperationName = "AuthRefresh"
+	BookmarkCollectionCreateOperation      OperationName = "BookmarkCollectionCreate"
+	BookmarkCollectionDeleteOperation      OperationName = "BookmarkCollectionDelete"
+	BookmarkCollectionsListOperation       OperationName = "BookmarkCollectionsList"
+	BookmarkCreateOperation                OperationName = "BookmarkCreate"
+	BookmarkDeleteOperation                OperationName = "BookmarkDelete"
+	BookmarksListOperation                 OperationName = "BookmarksList"
+	CommunitySubscribeOperation            OperationName = "CommunitySubscribe"
+	CommunitySubscriptionsListOperation    OperationName = "CommunitySubscriptionsList"
+	CommunityUnsubscribeOperation          OperationName = "CommunityUnsubscribe"
+	ConversationCreateOperation            OperationName = "ConversationCreate"
+	ConversationMarkReadOperation          OperationName = "ConversationMarkRead"
+	ConversationMessagesOperation          OperationName = "ConversationMessages"
+	ConversationSendOperation              OperationName = "ConversationSend"
+	ConversationsListOperation             OperationName = "ConversationsList"
+	ConversationsUnreadCountOperation      OperationName = "ConversationsUnreadCount"
+	FeedGetOperation                       OperationName = "FeedGet"
+	LiveThreadOperation                    OperationName = "LiveThread"
+	LiveThreadsOperation                   OperationName = "LiveThreads"
+	MentionUsersOperation                  OperationName = "MentionUsers"
+	ModerationDecideHeldOperation          OperationName = "ModerationDecideHeld"
+	ModerationHeldOperation                OperationName = "ModerationHeld"
+	ModerationLogOperation                 OperationName = "ModerationLog"
+	ModerationQueueOperation               OperationName = "ModerationQueue"
+	ModerationResolveOperation             OperationName = "ModerationResolve"
+	NotificationPreferencesGetOperation    OperationName = "NotificationPreferencesGet"
+	NotificationPreferencesUpdateOperation OperationName = "NotificationPreferencesUpdate"
+	NotificationsListOperation             OperationName = "NotificationsList"
+	NotificationsMarkReadOperation         OperationName = "NotificationsMarkRead"
+	NotificationsReadAllOperation          OperationName = "NotificationsReadAll"
+	NotificationsUnreadCountOperation      OperationName = "NotificationsUnreadCount"
+	PollVoteOperation                      OperationName = "PollVote"
+	PostEditOperation                      OperationName = "PostEdit"
+	PostRevisionRestoreOperation           OperationName = "PostRevisionRestore"
+	PostRevisionsOperation                 OperationName = "PostRevisions"
+	PostRevisionsDiffOperation             OperationName = "PostRevisionsDiff"
+	PostVoteOperation                      OperationName = "PostVote"
+	RelationDeleteOperation                OperationName = "RelationDelete"
+	RelationSetOperation                   OperationName = "RelationSet"
+	RelationsListOperation                 OperationName = "RelationsList"
+	ReportCreateOperation                  OperationName = "ReportCreate"
+	SearchOperation                        OperationName = "Search"
+	SubscriptionsListOperation             OperationName = "SubscriptionsList"
+	ThreadAcceptAnswerOperation            OperationName = "ThreadAcceptAnswer"
+	ThreadAddPostOperation                 OperationName = "ThreadAddPost"
+	ThreadCreateOperation                  OperationName = "ThreadCreate"
+	ThreadEditOperation                    OperationName = "ThreadEdit"
+	ThreadGetOperation                     OperationName = "ThreadGet"
+	ThreadRevisionRestoreOperation         OperationName = "ThreadRevisionRestore"
+	ThreadRevisionsOperation               OperationName = "ThreadRevisions"
+	ThreadRevisionsDiffOperation           OperationName = "ThreadRevisionsDiff"
+	ThreadSetStateOperation                OperationName = "ThreadSetState"
+	ThreadSubscribeOperation               OperationName = "ThreadSubscribe"
+	ThreadSubscriptionGetOperation         OperationName = "ThreadSubscriptionGet"
+	ThreadUnsubscribeOperation             OperationName = "ThreadUnsubscribe"
+	ThreadVoteOperation                    OperationName = "ThreadVote"
+	ThreadsListOperation                   OperationName = "ThreadsList"
+	UserCreateOperation                    OperationName = "UserCreate"
+	UserDeleteOperation                    OperationName = "UserDelete"
+	UserFollowOperation                    OperationName = "UserFollow"
+	UserFollowersOperation                 OperationName = "UserFollowers"
+	UserFollowingOperation                 OperationName = "UserFollowing"
+	UserGetOperation                       OperationName = "UserGet"
+	UserMeOperation                        OperationName = "UserMe"
+	UserPostsOperation                     OperationName = "UserPosts"
+	UserRankHistoryOperation               OperationName = "UserRankHistory"
+	UserThreadsOperation                   OperationName = "UserThreads"
+	UserUnfollowOperation                  OperationName = "UserUnfollow"
+	UserUpdateOperation                    OperationName = "UserUpdate"
 )
@@ -3,633 +3,8084 @@
 package api
 
 import (
+	"io"
 	"time"
+
+	"github.com/go-faster/errors"
+	ht "github.com/ogen-go/ogen/http"
+)
+
+type AnalyticsGraphBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsGraphBadRequest) analyticsGraphRes() {}
+
+type AnalyticsGraphBadRequestApplicationJSON string
+
+func (*AnalyticsGraphBadRequestApplicationJSON) liveThreadRes()  {}
+func (*AnalyticsGraphBadRequestApplicationJSON) liveThreadsRes() {}
+
+type AnalyticsGraphExportBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsGraphExportBadRequest) analyticsGraphExportRes() {}
+
+type AnalyticsGraphExportForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsGraphExportForbidden) analyticsGraphExportRes() {}
+
+type AnalyticsGraphExportInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsGraphExportInternalServerError) analyticsGraphExportRes() {}
+
+type AnalyticsGraphExportOKApplicationGexfXML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s AnalyticsGraphExportOKApplicationGexfXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// AnalyticsGraphExportOKApplicationGexfXMLHeaders wraps AnalyticsGraphExportOKApplicationGexfXML with response headers.
+type AnalyticsGraphExportOKApplicationGexfXMLHeaders struct {
+	ContentDisposition string
+	Response           AnalyticsGraphExportOKApplicationGexfXML
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *AnalyticsGraphExportOKApplicationGexfXMLHeaders) GetContentDisposition() string {
+	return s.ContentDisposition
+}
+
+// GetResponse returns the value of Response.
+func (s *AnalyticsGraphExportOKApplicationGexfXMLHeaders) GetResponse() AnalyticsGraphExportOKApplicationGexfXML {
+	return s.Response
+}
+
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *AnalyticsGraphExportOKApplicationGexfXMLHeaders) SetContentDisposition(val string) {
+	s.ContentDisposition = val
+}
+
+// SetResponse sets the value of Response.
+func (s *AnalyticsGraphExportOKApplicationGexfXMLHeaders) SetResponse(val AnalyticsGraphExportOKApplicationGexfXML) {
+	s.Response = val
+}
+
+func (*AnalyticsGraphExportOKApplicationGexfXMLHeaders) analyticsGraphExportRes() {}
+
+type AnalyticsGraphExportOKApplicationGraphmlXML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s AnalyticsGraphExportOKApplicationGraphmlXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// AnalyticsGraphExportOKApplicationGraphmlXMLHeaders wraps AnalyticsGraphExportOKApplicationGraphmlXML with response headers.
+type AnalyticsGraphExportOKApplicationGraphmlXMLHeaders struct {
+	ContentDisposition string
+	Response           AnalyticsGraphExportOKApplicationGraphmlXML
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *AnalyticsGraphExportOKApplicationGraphmlXMLHeaders) GetContentDisposition() string {
+	return s.ContentDisposition
+}
+
+// GetResponse returns the value of Response.
+func (s *AnalyticsGraphExportOKApplicationGraphmlXMLHeaders) GetResponse() AnalyticsGraphExportOKApplicationGraphmlXML {
+	return s.Response
+}
+
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *AnalyticsGraphExportOKApplicationGraphmlXMLHeaders) SetContentDisposition(val string) {
+	s.ContentDisposition = val
+}
+
+// SetResponse sets the value of Response.
+func (s *AnalyticsGraphExportOKApplicationGraphmlXMLHeaders) SetResponse(val AnalyticsGraphExportOKApplicationGraphmlXML) {
+	s.Response = val
+}
+
+func (*AnalyticsGraphExportOKApplicationGraphmlXMLHeaders) analyticsGraphExportRes() {}
+
+type AnalyticsGraphExportOKApplicationZip struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s AnalyticsGraphExportOKApplicationZip) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// AnalyticsGraphExportOKApplicationZipHeaders wraps AnalyticsGraphExportOKApplicationZip with response headers.
+type AnalyticsGraphExportOKApplicationZipHeaders struct {
+	ContentDisposition string
+	Response           AnalyticsGraphExportOKApplicationZip
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *AnalyticsGraphExportOKApplicationZipHeaders) GetContentDisposition() string {
+	return s.ContentDisposition
+}
+
+// GetResponse returns the value of Response.
+func (s *AnalyticsGraphExportOKApplicationZipHeaders) GetResponse() AnalyticsGraphExportOKApplicationZip {
+	return s.Response
+}
+
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *AnalyticsGraphExportOKApplicationZipHeaders) SetContentDisposition(val string) {
+	s.ContentDisposition = val
+}
+
+// SetResponse sets the value of Response.
+func (s *AnalyticsGraphExportOKApplicationZipHeaders) SetResponse(val AnalyticsGraphExportOKApplicationZip) {
+	s.Response = val
+}
+
+func (*AnalyticsGraphExportOKApplicationZipHeaders) analyticsGraphExportRes() {}
+
+type AnalyticsGraphExportUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsGraphExportUnauthorized) analyticsGraphExportRes() {}
+
+type AnalyticsGraphForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsGraphForbidden) analyticsGraphRes() {}
+
+type AnalyticsGraphInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsGraphInternalServerError) analyticsGraphRes() {}
+
+type AnalyticsGraphRebuildForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsGraphRebuildForbidden) analyticsGraphRebuildRes() {}
+
+type AnalyticsGraphRebuildInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsGraphRebuildInternalServerError) analyticsGraphRebuildRes() {}
+
+type AnalyticsGraphRebuildUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsGraphRebuildUnauthorized) analyticsGraphRebuildRes() {}
+
+type AnalyticsGraphUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsGraphUnauthorized) analyticsGraphRes() {}
+
+type AnalyticsInfluenceSnapshotForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsInfluenceSnapshotForbidden) analyticsInfluenceSnapshotRes() {}
+
+type AnalyticsInfluenceSnapshotInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsInfluenceSnapshotInternalServerError) analyticsInfluenceSnapshotRes() {}
+
+type AnalyticsInfluenceSnapshotUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsInfluenceSnapshotUnauthorized) analyticsInfluenceSnapshotRes() {}
+
+type AnalyticsLeaderboardBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsLeaderboardBadRequest) analyticsLeaderboardRes() {}
+
+type AnalyticsLeaderboardForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsLeaderboardForbidden) analyticsLeaderboardRes() {}
+
+type AnalyticsLeaderboardInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsLeaderboardInternalServerError) analyticsLeaderboardRes() {}
+
+type AnalyticsLeaderboardNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsLeaderboardNotFound) analyticsLeaderboardRes() {}
+
+type AnalyticsLeaderboardUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsLeaderboardUnauthorized) analyticsLeaderboardRes() {}
+
+type AnalyticsUserInfluenceForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsUserInfluenceForbidden) analyticsUserInfluenceRes() {}
+
+type AnalyticsUserInfluenceInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsUserInfluenceInternalServerError) analyticsUserInfluenceRes() {}
+
+type AnalyticsUserInfluenceUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*AnalyticsUserInfluenceUnauthorized) analyticsUserInfluenceRes() {}
+
+// Ref: #/components/schemas/Attachment
+type Attachment struct {
+	ID       int    `json:"id"`
+	FileName string `json:"file_name"`
+	// Type detected by file content.
+	ContentType string `json:"content_type"`
+	// Size in bytes.
+	Size int64 `json:"size"`
+	// Thread of attached file (thread of post for post attachment).
+	ThreadID OptInt `json:"thread_id"`
+	PostID   OptInt `json:"post_id"`
+	// Signed download url.
+	URL          string    `json:"url"`
+	URLExpiresAt time.Time `json:"url_expires_at"`
+	CreatedAt    time.Time `json:"created_at"`
+	// Images are processed in background, file is downloadable when status is `ready`.
+	// Other files are ready after upload.
+	Status AttachmentStatus `json:"status"`
+	// Image width, set when image is processed.
+	Width  OptInt `json:"width"`
+	Height OptInt `json:"height"`
+	// Blurhash placeholder of image, set when image is processed.
+	Blurhash OptString `json:"blurhash"`
+	// Thumbnails of image from smallest, thumbnail is not made if image is smaller.
+	Variants []AttachmentVariant `json:"variants"`
+}
+
+// GetID returns the value of ID.
+func (s *Attachment) GetID() int {
+	return s.ID
+}
+
+// GetFileName returns the value of FileName.
+func (s *Attachment) GetFileName() string {
+	return s.FileName
+}
+
+// GetContentType returns the value of ContentType.
+func (s *Attachment) GetContentType() string {
+	return s.ContentType
+}
+
+// GetSize returns the value of Size.
+func (s *Attachment) GetSize() int64 {
+	return s.Size
+}
+
+// GetThreadID returns the value of ThreadID.
+func (s *Attachment) GetThreadID() OptInt {
+	return s.ThreadID
+}
+
+// GetPostID returns the value of PostID.
+func (s *Attachment) GetPostID() OptInt {
+	return s.PostID
+}
+
+// GetURL returns the value of URL.
+func (s *Attachment) GetURL() string {
+	return s.URL
+}
+
+// GetURLExpiresAt returns the value of URLExpiresAt.
+func (s *Attachment) GetURLExpiresAt() time.Time {
+	return s.URLExpiresAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Attachment) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetStatus returns the value of Status.
+func (s *Attachment) GetStatus() AttachmentStatus {
+	return s.Status
+}
+
+// GetWidth returns the value of Width.
+func (s *Attachment) GetWidth() OptInt {
+	return s.Width
+}
+
+// GetHeight returns the value of Height.
+func (s *Attachment) GetHeight() OptInt {
+	return s.Height
+}
+
+// GetBlurhash returns the value of Blurhash.
+func (s *Attachment) GetBlurhash() OptString {
+	return s.Blurhash
+}
+
+// GetVariants returns the value of Variants.
+func (s *Attachment) GetVariants() []AttachmentVariant {
+	return s.Variants
+}
+
+// SetID sets the value of ID.
+func (s *Attachment) SetID(val int) {
+	s.ID = val
+}
+
+// SetFileName sets the value of FileName.
+func (s *Attachment) SetFileName(val string) {
+	s.FileName = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *Attachment) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetSize sets the value of Size.
+func (s *Attachment) SetSize(val int64) {
+	s.Size = val
+}
+
+// SetThreadID sets the value of ThreadID.
+func (s *Attachment) SetThreadID(val OptInt) {
+	s.ThreadID = val
+}
+
+// SetPostID sets the value of PostID.
+func (s *Attachment) SetPostID(val OptInt) {
+	s.PostID = val
+}
+
+// SetURL sets the value of URL.
+func (s *Attachment) SetURL(val string) {
+	s.URL = val
+}
+
+// SetURLExpiresAt sets the value of URLExpiresAt.
+func (s *Attachment) SetURLExpiresAt(val time.Time) {
+	s.URLExpiresAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Attachment) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Attachment) SetStatus(val AttachmentStatus) {
+	s.Status = val
+}
+
+// SetWidth sets the value of Width.
+func (s *Attachment) SetWidth(val OptInt) {
+	s.Width = val
+}
+
+// SetHeight sets the value of Height.
+func (s *Attachment) SetHeight(val OptInt) {
+	s.Height = val
+}
+
+// SetBlurhash sets the value of Blurhash.
+func (s *Attachment) SetBlurhash(val OptString) {
+	s.Blurhash = val
+}
+
+// SetVariants sets the value of Variants.
+func (s *Attachment) SetVariants(val []AttachmentVariant) {
+	s.Variants = val
+}
+
+func (*Attachment) attachmentGetRes()    {}
+func (*Attachment) attachmentUploadRes() {}
+
+type AttachmentDownloadBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*AttachmentDownloadBadRequest) attachmentDownloadRes() {}
+
+type AttachmentDownloadConflict AnalyticsGraphBadRequestApplicationJSON
+
+func (*AttachmentDownloadConflict) attachmentDownloadRes() {}
+
+type AttachmentDownloadForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*AttachmentDownloadForbidden) attachmentDownloadRes() {}
+
+type AttachmentDownloadInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*AttachmentDownloadInternalServerError) attachmentDownloadRes() {}
+
+type AttachmentDownloadNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*AttachmentDownloadNotFound) attachmentDownloadRes() {}
+
+type AttachmentDownloadOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s AttachmentDownloadOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*AttachmentDownloadOK) attachmentDownloadRes() {}
+
+type AttachmentGetInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*AttachmentGetInternalServerError) attachmentGetRes() {}
+
+type AttachmentGetNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*AttachmentGetNotFound) attachmentGetRes() {}
+
+type AttachmentGetUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*AttachmentGetUnauthorized) attachmentGetRes() {}
+
+// Images are processed in background, file is downloadable when status is `ready`.
+// Other files are ready after upload.
+type AttachmentStatus string
+
+const (
+	AttachmentStatusPending    AttachmentStatus = "pending"
+	AttachmentStatusProcessing AttachmentStatus = "processing"
+	AttachmentStatusReady      AttachmentStatus = "ready"
+	AttachmentStatusFailed     AttachmentStatus = "failed"
 )
 
+// AllValues returns all AttachmentStatus values.
+func (AttachmentStatus) AllValues() []AttachmentStatus {
+	return []AttachmentStatus{
+		AttachmentStatusPending,
+		AttachmentStatusProcessing,
+		AttachmentStatusReady,
+		AttachmentStatusFailed,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AttachmentStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case AttachmentStatusPending:
+		return []byte(s), nil
+	case AttachmentStatusProcessing:
+		return []byte(s), nil
+	case AttachmentStatusReady:
+		return []byte(s), nil
+	case AttachmentStatusFailed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AttachmentStatus) UnmarshalText(data []byte) error {
+	switch AttachmentStatus(data) {
+	case AttachmentStatusPending:
+		*s = AttachmentStatusPending
+		return nil
+	case AttachmentStatusProcessing:
+		*s = AttachmentStatusProcessing
+		return nil
+	case AttachmentStatusReady:
+		*s = AttachmentStatusReady
+		return nil
+	case AttachmentStatusFailed:
+		*s = AttachmentStatusFailed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type AttachmentUploadBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*AttachmentUploadBadRequest) attachmentUploadRes() {}
+
+type AttachmentUploadInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*AttachmentUploadInternalServerError) attachmentUploadRes() {}
+
+type AttachmentUploadRequestEntityTooLarge AnalyticsGraphBadRequestApplicationJSON
+
+func (*AttachmentUploadRequestEntityTooLarge) attachmentUploadRes() {}
+
+// Ref: #/components/schemas/AttachmentUploadRequest
+type AttachmentUploadRequestMultipart struct {
+	File ht.MultipartFile `json:"file"`
+}
+
+// GetFile returns the value of File.
+func (s *AttachmentUploadRequestMultipart) GetFile() ht.MultipartFile {
+	return s.File
+}
+
+// SetFile sets the value of File.
+func (s *AttachmentUploadRequestMultipart) SetFile(val ht.MultipartFile) {
+	s.File = val
+}
+
+type AttachmentUploadUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*AttachmentUploadUnauthorized) attachmentUploadRes() {}
+
+type AttachmentUploadUnsupportedMediaType AnalyticsGraphBadRequestApplicationJSON
+
+func (*AttachmentUploadUnsupportedMediaType) attachmentUploadRes() {}
+
+// Ref: #/components/schemas/AttachmentVariant
+type AttachmentVariant struct {
+	// Max width and height of thumbnail.
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	Size        int64  `json:"size"`
+	// Signed download url, expires with url of attachment.
+	URL string `json:"url"`
+}
+
+// GetName returns the value of Name.
+func (s *AttachmentVariant) GetName() string {
+	return s.Name
+}
+
+// GetContentType returns the value of ContentType.
+func (s *AttachmentVariant) GetContentType() string {
+	return s.ContentType
+}
+
+// GetWidth returns the value of Width.
+func (s *AttachmentVariant) GetWidth() int {
+	return s.Width
+}
+
+// GetHeight returns the value of Height.
+func (s *AttachmentVariant) GetHeight() int {
+	return s.Height
+}
+
+// GetSize returns the value of Size.
+func (s *AttachmentVariant) GetSize() int64 {
+	return s.Size
+}
+
+// GetURL returns the value of URL.
+func (s *AttachmentVariant) GetURL() string {
+	return s.URL
+}
+
+// SetName sets the value of Name.
+func (s *AttachmentVariant) SetName(val string) {
+	s.Name = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *AttachmentVariant) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetWidth sets the value of Width.
+func (s *AttachmentVariant) SetWidth(val int) {
+	s.Width = val
+}
+
+// SetHeight sets the value of Height.
+func (s *AttachmentVariant) SetHeight(val int) {
+	s.Height = val
+}
+
+// SetSize sets the value of Size.
+func (s *AttachmentVariant) SetSize(val int64) {
+	s.Size = val
+}
+
+// SetURL sets the value of URL.
+func (s *AttachmentVariant) SetURL(val string) {
+	s.URL = val
+}
+
 // Ref: #/components/schemas/AuthLoginRequest
 type AuthLoginRequest struct {
 	Login    string `json:"login"`
 	Password string `json:"password"`
 }
 
-// GetLogin returns the value of Login.
-func (s *AuthLoginRequest) GetLogin() string {
-	return s.Login
+// GetLogin returns the value of Login.
+func (s *AuthLoginRequest) GetLogin() string {
+	return s.Login
+}
+
+// GetPassword returns the value of Password.
+func (s *AuthLoginRequest) GetPassword() string {
+	return s.Password
+}
+
+// SetLogin sets the value of Login.
+func (s *AuthLoginRequest) SetLogin(val string) {
+	s.Login = val
+}
+
+// SetPassword sets the value of Password.
+func (s *AuthLoginRequest) SetPassword(val string) {
+	s.Password = val
+}
+
+// AuthLogoutNoContent is response for AuthLogout operation.
+type AuthLogoutNoContent struct{}
+
+type AuthRefreshInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*AuthRefreshInternalServerError) authRefreshRes() {}
+
+type AuthRefreshUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*AuthRefreshUnauthorized) authRefreshRes() {}
+
+// Ref: #/components/schemas/Bookmark
+type Bookmark struct {
+	ID         int                `json:"id"`
+	TargetType BookmarkTargetType `json:"target_type"`
+	TargetID   int                `json:"target_id"`
+	// Bookmarked thread or thread of bookmarked post.
+	ThreadID     int       `json:"thread_id"`
+	CollectionID OptInt    `json:"collection_id"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Bookmark) GetID() int {
+	return s.ID
+}
+
+// GetTargetType returns the value of TargetType.
+func (s *Bookmark) GetTargetType() BookmarkTargetType {
+	return s.TargetType
+}
+
+// GetTargetID returns the value of TargetID.
+func (s *Bookmark) GetTargetID() int {
+	return s.TargetID
+}
+
+// GetThreadID returns the value of ThreadID.
+func (s *Bookmark) GetThreadID() int {
+	return s.ThreadID
+}
+
+// GetCollectionID returns the value of CollectionID.
+func (s *Bookmark) GetCollectionID() OptInt {
+	return s.CollectionID
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Bookmark) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Bookmark) SetID(val int) {
+	s.ID = val
+}
+
+// SetTargetType sets the value of TargetType.
+func (s *Bookmark) SetTargetType(val BookmarkTargetType) {
+	s.TargetType = val
+}
+
+// SetTargetID sets the value of TargetID.
+func (s *Bookmark) SetTargetID(val int) {
+	s.TargetID = val
+}
+
+// SetThreadID sets the value of ThreadID.
+func (s *Bookmark) SetThreadID(val int) {
+	s.ThreadID = val
+}
+
+// SetCollectionID sets the value of CollectionID.
+func (s *Bookmark) SetCollectionID(val OptInt) {
+	s.CollectionID = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Bookmark) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*Bookmark) bookmarkCreateRes() {}
+
+// Ref: #/components/schemas/BookmarkCollection
+type BookmarkCollection struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *BookmarkCollection) GetID() int {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *BookmarkCollection) GetName() string {
+	return s.Name
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *BookmarkCollection) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *BookmarkCollection) SetID(val int) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *BookmarkCollection) SetName(val string) {
+	s.Name = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *BookmarkCollection) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*BookmarkCollection) bookmarkCollectionCreateRes() {}
+
+type BookmarkCollectionCreateBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkCollectionCreateBadRequest) bookmarkCollectionCreateRes() {}
+
+type BookmarkCollectionCreateConflict AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkCollectionCreateConflict) bookmarkCollectionCreateRes() {}
+
+type BookmarkCollectionCreateInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkCollectionCreateInternalServerError) bookmarkCollectionCreateRes() {}
+
+// Ref: #/components/schemas/BookmarkCollectionCreateRequest
+type BookmarkCollectionCreateRequest struct {
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *BookmarkCollectionCreateRequest) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *BookmarkCollectionCreateRequest) SetName(val string) {
+	s.Name = val
+}
+
+type BookmarkCollectionCreateUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkCollectionCreateUnauthorized) bookmarkCollectionCreateRes() {}
+
+type BookmarkCollectionDeleteInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkCollectionDeleteInternalServerError) bookmarkCollectionDeleteRes() {}
+
+// BookmarkCollectionDeleteNoContent is response for BookmarkCollectionDelete operation.
+type BookmarkCollectionDeleteNoContent struct{}
+
+func (*BookmarkCollectionDeleteNoContent) bookmarkCollectionDeleteRes() {}
+
+type BookmarkCollectionDeleteNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkCollectionDeleteNotFound) bookmarkCollectionDeleteRes() {}
+
+type BookmarkCollectionDeleteUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkCollectionDeleteUnauthorized) bookmarkCollectionDeleteRes() {}
+
+type BookmarkCollectionsListInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkCollectionsListInternalServerError) bookmarkCollectionsListRes() {}
+
+type BookmarkCollectionsListOKApplicationJSON []BookmarkCollection
+
+func (*BookmarkCollectionsListOKApplicationJSON) bookmarkCollectionsListRes() {}
+
+type BookmarkCollectionsListUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkCollectionsListUnauthorized) bookmarkCollectionsListRes() {}
+
+type BookmarkCreateBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkCreateBadRequest) bookmarkCreateRes() {}
+
+type BookmarkCreateForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkCreateForbidden) bookmarkCreateRes() {}
+
+type BookmarkCreateInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkCreateInternalServerError) bookmarkCreateRes() {}
+
+type BookmarkCreateNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkCreateNotFound) bookmarkCreateRes() {}
+
+// Ref: #/components/schemas/BookmarkCreateRequest
+type BookmarkCreateRequest struct {
+	TargetType BookmarkCreateRequestTargetType `json:"target_type"`
+	// Thread or post id.
+	TargetID     int    `json:"target_id"`
+	CollectionID OptInt `json:"collection_id"`
+}
+
+// GetTargetType returns the value of TargetType.
+func (s *BookmarkCreateRequest) GetTargetType() BookmarkCreateRequestTargetType {
+	return s.TargetType
+}
+
+// GetTargetID returns the value of TargetID.
+func (s *BookmarkCreateRequest) GetTargetID() int {
+	return s.TargetID
+}
+
+// GetCollectionID returns the value of CollectionID.
+func (s *BookmarkCreateRequest) GetCollectionID() OptInt {
+	return s.CollectionID
+}
+
+// SetTargetType sets the value of TargetType.
+func (s *BookmarkCreateRequest) SetTargetType(val BookmarkCreateRequestTargetType) {
+	s.TargetType = val
+}
+
+// SetTargetID sets the value of TargetID.
+func (s *BookmarkCreateRequest) SetTargetID(val int) {
+	s.TargetID = val
+}
+
+// SetCollectionID sets the value of CollectionID.
+func (s *BookmarkCreateRequest) SetCollectionID(val OptInt) {
+	s.CollectionID = val
+}
+
+type BookmarkCreateRequestTargetType string
+
+const (
+	BookmarkCreateRequestTargetTypeThread BookmarkCreateRequestTargetType = "thread"
+	BookmarkCreateRequestTargetTypePost   BookmarkCreateRequestTargetType = "post"
+)
+
+// AllValues returns all BookmarkCreateRequestTargetType values.
+func (BookmarkCreateRequestTargetType) AllValues() []BookmarkCreateRequestTargetType {
+	return []BookmarkCreateRequestTargetType{
+		BookmarkCreateRequestTargetTypeThread,
+		BookmarkCreateRequestTargetTypePost,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s BookmarkCreateRequestTargetType) MarshalText() ([]byte, error) {
+	switch s {
+	case BookmarkCreateRequestTargetTypeThread:
+		return []byte(s), nil
+	case BookmarkCreateRequestTargetTypePost:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BookmarkCreateRequestTargetType) UnmarshalText(data []byte) error {
+	switch BookmarkCreateRequestTargetType(data) {
+	case BookmarkCreateRequestTargetTypeThread:
+		*s = BookmarkCreateRequestTargetTypeThread
+		return nil
+	case BookmarkCreateRequestTargetTypePost:
+		*s = BookmarkCreateRequestTargetTypePost
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type BookmarkCreateUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkCreateUnauthorized) bookmarkCreateRes() {}
+
+type BookmarkDeleteInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkDeleteInternalServerError) bookmarkDeleteRes() {}
+
+// BookmarkDeleteNoContent is response for BookmarkDelete operation.
+type BookmarkDeleteNoContent struct{}
+
+func (*BookmarkDeleteNoContent) bookmarkDeleteRes() {}
+
+type BookmarkDeleteNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkDeleteNotFound) bookmarkDeleteRes() {}
+
+type BookmarkDeleteUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarkDeleteUnauthorized) bookmarkDeleteRes() {}
+
+// Ref: #/components/schemas/BookmarkListItem
+type BookmarkListItem struct {
+	Bookmark Bookmark       `json:"bookmark"`
+	Thread   ThreadListItem `json:"thread"`
+}
+
+// GetBookmark returns the value of Bookmark.
+func (s *BookmarkListItem) GetBookmark() Bookmark {
+	return s.Bookmark
+}
+
+// GetThread returns the value of Thread.
+func (s *BookmarkListItem) GetThread() ThreadListItem {
+	return s.Thread
+}
+
+// SetBookmark sets the value of Bookmark.
+func (s *BookmarkListItem) SetBookmark(val Bookmark) {
+	s.Bookmark = val
+}
+
+// SetThread sets the value of Thread.
+func (s *BookmarkListItem) SetThread(val ThreadListItem) {
+	s.Thread = val
+}
+
+// Ref: #/components/schemas/BookmarkListResponse
+type BookmarkListResponse struct {
+	Bookmarks []BookmarkListItem `json:"bookmarks"`
+	HaveNext  bool               `json:"have_next"`
+}
+
+// GetBookmarks returns the value of Bookmarks.
+func (s *BookmarkListResponse) GetBookmarks() []BookmarkListItem {
+	return s.Bookmarks
+}
+
+// GetHaveNext returns the value of HaveNext.
+func (s *BookmarkListResponse) GetHaveNext() bool {
+	return s.HaveNext
+}
+
+// SetBookmarks sets the value of Bookmarks.
+func (s *BookmarkListResponse) SetBookmarks(val []BookmarkListItem) {
+	s.Bookmarks = val
+}
+
+// SetHaveNext sets the value of HaveNext.
+func (s *BookmarkListResponse) SetHaveNext(val bool) {
+	s.HaveNext = val
+}
+
+func (*BookmarkListResponse) bookmarksListRes() {}
+
+type BookmarkTargetType string
+
+const (
+	BookmarkTargetTypeThread BookmarkTargetType = "thread"
+	BookmarkTargetTypePost   BookmarkTargetType = "post"
+)
+
+// AllValues returns all BookmarkTargetType values.
+func (BookmarkTargetType) AllValues() []BookmarkTargetType {
+	return []BookmarkTargetType{
+		BookmarkTargetTypeThread,
+		BookmarkTargetTypePost,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s BookmarkTargetType) MarshalText() ([]byte, error) {
+	switch s {
+	case BookmarkTargetTypeThread:
+		return []byte(s), nil
+	case BookmarkTargetTypePost:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BookmarkTargetType) UnmarshalText(data []byte) error {
+	switch BookmarkTargetType(data) {
+	case BookmarkTargetTypeThread:
+		*s = BookmarkTargetTypeThread
+		return nil
+	case BookmarkTargetTypePost:
+		*s = BookmarkTargetTypePost
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type BookmarksListForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarksListForbidden) bookmarksListRes() {}
+
+type BookmarksListInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarksListInternalServerError) bookmarksListRes() {}
+
+type BookmarksListNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarksListNotFound) bookmarksListRes() {}
+
+type BookmarksListUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*BookmarksListUnauthorized) bookmarksListRes() {}
+
+type CommunitySubscribeInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*CommunitySubscribeInternalServerError) communitySubscribeRes() {}
+
+type CommunitySubscribeNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*CommunitySubscribeNotFound) communitySubscribeRes() {}
+
+type CommunitySubscribeUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*CommunitySubscribeUnauthorized) communitySubscribeRes() {}
+
+// Ref: #/components/schemas/CommunitySubscription
+type CommunitySubscription struct {
+	CommunityID   int       `json:"community_id"`
+	CommunityName string    `json:"community_name"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// GetCommunityID returns the value of CommunityID.
+func (s *CommunitySubscription) GetCommunityID() int {
+	return s.CommunityID
+}
+
+// GetCommunityName returns the value of CommunityName.
+func (s *CommunitySubscription) GetCommunityName() string {
+	return s.CommunityName
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CommunitySubscription) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetCommunityID sets the value of CommunityID.
+func (s *CommunitySubscription) SetCommunityID(val int) {
+	s.CommunityID = val
+}
+
+// SetCommunityName sets the value of CommunityName.
+func (s *CommunitySubscription) SetCommunityName(val string) {
+	s.CommunityName = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CommunitySubscription) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*CommunitySubscription) communitySubscribeRes() {}
+
+// Ref: #/components/schemas/CommunitySubscriptionListResponse
+type CommunitySubscriptionListResponse struct {
+	Subscriptions []CommunitySubscription `json:"subscriptions"`
+}
+
+// GetSubscriptions returns the value of Subscriptions.
+func (s *CommunitySubscriptionListResponse) GetSubscriptions() []CommunitySubscription {
+	return s.Subscriptions
+}
+
+// SetSubscriptions sets the value of Subscriptions.
+func (s *CommunitySubscriptionListResponse) SetSubscriptions(val []CommunitySubscription) {
+	s.Subscriptions = val
+}
+
+func (*CommunitySubscriptionListResponse) communitySubscriptionsListRes() {}
+
+type CommunitySubscriptionsListInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*CommunitySubscriptionsListInternalServerError) communitySubscriptionsListRes() {}
+
+type CommunitySubscriptionsListUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*CommunitySubscriptionsListUnauthorized) communitySubscriptionsListRes() {}
+
+type CommunityUnsubscribeInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*CommunityUnsubscribeInternalServerError) communityUnsubscribeRes() {}
+
+// CommunityUnsubscribeNoContent is response for CommunityUnsubscribe operation.
+type CommunityUnsubscribeNoContent struct{}
+
+func (*CommunityUnsubscribeNoContent) communityUnsubscribeRes() {}
+
+type CommunityUnsubscribeNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*CommunityUnsubscribeNotFound) communityUnsubscribeRes() {}
+
+type CommunityUnsubscribeUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*CommunityUnsubscribeUnauthorized) communityUnsubscribeRes() {}
+
+// Ref: #/components/schemas/Conversation
+type Conversation struct {
+	ID          int                  `json:"id"`
+	Title       string               `json:"title"`
+	Members     []ConversationMember `json:"members"`
+	LastMessage DirectMessage        `json:"last_message"`
+	UnreadCount int                  `json:"unread_count"`
+	CreatedAt   time.Time            `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Conversation) GetID() int {
+	return s.ID
+}
+
+// GetTitle returns the value of Title.
+func (s *Conversation) GetTitle() string {
+	return s.Title
+}
+
+// GetMembers returns the value of Members.
+func (s *Conversation) GetMembers() []ConversationMember {
+	return s.Members
+}
+
+// GetLastMessage returns the value of LastMessage.
+func (s *Conversation) GetLastMessage() DirectMessage {
+	return s.LastMessage
+}
+
+// GetUnreadCount returns the value of UnreadCount.
+func (s *Conversation) GetUnreadCount() int {
+	return s.UnreadCount
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Conversation) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Conversation) SetID(val int) {
+	s.ID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *Conversation) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetMembers sets the value of Members.
+func (s *Conversation) SetMembers(val []ConversationMember) {
+	s.Members = val
+}
+
+// SetLastMessage sets the value of LastMessage.
+func (s *Conversation) SetLastMessage(val DirectMessage) {
+	s.LastMessage = val
+}
+
+// SetUnreadCount sets the value of UnreadCount.
+func (s *Conversation) SetUnreadCount(val int) {
+	s.UnreadCount = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Conversation) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+type ConversationCreateBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationCreateBadRequest) conversationCreateRes() {}
+
+type ConversationCreateForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationCreateForbidden) conversationCreateRes() {}
+
+type ConversationCreateInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationCreateInternalServerError) conversationCreateRes() {}
+
+// Ref: #/components/schemas/ConversationCreateRequest
+type ConversationCreateRequest struct {
+	// Other members of conversation (max 9).
+	UserIds []int `json:"user_ids"`
+	// Optional title of group conversation.
+	Title OptString `json:"title"`
+	// The first message (markdown).
+	Content string `json:"content"`
+}
+
+// GetUserIds returns the value of UserIds.
+func (s *ConversationCreateRequest) GetUserIds() []int {
+	return s.UserIds
+}
+
+// GetTitle returns the value of Title.
+func (s *ConversationCreateRequest) GetTitle() OptString {
+	return s.Title
+}
+
+// GetContent returns the value of Content.
+func (s *ConversationCreateRequest) GetContent() string {
+	return s.Content
+}
+
+// SetUserIds sets the value of UserIds.
+func (s *ConversationCreateRequest) SetUserIds(val []int) {
+	s.UserIds = val
+}
+
+// SetTitle sets the value of Title.
+func (s *ConversationCreateRequest) SetTitle(val OptString) {
+	s.Title = val
+}
+
+// SetContent sets the value of Content.
+func (s *ConversationCreateRequest) SetContent(val string) {
+	s.Content = val
+}
+
+type ConversationCreateUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationCreateUnauthorized) conversationCreateRes() {}
+
+// Ref: #/components/schemas/ConversationListResponse
+type ConversationListResponse struct {
+	Conversations []Conversation `json:"conversations"`
+	HaveNext      bool           `json:"have_next"`
+}
+
+// GetConversations returns the value of Conversations.
+func (s *ConversationListResponse) GetConversations() []Conversation {
+	return s.Conversations
+}
+
+// GetHaveNext returns the value of HaveNext.
+func (s *ConversationListResponse) GetHaveNext() bool {
+	return s.HaveNext
+}
+
+// SetConversations sets the value of Conversations.
+func (s *ConversationListResponse) SetConversations(val []Conversation) {
+	s.Conversations = val
+}
+
+// SetHaveNext sets the value of HaveNext.
+func (s *ConversationListResponse) SetHaveNext(val bool) {
+	s.HaveNext = val
+}
+
+func (*ConversationListResponse) conversationsListRes() {}
+
+type ConversationMarkReadInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationMarkReadInternalServerError) conversationMarkReadRes() {}
+
+// ConversationMarkReadNoContent is response for ConversationMarkRead operation.
+type ConversationMarkReadNoContent struct{}
+
+func (*ConversationMarkReadNoContent) conversationMarkReadRes() {}
+
+type ConversationMarkReadNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationMarkReadNotFound) conversationMarkReadRes() {}
+
+// Ref: #/components/schemas/ConversationMarkReadRequest
+type ConversationMarkReadRequest struct {
+	// Mark messages up to this id as read, all messages if not given.
+	MessageID OptInt `json:"message_id"`
+}
+
+// GetMessageID returns the value of MessageID.
+func (s *ConversationMarkReadRequest) GetMessageID() OptInt {
+	return s.MessageID
+}
+
+// SetMessageID sets the value of MessageID.
+func (s *ConversationMarkReadRequest) SetMessageID(val OptInt) {
+	s.MessageID = val
+}
+
+type ConversationMarkReadUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationMarkReadUnauthorized) conversationMarkReadRes() {}
+
+// Ref: #/components/schemas/ConversationMember
+type ConversationMember struct {
+	UserID   int    `json:"user_id"`
+	UserName string `json:"user_name"`
+}
+
+// GetUserID returns the value of UserID.
+func (s *ConversationMember) GetUserID() int {
+	return s.UserID
+}
+
+// GetUserName returns the value of UserName.
+func (s *ConversationMember) GetUserName() string {
+	return s.UserName
+}
+
+// SetUserID sets the value of UserID.
+func (s *ConversationMember) SetUserID(val int) {
+	s.UserID = val
+}
+
+// SetUserName sets the value of UserName.
+func (s *ConversationMember) SetUserName(val string) {
+	s.UserName = val
+}
+
+type ConversationMessagesInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationMessagesInternalServerError) conversationMessagesRes() {}
+
+type ConversationMessagesNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationMessagesNotFound) conversationMessagesRes() {}
+
+type ConversationMessagesUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationMessagesUnauthorized) conversationMessagesRes() {}
+
+type ConversationSendBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationSendBadRequest) conversationSendRes() {}
+
+type ConversationSendForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationSendForbidden) conversationSendRes() {}
+
+type ConversationSendInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationSendInternalServerError) conversationSendRes() {}
+
+type ConversationSendNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationSendNotFound) conversationSendRes() {}
+
+type ConversationSendUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationSendUnauthorized) conversationSendRes() {}
+
+type ConversationsListInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationsListInternalServerError) conversationsListRes() {}
+
+type ConversationsListUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationsListUnauthorized) conversationsListRes() {}
+
+type ConversationsUnreadCountInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationsUnreadCountInternalServerError) conversationsUnreadCountRes() {}
+
+type ConversationsUnreadCountUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ConversationsUnreadCountUnauthorized) conversationsUnreadCountRes() {}
+
+type CookieAuth struct {
+	APIKey string
+	Roles  []string
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *CookieAuth) GetAPIKey() string {
+	return s.APIKey
+}
+
+// GetRoles returns the value of Roles.
+func (s *CookieAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *CookieAuth) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *CookieAuth) SetRoles(val []string) {
+	s.Roles = val
+}
+
+// Piece of text. Old text is concatenation of `equal` and `delete` pieces, new text - of `equal` and
+// `insert`.
+// Ref: #/components/schemas/DiffOp
+type DiffOp struct {
+	Op   DiffOpOp `json:"op"`
+	Text string   `json:"text"`
+}
+
+// GetOp returns the value of Op.
+func (s *DiffOp) GetOp() DiffOpOp {
+	return s.Op
+}
+
+// GetText returns the value of Text.
+func (s *DiffOp) GetText() string {
+	return s.Text
+}
+
+// SetOp sets the value of Op.
+func (s *DiffOp) SetOp(val DiffOpOp) {
+	s.Op = val
+}
+
+// SetText sets the value of Text.
+func (s *DiffOp) SetText(val string) {
+	s.Text = val
+}
+
+type DiffOpOp string
+
+const (
+	DiffOpOpEqual  DiffOpOp = "equal"
+	DiffOpOpInsert DiffOpOp = "insert"
+	DiffOpOpDelete DiffOpOp = "delete"
+)
+
+// AllValues returns all DiffOpOp values.
+func (DiffOpOp) AllValues() []DiffOpOp {
+	return []DiffOpOp{
+		DiffOpOpEqual,
+		DiffOpOpInsert,
+		DiffOpOpDelete,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s DiffOpOp) MarshalText() ([]byte, error) {
+	switch s {
+	case DiffOpOpEqual:
+		return []byte(s), nil
+	case DiffOpOpInsert:
+		return []byte(s), nil
+	case DiffOpOpDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *DiffOpOp) UnmarshalText(data []byte) error {
+	switch DiffOpOp(data) {
+	case DiffOpOpEqual:
+		*s = DiffOpOpEqual
+		return nil
+	case DiffOpOpInsert:
+		*s = DiffOpOpInsert
+		return nil
+	case DiffOpOpDelete:
+		*s = DiffOpOpDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/DirectMessage
+type DirectMessage struct {
+	ID             int       `json:"id"`
+	ConversationID int       `json:"conversation_id"`
+	UserID         int       `json:"user_id"`
+	UserName       string    `json:"user_name"`
+	Content        string    `json:"content"`
+	ContentHTML    string    `json:"content_html"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *DirectMessage) GetID() int {
+	return s.ID
+}
+
+// GetConversationID returns the value of ConversationID.
+func (s *DirectMessage) GetConversationID() int {
+	return s.ConversationID
+}
+
+// GetUserID returns the value of UserID.
+func (s *DirectMessage) GetUserID() int {
+	return s.UserID
+}
+
+// GetUserName returns the value of UserName.
+func (s *DirectMessage) GetUserName() string {
+	return s.UserName
+}
+
+// GetContent returns the value of Content.
+func (s *DirectMessage) GetContent() string {
+	return s.Content
+}
+
+// GetContentHTML returns the value of ContentHTML.
+func (s *DirectMessage) GetContentHTML() string {
+	return s.ContentHTML
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DirectMessage) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *DirectMessage) SetID(val int) {
+	s.ID = val
+}
+
+// SetConversationID sets the value of ConversationID.
+func (s *DirectMessage) SetConversationID(val int) {
+	s.ConversationID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *DirectMessage) SetUserID(val int) {
+	s.UserID = val
+}
+
+// SetUserName sets the value of UserName.
+func (s *DirectMessage) SetUserName(val string) {
+	s.UserName = val
+}
+
+// SetContent sets the value of Content.
+func (s *DirectMessage) SetContent(val string) {
+	s.Content = val
+}
+
+// SetContentHTML sets the value of ContentHTML.
+func (s *DirectMessage) SetContentHTML(val string) {
+	s.ContentHTML = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DirectMessage) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*DirectMessage) conversationCreateRes() {}
+func (*DirectMessage) conversationSendRes()   {}
+
+// Ref: #/components/schemas/DirectMessageListResponse
+type DirectMessageListResponse struct {
+	Messages []DirectMessage `json:"messages"`
+	HaveNext bool            `json:"have_next"`
+}
+
+// GetMessages returns the value of Messages.
+func (s *DirectMessageListResponse) GetMessages() []DirectMessage {
+	return s.Messages
+}
+
+// GetHaveNext returns the value of HaveNext.
+func (s *DirectMessageListResponse) GetHaveNext() bool {
+	return s.HaveNext
+}
+
+// SetMessages sets the value of Messages.
+func (s *DirectMessageListResponse) SetMessages(val []DirectMessage) {
+	s.Messages = val
+}
+
+// SetHaveNext sets the value of HaveNext.
+func (s *DirectMessageListResponse) SetHaveNext(val bool) {
+	s.HaveNext = val
+}
+
+func (*DirectMessageListResponse) conversationMessagesRes() {}
+
+type FeedGetBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*FeedGetBadRequest) feedGetRes() {}
+
+type FeedGetInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*FeedGetInternalServerError) feedGetRes() {}
+
+type FeedGetUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*FeedGetUnauthorized) feedGetRes() {}
+
+// Ref: #/components/schemas/FeedItem
+type FeedItem struct {
+	Kind FeedItemKind `json:"kind"`
+	// Thread or post id.
+	ID          int       `json:"id"`
+	ThreadID    int       `json:"thread_id"`
+	ThreadTitle string    `json:"thread_title"`
+	CommunityID OptInt    `json:"community_id"`
+	ContentHTML string    `json:"content_html"`
+	AuthorID    int       `json:"author_id"`
+	AuthorName  string    `json:"author_name"`
+	AuthorRank  string    `json:"author_rank"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// GetKind returns the value of Kind.
+func (s *FeedItem) GetKind() FeedItemKind {
+	return s.Kind
+}
+
+// GetID returns the value of ID.
+func (s *FeedItem) GetID() int {
+	return s.ID
+}
+
+// GetThreadID returns the value of ThreadID.
+func (s *FeedItem) GetThreadID() int {
+	return s.ThreadID
+}
+
+// GetThreadTitle returns the value of ThreadTitle.
+func (s *FeedItem) GetThreadTitle() string {
+	return s.ThreadTitle
+}
+
+// GetCommunityID returns the value of CommunityID.
+func (s *FeedItem) GetCommunityID() OptInt {
+	return s.CommunityID
+}
+
+// GetContentHTML returns the value of ContentHTML.
+func (s *FeedItem) GetContentHTML() string {
+	return s.ContentHTML
+}
+
+// GetAuthorID returns the value of AuthorID.
+func (s *FeedItem) GetAuthorID() int {
+	return s.AuthorID
+}
+
+// GetAuthorName returns the value of AuthorName.
+func (s *FeedItem) GetAuthorName() string {
+	return s.AuthorName
+}
+
+// GetAuthorRank returns the value of AuthorRank.
+func (s *FeedItem) GetAuthorRank() string {
+	return s.AuthorRank
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *FeedItem) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetKind sets the value of Kind.
+func (s *FeedItem) SetKind(val FeedItemKind) {
+	s.Kind = val
+}
+
+// SetID sets the value of ID.
+func (s *FeedItem) SetID(val int) {
+	s.ID = val
+}
+
+// SetThreadID sets the value of ThreadID.
+func (s *FeedItem) SetThreadID(val int) {
+	s.ThreadID = val
+}
+
+// SetThreadTitle sets the value of ThreadTitle.
+func (s *FeedItem) SetThreadTitle(val string) {
+	s.ThreadTitle = val
+}
+
+// SetCommunityID sets the value of CommunityID.
+func (s *FeedItem) SetCommunityID(val OptInt) {
+	s.CommunityID = val
+}
+
+// SetContentHTML sets the value of ContentHTML.
+func (s *FeedItem) SetContentHTML(val string) {
+	s.ContentHTML = val
+}
+
+// SetAuthorID sets the value of AuthorID.
+func (s *FeedItem) SetAuthorID(val int) {
+	s.AuthorID = val
+}
+
+// SetAuthorName sets the value of AuthorName.
+func (s *FeedItem) SetAuthorName(val string) {
+	s.AuthorName = val
+}
+
+// SetAuthorRank sets the value of AuthorRank.
+func (s *FeedItem) SetAuthorRank(val string) {
+	s.AuthorRank = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *FeedItem) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Ref: #/components/schemas/FeedItemKind
+type FeedItemKind string
+
+const (
+	FeedItemKindThread FeedItemKind = "thread"
+	FeedItemKindPost   FeedItemKind = "post"
+)
+
+// AllValues returns all FeedItemKind values.
+func (FeedItemKind) AllValues() []FeedItemKind {
+	return []FeedItemKind{
+		FeedItemKindThread,
+		FeedItemKindPost,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FeedItemKind) MarshalText() ([]byte, error) {
+	switch s {
+	case FeedItemKindThread:
+		return []byte(s), nil
+	case FeedItemKindPost:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FeedItemKind) UnmarshalText(data []byte) error {
+	switch FeedItemKind(data) {
+	case FeedItemKindThread:
+		*s = FeedItemKindThread
+		return nil
+	case FeedItemKindPost:
+		*s = FeedItemKindPost
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/FeedResponse
+type FeedResponse struct {
+	Items []FeedItem `json:"items"`
+	// Cursor of the next page, absent on the last page.
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetItems returns the value of Items.
+func (s *FeedResponse) GetItems() []FeedItem {
+	return s.Items
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *FeedResponse) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetItems sets the value of Items.
+func (s *FeedResponse) SetItems(val []FeedItem) {
+	s.Items = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *FeedResponse) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*FeedResponse) feedGetRes() {}
+
+// Ref: #/components/schemas/FollowListResponse
+type FollowListResponse struct {
+	Users []FollowUser `json:"users"`
+	// Number of all followers or followed users.
+	Total    int  `json:"total"`
+	HaveNext bool `json:"have_next"`
+}
+
+// GetUsers returns the value of Users.
+func (s *FollowListResponse) GetUsers() []FollowUser {
+	return s.Users
+}
+
+// GetTotal returns the value of Total.
+func (s *FollowListResponse) GetTotal() int {
+	return s.Total
+}
+
+// GetHaveNext returns the value of HaveNext.
+func (s *FollowListResponse) GetHaveNext() bool {
+	return s.HaveNext
+}
+
+// SetUsers sets the value of Users.
+func (s *FollowListResponse) SetUsers(val []FollowUser) {
+	s.Users = val
+}
+
+// SetTotal sets the value of Total.
+func (s *FollowListResponse) SetTotal(val int) {
+	s.Total = val
+}
+
+// SetHaveNext sets the value of HaveNext.
+func (s *FollowListResponse) SetHaveNext(val bool) {
+	s.HaveNext = val
+}
+
+func (*FollowListResponse) userFollowersRes() {}
+func (*FollowListResponse) userFollowingRes() {}
+
+// Ref: #/components/schemas/FollowUser
+type FollowUser struct {
+	// Id of follow, use as `before` for the next page.
+	ID         int       `json:"id"`
+	UserID     int       `json:"user_id"`
+	UserName   string    `json:"user_name"`
+	UserRank   string    `json:"user_rank"`
+	FollowedAt time.Time `json:"followed_at"`
+}
+
+// GetID returns the value of ID.
+func (s *FollowUser) GetID() int {
+	return s.ID
+}
+
+// GetUserID returns the value of UserID.
+func (s *FollowUser) GetUserID() int {
+	return s.UserID
+}
+
+// GetUserName returns the value of UserName.
+func (s *FollowUser) GetUserName() string {
+	return s.UserName
+}
+
+// GetUserRank returns the value of UserRank.
+func (s *FollowUser) GetUserRank() string {
+	return s.UserRank
+}
+
+// GetFollowedAt returns the value of FollowedAt.
+func (s *FollowUser) GetFollowedAt() time.Time {
+	return s.FollowedAt
+}
+
+// SetID sets the value of ID.
+func (s *FollowUser) SetID(val int) {
+	s.ID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *FollowUser) SetUserID(val int) {
+	s.UserID = val
+}
+
+// SetUserName sets the value of UserName.
+func (s *FollowUser) SetUserName(val string) {
+	s.UserName = val
+}
+
+// SetUserRank sets the value of UserRank.
+func (s *FollowUser) SetUserRank(val string) {
+	s.UserRank = val
+}
+
+// SetFollowedAt sets the value of FollowedAt.
+func (s *FollowUser) SetFollowedAt(val time.Time) {
+	s.FollowedAt = val
+}
+
+func (*FollowUser) userFollowRes() {}
+
+// Ref: #/components/schemas/GraphEdge
+type GraphEdge struct {
+	// Id of user who interacted.
+	Source int `json:"source"`
+	// Id of user who received interaction.
+	Target   int `json:"target"`
+	Weight   int `json:"weight"`
+	Replies  int `json:"replies"`
+	Mentions int `json:"mentions"`
+	Votes    int `json:"votes"`
+}
+
+// GetSource returns the value of Source.
+func (s *GraphEdge) GetSource() int {
+	return s.Source
+}
+
+// GetTarget returns the value of Target.
+func (s *GraphEdge) GetTarget() int {
+	return s.Target
+}
+
+// GetWeight returns the value of Weight.
+func (s *GraphEdge) GetWeight() int {
+	return s.Weight
+}
+
+// GetReplies returns the value of Replies.
+func (s *GraphEdge) GetReplies() int {
+	return s.Replies
+}
+
+// GetMentions returns the value of Mentions.
+func (s *GraphEdge) GetMentions() int {
+	return s.Mentions
+}
+
+// GetVotes returns the value of Votes.
+func (s *GraphEdge) GetVotes() int {
+	return s.Votes
+}
+
+// SetSource sets the value of Source.
+func (s *GraphEdge) SetSource(val int) {
+	s.Source = val
+}
+
+// SetTarget sets the value of Target.
+func (s *GraphEdge) SetTarget(val int) {
+	s.Target = val
+}
+
+// SetWeight sets the value of Weight.
+func (s *GraphEdge) SetWeight(val int) {
+	s.Weight = val
+}
+
+// SetReplies sets the value of Replies.
+func (s *GraphEdge) SetReplies(val int) {
+	s.Replies = val
+}
+
+// SetMentions sets the value of Mentions.
+func (s *GraphEdge) SetMentions(val int) {
+	s.Mentions = val
+}
+
+// SetVotes sets the value of Votes.
+func (s *GraphEdge) SetVotes(val int) {
+	s.Votes = val
+}
+
+// Ref: #/components/schemas/GraphFormat
+type GraphFormat string
+
+const (
+	GraphFormatGraphml GraphFormat = "graphml"
+	GraphFormatGexf    GraphFormat = "gexf"
+	GraphFormatCsv     GraphFormat = "csv"
+)
+
+// AllValues returns all GraphFormat values.
+func (GraphFormat) AllValues() []GraphFormat {
+	return []GraphFormat{
+		GraphFormatGraphml,
+		GraphFormatGexf,
+		GraphFormatCsv,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GraphFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case GraphFormatGraphml:
+		return []byte(s), nil
+	case GraphFormatGexf:
+		return []byte(s), nil
+	case GraphFormatCsv:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GraphFormat) UnmarshalText(data []byte) error {
+	switch GraphFormat(data) {
+	case GraphFormatGraphml:
+		*s = GraphFormatGraphml
+		return nil
+	case GraphFormatGexf:
+		*s = GraphFormatGexf
+		return nil
+	case GraphFormatCsv:
+		*s = GraphFormatCsv
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/GraphNode
+type GraphNode struct {
+	UserID     int    `json:"user_id"`
+	Name       string `json:"name"`
+	Karma      int    `json:"karma"`
+	PostsCount int    `json:"posts_count"`
+	// Number of users interacting with this user.
+	InDegree int `json:"in_degree"`
+	// Number of users this user interacts with.
+	OutDegree int `json:"out_degree"`
+	InWeight  int `json:"in_weight"`
+	OutWeight int `json:"out_weight"`
+}
+
+// GetUserID returns the value of UserID.
+func (s *GraphNode) GetUserID() int {
+	return s.UserID
+}
+
+// GetName returns the value of Name.
+func (s *GraphNode) GetName() string {
+	return s.Name
+}
+
+// GetKarma returns the value of Karma.
+func (s *GraphNode) GetKarma() int {
+	return s.Karma
+}
+
+// GetPostsCount returns the value of PostsCount.
+func (s *GraphNode) GetPostsCount() int {
+	return s.PostsCount
+}
+
+// GetInDegree returns the value of InDegree.
+func (s *GraphNode) GetInDegree() int {
+	return s.InDegree
+}
+
+// GetOutDegree returns the value of OutDegree.
+func (s *GraphNode) GetOutDegree() int {
+	return s.OutDegree
+}
+
+// GetInWeight returns the value of InWeight.
+func (s *GraphNode) GetInWeight() int {
+	return s.InWeight
+}
+
+// GetOutWeight returns the value of OutWeight.
+func (s *GraphNode) GetOutWeight() int {
+	return s.OutWeight
+}
+
+// SetUserID sets the value of UserID.
+func (s *GraphNode) SetUserID(val int) {
+	s.UserID = val
+}
+
+// SetName sets the value of Name.
+func (s *GraphNode) SetName(val string) {
+	s.Name = val
+}
+
+// SetKarma sets the value of Karma.
+func (s *GraphNode) SetKarma(val int) {
+	s.Karma = val
+}
+
+// SetPostsCount sets the value of PostsCount.
+func (s *GraphNode) SetPostsCount(val int) {
+	s.PostsCount = val
+}
+
+// SetInDegree sets the value of InDegree.
+func (s *GraphNode) SetInDegree(val int) {
+	s.InDegree = val
+}
+
+// SetOutDegree sets the value of OutDegree.
+func (s *GraphNode) SetOutDegree(val int) {
+	s.OutDegree = val
+}
+
+// SetInWeight sets the value of InWeight.
+func (s *GraphNode) SetInWeight(val int) {
+	s.InWeight = val
+}
+
+// SetOutWeight sets the value of OutWeight.
+func (s *GraphNode) SetOutWeight(val int) {
+	s.OutWeight = val
+}
+
+// Ref: #/components/schemas/GraphRebuildResponse
+type GraphRebuildResponse struct {
+	// Number of stored daily edges.
+	Edges      int `json:"edges"`
+	DurationMs int `json:"duration_ms"`
+}
+
+// GetEdges returns the value of Edges.
+func (s *GraphRebuildResponse) GetEdges() int {
+	return s.Edges
+}
+
+// GetDurationMs returns the value of DurationMs.
+func (s *GraphRebuildResponse) GetDurationMs() int {
+	return s.DurationMs
+}
+
+// SetEdges sets the value of Edges.
+func (s *GraphRebuildResponse) SetEdges(val int) {
+	s.Edges = val
+}
+
+// SetDurationMs sets the value of DurationMs.
+func (s *GraphRebuildResponse) SetDurationMs(val int) {
+	s.DurationMs = val
+}
+
+func (*GraphRebuildResponse) analyticsGraphRebuildRes() {}
+
+// Thread or post held by content filter, or held edit of visible thread or post.
+// Ref: #/components/schemas/HeldContent
+type HeldContent struct {
+	ID         int              `json:"id"`
+	TargetType ReportTargetType `json:"target_type"`
+	TargetID   int              `json:"target_id"`
+	ThreadID   int              `json:"thread_id"`
+	AuthorID   int              `json:"author_id"`
+	AuthorName string           `json:"author_name"`
+	// Thread title, empty for post.
+	Title string `json:"title"`
+	// Markdown source.
+	Content string `json:"content"`
+	// Why content filter held content.
+	Reason string `json:"reason"`
+	// Held edit of visible thread or post, title and content are edited version.
+	// Approved edit becomes new revision, rejected edit is discarded.
+	Edit      bool      `json:"edit"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *HeldContent) GetID() int {
+	return s.ID
+}
+
+// GetTargetType returns the value of TargetType.
+func (s *HeldContent) GetTargetType() ReportTargetType {
+	return s.TargetType
+}
+
+// GetTargetID returns the value of TargetID.
+func (s *HeldContent) GetTargetID() int {
+	return s.TargetID
+}
+
+// GetThreadID returns the value of ThreadID.
+func (s *HeldContent) GetThreadID() int {
+	return s.ThreadID
+}
+
+// GetAuthorID returns the value of AuthorID.
+func (s *HeldContent) GetAuthorID() int {
+	return s.AuthorID
+}
+
+// GetAuthorName returns the value of AuthorName.
+func (s *HeldContent) GetAuthorName() string {
+	return s.AuthorName
+}
+
+// GetTitle returns the value of Title.
+func (s *HeldContent) GetTitle() string {
+	return s.Title
+}
+
+// GetContent returns the value of Content.
+func (s *HeldContent) GetContent() string {
+	return s.Content
+}
+
+// GetReason returns the value of Reason.
+func (s *HeldContent) GetReason() string {
+	return s.Reason
+}
+
+// GetEdit returns the value of Edit.
+func (s *HeldContent) GetEdit() bool {
+	return s.Edit
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *HeldContent) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *HeldContent) SetID(val int) {
+	s.ID = val
+}
+
+// SetTargetType sets the value of TargetType.
+func (s *HeldContent) SetTargetType(val ReportTargetType) {
+	s.TargetType = val
+}
+
+// SetTargetID sets the value of TargetID.
+func (s *HeldContent) SetTargetID(val int) {
+	s.TargetID = val
+}
+
+// SetThreadID sets the value of ThreadID.
+func (s *HeldContent) SetThreadID(val int) {
+	s.ThreadID = val
+}
+
+// SetAuthorID sets the value of AuthorID.
+func (s *HeldContent) SetAuthorID(val int) {
+	s.AuthorID = val
+}
+
+// SetAuthorName sets the value of AuthorName.
+func (s *HeldContent) SetAuthorName(val string) {
+	s.AuthorName = val
+}
+
+// SetTitle sets the value of Title.
+func (s *HeldContent) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetContent sets the value of Content.
+func (s *HeldContent) SetContent(val string) {
+	s.Content = val
+}
+
+// SetReason sets the value of Reason.
+func (s *HeldContent) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetEdit sets the value of Edit.
+func (s *HeldContent) SetEdit(val bool) {
+	s.Edit = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *HeldContent) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Ref: #/components/schemas/HeldContentListResponse
+type HeldContentListResponse struct {
+	Items    []HeldContent `json:"items"`
+	HaveNext bool          `json:"have_next"`
+}
+
+// GetItems returns the value of Items.
+func (s *HeldContentListResponse) GetItems() []HeldContent {
+	return s.Items
+}
+
+// GetHaveNext returns the value of HaveNext.
+func (s *HeldContentListResponse) GetHaveNext() bool {
+	return s.HaveNext
+}
+
+// SetItems sets the value of Items.
+func (s *HeldContentListResponse) SetItems(val []HeldContent) {
+	s.Items = val
+}
+
+// SetHaveNext sets the value of HaveNext.
+func (s *HeldContentListResponse) SetHaveNext(val bool) {
+	s.HaveNext = val
+}
+
+func (*HeldContentListResponse) moderationHeldRes() {}
+
+// Ref: #/components/schemas/HeldDecisionRequest
+type HeldDecisionRequest struct {
+	Decision HeldDecisionRequestDecision `json:"decision"`
+	// Reason of decision, sent to author on reject.
+	Comment OptString `json:"comment"`
+}
+
+// GetDecision returns the value of Decision.
+func (s *HeldDecisionRequest) GetDecision() HeldDecisionRequestDecision {
+	return s.Decision
+}
+
+// GetComment returns the value of Comment.
+func (s *HeldDecisionRequest) GetComment() OptString {
+	return s.Comment
+}
+
+// SetDecision sets the value of Decision.
+func (s *HeldDecisionRequest) SetDecision(val HeldDecisionRequestDecision) {
+	s.Decision = val
+}
+
+// SetComment sets the value of Comment.
+func (s *HeldDecisionRequest) SetComment(val OptString) {
+	s.Comment = val
+}
+
+type HeldDecisionRequestDecision string
+
+const (
+	HeldDecisionRequestDecisionApprove HeldDecisionRequestDecision = "approve"
+	HeldDecisionRequestDecisionReject  HeldDecisionRequestDecision = "reject"
+)
+
+// AllValues returns all HeldDecisionRequestDecision values.
+func (HeldDecisionRequestDecision) AllValues() []HeldDecisionRequestDecision {
+	return []HeldDecisionRequestDecision{
+		HeldDecisionRequestDecisionApprove,
+		HeldDecisionRequestDecisionReject,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s HeldDecisionRequestDecision) MarshalText() ([]byte, error) {
+	switch s {
+	case HeldDecisionRequestDecisionApprove:
+		return []byte(s), nil
+	case HeldDecisionRequestDecisionReject:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *HeldDecisionRequestDecision) UnmarshalText(data []byte) error {
+	switch HeldDecisionRequestDecision(data) {
+	case HeldDecisionRequestDecisionApprove:
+		*s = HeldDecisionRequestDecisionApprove
+		return nil
+	case HeldDecisionRequestDecisionReject:
+		*s = HeldDecisionRequestDecisionReject
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/InfluenceMetric
+type InfluenceMetric string
+
+const (
+	InfluenceMetricPagerank       InfluenceMetric = "pagerank"
+	InfluenceMetricBetweenness    InfluenceMetric = "betweenness"
+	InfluenceMetricInDegree       InfluenceMetric = "in_degree"
+	InfluenceMetricOutDegree      InfluenceMetric = "out_degree"
+	InfluenceMetricWeightedDegree InfluenceMetric = "weighted_degree"
+)
+
+// AllValues returns all InfluenceMetric values.
+func (InfluenceMetric) AllValues() []InfluenceMetric {
+	return []InfluenceMetric{
+		InfluenceMetricPagerank,
+		InfluenceMetricBetweenness,
+		InfluenceMetricInDegree,
+		InfluenceMetricOutDegree,
+		InfluenceMetricWeightedDegree,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s InfluenceMetric) MarshalText() ([]byte, error) {
+	switch s {
+	case InfluenceMetricPagerank:
+		return []byte(s), nil
+	case InfluenceMetricBetweenness:
+		return []byte(s), nil
+	case InfluenceMetricInDegree:
+		return []byte(s), nil
+	case InfluenceMetricOutDegree:
+		return []byte(s), nil
+	case InfluenceMetricWeightedDegree:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *InfluenceMetric) UnmarshalText(data []byte) error {
+	switch InfluenceMetric(data) {
+	case InfluenceMetricPagerank:
+		*s = InfluenceMetricPagerank
+		return nil
+	case InfluenceMetricBetweenness:
+		*s = InfluenceMetricBetweenness
+		return nil
+	case InfluenceMetricInDegree:
+		*s = InfluenceMetricInDegree
+		return nil
+	case InfluenceMetricOutDegree:
+		*s = InfluenceMetricOutDegree
+		return nil
+	case InfluenceMetricWeightedDegree:
+		*s = InfluenceMetricWeightedDegree
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/InfluenceScore
+type InfluenceScore struct {
+	UserID int    `json:"user_id"`
+	Name   string `json:"name"`
+	// Position by PageRank, from 1.
+	Rank     int     `json:"rank"`
+	Pagerank float64 `json:"pagerank"`
+	// Normalized to [0, 1], estimated on big graphs.
+	Betweenness float64 `json:"betweenness"`
+	InDegree    int     `json:"in_degree"`
+	OutDegree   int     `json:"out_degree"`
+	InWeight    int     `json:"in_weight"`
+	OutWeight   int     `json:"out_weight"`
+	// Sum of in and out weights.
+	WeightedDegree int `json:"weighted_degree"`
+}
+
+// GetUserID returns the value of UserID.
+func (s *InfluenceScore) GetUserID() int {
+	return s.UserID
+}
+
+// GetName returns the value of Name.
+func (s *InfluenceScore) GetName() string {
+	return s.Name
+}
+
+// GetRank returns the value of Rank.
+func (s *InfluenceScore) GetRank() int {
+	return s.Rank
+}
+
+// GetPagerank returns the value of Pagerank.
+func (s *InfluenceScore) GetPagerank() float64 {
+	return s.Pagerank
+}
+
+// GetBetweenness returns the value of Betweenness.
+func (s *InfluenceScore) GetBetweenness() float64 {
+	return s.Betweenness
+}
+
+// GetInDegree returns the value of InDegree.
+func (s *InfluenceScore) GetInDegree() int {
+	return s.InDegree
+}
+
+// GetOutDegree returns the value of OutDegree.
+func (s *InfluenceScore) GetOutDegree() int {
+	return s.OutDegree
+}
+
+// GetInWeight returns the value of InWeight.
+func (s *InfluenceScore) GetInWeight() int {
+	return s.InWeight
+}
+
+// GetOutWeight returns the value of OutWeight.
+func (s *InfluenceScore) GetOutWeight() int {
+	return s.OutWeight
+}
+
+// GetWeightedDegree returns the value of WeightedDegree.
+func (s *InfluenceScore) GetWeightedDegree() int {
+	return s.WeightedDegree
+}
+
+// SetUserID sets the value of UserID.
+func (s *InfluenceScore) SetUserID(val int) {
+	s.UserID = val
+}
+
+// SetName sets the value of Name.
+func (s *InfluenceScore) SetName(val string) {
+	s.Name = val
+}
+
+// SetRank sets the value of Rank.
+func (s *InfluenceScore) SetRank(val int) {
+	s.Rank = val
+}
+
+// SetPagerank sets the value of Pagerank.
+func (s *InfluenceScore) SetPagerank(val float64) {
+	s.Pagerank = val
+}
+
+// SetBetweenness sets the value of Betweenness.
+func (s *InfluenceScore) SetBetweenness(val float64) {
+	s.Betweenness = val
+}
+
+// SetInDegree sets the value of InDegree.
+func (s *InfluenceScore) SetInDegree(val int) {
+	s.InDegree = val
+}
+
+// SetOutDegree sets the value of OutDegree.
+func (s *InfluenceScore) SetOutDegree(val int) {
+	s.OutDegree = val
+}
+
+// SetInWeight sets the value of InWeight.
+func (s *InfluenceScore) SetInWeight(val int) {
+	s.InWeight = val
+}
+
+// SetOutWeight sets the value of OutWeight.
+func (s *InfluenceScore) SetOutWeight(val int) {
+	s.OutWeight = val
+}
+
+// SetWeightedDegree sets the value of WeightedDegree.
+func (s *InfluenceScore) SetWeightedDegree(val int) {
+	s.WeightedDegree = val
+}
+
+// Ref: #/components/schemas/InfluenceSnapshot
+type InfluenceSnapshot struct {
+	ID int `json:"id"`
+	// Absent for snapshot of whole forum.
+	CommunityID OptInt `json:"community_id"`
+	// Start of graph time window, absent if graph has all interactions.
+	WindowFrom OptDateTime `json:"window_from"`
+	UsersCount int         `json:"users_count"`
+	EdgesCount int         `json:"edges_count"`
+	DurationMs int         `json:"duration_ms"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *InfluenceSnapshot) GetID() int {
+	return s.ID
+}
+
+// GetCommunityID returns the value of CommunityID.
+func (s *InfluenceSnapshot) GetCommunityID() OptInt {
+	return s.CommunityID
+}
+
+// GetWindowFrom returns the value of WindowFrom.
+func (s *InfluenceSnapshot) GetWindowFrom() OptDateTime {
+	return s.WindowFrom
+}
+
+// GetUsersCount returns the value of UsersCount.
+func (s *InfluenceSnapshot) GetUsersCount() int {
+	return s.UsersCount
+}
+
+// GetEdgesCount returns the value of EdgesCount.
+func (s *InfluenceSnapshot) GetEdgesCount() int {
+	return s.EdgesCount
+}
+
+// GetDurationMs returns the value of DurationMs.
+func (s *InfluenceSnapshot) GetDurationMs() int {
+	return s.DurationMs
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *InfluenceSnapshot) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *InfluenceSnapshot) SetID(val int) {
+	s.ID = val
+}
+
+// SetCommunityID sets the value of CommunityID.
+func (s *InfluenceSnapshot) SetCommunityID(val OptInt) {
+	s.CommunityID = val
+}
+
+// SetWindowFrom sets the value of WindowFrom.
+func (s *InfluenceSnapshot) SetWindowFrom(val OptDateTime) {
+	s.WindowFrom = val
+}
+
+// SetUsersCount sets the value of UsersCount.
+func (s *InfluenceSnapshot) SetUsersCount(val int) {
+	s.UsersCount = val
+}
+
+// SetEdgesCount sets the value of EdgesCount.
+func (s *InfluenceSnapshot) SetEdgesCount(val int) {
+	s.EdgesCount = val
+}
+
+// SetDurationMs sets the value of DurationMs.
+func (s *InfluenceSnapshot) SetDurationMs(val int) {
+	s.DurationMs = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *InfluenceSnapshot) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Ref: #/components/schemas/InfluenceSnapshotsResponse
+type InfluenceSnapshotsResponse struct {
+	Snapshots []InfluenceSnapshot `json:"snapshots"`
+}
+
+// GetSnapshots returns the value of Snapshots.
+func (s *InfluenceSnapshotsResponse) GetSnapshots() []InfluenceSnapshot {
+	return s.Snapshots
+}
+
+// SetSnapshots sets the value of Snapshots.
+func (s *InfluenceSnapshotsResponse) SetSnapshots(val []InfluenceSnapshot) {
+	s.Snapshots = val
+}
+
+func (*InfluenceSnapshotsResponse) analyticsInfluenceSnapshotRes() {}
+
+// Ref: #/components/schemas/InteractionGraphResponse
+type InteractionGraphResponse struct {
+	Nodes     []GraphNode `json:"nodes"`
+	Edges     []GraphEdge `json:"edges"`
+	Truncated bool        `json:"truncated"`
+}
+
+// GetNodes returns the value of Nodes.
+func (s *InteractionGraphResponse) GetNodes() []GraphNode {
+	return s.Nodes
+}
+
+// GetEdges returns the value of Edges.
+func (s *InteractionGraphResponse) GetEdges() []GraphEdge {
+	return s.Edges
+}
+
+// GetTruncated returns the value of Truncated.
+func (s *InteractionGraphResponse) GetTruncated() bool {
+	return s.Truncated
+}
+
+// SetNodes sets the value of Nodes.
+func (s *InteractionGraphResponse) SetNodes(val []GraphNode) {
+	s.Nodes = val
+}
+
+// SetEdges sets the value of Edges.
+func (s *InteractionGraphResponse) SetEdges(val []GraphEdge) {
+	s.Edges = val
+}
+
+// SetTruncated sets the value of Truncated.
+func (s *InteractionGraphResponse) SetTruncated(val bool) {
+	s.Truncated = val
+}
+
+func (*InteractionGraphResponse) analyticsGraphRes() {}
+
+// Ref: #/components/schemas/InteractionKind
+type InteractionKind string
+
+const (
+	InteractionKindReply   InteractionKind = "reply"
+	InteractionKindMention InteractionKind = "mention"
+	InteractionKindVote    InteractionKind = "vote"
+)
+
+// AllValues returns all InteractionKind values.
+func (InteractionKind) AllValues() []InteractionKind {
+	return []InteractionKind{
+		InteractionKindReply,
+		InteractionKindMention,
+		InteractionKindVote,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s InteractionKind) MarshalText() ([]byte, error) {
+	switch s {
+	case InteractionKindReply:
+		return []byte(s), nil
+	case InteractionKindMention:
+		return []byte(s), nil
+	case InteractionKindVote:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *InteractionKind) UnmarshalText(data []byte) error {
+	switch InteractionKind(data) {
+	case InteractionKindReply:
+		*s = InteractionKindReply
+		return nil
+	case InteractionKindMention:
+		*s = InteractionKindMention
+		return nil
+	case InteractionKindVote:
+		*s = InteractionKindVote
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type JwtAuth struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *JwtAuth) GetToken() string {
+	return s.Token
+}
+
+// GetRoles returns the value of Roles.
+func (s *JwtAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetToken sets the value of Token.
+func (s *JwtAuth) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *JwtAuth) SetRoles(val []string) {
+	s.Roles = val
+}
+
+type JwtToken struct {
+	RefreshToken string `json:"refreshToken"`
+	AccessToken  string `json:"accessToken"`
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *JwtToken) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// GetAccessToken returns the value of AccessToken.
+func (s *JwtToken) GetAccessToken() string {
+	return s.AccessToken
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *JwtToken) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
+// SetAccessToken sets the value of AccessToken.
+func (s *JwtToken) SetAccessToken(val string) {
+	s.AccessToken = val
+}
+
+func (*JwtToken) authRefreshRes() {}
+
+// Ref: #/components/schemas/LeaderboardResponse
+type LeaderboardResponse struct {
+	Snapshot InfluenceSnapshot `json:"snapshot"`
+	Metric   InfluenceMetric   `json:"metric"`
+	Scores   []InfluenceScore  `json:"scores"`
+}
+
+// GetSnapshot returns the value of Snapshot.
+func (s *LeaderboardResponse) GetSnapshot() InfluenceSnapshot {
+	return s.Snapshot
+}
+
+// GetMetric returns the value of Metric.
+func (s *LeaderboardResponse) GetMetric() InfluenceMetric {
+	return s.Metric
+}
+
+// GetScores returns the value of Scores.
+func (s *LeaderboardResponse) GetScores() []InfluenceScore {
+	return s.Scores
+}
+
+// SetSnapshot sets the value of Snapshot.
+func (s *LeaderboardResponse) SetSnapshot(val InfluenceSnapshot) {
+	s.Snapshot = val
+}
+
+// SetMetric sets the value of Metric.
+func (s *LeaderboardResponse) SetMetric(val InfluenceMetric) {
+	s.Metric = val
+}
+
+// SetScores sets the value of Scores.
+func (s *LeaderboardResponse) SetScores(val []InfluenceScore) {
+	s.Scores = val
+}
+
+func (*LeaderboardResponse) analyticsLeaderboardRes() {}
+
+type LiveThreadOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s LiveThreadOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*LiveThreadOK) liveThreadRes() {}
+
+type LiveThreadsOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s LiveThreadsOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*LiveThreadsOK) liveThreadsRes() {}
+
+// Position of `@name` mention in content. Offset and length are in unicode code points
+// and include leading `@`.
+// Ref: #/components/schemas/MentionEntity
+type MentionEntity struct {
+	UserID int `json:"user_id"`
+	// Name as it was written, user could be renamed after that.
+	Name   string `json:"name"`
+	Offset int    `json:"offset"`
+	Length int    `json:"length"`
+}
+
+// GetUserID returns the value of UserID.
+func (s *MentionEntity) GetUserID() int {
+	return s.UserID
+}
+
+// GetName returns the value of Name.
+func (s *MentionEntity) GetName() string {
+	return s.Name
+}
+
+// GetOffset returns the value of Offset.
+func (s *MentionEntity) GetOffset() int {
+	return s.Offset
+}
+
+// GetLength returns the value of Length.
+func (s *MentionEntity) GetLength() int {
+	return s.Length
+}
+
+// SetUserID sets the value of UserID.
+func (s *MentionEntity) SetUserID(val int) {
+	s.UserID = val
+}
+
+// SetName sets the value of Name.
+func (s *MentionEntity) SetName(val string) {
+	s.Name = val
+}
+
+// SetOffset sets the value of Offset.
+func (s *MentionEntity) SetOffset(val int) {
+	s.Offset = val
+}
+
+// SetLength sets the value of Length.
+func (s *MentionEntity) SetLength(val int) {
+	s.Length = val
+}
+
+// Ref: #/components/schemas/MentionUser
+type MentionUser struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Rank string `json:"rank"`
+}
+
+// GetID returns the value of ID.
+func (s *MentionUser) GetID() int {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *MentionUser) GetName() string {
+	return s.Name
+}
+
+// GetRank returns the value of Rank.
+func (s *MentionUser) GetRank() string {
+	return s.Rank
+}
+
+// SetID sets the value of ID.
+func (s *MentionUser) SetID(val int) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *MentionUser) SetName(val string) {
+	s.Name = val
+}
+
+// SetRank sets the value of Rank.
+func (s *MentionUser) SetRank(val string) {
+	s.Rank = val
+}
+
+type MentionUsersInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*MentionUsersInternalServerError) mentionUsersRes() {}
+
+type MentionUsersOKApplicationJSON []MentionUser
+
+func (*MentionUsersOKApplicationJSON) mentionUsersRes() {}
+
+type MentionUsersUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*MentionUsersUnauthorized) mentionUsersRes() {}
+
+// Ref: #/components/schemas/MessageSendRequest
+type MessageSendRequest struct {
+	// Message text (markdown).
+	Content string `json:"content"`
+}
+
+// GetContent returns the value of Content.
+func (s *MessageSendRequest) GetContent() string {
+	return s.Content
+}
+
+// SetContent sets the value of Content.
+func (s *MessageSendRequest) SetContent(val string) {
+	s.Content = val
+}
+
+// Ref: #/components/schemas/MessagesUnreadResponse
+type MessagesUnreadResponse struct {
+	// Number of conversations with unread messages.
+	Conversations int `json:"conversations"`
+	// Number of unread messages.
+	Messages int `json:"messages"`
+}
+
+// GetConversations returns the value of Conversations.
+func (s *MessagesUnreadResponse) GetConversations() int {
+	return s.Conversations
+}
+
+// GetMessages returns the value of Messages.
+func (s *MessagesUnreadResponse) GetMessages() int {
+	return s.Messages
+}
+
+// SetConversations sets the value of Conversations.
+func (s *MessagesUnreadResponse) SetConversations(val int) {
+	s.Conversations = val
+}
+
+// SetMessages sets the value of Messages.
+func (s *MessagesUnreadResponse) SetMessages(val int) {
+	s.Messages = val
+}
+
+func (*MessagesUnreadResponse) conversationsUnreadCountRes() {}
+
+// Ref: #/components/schemas/ModerationAction
+type ModerationAction string
+
+const (
+	ModerationActionDismiss ModerationAction = "dismiss"
+	ModerationActionHide    ModerationAction = "hide"
+	ModerationActionWarn    ModerationAction = "warn"
+	ModerationActionSuspend ModerationAction = "suspend"
+	ModerationActionApprove ModerationAction = "approve"
+	ModerationActionReject  ModerationAction = "reject"
+)
+
+// AllValues returns all ModerationAction values.
+func (ModerationAction) AllValues() []ModerationAction {
+	return []ModerationAction{
+		ModerationActionDismiss,
+		ModerationActionHide,
+		ModerationActionWarn,
+		ModerationActionSuspend,
+		ModerationActionApprove,
+		ModerationActionReject,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ModerationAction) MarshalText() ([]byte, error) {
+	switch s {
+	case ModerationActionDismiss:
+		return []byte(s), nil
+	case ModerationActionHide:
+		return []byte(s), nil
+	case ModerationActionWarn:
+		return []byte(s), nil
+	case ModerationActionSuspend:
+		return []byte(s), nil
+	case ModerationActionApprove:
+		return []byte(s), nil
+	case ModerationActionReject:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ModerationAction) UnmarshalText(data []byte) error {
+	switch ModerationAction(data) {
+	case ModerationActionDismiss:
+		*s = ModerationActionDismiss
+		return nil
+	case ModerationActionHide:
+		*s = ModerationActionHide
+		return nil
+	case ModerationActionWarn:
+		*s = ModerationActionWarn
+		return nil
+	case ModerationActionSuspend:
+		*s = ModerationActionSuspend
+		return nil
+	case ModerationActionApprove:
+		*s = ModerationActionApprove
+		return nil
+	case ModerationActionReject:
+		*s = ModerationActionReject
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ModerationDecideHeldBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationDecideHeldBadRequest) moderationDecideHeldRes() {}
+
+type ModerationDecideHeldForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationDecideHeldForbidden) moderationDecideHeldRes() {}
+
+type ModerationDecideHeldInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationDecideHeldInternalServerError) moderationDecideHeldRes() {}
+
+type ModerationDecideHeldNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationDecideHeldNotFound) moderationDecideHeldRes() {}
+
+type ModerationDecideHeldUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationDecideHeldUnauthorized) moderationDecideHeldRes() {}
+
+type ModerationHeldForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationHeldForbidden) moderationHeldRes() {}
+
+type ModerationHeldInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationHeldInternalServerError) moderationHeldRes() {}
+
+type ModerationHeldUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationHeldUnauthorized) moderationHeldRes() {}
+
+// Ref: #/components/schemas/ModerationLogEntry
+type ModerationLogEntry struct {
+	ID             int              `json:"id"`
+	ModeratorID    int              `json:"moderator_id"`
+	ModeratorName  string           `json:"moderator_name"`
+	Action         ModerationAction `json:"action"`
+	TargetType     ReportTargetType `json:"target_type"`
+	TargetID       int              `json:"target_id"`
+	TargetUserID   int              `json:"target_user_id"`
+	TargetUserName string           `json:"target_user_name"`
+	ReportsCount   int              `json:"reports_count"`
+	Comment        string           `json:"comment"`
+	SuspendedUntil OptDateTime      `json:"suspended_until"`
+	CreatedAt      time.Time        `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *ModerationLogEntry) GetID() int {
+	return s.ID
+}
+
+// GetModeratorID returns the value of ModeratorID.
+func (s *ModerationLogEntry) GetModeratorID() int {
+	return s.ModeratorID
+}
+
+// GetModeratorName returns the value of ModeratorName.
+func (s *ModerationLogEntry) GetModeratorName() string {
+	return s.ModeratorName
+}
+
+// GetAction returns the value of Action.
+func (s *ModerationLogEntry) GetAction() ModerationAction {
+	return s.Action
+}
+
+// GetTargetType returns the value of TargetType.
+func (s *ModerationLogEntry) GetTargetType() ReportTargetType {
+	return s.TargetType
+}
+
+// GetTargetID returns the value of TargetID.
+func (s *ModerationLogEntry) GetTargetID() int {
+	return s.TargetID
+}
+
+// GetTargetUserID returns the value of TargetUserID.
+func (s *ModerationLogEntry) GetTargetUserID() int {
+	return s.TargetUserID
+}
+
+// GetTargetUserName returns the value of TargetUserName.
+func (s *ModerationLogEntry) GetTargetUserName() string {
+	return s.TargetUserName
+}
+
+// GetReportsCount returns the value of ReportsCount.
+func (s *ModerationLogEntry) GetReportsCount() int {
+	return s.ReportsCount
+}
+
+// GetComment returns the value of Comment.
+func (s *ModerationLogEntry) GetComment() string {
+	return s.Comment
+}
+
+// GetSuspendedUntil returns the value of SuspendedUntil.
+func (s *ModerationLogEntry) GetSuspendedUntil() OptDateTime {
+	return s.SuspendedUntil
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ModerationLogEntry) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *ModerationLogEntry) SetID(val int) {
+	s.ID = val
+}
+
+// SetModeratorID sets the value of ModeratorID.
+func (s *ModerationLogEntry) SetModeratorID(val int) {
+	s.ModeratorID = val
+}
+
+// SetModeratorName sets the value of ModeratorName.
+func (s *ModerationLogEntry) SetModeratorName(val string) {
+	s.ModeratorName = val
+}
+
+// SetAction sets the value of Action.
+func (s *ModerationLogEntry) SetAction(val ModerationAction) {
+	s.Action = val
+}
+
+// SetTargetType sets the value of TargetType.
+func (s *ModerationLogEntry) SetTargetType(val ReportTargetType) {
+	s.TargetType = val
+}
+
+// SetTargetID sets the value of TargetID.
+func (s *ModerationLogEntry) SetTargetID(val int) {
+	s.TargetID = val
+}
+
+// SetTargetUserID sets the value of TargetUserID.
+func (s *ModerationLogEntry) SetTargetUserID(val int) {
+	s.TargetUserID = val
+}
+
+// SetTargetUserName sets the value of TargetUserName.
+func (s *ModerationLogEntry) SetTargetUserName(val string) {
+	s.TargetUserName = val
+}
+
+// SetReportsCount sets the value of ReportsCount.
+func (s *ModerationLogEntry) SetReportsCount(val int) {
+	s.ReportsCount = val
+}
+
+// SetComment sets the value of Comment.
+func (s *ModerationLogEntry) SetComment(val string) {
+	s.Comment = val
+}
+
+// SetSuspendedUntil sets the value of SuspendedUntil.
+func (s *ModerationLogEntry) SetSuspendedUntil(val OptDateTime) {
+	s.SuspendedUntil = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ModerationLogEntry) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*ModerationLogEntry) moderationDecideHeldRes() {}
+func (*ModerationLogEntry) moderationResolveRes()    {}
+
+type ModerationLogForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationLogForbidden) moderationLogRes() {}
+
+type ModerationLogInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationLogInternalServerError) moderationLogRes() {}
+
+// Ref: #/components/schemas/ModerationLogResponse
+type ModerationLogResponse struct {
+	Entries  []ModerationLogEntry `json:"entries"`
+	HaveNext bool                 `json:"have_next"`
+}
+
+// GetEntries returns the value of Entries.
+func (s *ModerationLogResponse) GetEntries() []ModerationLogEntry {
+	return s.Entries
+}
+
+// GetHaveNext returns the value of HaveNext.
+func (s *ModerationLogResponse) GetHaveNext() bool {
+	return s.HaveNext
+}
+
+// SetEntries sets the value of Entries.
+func (s *ModerationLogResponse) SetEntries(val []ModerationLogEntry) {
+	s.Entries = val
+}
+
+// SetHaveNext sets the value of HaveNext.
+func (s *ModerationLogResponse) SetHaveNext(val bool) {
+	s.HaveNext = val
+}
+
+func (*ModerationLogResponse) moderationLogRes() {}
+
+type ModerationLogUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationLogUnauthorized) moderationLogRes() {}
+
+type ModerationQueueForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationQueueForbidden) moderationQueueRes() {}
+
+type ModerationQueueInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationQueueInternalServerError) moderationQueueRes() {}
+
+type ModerationQueueUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationQueueUnauthorized) moderationQueueRes() {}
+
+type ModerationResolveBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationResolveBadRequest) moderationResolveRes() {}
+
+type ModerationResolveForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationResolveForbidden) moderationResolveRes() {}
+
+type ModerationResolveInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationResolveInternalServerError) moderationResolveRes() {}
+
+type ModerationResolveNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationResolveNotFound) moderationResolveRes() {}
+
+// Ref: #/components/schemas/ModerationResolveRequest
+type ModerationResolveRequest struct {
+	Action ModerationAction `json:"action"`
+	// Reason of action, sent to user with notification.
+	Comment OptString `json:"comment"`
+	// Suspension length for suspend action (1-365).
+	SuspendDays OptInt `json:"suspend_days"`
+}
+
+// GetAction returns the value of Action.
+func (s *ModerationResolveRequest) GetAction() ModerationAction {
+	return s.Action
+}
+
+// GetComment returns the value of Comment.
+func (s *ModerationResolveRequest) GetComment() OptString {
+	return s.Comment
+}
+
+// GetSuspendDays returns the value of SuspendDays.
+func (s *ModerationResolveRequest) GetSuspendDays() OptInt {
+	return s.SuspendDays
+}
+
+// SetAction sets the value of Action.
+func (s *ModerationResolveRequest) SetAction(val ModerationAction) {
+	s.Action = val
+}
+
+// SetComment sets the value of Comment.
+func (s *ModerationResolveRequest) SetComment(val OptString) {
+	s.Comment = val
+}
+
+// SetSuspendDays sets the value of SuspendDays.
+func (s *ModerationResolveRequest) SetSuspendDays(val OptInt) {
+	s.SuspendDays = val
+}
+
+type ModerationResolveUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ModerationResolveUnauthorized) moderationResolveRes() {}
+
+// Ref: #/components/schemas/Notification
+type Notification struct {
+	ID   int              `json:"id"`
+	Type NotificationType `json:"type"`
+	// User who caused notification, absent for system notifications.
+	ActorID   OptInt    `json:"actor_id"`
+	ActorName OptString `json:"actor_name"`
+	ThreadID  OptInt    `json:"thread_id"`
+	PostID    OptInt    `json:"post_id"`
+	// Vote value (1 or -1) for vote notifications.
+	VoteValue OptInt `json:"vote_value"`
+	// Text of moderation notification.
+	Message   OptString `json:"message"`
+	IsRead    bool      `json:"is_read"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Notification) GetID() int {
+	return s.ID
+}
+
+// GetType returns the value of Type.
+func (s *Notification) GetType() NotificationType {
+	return s.Type
+}
+
+// GetActorID returns the value of ActorID.
+func (s *Notification) GetActorID() OptInt {
+	return s.ActorID
+}
+
+// GetActorName returns the value of ActorName.
+func (s *Notification) GetActorName() OptString {
+	return s.ActorName
+}
+
+// GetThreadID returns the value of ThreadID.
+func (s *Notification) GetThreadID() OptInt {
+	return s.ThreadID
+}
+
+// GetPostID returns the value of PostID.
+func (s *Notification) GetPostID() OptInt {
+	return s.PostID
+}
+
+// GetVoteValue returns the value of VoteValue.
+func (s *Notification) GetVoteValue() OptInt {
+	return s.VoteValue
+}
+
+// GetMessage returns the value of Message.
+func (s *Notification) GetMessage() OptString {
+	return s.Message
+}
+
+// GetIsRead returns the value of IsRead.
+func (s *Notification) GetIsRead() bool {
+	return s.IsRead
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Notification) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Notification) SetID(val int) {
+	s.ID = val
+}
+
+// SetType sets the value of Type.
+func (s *Notification) SetType(val NotificationType) {
+	s.Type = val
+}
+
+// SetActorID sets the value of ActorID.
+func (s *Notification) SetActorID(val OptInt) {
+	s.ActorID = val
+}
+
+// SetActorName sets the value of ActorName.
+func (s *Notification) SetActorName(val OptString) {
+	s.ActorName = val
+}
+
+// SetThreadID sets the value of ThreadID.
+func (s *Notification) SetThreadID(val OptInt) {
+	s.ThreadID = val
+}
+
+// SetPostID sets the value of PostID.
+func (s *Notification) SetPostID(val OptInt) {
+	s.PostID = val
+}
+
+// SetVoteValue sets the value of VoteValue.
+func (s *Notification) SetVoteValue(val OptInt) {
+	s.VoteValue = val
+}
+
+// SetMessage sets the value of Message.
+func (s *Notification) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetIsRead sets the value of IsRead.
+func (s *Notification) SetIsRead(val bool) {
+	s.IsRead = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Notification) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Ref: #/components/schemas/NotificationListResponse
+type NotificationListResponse struct {
+	Notifications []Notification `json:"notifications"`
+	HaveNext      bool           `json:"have_next"`
+}
+
+// GetNotifications returns the value of Notifications.
+func (s *NotificationListResponse) GetNotifications() []Notification {
+	return s.Notifications
+}
+
+// GetHaveNext returns the value of HaveNext.
+func (s *NotificationListResponse) GetHaveNext() bool {
+	return s.HaveNext
+}
+
+// SetNotifications sets the value of Notifications.
+func (s *NotificationListResponse) SetNotifications(val []Notification) {
+	s.Notifications = val
+}
+
+// SetHaveNext sets the value of HaveNext.
+func (s *NotificationListResponse) SetHaveNext(val bool) {
+	s.HaveNext = val
+}
+
+func (*NotificationListResponse) notificationsListRes() {}
+
+// Ref: #/components/schemas/NotificationMarkReadRequest
+type NotificationMarkReadRequest struct {
+	Ids []int `json:"ids"`
+}
+
+// GetIds returns the value of Ids.
+func (s *NotificationMarkReadRequest) GetIds() []int {
+	return s.Ids
+}
+
+// SetIds sets the value of Ids.
+func (s *NotificationMarkReadRequest) SetIds(val []int) {
+	s.Ids = val
+}
+
+// Ref: #/components/schemas/NotificationPreference
+type NotificationPreference struct {
+	Type    NotificationType `json:"type"`
+	Enabled bool             `json:"enabled"`
+}
+
+// GetType returns the value of Type.
+func (s *NotificationPreference) GetType() NotificationType {
+	return s.Type
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *NotificationPreference) GetEnabled() bool {
+	return s.Enabled
+}
+
+// SetType sets the value of Type.
+func (s *NotificationPreference) SetType(val NotificationType) {
+	s.Type = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *NotificationPreference) SetEnabled(val bool) {
+	s.Enabled = val
+}
+
+type NotificationPreferencesGetInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*NotificationPreferencesGetInternalServerError) notificationPreferencesGetRes() {}
+
+type NotificationPreferencesGetOKApplicationJSON []NotificationPreference
+
+func (*NotificationPreferencesGetOKApplicationJSON) notificationPreferencesGetRes() {}
+
+type NotificationPreferencesGetUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*NotificationPreferencesGetUnauthorized) notificationPreferencesGetRes() {}
+
+type NotificationPreferencesUpdateBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*NotificationPreferencesUpdateBadRequest) notificationPreferencesUpdateRes() {}
+
+type NotificationPreferencesUpdateInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*NotificationPreferencesUpdateInternalServerError) notificationPreferencesUpdateRes() {}
+
+type NotificationPreferencesUpdateOKApplicationJSON []NotificationPreference
+
+func (*NotificationPreferencesUpdateOKApplicationJSON) notificationPreferencesUpdateRes() {}
+
+type NotificationPreferencesUpdateUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*NotificationPreferencesUpdateUnauthorized) notificationPreferencesUpdateRes() {}
+
+// `thread_reply` - post in user thread, `post_reply` - reply to user post,
+// `mention` - user @mentioned, `vote` - vote for user thread or post,
+// `moderation` - moderator action on user content, `thread_post` - post in watched thread.
+// Ref: #/components/schemas/NotificationType
+type NotificationType string
+
+const (
+	NotificationTypeThreadReply NotificationType = "thread_reply"
+	NotificationTypePostReply   NotificationType = "post_reply"
+	NotificationTypeMention     NotificationType = "mention"
+	NotificationTypeVote        NotificationType = "vote"
+	NotificationTypeModeration  NotificationType = "moderation"
+	NotificationTypeThreadPost  NotificationType = "thread_post"
+)
+
+// AllValues returns all NotificationType values.
+func (NotificationType) AllValues() []NotificationType {
+	return []NotificationType{
+		NotificationTypeThreadReply,
+		NotificationTypePostReply,
+		NotificationTypeMention,
+		NotificationTypeVote,
+		NotificationTypeModeration,
+		NotificationTypeThreadPost,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s NotificationType) MarshalText() ([]byte, error) {
+	switch s {
+	case NotificationTypeThreadReply:
+		return []byte(s), nil
+	case NotificationTypePostReply:
+		return []byte(s), nil
+	case NotificationTypeMention:
+		return []byte(s), nil
+	case NotificationTypeVote:
+		return []byte(s), nil
+	case NotificationTypeModeration:
+		return []byte(s), nil
+	case NotificationTypeThreadPost:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *NotificationType) UnmarshalText(data []byte) error {
+	switch NotificationType(data) {
+	case NotificationTypeThreadReply:
+		*s = NotificationTypeThreadReply
+		return nil
+	case NotificationTypePostReply:
+		*s = NotificationTypePostReply
+		return nil
+	case NotificationTypeMention:
+		*s = NotificationTypeMention
+		return nil
+	case NotificationTypeVote:
+		*s = NotificationTypeVote
+		return nil
+	case NotificationTypeModeration:
+		*s = NotificationTypeModeration
+		return nil
+	case NotificationTypeThreadPost:
+		*s = NotificationTypeThreadPost
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/NotificationUnreadCount
+type NotificationUnreadCount struct {
+	Count int `json:"count"`
+}
+
+// GetCount returns the value of Count.
+func (s *NotificationUnreadCount) GetCount() int {
+	return s.Count
+}
+
+// SetCount sets the value of Count.
+func (s *NotificationUnreadCount) SetCount(val int) {
+	s.Count = val
+}
+
+func (*NotificationUnreadCount) notificationsUnreadCountRes() {}
+
+type NotificationsListInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*NotificationsListInternalServerError) notificationsListRes() {}
+
+type NotificationsListUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*NotificationsListUnauthorized) notificationsListRes() {}
+
+type NotificationsMarkReadInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*NotificationsMarkReadInternalServerError) notificationsMarkReadRes() {}
+
+// NotificationsMarkReadNoContent is response for NotificationsMarkRead operation.
+type NotificationsMarkReadNoContent struct{}
+
+func (*NotificationsMarkReadNoContent) notificationsMarkReadRes() {}
+
+type NotificationsMarkReadUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*NotificationsMarkReadUnauthorized) notificationsMarkReadRes() {}
+
+type NotificationsReadAllInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*NotificationsReadAllInternalServerError) notificationsReadAllRes() {}
+
+// NotificationsReadAllNoContent is response for NotificationsReadAll operation.
+type NotificationsReadAllNoContent struct{}
+
+func (*NotificationsReadAllNoContent) notificationsReadAllRes() {}
+
+type NotificationsReadAllUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*NotificationsReadAllUnauthorized) notificationsReadAllRes() {}
+
+type NotificationsUnreadCountInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*NotificationsUnreadCountInternalServerError) notificationsUnreadCountRes() {}
+
+type NotificationsUnreadCountUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*NotificationsUnreadCountUnauthorized) notificationsUnreadCountRes() {}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptConversationMarkReadRequest returns new OptConversationMarkReadRequest with value set to v.
+func NewOptConversationMarkReadRequest(v ConversationMarkReadRequest) OptConversationMarkReadRequest {
+	return OptConversationMarkReadRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptConversationMarkReadRequest is optional ConversationMarkReadRequest.
+type OptConversationMarkReadRequest struct {
+	Value ConversationMarkReadRequest
+	Set   bool
+}
+
+// IsSet returns true if OptConversationMarkReadRequest was set.
+func (o OptConversationMarkReadRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptConversationMarkReadRequest) Reset() {
+	var v ConversationMarkReadRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptConversationMarkReadRequest) SetTo(v ConversationMarkReadRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptConversationMarkReadRequest) Get() (v ConversationMarkReadRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptConversationMarkReadRequest) Or(d ConversationMarkReadRequest) ConversationMarkReadRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInfluenceMetric returns new OptInfluenceMetric with value set to v.
+func NewOptInfluenceMetric(v InfluenceMetric) OptInfluenceMetric {
+	return OptInfluenceMetric{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInfluenceMetric is optional InfluenceMetric.
+type OptInfluenceMetric struct {
+	Value InfluenceMetric
+	Set   bool
+}
+
+// IsSet returns true if OptInfluenceMetric was set.
+func (o OptInfluenceMetric) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInfluenceMetric) Reset() {
+	var v InfluenceMetric
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInfluenceMetric) SetTo(v InfluenceMetric) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInfluenceMetric) Get() (v InfluenceMetric, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInfluenceMetric) Or(d InfluenceMetric) InfluenceMetric {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPoll returns new OptPoll with value set to v.
+func NewOptPoll(v Poll) OptPoll {
+	return OptPoll{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPoll is optional Poll.
+type OptPoll struct {
+	Value Poll
+	Set   bool
+}
+
+// IsSet returns true if OptPoll was set.
+func (o OptPoll) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPoll) Reset() {
+	var v Poll
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPoll) SetTo(v Poll) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPoll) Get() (v Poll, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPoll) Or(d Poll) Poll {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPollCreateRequest returns new OptPollCreateRequest with value set to v.
+func NewOptPollCreateRequest(v PollCreateRequest) OptPollCreateRequest {
+	return OptPollCreateRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPollCreateRequest is optional PollCreateRequest.
+type OptPollCreateRequest struct {
+	Value PollCreateRequest
+	Set   bool
+}
+
+// IsSet returns true if OptPollCreateRequest was set.
+func (o OptPollCreateRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPollCreateRequest) Reset() {
+	var v PollCreateRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPollCreateRequest) SetTo(v PollCreateRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPollCreateRequest) Get() (v PollCreateRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPollCreateRequest) Or(d PollCreateRequest) PollCreateRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPostRevisionsDiffMode returns new OptPostRevisionsDiffMode with value set to v.
+func NewOptPostRevisionsDiffMode(v PostRevisionsDiffMode) OptPostRevisionsDiffMode {
+	return OptPostRevisionsDiffMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPostRevisionsDiffMode is optional PostRevisionsDiffMode.
+type OptPostRevisionsDiffMode struct {
+	Value PostRevisionsDiffMode
+	Set   bool
+}
+
+// IsSet returns true if OptPostRevisionsDiffMode was set.
+func (o OptPostRevisionsDiffMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPostRevisionsDiffMode) Reset() {
+	var v PostRevisionsDiffMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPostRevisionsDiffMode) SetTo(v PostRevisionsDiffMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPostRevisionsDiffMode) Get() (v PostRevisionsDiffMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPostRevisionsDiffMode) Or(d PostRevisionsDiffMode) PostRevisionsDiffMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRelationKind returns new OptRelationKind with value set to v.
+func NewOptRelationKind(v RelationKind) OptRelationKind {
+	return OptRelationKind{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRelationKind is optional RelationKind.
+type OptRelationKind struct {
+	Value RelationKind
+	Set   bool
+}
+
+// IsSet returns true if OptRelationKind was set.
+func (o OptRelationKind) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRelationKind) Reset() {
+	var v RelationKind
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRelationKind) SetTo(v RelationKind) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRelationKind) Get() (v RelationKind, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRelationKind) Or(d RelationKind) RelationKind {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptThreadPinned returns new OptThreadPinned with value set to v.
+func NewOptThreadPinned(v ThreadPinned) OptThreadPinned {
+	return OptThreadPinned{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptThreadPinned is optional ThreadPinned.
+type OptThreadPinned struct {
+	Value ThreadPinned
+	Set   bool
+}
+
+// IsSet returns true if OptThreadPinned was set.
+func (o OptThreadPinned) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptThreadPinned) Reset() {
+	var v ThreadPinned
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptThreadPinned) SetTo(v ThreadPinned) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptThreadPinned) Get() (v ThreadPinned, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptThreadPinned) Or(d ThreadPinned) ThreadPinned {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptThreadRevisionsDiffMode returns new OptThreadRevisionsDiffMode with value set to v.
+func NewOptThreadRevisionsDiffMode(v ThreadRevisionsDiffMode) OptThreadRevisionsDiffMode {
+	return OptThreadRevisionsDiffMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptThreadRevisionsDiffMode is optional ThreadRevisionsDiffMode.
+type OptThreadRevisionsDiffMode struct {
+	Value ThreadRevisionsDiffMode
+	Set   bool
+}
+
+// IsSet returns true if OptThreadRevisionsDiffMode was set.
+func (o OptThreadRevisionsDiffMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptThreadRevisionsDiffMode) Reset() {
+	var v ThreadRevisionsDiffMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptThreadRevisionsDiffMode) SetTo(v ThreadRevisionsDiffMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptThreadRevisionsDiffMode) Get() (v ThreadRevisionsDiffMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptThreadRevisionsDiffMode) Or(d ThreadRevisionsDiffMode) ThreadRevisionsDiffMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptThreadSubscriptionLevel returns new OptThreadSubscriptionLevel with value set to v.
+func NewOptThreadSubscriptionLevel(v ThreadSubscriptionLevel) OptThreadSubscriptionLevel {
+	return OptThreadSubscriptionLevel{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptThreadSubscriptionLevel is optional ThreadSubscriptionLevel.
+type OptThreadSubscriptionLevel struct {
+	Value ThreadSubscriptionLevel
+	Set   bool
+}
+
+// IsSet returns true if OptThreadSubscriptionLevel was set.
+func (o OptThreadSubscriptionLevel) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptThreadSubscriptionLevel) Reset() {
+	var v ThreadSubscriptionLevel
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptThreadSubscriptionLevel) SetTo(v ThreadSubscriptionLevel) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptThreadSubscriptionLevel) Get() (v ThreadSubscriptionLevel, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptThreadSubscriptionLevel) Or(d ThreadSubscriptionLevel) ThreadSubscriptionLevel {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/Poll
+type Poll struct {
+	ID          int         `json:"id"`
+	Question    string      `json:"question"`
+	Multiple    bool        `json:"multiple"`
+	Anonymous   bool        `json:"anonymous"`
+	ClosesAt    OptDateTime `json:"closes_at"`
+	IsClosed    bool        `json:"is_closed"`
+	VotersCount int         `json:"voters_count"`
+	// Results of open poll are visible after current user votes or before it if enabled by server config.
+	// Results of closed poll are visible to everybody.
+	ResultsVisible bool `json:"results_visible"`
+	// Options chosen by current user, empty if not voted.
+	VotedOptionIds []int        `json:"voted_option_ids"`
+	Options        []PollOption `json:"options"`
+	CreatedAt      time.Time    `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Poll) GetID() int {
+	return s.ID
+}
+
+// GetQuestion returns the value of Question.
+func (s *Poll) GetQuestion() string {
+	return s.Question
+}
+
+// GetMultiple returns the value of Multiple.
+func (s *Poll) GetMultiple() bool {
+	return s.Multiple
+}
+
+// GetAnonymous returns the value of Anonymous.
+func (s *Poll) GetAnonymous() bool {
+	return s.Anonymous
+}
+
+// GetClosesAt returns the value of ClosesAt.
+func (s *Poll) GetClosesAt() OptDateTime {
+	return s.ClosesAt
+}
+
+// GetIsClosed returns the value of IsClosed.
+func (s *Poll) GetIsClosed() bool {
+	return s.IsClosed
+}
+
+// GetVotersCount returns the value of VotersCount.
+func (s *Poll) GetVotersCount() int {
+	return s.VotersCount
+}
+
+// GetResultsVisible returns the value of ResultsVisible.
+func (s *Poll) GetResultsVisible() bool {
+	return s.ResultsVisible
+}
+
+// GetVotedOptionIds returns the value of VotedOptionIds.
+func (s *Poll) GetVotedOptionIds() []int {
+	return s.VotedOptionIds
+}
+
+// GetOptions returns the value of Options.
+func (s *Poll) GetOptions() []PollOption {
+	return s.Options
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Poll) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Poll) SetID(val int) {
+	s.ID = val
+}
+
+// SetQuestion sets the value of Question.
+func (s *Poll) SetQuestion(val string) {
+	s.Question = val
+}
+
+// SetMultiple sets the value of Multiple.
+func (s *Poll) SetMultiple(val bool) {
+	s.Multiple = val
+}
+
+// SetAnonymous sets the value of Anonymous.
+func (s *Poll) SetAnonymous(val bool) {
+	s.Anonymous = val
+}
+
+// SetClosesAt sets the value of ClosesAt.
+func (s *Poll) SetClosesAt(val OptDateTime) {
+	s.ClosesAt = val
+}
+
+// SetIsClosed sets the value of IsClosed.
+func (s *Poll) SetIsClosed(val bool) {
+	s.IsClosed = val
+}
+
+// SetVotersCount sets the value of VotersCount.
+func (s *Poll) SetVotersCount(val int) {
+	s.VotersCount = val
+}
+
+// SetResultsVisible sets the value of ResultsVisible.
+func (s *Poll) SetResultsVisible(val bool) {
+	s.ResultsVisible = val
+}
+
+// SetVotedOptionIds sets the value of VotedOptionIds.
+func (s *Poll) SetVotedOptionIds(val []int) {
+	s.VotedOptionIds = val
+}
+
+// SetOptions sets the value of Options.
+func (s *Poll) SetOptions(val []PollOption) {
+	s.Options = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Poll) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*Poll) pollVoteRes() {}
+
+// Ref: #/components/schemas/PollCreateRequest
+type PollCreateRequest struct {
+	Question string `json:"question"`
+	// Distinct option texts, 2 to configured maximum.
+	Options []string `json:"options"`
+	// User can choose several options.
+	Multiple OptBool `json:"multiple"`
+	// Voters are not shown, only votes counts.
+	Anonymous OptBool `json:"anonymous"`
+	// Voting end, poll is open forever if absent.
+	ClosesAt OptDateTime `json:"closes_at"`
+}
+
+// GetQuestion returns the value of Question.
+func (s *PollCreateRequest) GetQuestion() string {
+	return s.Question
+}
+
+// GetOptions returns the value of Options.
+func (s *PollCreateRequest) GetOptions() []string {
+	return s.Options
+}
+
+// GetMultiple returns the value of Multiple.
+func (s *PollCreateRequest) GetMultiple() OptBool {
+	return s.Multiple
+}
+
+// GetAnonymous returns the value of Anonymous.
+func (s *PollCreateRequest) GetAnonymous() OptBool {
+	return s.Anonymous
+}
+
+// GetClosesAt returns the value of ClosesAt.
+func (s *PollCreateRequest) GetClosesAt() OptDateTime {
+	return s.ClosesAt
+}
+
+// SetQuestion sets the value of Question.
+func (s *PollCreateRequest) SetQuestion(val string) {
+	s.Question = val
+}
+
+// SetOptions sets the value of Options.
+func (s *PollCreateRequest) SetOptions(val []string) {
+	s.Options = val
+}
+
+// SetMultiple sets the value of Multiple.
+func (s *PollCreateRequest) SetMultiple(val OptBool) {
+	s.Multiple = val
+}
+
+// SetAnonymous sets the value of Anonymous.
+func (s *PollCreateRequest) SetAnonymous(val OptBool) {
+	s.Anonymous = val
+}
+
+// SetClosesAt sets the value of ClosesAt.
+func (s *PollCreateRequest) SetClosesAt(val OptDateTime) {
+	s.ClosesAt = val
+}
+
+// Ref: #/components/schemas/PollOption
+type PollOption struct {
+	ID   int    `json:"id"`
+	Text string `json:"text"`
+	// Absent if results are not visible.
+	VotesCount OptInt `json:"votes_count"`
+	// Voters of public poll, absent if results are not visible or poll is anonymous.
+	Voters []PollVoter `json:"voters"`
+}
+
+// GetID returns the value of ID.
+func (s *PollOption) GetID() int {
+	return s.ID
+}
+
+// GetText returns the value of Text.
+func (s *PollOption) GetText() string {
+	return s.Text
+}
+
+// GetVotesCount returns the value of VotesCount.
+func (s *PollOption) GetVotesCount() OptInt {
+	return s.VotesCount
+}
+
+// GetVoters returns the value of Voters.
+func (s *PollOption) GetVoters() []PollVoter {
+	return s.Voters
+}
+
+// SetID sets the value of ID.
+func (s *PollOption) SetID(val int) {
+	s.ID = val
+}
+
+// SetText sets the value of Text.
+func (s *PollOption) SetText(val string) {
+	s.Text = val
+}
+
+// SetVotesCount sets the value of VotesCount.
+func (s *PollOption) SetVotesCount(val OptInt) {
+	s.VotesCount = val
+}
+
+// SetVoters sets the value of Voters.
+func (s *PollOption) SetVoters(val []PollVoter) {
+	s.Voters = val
+}
+
+type PollVoteBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*PollVoteBadRequest) pollVoteRes() {}
+
+type PollVoteConflict AnalyticsGraphBadRequestApplicationJSON
+
+func (*PollVoteConflict) pollVoteRes() {}
+
+type PollVoteForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*PollVoteForbidden) pollVoteRes() {}
+
+type PollVoteInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*PollVoteInternalServerError) pollVoteRes() {}
+
+type PollVoteNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*PollVoteNotFound) pollVoteRes() {}
+
+// Ref: #/components/schemas/PollVoteRequest
+type PollVoteRequest struct {
+	OptionIds []int `json:"option_ids"`
+}
+
+// GetOptionIds returns the value of OptionIds.
+func (s *PollVoteRequest) GetOptionIds() []int {
+	return s.OptionIds
+}
+
+// SetOptionIds sets the value of OptionIds.
+func (s *PollVoteRequest) SetOptionIds(val []int) {
+	s.OptionIds = val
+}
+
+type PollVoteUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*PollVoteUnauthorized) pollVoteRes() {}
+
+// Ref: #/components/schemas/PollVoter
+type PollVoter struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetID returns the value of ID.
+func (s *PollVoter) GetID() int {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *PollVoter) GetName() string {
+	return s.Name
+}
+
+// SetID sets the value of ID.
+func (s *PollVoter) SetID(val int) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *PollVoter) SetName(val string) {
+	s.Name = val
+}
+
+type PostEditBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostEditBadRequest) postEditRes() {}
+
+type PostEditForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostEditForbidden) postEditRes() {}
+
+type PostEditInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostEditInternalServerError) postEditRes() {}
+
+type PostEditNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostEditNotFound) postEditRes() {}
+
+// Ref: #/components/schemas/PostEditRequest
+type PostEditRequest struct {
+	Content string `json:"content"`
+}
+
+// GetContent returns the value of Content.
+func (s *PostEditRequest) GetContent() string {
+	return s.Content
+}
+
+// SetContent sets the value of Content.
+func (s *PostEditRequest) SetContent(val string) {
+	s.Content = val
+}
+
+type PostEditUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostEditUnauthorized) postEditRes() {}
+
+type PostRevisionRestoreBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostRevisionRestoreBadRequest) postRevisionRestoreRes() {}
+
+type PostRevisionRestoreForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostRevisionRestoreForbidden) postRevisionRestoreRes() {}
+
+type PostRevisionRestoreInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostRevisionRestoreInternalServerError) postRevisionRestoreRes() {}
+
+type PostRevisionRestoreNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostRevisionRestoreNotFound) postRevisionRestoreRes() {}
+
+type PostRevisionRestoreUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostRevisionRestoreUnauthorized) postRevisionRestoreRes() {}
+
+type PostRevisionsDiffBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostRevisionsDiffBadRequest) postRevisionsDiffRes() {}
+
+type PostRevisionsDiffInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostRevisionsDiffInternalServerError) postRevisionsDiffRes() {}
+
+type PostRevisionsDiffMode string
+
+const (
+	PostRevisionsDiffModeLine PostRevisionsDiffMode = "line"
+	PostRevisionsDiffModeWord PostRevisionsDiffMode = "word"
+)
+
+// AllValues returns all PostRevisionsDiffMode values.
+func (PostRevisionsDiffMode) AllValues() []PostRevisionsDiffMode {
+	return []PostRevisionsDiffMode{
+		PostRevisionsDiffModeLine,
+		PostRevisionsDiffModeWord,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PostRevisionsDiffMode) MarshalText() ([]byte, error) {
+	switch s {
+	case PostRevisionsDiffModeLine:
+		return []byte(s), nil
+	case PostRevisionsDiffModeWord:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PostRevisionsDiffMode) UnmarshalText(data []byte) error {
+	switch PostRevisionsDiffMode(data) {
+	case PostRevisionsDiffModeLine:
+		*s = PostRevisionsDiffModeLine
+		return nil
+	case PostRevisionsDiffModeWord:
+		*s = PostRevisionsDiffModeWord
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type PostRevisionsDiffNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostRevisionsDiffNotFound) postRevisionsDiffRes() {}
+
+type PostRevisionsDiffUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostRevisionsDiffUnauthorized) postRevisionsDiffRes() {}
+
+type PostRevisionsInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostRevisionsInternalServerError) postRevisionsRes() {}
+
+type PostRevisionsNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostRevisionsNotFound) postRevisionsRes() {}
+
+type PostRevisionsUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostRevisionsUnauthorized) postRevisionsRes() {}
+
+type PostVoteBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostVoteBadRequest) postVoteRes() {}
+
+type PostVoteForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostVoteForbidden) postVoteRes() {}
+
+type PostVoteInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostVoteInternalServerError) postVoteRes() {}
+
+type PostVoteNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostVoteNotFound) postVoteRes() {}
+
+type PostVoteUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*PostVoteUnauthorized) postVoteRes() {}
+
+// Ref: #/components/schemas/RankChange
+type RankChange struct {
+	OldRank   string    `json:"old_rank"`
+	NewRank   string    `json:"new_rank"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// GetOldRank returns the value of OldRank.
+func (s *RankChange) GetOldRank() string {
+	return s.OldRank
+}
+
+// GetNewRank returns the value of NewRank.
+func (s *RankChange) GetNewRank() string {
+	return s.NewRank
+}
+
+// GetChangedAt returns the value of ChangedAt.
+func (s *RankChange) GetChangedAt() time.Time {
+	return s.ChangedAt
+}
+
+// SetOldRank sets the value of OldRank.
+func (s *RankChange) SetOldRank(val string) {
+	s.OldRank = val
+}
+
+// SetNewRank sets the value of NewRank.
+func (s *RankChange) SetNewRank(val string) {
+	s.NewRank = val
+}
+
+// SetChangedAt sets the value of ChangedAt.
+func (s *RankChange) SetChangedAt(val time.Time) {
+	s.ChangedAt = val
+}
+
+type RelationDeleteInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*RelationDeleteInternalServerError) relationDeleteRes() {}
+
+// RelationDeleteNoContent is response for RelationDelete operation.
+type RelationDeleteNoContent struct{}
+
+func (*RelationDeleteNoContent) relationDeleteRes() {}
+
+type RelationDeleteNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*RelationDeleteNotFound) relationDeleteRes() {}
+
+type RelationDeleteUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*RelationDeleteUnauthorized) relationDeleteRes() {}
+
+// Ref: #/components/schemas/RelationKind
+type RelationKind string
+
+const (
+	RelationKindBlock  RelationKind = "block"
+	RelationKindIgnore RelationKind = "ignore"
+)
+
+// AllValues returns all RelationKind values.
+func (RelationKind) AllValues() []RelationKind {
+	return []RelationKind{
+		RelationKindBlock,
+		RelationKindIgnore,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RelationKind) MarshalText() ([]byte, error) {
+	switch s {
+	case RelationKindBlock:
+		return []byte(s), nil
+	case RelationKindIgnore:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RelationKind) UnmarshalText(data []byte) error {
+	switch RelationKind(data) {
+	case RelationKindBlock:
+		*s = RelationKindBlock
+		return nil
+	case RelationKindIgnore:
+		*s = RelationKindIgnore
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/RelationListResponse
+type RelationListResponse struct {
+	Relations []UserRelation `json:"relations"`
+}
+
+// GetRelations returns the value of Relations.
+func (s *RelationListResponse) GetRelations() []UserRelation {
+	return s.Relations
+}
+
+// SetRelations sets the value of Relations.
+func (s *RelationListResponse) SetRelations(val []UserRelation) {
+	s.Relations = val
+}
+
+func (*RelationListResponse) relationsListRes() {}
+
+type RelationSetBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*RelationSetBadRequest) relationSetRes() {}
+
+type RelationSetForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*RelationSetForbidden) relationSetRes() {}
+
+type RelationSetInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*RelationSetInternalServerError) relationSetRes() {}
+
+type RelationSetNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*RelationSetNotFound) relationSetRes() {}
+
+// Ref: #/components/schemas/RelationSetRequest
+type RelationSetRequest struct {
+	Kind RelationKind `json:"kind"`
+}
+
+// GetKind returns the value of Kind.
+func (s *RelationSetRequest) GetKind() RelationKind {
+	return s.Kind
+}
+
+// SetKind sets the value of Kind.
+func (s *RelationSetRequest) SetKind(val RelationKind) {
+	s.Kind = val
+}
+
+type RelationSetUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*RelationSetUnauthorized) relationSetRes() {}
+
+type RelationsListBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*RelationsListBadRequest) relationsListRes() {}
+
+type RelationsListInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*RelationsListInternalServerError) relationsListRes() {}
+
+type RelationsListUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*RelationsListUnauthorized) relationsListRes() {}
+
+// Ref: #/components/schemas/Report
+type Report struct {
+	ID           int          `json:"id"`
+	ReporterID   int          `json:"reporter_id"`
+	ReporterName string       `json:"reporter_name"`
+	Reason       ReportReason `json:"reason"`
+	Text         string       `json:"text"`
+	CreatedAt    time.Time    `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Report) GetID() int {
+	return s.ID
+}
+
+// GetReporterID returns the value of ReporterID.
+func (s *Report) GetReporterID() int {
+	return s.ReporterID
+}
+
+// GetReporterName returns the value of ReporterName.
+func (s *Report) GetReporterName() string {
+	return s.ReporterName
+}
+
+// GetReason returns the value of Reason.
+func (s *Report) GetReason() ReportReason {
+	return s.Reason
+}
+
+// GetText returns the value of Text.
+func (s *Report) GetText() string {
+	return s.Text
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Report) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Report) SetID(val int) {
+	s.ID = val
+}
+
+// SetReporterID sets the value of ReporterID.
+func (s *Report) SetReporterID(val int) {
+	s.ReporterID = val
+}
+
+// SetReporterName sets the value of ReporterName.
+func (s *Report) SetReporterName(val string) {
+	s.ReporterName = val
+}
+
+// SetReason sets the value of Reason.
+func (s *Report) SetReason(val ReportReason) {
+	s.Reason = val
+}
+
+// SetText sets the value of Text.
+func (s *Report) SetText(val string) {
+	s.Text = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Report) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+type ReportCreateBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*ReportCreateBadRequest) reportCreateRes() {}
+
+type ReportCreateConflict AnalyticsGraphBadRequestApplicationJSON
+
+func (*ReportCreateConflict) reportCreateRes() {}
+
+type ReportCreateInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ReportCreateInternalServerError) reportCreateRes() {}
+
+// ReportCreateNoContent is response for ReportCreate operation.
+type ReportCreateNoContent struct{}
+
+func (*ReportCreateNoContent) reportCreateRes() {}
+
+type ReportCreateNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ReportCreateNotFound) reportCreateRes() {}
+
+type ReportCreateUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ReportCreateUnauthorized) reportCreateRes() {}
+
+// Open reports of one target.
+// Ref: #/components/schemas/ReportGroup
+type ReportGroup struct {
+	TargetType ReportTargetType `json:"target_type"`
+	TargetID   int              `json:"target_id"`
+	// Author of thread or post, reported user.
+	TargetUserID   int    `json:"target_user_id"`
+	TargetUserName string `json:"target_user_name"`
+	// Thread of reported thread or post.
+	ThreadID OptInt `json:"thread_id"`
+	// Thread title, beginning of post content or user name.
+	Excerpt      string              `json:"excerpt"`
+	Hidden       bool                `json:"hidden"`
+	ReportsCount int                 `json:"reports_count"`
+	Reasons      []ReportReasonCount `json:"reasons"`
+	// The latest reports (up to 10).
+	Reports      []Report  `json:"reports"`
+	LastReportID int       `json:"last_report_id"`
+	LastReportAt time.Time `json:"last_report_at"`
+}
+
+// GetTargetType returns the value of TargetType.
+func (s *ReportGroup) GetTargetType() ReportTargetType {
+	return s.TargetType
+}
+
+// GetTargetID returns the value of TargetID.
+func (s *ReportGroup) GetTargetID() int {
+	return s.TargetID
+}
+
+// GetTargetUserID returns the value of TargetUserID.
+func (s *ReportGroup) GetTargetUserID() int {
+	return s.TargetUserID
+}
+
+// GetTargetUserName returns the value of TargetUserName.
+func (s *ReportGroup) GetTargetUserName() string {
+	return s.TargetUserName
+}
+
+// GetThreadID returns the value of ThreadID.
+func (s *ReportGroup) GetThreadID() OptInt {
+	return s.ThreadID
+}
+
+// GetExcerpt returns the value of Excerpt.
+func (s *ReportGroup) GetExcerpt() string {
+	return s.Excerpt
+}
+
+// GetHidden returns the value of Hidden.
+func (s *ReportGroup) GetHidden() bool {
+	return s.Hidden
+}
+
+// GetReportsCount returns the value of ReportsCount.
+func (s *ReportGroup) GetReportsCount() int {
+	return s.ReportsCount
+}
+
+// GetReasons returns the value of Reasons.
+func (s *ReportGroup) GetReasons() []ReportReasonCount {
+	return s.Reasons
+}
+
+// GetReports returns the value of Reports.
+func (s *ReportGroup) GetReports() []Report {
+	return s.Reports
+}
+
+// GetLastReportID returns the value of LastReportID.
+func (s *ReportGroup) GetLastReportID() int {
+	return s.LastReportID
+}
+
+// GetLastReportAt returns the value of LastReportAt.
+func (s *ReportGroup) GetLastReportAt() time.Time {
+	return s.LastReportAt
+}
+
+// SetTargetType sets the value of TargetType.
+func (s *ReportGroup) SetTargetType(val ReportTargetType) {
+	s.TargetType = val
+}
+
+// SetTargetID sets the value of TargetID.
+func (s *ReportGroup) SetTargetID(val int) {
+	s.TargetID = val
+}
+
+// SetTargetUserID sets the value of TargetUserID.
+func (s *ReportGroup) SetTargetUserID(val int) {
+	s.TargetUserID = val
+}
+
+// SetTargetUserName sets the value of TargetUserName.
+func (s *ReportGroup) SetTargetUserName(val string) {
+	s.TargetUserName = val
+}
+
+// SetThreadID sets the value of ThreadID.
+func (s *ReportGroup) SetThreadID(val OptInt) {
+	s.ThreadID = val
+}
+
+// SetExcerpt sets the value of Excerpt.
+func (s *ReportGroup) SetExcerpt(val string) {
+	s.Excerpt = val
+}
+
+// SetHidden sets the value of Hidden.
+func (s *ReportGroup) SetHidden(val bool) {
+	s.Hidden = val
+}
+
+// SetReportsCount sets the value of ReportsCount.
+func (s *ReportGroup) SetReportsCount(val int) {
+	s.ReportsCount = val
+}
+
+// SetReasons sets the value of Reasons.
+func (s *ReportGroup) SetReasons(val []ReportReasonCount) {
+	s.Reasons = val
+}
+
+// SetReports sets the value of Reports.
+func (s *ReportGroup) SetReports(val []Report) {
+	s.Reports = val
+}
+
+// SetLastReportID sets the value of LastReportID.
+func (s *ReportGroup) SetLastReportID(val int) {
+	s.LastReportID = val
+}
+
+// SetLastReportAt sets the value of LastReportAt.
+func (s *ReportGroup) SetLastReportAt(val time.Time) {
+	s.LastReportAt = val
+}
+
+// Ref: #/components/schemas/ReportQueueResponse
+type ReportQueueResponse struct {
+	Groups   []ReportGroup `json:"groups"`
+	HaveNext bool          `json:"have_next"`
+}
+
+// GetGroups returns the value of Groups.
+func (s *ReportQueueResponse) GetGroups() []ReportGroup {
+	return s.Groups
+}
+
+// GetHaveNext returns the value of HaveNext.
+func (s *ReportQueueResponse) GetHaveNext() bool {
+	return s.HaveNext
+}
+
+// SetGroups sets the value of Groups.
+func (s *ReportQueueResponse) SetGroups(val []ReportGroup) {
+	s.Groups = val
+}
+
+// SetHaveNext sets the value of HaveNext.
+func (s *ReportQueueResponse) SetHaveNext(val bool) {
+	s.HaveNext = val
+}
+
+func (*ReportQueueResponse) moderationQueueRes() {}
+
+// Ref: #/components/schemas/ReportReason
+type ReportReason string
+
+const (
+	ReportReasonSpam     ReportReason = "spam"
+	ReportReasonAbuse    ReportReason = "abuse"
+	ReportReasonOffTopic ReportReason = "off_topic"
+	ReportReasonIllegal  ReportReason = "illegal"
+	ReportReasonOther    ReportReason = "other"
+)
+
+// AllValues returns all ReportReason values.
+func (ReportReason) AllValues() []ReportReason {
+	return []ReportReason{
+		ReportReasonSpam,
+		ReportReasonAbuse,
+		ReportReasonOffTopic,
+		ReportReasonIllegal,
+		ReportReasonOther,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ReportReason) MarshalText() ([]byte, error) {
+	switch s {
+	case ReportReasonSpam:
+		return []byte(s), nil
+	case ReportReasonAbuse:
+		return []byte(s), nil
+	case ReportReasonOffTopic:
+		return []byte(s), nil
+	case ReportReasonIllegal:
+		return []byte(s), nil
+	case ReportReasonOther:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReportReason) UnmarshalText(data []byte) error {
+	switch ReportReason(data) {
+	case ReportReasonSpam:
+		*s = ReportReasonSpam
+		return nil
+	case ReportReasonAbuse:
+		*s = ReportReasonAbuse
+		return nil
+	case ReportReasonOffTopic:
+		*s = ReportReasonOffTopic
+		return nil
+	case ReportReasonIllegal:
+		*s = ReportReasonIllegal
+		return nil
+	case ReportReasonOther:
+		*s = ReportReasonOther
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ReportReasonCount
+type ReportReasonCount struct {
+	Reason ReportReason `json:"reason"`
+	Count  int          `json:"count"`
+}
+
+// GetReason returns the value of Reason.
+func (s *ReportReasonCount) GetReason() ReportReason {
+	return s.Reason
+}
+
+// GetCount returns the value of Count.
+func (s *ReportReasonCount) GetCount() int {
+	return s.Count
+}
+
+// SetReason sets the value of Reason.
+func (s *ReportReasonCount) SetReason(val ReportReason) {
+	s.Reason = val
+}
+
+// SetCount sets the value of Count.
+func (s *ReportReasonCount) SetCount(val int) {
+	s.Count = val
+}
+
+// Ref: #/components/schemas/ReportRequest
+type ReportRequest struct {
+	TargetType ReportTargetType `json:"target_type"`
+	TargetID   int              `json:"target_id"`
+	Reason     ReportReason     `json:"reason"`
+	// Details, required for reason other (max 1000 characters).
+	Text OptString `json:"text"`
+}
+
+// GetTargetType returns the value of TargetType.
+func (s *ReportRequest) GetTargetType() ReportTargetType {
+	return s.TargetType
+}
+
+// GetTargetID returns the value of TargetID.
+func (s *ReportRequest) GetTargetID() int {
+	return s.TargetID
+}
+
+// GetReason returns the value of Reason.
+func (s *ReportRequest) GetReason() ReportReason {
+	return s.Reason
+}
+
+// GetText returns the value of Text.
+func (s *ReportRequest) GetText() OptString {
+	return s.Text
+}
+
+// SetTargetType sets the value of TargetType.
+func (s *ReportRequest) SetTargetType(val ReportTargetType) {
+	s.TargetType = val
+}
+
+// SetTargetID sets the value of TargetID.
+func (s *ReportRequest) SetTargetID(val int) {
+	s.TargetID = val
+}
+
+// SetReason sets the value of Reason.
+func (s *ReportRequest) SetReason(val ReportReason) {
+	s.Reason = val
+}
+
+// SetText sets the value of Text.
+func (s *ReportRequest) SetText(val OptString) {
+	s.Text = val
+}
+
+// Ref: #/components/schemas/ReportTargetType
+type ReportTargetType string
+
+const (
+	ReportTargetTypeThread ReportTargetType = "thread"
+	ReportTargetTypePost   ReportTargetType = "post"
+	ReportTargetTypeUser   ReportTargetType = "user"
+)
+
+// AllValues returns all ReportTargetType values.
+func (ReportTargetType) AllValues() []ReportTargetType {
+	return []ReportTargetType{
+		ReportTargetTypeThread,
+		ReportTargetTypePost,
+		ReportTargetTypeUser,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ReportTargetType) MarshalText() ([]byte, error) {
+	switch s {
+	case ReportTargetTypeThread:
+		return []byte(s), nil
+	case ReportTargetTypePost:
+		return []byte(s), nil
+	case ReportTargetTypeUser:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReportTargetType) UnmarshalText(data []byte) error {
+	switch ReportTargetType(data) {
+	case ReportTargetTypeThread:
+		*s = ReportTargetTypeThread
+		return nil
+	case ReportTargetTypePost:
+		*s = ReportTargetTypePost
+		return nil
+	case ReportTargetTypeUser:
+		*s = ReportTargetTypeUser
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Revision
+type Revision struct {
+	// Revision number, 1 is the original, 0 for held edit.
+	Revision int `json:"revision"`
+	// Thread title, absent for posts.
+	Title      OptString `json:"title"`
+	Content    string    `json:"content"`
+	EditorID   int       `json:"editor_id"`
+	EditorName string    `json:"editor_name"`
+	// Number of revision restored by moderator.
+	RestoredFrom OptInt `json:"restored_from"`
+	// Edit is held by content filter for moderator review, current content is not changed
+	// until approval. Held edit gets revision number when approved. Set on edit.
+	Held      OptBool   `json:"held"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetRevision returns the value of Revision.
+func (s *Revision) GetRevision() int {
+	return s.Revision
+}
+
+// GetTitle returns the value of Title.
+func (s *Revision) GetTitle() OptString {
+	return s.Title
+}
+
+// GetContent returns the value of Content.
+func (s *Revision) GetContent() string {
+	return s.Content
+}
+
+// GetEditorID returns the value of EditorID.
+func (s *Revision) GetEditorID() int {
+	return s.EditorID
+}
+
+// GetEditorName returns the value of EditorName.
+func (s *Revision) GetEditorName() string {
+	return s.EditorName
+}
+
+// GetRestoredFrom returns the value of RestoredFrom.
+func (s *Revision) GetRestoredFrom() OptInt {
+	return s.RestoredFrom
+}
+
+// GetHeld returns the value of Held.
+func (s *Revision) GetHeld() OptBool {
+	return s.Held
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Revision) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetRevision sets the value of Revision.
+func (s *Revision) SetRevision(val int) {
+	s.Revision = val
+}
+
+// SetTitle sets the value of Title.
+func (s *Revision) SetTitle(val OptString) {
+	s.Title = val
+}
+
+// SetContent sets the value of Content.
+func (s *Revision) SetContent(val string) {
+	s.Content = val
+}
+
+// SetEditorID sets the value of EditorID.
+func (s *Revision) SetEditorID(val int) {
+	s.EditorID = val
+}
+
+// SetEditorName sets the value of EditorName.
+func (s *Revision) SetEditorName(val string) {
+	s.EditorName = val
+}
+
+// SetRestoredFrom sets the value of RestoredFrom.
+func (s *Revision) SetRestoredFrom(val OptInt) {
+	s.RestoredFrom = val
+}
+
+// SetHeld sets the value of Held.
+func (s *Revision) SetHeld(val OptBool) {
+	s.Held = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Revision) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*Revision) postEditRes()              {}
+func (*Revision) postRevisionRestoreRes()   {}
+func (*Revision) threadEditRes()            {}
+func (*Revision) threadRevisionRestoreRes() {}
+
+// Ref: #/components/schemas/RevisionDiff
+type RevisionDiff struct {
+	From int              `json:"from"`
+	To   int              `json:"to"`
+	Mode RevisionDiffMode `json:"mode"`
+	// Difference of thread titles by words, absent for posts.
+	Title   []DiffOp `json:"title"`
+	Content []DiffOp `json:"content"`
+}
+
+// GetFrom returns the value of From.
+func (s *RevisionDiff) GetFrom() int {
+	return s.From
+}
+
+// GetTo returns the value of To.
+func (s *RevisionDiff) GetTo() int {
+	return s.To
+}
+
+// GetMode returns the value of Mode.
+func (s *RevisionDiff) GetMode() RevisionDiffMode {
+	return s.Mode
+}
+
+// GetTitle returns the value of Title.
+func (s *RevisionDiff) GetTitle() []DiffOp {
+	return s.Title
+}
+
+// GetContent returns the value of Content.
+func (s *RevisionDiff) GetContent() []DiffOp {
+	return s.Content
+}
+
+// SetFrom sets the value of From.
+func (s *RevisionDiff) SetFrom(val int) {
+	s.From = val
+}
+
+// SetTo sets the value of To.
+func (s *RevisionDiff) SetTo(val int) {
+	s.To = val
+}
+
+// SetMode sets the value of Mode.
+func (s *RevisionDiff) SetMode(val RevisionDiffMode) {
+	s.Mode = val
+}
+
+// SetTitle sets the value of Title.
+func (s *RevisionDiff) SetTitle(val []DiffOp) {
+	s.Title = val
+}
+
+// SetContent sets the value of Content.
+func (s *RevisionDiff) SetContent(val []DiffOp) {
+	s.Content = val
+}
+
+func (*RevisionDiff) postRevisionsDiffRes()   {}
+func (*RevisionDiff) threadRevisionsDiffRes() {}
+
+type RevisionDiffMode string
+
+const (
+	RevisionDiffModeLine RevisionDiffMode = "line"
+	RevisionDiffModeWord RevisionDiffMode = "word"
+)
+
+// AllValues returns all RevisionDiffMode values.
+func (RevisionDiffMode) AllValues() []RevisionDiffMode {
+	return []RevisionDiffMode{
+		RevisionDiffModeLine,
+		RevisionDiffModeWord,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RevisionDiffMode) MarshalText() ([]byte, error) {
+	switch s {
+	case RevisionDiffModeLine:
+		return []byte(s), nil
+	case RevisionDiffModeWord:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RevisionDiffMode) UnmarshalText(data []byte) error {
+	switch RevisionDiffMode(data) {
+	case RevisionDiffModeLine:
+		*s = RevisionDiffModeLine
+		return nil
+	case RevisionDiffModeWord:
+		*s = RevisionDiffModeWord
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/RevisionListResponse
+type RevisionListResponse struct {
+	Revisions []Revision `json:"revisions"`
+}
+
+// GetRevisions returns the value of Revisions.
+func (s *RevisionListResponse) GetRevisions() []Revision {
+	return s.Revisions
+}
+
+// SetRevisions sets the value of Revisions.
+func (s *RevisionListResponse) SetRevisions(val []Revision) {
+	s.Revisions = val
+}
+
+func (*RevisionListResponse) postRevisionsRes()   {}
+func (*RevisionListResponse) threadRevisionsRes() {}
+
+type SearchBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*SearchBadRequest) searchRes() {}
+
+type SearchInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*SearchInternalServerError) searchRes() {}
+
+// Ref: #/components/schemas/SearchResponse
+type SearchResponse struct {
+	Results []SearchResultItem `json:"results"`
+	// Cursor for next page, absent on last page.
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetResults returns the value of Results.
+func (s *SearchResponse) GetResults() []SearchResultItem {
+	return s.Results
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *SearchResponse) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetResults sets the value of Results.
+func (s *SearchResponse) SetResults(val []SearchResultItem) {
+	s.Results = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *SearchResponse) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*SearchResponse) searchRes() {}
+
+// Ref: #/components/schemas/SearchResultItem
+type SearchResultItem struct {
+	Kind     SearchResultItemKind `json:"kind"`
+	ThreadID int                  `json:"thread_id"`
+	// Post id, only for post results.
+	PostID OptInt `json:"post_id"`
+	// Thread title.
+	Title string `json:"title"`
+	// Html escaped fragment of content with matched words in `<mark>` tags.
+	Snippet     string    `json:"snippet"`
+	AuthorID    int       `json:"author_id"`
+	AuthorName  string    `json:"author_name"`
+	CommunityID OptInt    `json:"community_id"`
+	Rank        float32   `json:"rank"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// GetKind returns the value of Kind.
+func (s *SearchResultItem) GetKind() SearchResultItemKind {
+	return s.Kind
+}
+
+// GetThreadID returns the value of ThreadID.
+func (s *SearchResultItem) GetThreadID() int {
+	return s.ThreadID
+}
+
+// GetPostID returns the value of PostID.
+func (s *SearchResultItem) GetPostID() OptInt {
+	return s.PostID
+}
+
+// GetTitle returns the value of Title.
+func (s *SearchResultItem) GetTitle() string {
+	return s.Title
+}
+
+// GetSnippet returns the value of Snippet.
+func (s *SearchResultItem) GetSnippet() string {
+	return s.Snippet
+}
+
+// GetAuthorID returns the value of AuthorID.
+func (s *SearchResultItem) GetAuthorID() int {
+	return s.AuthorID
+}
+
+// GetAuthorName returns the value of AuthorName.
+func (s *SearchResultItem) GetAuthorName() string {
+	return s.AuthorName
+}
+
+// GetCommunityID returns the value of CommunityID.
+func (s *SearchResultItem) GetCommunityID() OptInt {
+	return s.CommunityID
+}
+
+// GetRank returns the value of Rank.
+func (s *SearchResultItem) GetRank() float32 {
+	return s.Rank
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *SearchResultItem) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetKind sets the value of Kind.
+func (s *SearchResultItem) SetKind(val SearchResultItemKind) {
+	s.Kind = val
+}
+
+// SetThreadID sets the value of ThreadID.
+func (s *SearchResultItem) SetThreadID(val int) {
+	s.ThreadID = val
+}
+
+// SetPostID sets the value of PostID.
+func (s *SearchResultItem) SetPostID(val OptInt) {
+	s.PostID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *SearchResultItem) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetSnippet sets the value of Snippet.
+func (s *SearchResultItem) SetSnippet(val string) {
+	s.Snippet = val
+}
+
+// SetAuthorID sets the value of AuthorID.
+func (s *SearchResultItem) SetAuthorID(val int) {
+	s.AuthorID = val
+}
+
+// SetAuthorName sets the value of AuthorName.
+func (s *SearchResultItem) SetAuthorName(val string) {
+	s.AuthorName = val
+}
+
+// SetCommunityID sets the value of CommunityID.
+func (s *SearchResultItem) SetCommunityID(val OptInt) {
+	s.CommunityID = val
+}
+
+// SetRank sets the value of Rank.
+func (s *SearchResultItem) SetRank(val float32) {
+	s.Rank = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *SearchResultItem) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+type SearchResultItemKind string
+
+const (
+	SearchResultItemKindThread SearchResultItemKind = "thread"
+	SearchResultItemKindPost   SearchResultItemKind = "post"
+)
+
+// AllValues returns all SearchResultItemKind values.
+func (SearchResultItemKind) AllValues() []SearchResultItemKind {
+	return []SearchResultItemKind{
+		SearchResultItemKindThread,
+		SearchResultItemKindPost,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchResultItemKind) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchResultItemKindThread:
+		return []byte(s), nil
+	case SearchResultItemKindPost:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchResultItemKind) UnmarshalText(data []byte) error {
+	switch SearchResultItemKind(data) {
+	case SearchResultItemKindThread:
+		*s = SearchResultItemKindThread
+		return nil
+	case SearchResultItemKindPost:
+		*s = SearchResultItemKindPost
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type SubscriptionsListInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*SubscriptionsListInternalServerError) subscriptionsListRes() {}
+
+type SubscriptionsListUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*SubscriptionsListUnauthorized) subscriptionsListRes() {}
+
+type ThreadAcceptAnswerBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadAcceptAnswerBadRequest) threadAcceptAnswerRes() {}
+
+type ThreadAcceptAnswerForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadAcceptAnswerForbidden) threadAcceptAnswerRes() {}
+
+type ThreadAcceptAnswerInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadAcceptAnswerInternalServerError) threadAcceptAnswerRes() {}
+
+// ThreadAcceptAnswerNoContent is response for ThreadAcceptAnswer operation.
+type ThreadAcceptAnswerNoContent struct{}
+
+func (*ThreadAcceptAnswerNoContent) threadAcceptAnswerRes() {}
+
+type ThreadAcceptAnswerNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadAcceptAnswerNotFound) threadAcceptAnswerRes() {}
+
+// Ref: #/components/schemas/ThreadAcceptAnswerRequest
+type ThreadAcceptAnswerRequest struct {
+	PostID int `json:"post_id"`
+}
+
+// GetPostID returns the value of PostID.
+func (s *ThreadAcceptAnswerRequest) GetPostID() int {
+	return s.PostID
+}
+
+// SetPostID sets the value of PostID.
+func (s *ThreadAcceptAnswerRequest) SetPostID(val int) {
+	s.PostID = val
+}
+
+type ThreadAcceptAnswerUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadAcceptAnswerUnauthorized) threadAcceptAnswerRes() {}
+
+type ThreadAddPostBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadAddPostBadRequest) threadAddPostRes() {}
+
+type ThreadAddPostForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadAddPostForbidden) threadAddPostRes() {}
+
+type ThreadAddPostInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadAddPostInternalServerError) threadAddPostRes() {}
+
+type ThreadAddPostNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadAddPostNotFound) threadAddPostRes() {}
+
+type ThreadAddPostUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadAddPostUnauthorized) threadAddPostRes() {}
+
+type ThreadCreateBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadCreateBadRequest) threadCreateRes() {}
+
+type ThreadCreateForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadCreateForbidden) threadCreateRes() {}
+
+type ThreadCreateInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadCreateInternalServerError) threadCreateRes() {}
+
+// Ref: #/components/schemas/ThreadCreatePostRequest
+type ThreadCreatePostRequest struct {
+	Content string `json:"content"`
+	// Id of post of the same thread this post replies to.
+	ReplyToID OptInt `json:"reply_to_id"`
+	// Ids of uploaded and not attached files.
+	AttachmentIds []int `json:"attachment_ids"`
+}
+
+// GetContent returns the value of Content.
+func (s *ThreadCreatePostRequest) GetContent() string {
+	return s.Content
+}
+
+// GetReplyToID returns the value of ReplyToID.
+func (s *ThreadCreatePostRequest) GetReplyToID() OptInt {
+	return s.ReplyToID
+}
+
+// GetAttachmentIds returns the value of AttachmentIds.
+func (s *ThreadCreatePostRequest) GetAttachmentIds() []int {
+	return s.AttachmentIds
+}
+
+// SetContent sets the value of Content.
+func (s *ThreadCreatePostRequest) SetContent(val string) {
+	s.Content = val
+}
+
+// SetReplyToID sets the value of ReplyToID.
+func (s *ThreadCreatePostRequest) SetReplyToID(val OptInt) {
+	s.ReplyToID = val
+}
+
+// SetAttachmentIds sets the value of AttachmentIds.
+func (s *ThreadCreatePostRequest) SetAttachmentIds(val []int) {
+	s.AttachmentIds = val
+}
+
+// Ref: #/components/schemas/ThreadCreateRequest
+type ThreadCreateRequest struct {
+	Title       string `json:"title"`
+	Content     string `json:"content"`
+	CommunityID OptInt `json:"community_id"`
+	// Ids of uploaded and not attached files.
+	AttachmentIds []int                `json:"attachment_ids"`
+	Poll          OptPollCreateRequest `json:"poll"`
+}
+
+// GetTitle returns the value of Title.
+func (s *ThreadCreateRequest) GetTitle() string {
+	return s.Title
+}
+
+// GetContent returns the value of Content.
+func (s *ThreadCreateRequest) GetContent() string {
+	return s.Content
+}
+
+// GetCommunityID returns the value of CommunityID.
+func (s *ThreadCreateRequest) GetCommunityID() OptInt {
+	return s.CommunityID
+}
+
+// GetAttachmentIds returns the value of AttachmentIds.
+func (s *ThreadCreateRequest) GetAttachmentIds() []int {
+	return s.AttachmentIds
+}
+
+// GetPoll returns the value of Poll.
+func (s *ThreadCreateRequest) GetPoll() OptPollCreateRequest {
+	return s.Poll
+}
+
+// SetTitle sets the value of Title.
+func (s *ThreadCreateRequest) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetContent sets the value of Content.
+func (s *ThreadCreateRequest) SetContent(val string) {
+	s.Content = val
+}
+
+// SetCommunityID sets the value of CommunityID.
+func (s *ThreadCreateRequest) SetCommunityID(val OptInt) {
+	s.CommunityID = val
+}
+
+// SetAttachmentIds sets the value of AttachmentIds.
+func (s *ThreadCreateRequest) SetAttachmentIds(val []int) {
+	s.AttachmentIds = val
+}
+
+// SetPoll sets the value of Poll.
+func (s *ThreadCreateRequest) SetPoll(val OptPollCreateRequest) {
+	s.Poll = val
+}
+
+type ThreadCreateUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadCreateUnauthorized) threadCreateRes() {}
+
+type ThreadEditBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadEditBadRequest) threadEditRes() {}
+
+type ThreadEditForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadEditForbidden) threadEditRes() {}
+
+type ThreadEditInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadEditInternalServerError) threadEditRes() {}
+
+type ThreadEditNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadEditNotFound) threadEditRes() {}
+
+// Absent field keeps current value.
+// Ref: #/components/schemas/ThreadEditRequest
+type ThreadEditRequest struct {
+	Title   OptString `json:"title"`
+	Content OptString `json:"content"`
+}
+
+// GetTitle returns the value of Title.
+func (s *ThreadEditRequest) GetTitle() OptString {
+	return s.Title
+}
+
+// GetContent returns the value of Content.
+func (s *ThreadEditRequest) GetContent() OptString {
+	return s.Content
+}
+
+// SetTitle sets the value of Title.
+func (s *ThreadEditRequest) SetTitle(val OptString) {
+	s.Title = val
+}
+
+// SetContent sets the value of Content.
+func (s *ThreadEditRequest) SetContent(val OptString) {
+	s.Content = val
+}
+
+type ThreadEditUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadEditUnauthorized) threadEditRes() {}
+
+type ThreadGetBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadGetBadRequest) threadGetRes() {}
+
+type ThreadGetInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadGetInternalServerError) threadGetRes() {}
+
+type ThreadGetNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadGetNotFound) threadGetRes() {}
+
+// Ref: #/components/schemas/ThreadListItem
+type ThreadListItem struct {
+	ID         int    `json:"id"`
+	AuthorID   int    `json:"author_id"`
+	AuthorName string `json:"author_name"`
+	AuthorRank string `json:"author_rank"`
+	Title      string `json:"title"`
+	// Markdown source (CommonMark with GFM extensions).
+	Content string `json:"content"`
+	// Content rendered to sanitized HTML.
+	ContentHTML string `json:"content_html"`
+	CommunityID OptInt `json:"community_id"`
+	PostsCount  int    `json:"posts_count"`
+	// Sum of up (+1) and down (-1) votes.
+	Score int `json:"score"`
+	// Thread is bookmarked by current user.
+	IsBookmarked bool            `json:"is_bookmarked"`
+	Pinned       OptThreadPinned `json:"pinned"`
+	// Thread does not accept new posts, set in thread list.
+	Locked OptBool `json:"locked"`
+	// Thread is read-only, set in thread list.
+	Archived OptBool `json:"archived"`
+	// Thread is held by content filter for moderator review, set on create.
+	Held OptBool `json:"held"`
+	// Author is blocked or ignored by current user, thread should be collapsed.
+	AuthorIgnored OptBool   `json:"author_ignored"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *ThreadListItem) GetID() int {
+	return s.ID
+}
+
+// GetAuthorID returns the value of AuthorID.
+func (s *ThreadListItem) GetAuthorID() int {
+	return s.AuthorID
+}
+
+// GetAuthorName returns the value of AuthorName.
+func (s *ThreadListItem) GetAuthorName() string {
+	return s.AuthorName
+}
+
+// GetAuthorRank returns the value of AuthorRank.
+func (s *ThreadListItem) GetAuthorRank() string {
+	return s.AuthorRank
+}
+
+// GetTitle returns the value of Title.
+func (s *ThreadListItem) GetTitle() string {
+	return s.Title
+}
+
+// GetContent returns the value of Content.
+func (s *ThreadListItem) GetContent() string {
+	return s.Content
+}
+
+// GetContentHTML returns the value of ContentHTML.
+func (s *ThreadListItem) GetContentHTML() string {
+	return s.ContentHTML
+}
+
+// GetCommunityID returns the value of CommunityID.
+func (s *ThreadListItem) GetCommunityID() OptInt {
+	return s.CommunityID
+}
+
+// GetPostsCount returns the value of PostsCount.
+func (s *ThreadListItem) GetPostsCount() int {
+	return s.PostsCount
+}
+
+// GetScore returns the value of Score.
+func (s *ThreadListItem) GetScore() int {
+	return s.Score
+}
+
+// GetIsBookmarked returns the value of IsBookmarked.
+func (s *ThreadListItem) GetIsBookmarked() bool {
+	return s.IsBookmarked
+}
+
+// GetPinned returns the value of Pinned.
+func (s *ThreadListItem) GetPinned() OptThreadPinned {
+	return s.Pinned
+}
+
+// GetLocked returns the value of Locked.
+func (s *ThreadListItem) GetLocked() OptBool {
+	return s.Locked
+}
+
+// GetArchived returns the value of Archived.
+func (s *ThreadListItem) GetArchived() OptBool {
+	return s.Archived
+}
+
+// GetHeld returns the value of Held.
+func (s *ThreadListItem) GetHeld() OptBool {
+	return s.Held
+}
+
+// GetAuthorIgnored returns the value of AuthorIgnored.
+func (s *ThreadListItem) GetAuthorIgnored() OptBool {
+	return s.AuthorIgnored
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ThreadListItem) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *ThreadListItem) SetID(val int) {
+	s.ID = val
+}
+
+// SetAuthorID sets the value of AuthorID.
+func (s *ThreadListItem) SetAuthorID(val int) {
+	s.AuthorID = val
+}
+
+// SetAuthorName sets the value of AuthorName.
+func (s *ThreadListItem) SetAuthorName(val string) {
+	s.AuthorName = val
+}
+
+// SetAuthorRank sets the value of AuthorRank.
+func (s *ThreadListItem) SetAuthorRank(val string) {
+	s.AuthorRank = val
+}
+
+// SetTitle sets the value of Title.
+func (s *ThreadListItem) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetContent sets the value of Content.
+func (s *ThreadListItem) SetContent(val string) {
+	s.Content = val
+}
+
+// SetContentHTML sets the value of ContentHTML.
+func (s *ThreadListItem) SetContentHTML(val string) {
+	s.ContentHTML = val
+}
+
+// SetCommunityID sets the value of CommunityID.
+func (s *ThreadListItem) SetCommunityID(val OptInt) {
+	s.CommunityID = val
+}
+
+// SetPostsCount sets the value of PostsCount.
+func (s *ThreadListItem) SetPostsCount(val int) {
+	s.PostsCount = val
+}
+
+// SetScore sets the value of Score.
+func (s *ThreadListItem) SetScore(val int) {
+	s.Score = val
+}
+
+// SetIsBookmarked sets the value of IsBookmarked.
+func (s *ThreadListItem) SetIsBookmarked(val bool) {
+	s.IsBookmarked = val
+}
+
+// SetPinned sets the value of Pinned.
+func (s *ThreadListItem) SetPinned(val OptThreadPinned) {
+	s.Pinned = val
+}
+
+// SetLocked sets the value of Locked.
+func (s *ThreadListItem) SetLocked(val OptBool) {
+	s.Locked = val
+}
+
+// SetArchived sets the value of Archived.
+func (s *ThreadListItem) SetArchived(val OptBool) {
+	s.Archived = val
+}
+
+// SetHeld sets the value of Held.
+func (s *ThreadListItem) SetHeld(val OptBool) {
+	s.Held = val
+}
+
+// SetAuthorIgnored sets the value of AuthorIgnored.
+func (s *ThreadListItem) SetAuthorIgnored(val OptBool) {
+	s.AuthorIgnored = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ThreadListItem) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*ThreadListItem) threadCreateRes() {}
+
+// Ref: #/components/schemas/ThreadListResponse
+type ThreadListResponse struct {
+	Threads             []ThreadListItem `json:"threads"`
+	TotalCountEstimated int              `json:"total_count_estimated"`
+	HavePrev            bool             `json:"have_prev"`
+	HaveNext            bool             `json:"have_next"`
+}
+
+// GetThreads returns the value of Threads.
+func (s *ThreadListResponse) GetThreads() []ThreadListItem {
+	return s.Threads
+}
+
+// GetTotalCountEstimated returns the value of TotalCountEstimated.
+func (s *ThreadListResponse) GetTotalCountEstimated() int {
+	return s.TotalCountEstimated
+}
+
+// GetHavePrev returns the value of HavePrev.
+func (s *ThreadListResponse) GetHavePrev() bool {
+	return s.HavePrev
+}
+
+// GetHaveNext returns the value of HaveNext.
+func (s *ThreadListResponse) GetHaveNext() bool {
+	return s.HaveNext
+}
+
+// SetThreads sets the value of Threads.
+func (s *ThreadListResponse) SetThreads(val []ThreadListItem) {
+	s.Threads = val
+}
+
+// SetTotalCountEstimated sets the value of TotalCountEstimated.
+func (s *ThreadListResponse) SetTotalCountEstimated(val int) {
+	s.TotalCountEstimated = val
+}
+
+// SetHavePrev sets the value of HavePrev.
+func (s *ThreadListResponse) SetHavePrev(val bool) {
+	s.HavePrev = val
+}
+
+// SetHaveNext sets the value of HaveNext.
+func (s *ThreadListResponse) SetHaveNext(val bool) {
+	s.HaveNext = val
+}
+
+func (*ThreadListResponse) threadsListRes() {}
+
+// `global` - listed first in all thread lists, `community` - listed first in thread list of its
+// community,
+// `none` - not pinned.
+// Ref: #/components/schemas/ThreadPinned
+type ThreadPinned string
+
+const (
+	ThreadPinnedNone      ThreadPinned = "none"
+	ThreadPinnedGlobal    ThreadPinned = "global"
+	ThreadPinnedCommunity ThreadPinned = "community"
+)
+
+// AllValues returns all ThreadPinned values.
+func (ThreadPinned) AllValues() []ThreadPinned {
+	return []ThreadPinned{
+		ThreadPinnedNone,
+		ThreadPinnedGlobal,
+		ThreadPinnedCommunity,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ThreadPinned) MarshalText() ([]byte, error) {
+	switch s {
+	case ThreadPinnedNone:
+		return []byte(s), nil
+	case ThreadPinnedGlobal:
+		return []byte(s), nil
+	case ThreadPinnedCommunity:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ThreadPinned) UnmarshalText(data []byte) error {
+	switch ThreadPinned(data) {
+	case ThreadPinnedNone:
+		*s = ThreadPinnedNone
+		return nil
+	case ThreadPinnedGlobal:
+		*s = ThreadPinnedGlobal
+		return nil
+	case ThreadPinnedCommunity:
+		*s = ThreadPinnedCommunity
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ThreadPostItem
+type ThreadPostItem struct {
+	ID         int    `json:"id"`
+	AuthorID   int    `json:"author_id"`
+	AuthorName string `json:"author_name"`
+	AuthorRank string `json:"author_rank"`
+	// Id of post this post replies to.
+	ReplyToID OptInt `json:"reply_to_id"`
+	// Markdown source (CommonMark with GFM extensions).
+	Content string `json:"content"`
+	// Content rendered to sanitized HTML.
+	ContentHTML string `json:"content_html"`
+	// Mentions of users in content.
+	Mentions    []MentionEntity `json:"mentions"`
+	Attachments []Attachment    `json:"attachments"`
+	// Sum of up (+1) and down (-1) votes.
+	Score int `json:"score"`
+	// Post is held by content filter for moderator review, set on create.
+	Held OptBool `json:"held"`
+	// Author is blocked or ignored by current user, post should be collapsed.
+	AuthorIgnored OptBool   `json:"author_ignored"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *ThreadPostItem) GetID() int {
+	return s.ID
+}
+
+// GetAuthorID returns the value of AuthorID.
+func (s *ThreadPostItem) GetAuthorID() int {
+	return s.AuthorID
+}
+
+// GetAuthorName returns the value of AuthorName.
+func (s *ThreadPostItem) GetAuthorName() string {
+	return s.AuthorName
+}
+
+// GetAuthorRank returns the value of AuthorRank.
+func (s *ThreadPostItem) GetAuthorRank() string {
+	return s.AuthorRank
+}
+
+// GetReplyToID returns the value of ReplyToID.
+func (s *ThreadPostItem) GetReplyToID() OptInt {
+	return s.ReplyToID
+}
+
+// GetContent returns the value of Content.
+func (s *ThreadPostItem) GetContent() string {
+	return s.Content
+}
+
+// GetContentHTML returns the value of ContentHTML.
+func (s *ThreadPostItem) GetContentHTML() string {
+	return s.ContentHTML
+}
+
+// GetMentions returns the value of Mentions.
+func (s *ThreadPostItem) GetMentions() []MentionEntity {
+	return s.Mentions
+}
+
+// GetAttachments returns the value of Attachments.
+func (s *ThreadPostItem) GetAttachments() []Attachment {
+	return s.Attachments
+}
+
+// GetScore returns the value of Score.
+func (s *ThreadPostItem) GetScore() int {
+	return s.Score
+}
+
+// GetHeld returns the value of Held.
+func (s *ThreadPostItem) GetHeld() OptBool {
+	return s.Held
+}
+
+// GetAuthorIgnored returns the value of AuthorIgnored.
+func (s *ThreadPostItem) GetAuthorIgnored() OptBool {
+	return s.AuthorIgnored
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ThreadPostItem) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *ThreadPostItem) SetID(val int) {
+	s.ID = val
+}
+
+// SetAuthorID sets the value of AuthorID.
+func (s *ThreadPostItem) SetAuthorID(val int) {
+	s.AuthorID = val
+}
+
+// SetAuthorName sets the value of AuthorName.
+func (s *ThreadPostItem) SetAuthorName(val string) {
+	s.AuthorName = val
+}
+
+// SetAuthorRank sets the value of AuthorRank.
+func (s *ThreadPostItem) SetAuthorRank(val string) {
+	s.AuthorRank = val
+}
+
+// SetReplyToID sets the value of ReplyToID.
+func (s *ThreadPostItem) SetReplyToID(val OptInt) {
+	s.ReplyToID = val
+}
+
+// SetContent sets the value of Content.
+func (s *ThreadPostItem) SetContent(val string) {
+	s.Content = val
+}
+
+// SetContentHTML sets the value of ContentHTML.
+func (s *ThreadPostItem) SetContentHTML(val string) {
+	s.ContentHTML = val
+}
+
+// SetMentions sets the value of Mentions.
+func (s *ThreadPostItem) SetMentions(val []MentionEntity) {
+	s.Mentions = val
+}
+
+// SetAttachments sets the value of Attachments.
+func (s *ThreadPostItem) SetAttachments(val []Attachment) {
+	s.Attachments = val
+}
+
+// SetScore sets the value of Score.
+func (s *ThreadPostItem) SetScore(val int) {
+	s.Score = val
+}
+
+// SetHeld sets the value of Held.
+func (s *ThreadPostItem) SetHeld(val OptBool) {
+	s.Held = val
+}
+
+// SetAuthorIgnored sets the value of AuthorIgnored.
+func (s *ThreadPostItem) SetAuthorIgnored(val OptBool) {
+	s.AuthorIgnored = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ThreadPostItem) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*ThreadPostItem) threadAddPostRes() {}
+
+type ThreadRevisionRestoreBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadRevisionRestoreBadRequest) threadRevisionRestoreRes() {}
+
+type ThreadRevisionRestoreForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadRevisionRestoreForbidden) threadRevisionRestoreRes() {}
+
+type ThreadRevisionRestoreInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadRevisionRestoreInternalServerError) threadRevisionRestoreRes() {}
+
+type ThreadRevisionRestoreNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadRevisionRestoreNotFound) threadRevisionRestoreRes() {}
+
+type ThreadRevisionRestoreUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadRevisionRestoreUnauthorized) threadRevisionRestoreRes() {}
+
+type ThreadRevisionsDiffBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadRevisionsDiffBadRequest) threadRevisionsDiffRes() {}
+
+type ThreadRevisionsDiffInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadRevisionsDiffInternalServerError) threadRevisionsDiffRes() {}
+
+type ThreadRevisionsDiffMode string
+
+const (
+	ThreadRevisionsDiffModeLine ThreadRevisionsDiffMode = "line"
+	ThreadRevisionsDiffModeWord ThreadRevisionsDiffMode = "word"
+)
+
+// AllValues returns all ThreadRevisionsDiffMode values.
+func (ThreadRevisionsDiffMode) AllValues() []ThreadRevisionsDiffMode {
+	return []ThreadRevisionsDiffMode{
+		ThreadRevisionsDiffModeLine,
+		ThreadRevisionsDiffModeWord,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ThreadRevisionsDiffMode) MarshalText() ([]byte, error) {
+	switch s {
+	case ThreadRevisionsDiffModeLine:
+		return []byte(s), nil
+	case ThreadRevisionsDiffModeWord:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ThreadRevisionsDiffMode) UnmarshalText(data []byte) error {
+	switch ThreadRevisionsDiffMode(data) {
+	case ThreadRevisionsDiffModeLine:
+		*s = ThreadRevisionsDiffModeLine
+		return nil
+	case ThreadRevisionsDiffModeWord:
+		*s = ThreadRevisionsDiffModeWord
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ThreadRevisionsDiffNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadRevisionsDiffNotFound) threadRevisionsDiffRes() {}
+
+type ThreadRevisionsDiffUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadRevisionsDiffUnauthorized) threadRevisionsDiffRes() {}
+
+type ThreadRevisionsInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadRevisionsInternalServerError) threadRevisionsRes() {}
+
+type ThreadRevisionsNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadRevisionsNotFound) threadRevisionsRes() {}
+
+type ThreadRevisionsUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadRevisionsUnauthorized) threadRevisionsRes() {}
+
+type ThreadSetStateBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadSetStateBadRequest) threadSetStateRes() {}
+
+type ThreadSetStateForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadSetStateForbidden) threadSetStateRes() {}
+
+type ThreadSetStateInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadSetStateInternalServerError) threadSetStateRes() {}
+
+type ThreadSetStateNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadSetStateNotFound) threadSetStateRes() {}
+
+type ThreadSetStateUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadSetStateUnauthorized) threadSetStateRes() {}
+
+// Ref: #/components/schemas/ThreadState
+type ThreadState struct {
+	Pinned          ThreadPinned `json:"pinned"`
+	Locked          bool         `json:"locked"`
+	Archived        bool         `json:"archived"`
+	SlowModeSeconds int          `json:"slow_mode_seconds"`
+}
+
+// GetPinned returns the value of Pinned.
+func (s *ThreadState) GetPinned() ThreadPinned {
+	return s.Pinned
+}
+
+// GetLocked returns the value of Locked.
+func (s *ThreadState) GetLocked() bool {
+	return s.Locked
+}
+
+// GetArchived returns the value of Archived.
+func (s *ThreadState) GetArchived() bool {
+	return s.Archived
+}
+
+// GetSlowModeSeconds returns the value of SlowModeSeconds.
+func (s *ThreadState) GetSlowModeSeconds() int {
+	return s.SlowModeSeconds
+}
+
+// SetPinned sets the value of Pinned.
+func (s *ThreadState) SetPinned(val ThreadPinned) {
+	s.Pinned = val
+}
+
+// SetLocked sets the value of Locked.
+func (s *ThreadState) SetLocked(val bool) {
+	s.Locked = val
+}
+
+// SetArchived sets the value of Archived.
+func (s *ThreadState) SetArchived(val bool) {
+	s.Archived = val
+}
+
+// SetSlowModeSeconds sets the value of SlowModeSeconds.
+func (s *ThreadState) SetSlowModeSeconds(val int) {
+	s.SlowModeSeconds = val
+}
+
+func (*ThreadState) threadSetStateRes() {}
+
+// Ref: #/components/schemas/ThreadStateRequest
+type ThreadStateRequest struct {
+	Pinned   OptThreadPinned `json:"pinned"`
+	Locked   OptBool         `json:"locked"`
+	Archived OptBool         `json:"archived"`
+	// Minimal interval between posts of user in thread (0-86400), 0 turns slow mode off.
+	SlowModeSeconds OptInt `json:"slow_mode_seconds"`
+}
+
+// GetPinned returns the value of Pinned.
+func (s *ThreadStateRequest) GetPinned() OptThreadPinned {
+	return s.Pinned
+}
+
+// GetLocked returns the value of Locked.
+func (s *ThreadStateRequest) GetLocked() OptBool {
+	return s.Locked
+}
+
+// GetArchived returns the value of Archived.
+func (s *ThreadStateRequest) GetArchived() OptBool {
+	return s.Archived
+}
+
+// GetSlowModeSeconds returns the value of SlowModeSeconds.
+func (s *ThreadStateRequest) GetSlowModeSeconds() OptInt {
+	return s.SlowModeSeconds
+}
+
+// SetPinned sets the value of Pinned.
+func (s *ThreadStateRequest) SetPinned(val OptThreadPinned) {
+	s.Pinned = val
+}
+
+// SetLocked sets the value of Locked.
+func (s *ThreadStateRequest) SetLocked(val OptBool) {
+	s.Locked = val
+}
+
+// SetArchived sets the value of Archived.
+func (s *ThreadStateRequest) SetArchived(val OptBool) {
+	s.Archived = val
+}
+
+// SetSlowModeSeconds sets the value of SlowModeSeconds.
+func (s *ThreadStateRequest) SetSlowModeSeconds(val OptInt) {
+	s.SlowModeSeconds = val
+}
+
+type ThreadSubscribeBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadSubscribeBadRequest) threadSubscribeRes() {}
+
+type ThreadSubscribeInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadSubscribeInternalServerError) threadSubscribeRes() {}
+
+type ThreadSubscribeNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadSubscribeNotFound) threadSubscribeRes() {}
+
+type ThreadSubscribeUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadSubscribeUnauthorized) threadSubscribeRes() {}
+
+// Ref: #/components/schemas/ThreadSubscription
+type ThreadSubscription struct {
+	ThreadID  int                     `json:"thread_id"`
+	Level     ThreadSubscriptionLevel `json:"level"`
+	CreatedAt time.Time               `json:"created_at"`
+}
+
+// GetThreadID returns the value of ThreadID.
+func (s *ThreadSubscription) GetThreadID() int {
+	return s.ThreadID
+}
+
+// GetLevel returns the value of Level.
+func (s *ThreadSubscription) GetLevel() ThreadSubscriptionLevel {
+	return s.Level
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ThreadSubscription) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetThreadID sets the value of ThreadID.
+func (s *ThreadSubscription) SetThreadID(val int) {
+	s.ThreadID = val
+}
+
+// SetLevel sets the value of Level.
+func (s *ThreadSubscription) SetLevel(val ThreadSubscriptionLevel) {
+	s.Level = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ThreadSubscription) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*ThreadSubscription) threadSubscribeRes()       {}
+func (*ThreadSubscription) threadSubscriptionGetRes() {}
+
+type ThreadSubscriptionGetInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadSubscriptionGetInternalServerError) threadSubscriptionGetRes() {}
+
+type ThreadSubscriptionGetNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadSubscriptionGetNotFound) threadSubscriptionGetRes() {}
+
+type ThreadSubscriptionGetUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadSubscriptionGetUnauthorized) threadSubscriptionGetRes() {}
+
+// `watching` - notified about every post in thread, `tracking` - thread is followed,
+// notified only about replies and mentions, `muted` - no notifications about thread at all.
+// Ref: #/components/schemas/ThreadSubscriptionLevel
+type ThreadSubscriptionLevel string
+
+const (
+	ThreadSubscriptionLevelWatching ThreadSubscriptionLevel = "watching"
+	ThreadSubscriptionLevelTracking ThreadSubscriptionLevel = "tracking"
+	ThreadSubscriptionLevelMuted    ThreadSubscriptionLevel = "muted"
+)
+
+// AllValues returns all ThreadSubscriptionLevel values.
+func (ThreadSubscriptionLevel) AllValues() []ThreadSubscriptionLevel {
+	return []ThreadSubscriptionLevel{
+		ThreadSubscriptionLevelWatching,
+		ThreadSubscriptionLevelTracking,
+		ThreadSubscriptionLevelMuted,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ThreadSubscriptionLevel) MarshalText() ([]byte, error) {
+	switch s {
+	case ThreadSubscriptionLevelWatching:
+		return []byte(s), nil
+	case ThreadSubscriptionLevelTracking:
+		return []byte(s), nil
+	case ThreadSubscriptionLevelMuted:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ThreadSubscriptionLevel) UnmarshalText(data []byte) error {
+	switch ThreadSubscriptionLevel(data) {
+	case ThreadSubscriptionLevelWatching:
+		*s = ThreadSubscriptionLevelWatching
+		return nil
+	case ThreadSubscriptionLevelTracking:
+		*s = ThreadSubscriptionLevelTracking
+		return nil
+	case ThreadSubscriptionLevelMuted:
+		*s = ThreadSubscriptionLevelMuted
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ThreadSubscriptionListItem
+type ThreadSubscriptionListItem struct {
+	Subscription ThreadSubscription `json:"subscription"`
+	Thread       ThreadListItem     `json:"thread"`
+}
+
+// GetSubscription returns the value of Subscription.
+func (s *ThreadSubscriptionListItem) GetSubscription() ThreadSubscription {
+	return s.Subscription
+}
+
+// GetThread returns the value of Thread.
+func (s *ThreadSubscriptionListItem) GetThread() ThreadListItem {
+	return s.Thread
+}
+
+// SetSubscription sets the value of Subscription.
+func (s *ThreadSubscriptionListItem) SetSubscription(val ThreadSubscription) {
+	s.Subscription = val
+}
+
+// SetThread sets the value of Thread.
+func (s *ThreadSubscriptionListItem) SetThread(val ThreadListItem) {
+	s.Thread = val
+}
+
+// Ref: #/components/schemas/ThreadSubscriptionListResponse
+type ThreadSubscriptionListResponse struct {
+	Subscriptions []ThreadSubscriptionListItem `json:"subscriptions"`
+	HaveNext      bool                         `json:"have_next"`
+}
+
+// GetSubscriptions returns the value of Subscriptions.
+func (s *ThreadSubscriptionListResponse) GetSubscriptions() []ThreadSubscriptionListItem {
+	return s.Subscriptions
+}
+
+// GetHaveNext returns the value of HaveNext.
+func (s *ThreadSubscriptionListResponse) GetHaveNext() bool {
+	return s.HaveNext
+}
+
+// SetSubscriptions sets the value of Subscriptions.
+func (s *ThreadSubscriptionListResponse) SetSubscriptions(val []ThreadSubscriptionListItem) {
+	s.Subscriptions = val
+}
+
+// SetHaveNext sets the value of HaveNext.
+func (s *ThreadSubscriptionListResponse) SetHaveNext(val bool) {
+	s.HaveNext = val
+}
+
+func (*ThreadSubscriptionListResponse) subscriptionsListRes() {}
+
+// Ref: #/components/schemas/ThreadSubscriptionRequest
+type ThreadSubscriptionRequest struct {
+	Level ThreadSubscriptionLevel `json:"level"`
+}
+
+// GetLevel returns the value of Level.
+func (s *ThreadSubscriptionRequest) GetLevel() ThreadSubscriptionLevel {
+	return s.Level
+}
+
+// SetLevel sets the value of Level.
+func (s *ThreadSubscriptionRequest) SetLevel(val ThreadSubscriptionLevel) {
+	s.Level = val
+}
+
+type ThreadUnsubscribeInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadUnsubscribeInternalServerError) threadUnsubscribeRes() {}
+
+// ThreadUnsubscribeNoContent is response for ThreadUnsubscribe operation.
+type ThreadUnsubscribeNoContent struct{}
+
+func (*ThreadUnsubscribeNoContent) threadUnsubscribeRes() {}
+
+type ThreadUnsubscribeNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadUnsubscribeNotFound) threadUnsubscribeRes() {}
+
+type ThreadUnsubscribeUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadUnsubscribeUnauthorized) threadUnsubscribeRes() {}
+
+type ThreadVoteBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadVoteBadRequest) threadVoteRes() {}
+
+type ThreadVoteForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadVoteForbidden) threadVoteRes() {}
+
+type ThreadVoteInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadVoteInternalServerError) threadVoteRes() {}
+
+type ThreadVoteNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadVoteNotFound) threadVoteRes() {}
+
+type ThreadVoteUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadVoteUnauthorized) threadVoteRes() {}
+
+// Ref: #/components/schemas/ThreadWithPostsListResponse
+type ThreadWithPostsListResponse struct {
+	ID         int    `json:"id"`
+	AuthorID   int    `json:"author_id"`
+	AuthorName string `json:"author_name"`
+	AuthorRank string `json:"author_rank"`
+	Title      string `json:"title"`
+	// Markdown source (CommonMark with GFM extensions).
+	Content string `json:"content"`
+	// Content rendered to sanitized HTML.
+	ContentHTML string `json:"content_html"`
+	// Mentions of users in content.
+	Mentions    []MentionEntity `json:"mentions"`
+	Attachments []Attachment    `json:"attachments"`
+	Poll        OptPoll         `json:"poll"`
+	PostsCount  int             `json:"posts_count"`
+	Score       int             `json:"score"`
+	// Id of post accepted by thread author as answer.
+	AcceptedPostID OptInt `json:"accepted_post_id"`
+	// Thread is bookmarked by current user.
+	IsBookmarked bool         `json:"is_bookmarked"`
+	Pinned       ThreadPinned `json:"pinned"`
+	// Thread does not accept new posts.
+	Locked bool `json:"locked"`
+	// Thread is read-only.
+	Archived bool `json:"archived"`
+	// User can post to thread once in this many seconds, 0 - slow mode is off.
+	SlowModeSeconds int              `json:"slow_mode_seconds"`
+	CreatedAt       time.Time        `json:"created_at"`
+	Posts           []ThreadPostItem `json:"posts"`
+}
+
+// GetID returns the value of ID.
+func (s *ThreadWithPostsListResponse) GetID() int {
+	return s.ID
+}
+
+// GetAuthorID returns the value of AuthorID.
+func (s *ThreadWithPostsListResponse) GetAuthorID() int {
+	return s.AuthorID
+}
+
+// GetAuthorName returns the value of AuthorName.
+func (s *ThreadWithPostsListResponse) GetAuthorName() string {
+	return s.AuthorName
+}
+
+// GetAuthorRank returns the value of AuthorRank.
+func (s *ThreadWithPostsListResponse) GetAuthorRank() string {
+	return s.AuthorRank
+}
+
+// GetTitle returns the value of Title.
+func (s *ThreadWithPostsListResponse) GetTitle() string {
+	return s.Title
+}
+
+// GetContent returns the value of Content.
+func (s *ThreadWithPostsListResponse) GetContent() string {
+	return s.Content
+}
+
+// GetContentHTML returns the value of ContentHTML.
+func (s *ThreadWithPostsListResponse) GetContentHTML() string {
+	return s.ContentHTML
+}
+
+// GetMentions returns the value of Mentions.
+func (s *ThreadWithPostsListResponse) GetMentions() []MentionEntity {
+	return s.Mentions
+}
+
+// GetAttachments returns the value of Attachments.
+func (s *ThreadWithPostsListResponse) GetAttachments() []Attachment {
+	return s.Attachments
+}
+
+// GetPoll returns the value of Poll.
+func (s *ThreadWithPostsListResponse) GetPoll() OptPoll {
+	return s.Poll
+}
+
+// GetPostsCount returns the value of PostsCount.
+func (s *ThreadWithPostsListResponse) GetPostsCount() int {
+	return s.PostsCount
+}
+
+// GetScore returns the value of Score.
+func (s *ThreadWithPostsListResponse) GetScore() int {
+	return s.Score
+}
+
+// GetAcceptedPostID returns the value of AcceptedPostID.
+func (s *ThreadWithPostsListResponse) GetAcceptedPostID() OptInt {
+	return s.AcceptedPostID
+}
+
+// GetIsBookmarked returns the value of IsBookmarked.
+func (s *ThreadWithPostsListResponse) GetIsBookmarked() bool {
+	return s.IsBookmarked
+}
+
+// GetPinned returns the value of Pinned.
+func (s *ThreadWithPostsListResponse) GetPinned() ThreadPinned {
+	return s.Pinned
+}
+
+// GetLocked returns the value of Locked.
+func (s *ThreadWithPostsListResponse) GetLocked() bool {
+	return s.Locked
+}
+
+// GetArchived returns the value of Archived.
+func (s *ThreadWithPostsListResponse) GetArchived() bool {
+	return s.Archived
+}
+
+// GetSlowModeSeconds returns the value of SlowModeSeconds.
+func (s *ThreadWithPostsListResponse) GetSlowModeSeconds() int {
+	return s.SlowModeSeconds
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ThreadWithPostsListResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetPosts returns the value of Posts.
+func (s *ThreadWithPostsListResponse) GetPosts() []ThreadPostItem {
+	return s.Posts
+}
+
+// SetID sets the value of ID.
+func (s *ThreadWithPostsListResponse) SetID(val int) {
+	s.ID = val
+}
+
+// SetAuthorID sets the value of AuthorID.
+func (s *ThreadWithPostsListResponse) SetAuthorID(val int) {
+	s.AuthorID = val
+}
+
+// SetAuthorName sets the value of AuthorName.
+func (s *ThreadWithPostsListResponse) SetAuthorName(val string) {
+	s.AuthorName = val
+}
+
+// SetAuthorRank sets the value of AuthorRank.
+func (s *ThreadWithPostsListResponse) SetAuthorRank(val string) {
+	s.AuthorRank = val
+}
+
+// SetTitle sets the value of Title.
+func (s *ThreadWithPostsListResponse) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetContent sets the value of Content.
+func (s *ThreadWithPostsListResponse) SetContent(val string) {
+	s.Content = val
+}
+
+// SetContentHTML sets the value of ContentHTML.
+func (s *ThreadWithPostsListResponse) SetContentHTML(val string) {
+	s.ContentHTML = val
+}
+
+// SetMentions sets the value of Mentions.
+func (s *ThreadWithPostsListResponse) SetMentions(val []MentionEntity) {
+	s.Mentions = val
+}
+
+// SetAttachments sets the value of Attachments.
+func (s *ThreadWithPostsListResponse) SetAttachments(val []Attachment) {
+	s.Attachments = val
+}
+
+// SetPoll sets the value of Poll.
+func (s *ThreadWithPostsListResponse) SetPoll(val OptPoll) {
+	s.Poll = val
+}
+
+// SetPostsCount sets the value of PostsCount.
+func (s *ThreadWithPostsListResponse) SetPostsCount(val int) {
+	s.PostsCount = val
+}
+
+// SetScore sets the value of Score.
+func (s *ThreadWithPostsListResponse) SetScore(val int) {
+	s.Score = val
+}
+
+// SetAcceptedPostID sets the value of AcceptedPostID.
+func (s *ThreadWithPostsListResponse) SetAcceptedPostID(val OptInt) {
+	s.AcceptedPostID = val
+}
+
+// SetIsBookmarked sets the value of IsBookmarked.
+func (s *ThreadWithPostsListResponse) SetIsBookmarked(val bool) {
+	s.IsBookmarked = val
+}
+
+// SetPinned sets the value of Pinned.
+func (s *ThreadWithPostsListResponse) SetPinned(val ThreadPinned) {
+	s.Pinned = val
+}
+
+// SetLocked sets the value of Locked.
+func (s *ThreadWithPostsListResponse) SetLocked(val bool) {
+	s.Locked = val
+}
+
+// SetArchived sets the value of Archived.
+func (s *ThreadWithPostsListResponse) SetArchived(val bool) {
+	s.Archived = val
+}
+
+// SetSlowModeSeconds sets the value of SlowModeSeconds.
+func (s *ThreadWithPostsListResponse) SetSlowModeSeconds(val int) {
+	s.SlowModeSeconds = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ThreadWithPostsListResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetPosts sets the value of Posts.
+func (s *ThreadWithPostsListResponse) SetPosts(val []ThreadPostItem) {
+	s.Posts = val
+}
+
+func (*ThreadWithPostsListResponse) threadGetRes() {}
+
+type ThreadsListInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadsListInternalServerError) threadsListRes() {}
+
+type ThreadsListUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*ThreadsListUnauthorized) threadsListRes() {}
+
+// TooManyRequestsHeaders wraps string with response headers.
+type TooManyRequestsHeaders struct {
+	RetryAfter int
+	Response   string
+}
+
+// GetRetryAfter returns the value of RetryAfter.
+func (s *TooManyRequestsHeaders) GetRetryAfter() int {
+	return s.RetryAfter
+}
+
+// GetResponse returns the value of Response.
+func (s *TooManyRequestsHeaders) GetResponse() string {
+	return s.Response
+}
+
+// SetRetryAfter sets the value of RetryAfter.
+func (s *TooManyRequestsHeaders) SetRetryAfter(val int) {
+	s.RetryAfter = val
+}
+
+// SetResponse sets the value of Response.
+func (s *TooManyRequestsHeaders) SetResponse(val string) {
+	s.Response = val
+}
+
+func (*TooManyRequestsHeaders) conversationCreateRes() {}
+func (*TooManyRequestsHeaders) conversationSendRes()   {}
+func (*TooManyRequestsHeaders) threadAddPostRes()      {}
+func (*TooManyRequestsHeaders) threadCreateRes()       {}
+
+type UserCreateBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserCreateBadRequest) userCreateRes() {}
+
+type UserCreateInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserCreateInternalServerError) userCreateRes() {}
+
+// Ref: #/components/schemas/UserCreateRequest
+type UserCreateRequest struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// GetName returns the value of Name.
+func (s *UserCreateRequest) GetName() string {
+	return s.Name
+}
+
+// GetEmail returns the value of Email.
+func (s *UserCreateRequest) GetEmail() string {
+	return s.Email
 }
 
 // GetPassword returns the value of Password.
-func (s *AuthLoginRequest) GetPassword() string {
+func (s *UserCreateRequest) GetPassword() string {
 	return s.Password
 }
 
-// SetLogin sets the value of Login.
-func (s *AuthLoginRequest) SetLogin(val string) {
-	s.Login = val
+// SetName sets the value of Name.
+func (s *UserCreateRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetEmail sets the value of Email.
+func (s *UserCreateRequest) SetEmail(val string) {
+	s.Email = val
 }
 
 // SetPassword sets the value of Password.
-func (s *AuthLoginRequest) SetPassword(val string) {
+func (s *UserCreateRequest) SetPassword(val string) {
 	s.Password = val
 }
 
-// AuthLogoutNoContent is response for AuthLogout operation.
-type AuthLogoutNoContent struct{}
+// Ref: #/components/schemas/UserCreateResponseOk
+type UserCreateResponseOk struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	// Private, returned only to the user and admins.
+	Email OptString `json:"email"`
+	// Sum of votes for user threads and posts.
+	Karma int `json:"karma"`
+	// Reputation rank calculated from karma, posts, accepted answers and account age.
+	Rank string `json:"rank"`
+	// Moderators and admins manage content of other users.
+	Role      UserCreateResponseOkRole `json:"role"`
+	AvatarURL string                   `json:"avatar_url"`
+	Bio       string                   `json:"bio"`
+	Location  string                   `json:"location"`
+	Website   string                   `json:"website"`
+	// Join date.
+	CreatedAt time.Time `json:"created_at"`
+	// Time of the last authenticated request (with a few minutes precision), absent if never seen.
+	LastSeenAt OptDateTime `json:"last_seen_at"`
+	// Visible threads of user.
+	ThreadsCount int `json:"threads_count"`
+	// Visible posts of user.
+	PostsCount     int `json:"posts_count"`
+	FollowersCount int `json:"followers_count"`
+	FollowingCount int `json:"following_count"`
+}
 
-type AuthRefreshInternalServerError AuthRefreshUnauthorizedApplicationJSON
+// GetID returns the value of ID.
+func (s *UserCreateResponseOk) GetID() int {
+	return s.ID
+}
 
-func (*AuthRefreshInternalServerError) authRefreshRes() {}
+// GetName returns the value of Name.
+func (s *UserCreateResponseOk) GetName() string {
+	return s.Name
+}
 
-type AuthRefreshUnauthorized AuthRefreshUnauthorizedApplicationJSON
+// GetEmail returns the value of Email.
+func (s *UserCreateResponseOk) GetEmail() OptString {
+	return s.Email
+}
 
-func (*AuthRefreshUnauthorized) authRefreshRes() {}
+// GetKarma returns the value of Karma.
+func (s *UserCreateResponseOk) GetKarma() int {
+	return s.Karma
+}
 
-type AuthRefreshUnauthorizedApplicationJSON string
+// GetRank returns the value of Rank.
+func (s *UserCreateResponseOk) GetRank() string {
+	return s.Rank
+}
 
-type CookieAuth struct {
-	APIKey string
-	Roles  []string
+// GetRole returns the value of Role.
+func (s *UserCreateResponseOk) GetRole() UserCreateResponseOkRole {
+	return s.Role
 }
 
-// GetAPIKey returns the value of APIKey.
-func (s *CookieAuth) GetAPIKey() string {
-	return s.APIKey
+// GetAvatarURL returns the value of AvatarURL.
+func (s *UserCreateResponseOk) GetAvatarURL() string {
+	return s.AvatarURL
 }
 
-// GetRoles returns the value of Roles.
-func (s *CookieAuth) GetRoles() []string {
-	return s.Roles
+// GetBio returns the value of Bio.
+func (s *UserCreateResponseOk) GetBio() string {
+	return s.Bio
 }
 
-// SetAPIKey sets the value of APIKey.
-func (s *CookieAuth) SetAPIKey(val string) {
-	s.APIKey = val
+// GetLocation returns the value of Location.
+func (s *UserCreateResponseOk) GetLocation() string {
+	return s.Location
 }
 
-// SetRoles sets the value of Roles.
-func (s *CookieAuth) SetRoles(val []string) {
-	s.Roles = val
+// GetWebsite returns the value of Website.
+func (s *UserCreateResponseOk) GetWebsite() string {
+	return s.Website
 }
 
-type JwtAuth struct {
-	Token string
-	Roles []string
+// GetCreatedAt returns the value of CreatedAt.
+func (s *UserCreateResponseOk) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// GetToken returns the value of Token.
-func (s *JwtAuth) GetToken() string {
-	return s.Token
+// GetLastSeenAt returns the value of LastSeenAt.
+func (s *UserCreateResponseOk) GetLastSeenAt() OptDateTime {
+	return s.LastSeenAt
 }
 
-// GetRoles returns the value of Roles.
-func (s *JwtAuth) GetRoles() []string {
-	return s.Roles
+// GetThreadsCount returns the value of ThreadsCount.
+func (s *UserCreateResponseOk) GetThreadsCount() int {
+	return s.ThreadsCount
 }
 
-// SetToken sets the value of Token.
-func (s *JwtAuth) SetToken(val string) {
-	s.Token = val
+// GetPostsCount returns the value of PostsCount.
+func (s *UserCreateResponseOk) GetPostsCount() int {
+	return s.PostsCount
 }
 
-// SetRoles sets the value of Roles.
-func (s *JwtAuth) SetRoles(val []string) {
-	s.Roles = val
+// GetFollowersCount returns the value of FollowersCount.
+func (s *UserCreateResponseOk) GetFollowersCount() int {
+	return s.FollowersCount
 }
 
-type JwtToken struct {
-	RefreshToken string `json:"refreshToken"`
-	AccessToken  string `json:"accessToken"`
+// GetFollowingCount returns the value of FollowingCount.
+func (s *UserCreateResponseOk) GetFollowingCount() int {
+	return s.FollowingCount
 }
 
-// GetRefreshToken returns the value of RefreshToken.
-func (s *JwtToken) GetRefreshToken() string {
-	return s.RefreshToken
+// SetID sets the value of ID.
+func (s *UserCreateResponseOk) SetID(val int) {
+	s.ID = val
 }
 
-// GetAccessToken returns the value of AccessToken.
-func (s *JwtToken) GetAccessToken() string {
-	return s.AccessToken
+// SetName sets the value of Name.
+func (s *UserCreateResponseOk) SetName(val string) {
+	s.Name = val
 }
 
-// SetRefreshToken sets the value of RefreshToken.
-func (s *JwtToken) SetRefreshToken(val string) {
-	s.RefreshToken = val
+// SetEmail sets the value of Email.
+func (s *UserCreateResponseOk) SetEmail(val OptString) {
+	s.Email = val
 }
 
-// SetAccessToken sets the value of AccessToken.
-func (s *JwtToken) SetAccessToken(val string) {
-	s.AccessToken = val
+// SetKarma sets the value of Karma.
+func (s *UserCreateResponseOk) SetKarma(val int) {
+	s.Karma = val
 }
 
-func (*JwtToken) authRefreshRes() {}
+// SetRank sets the value of Rank.
+func (s *UserCreateResponseOk) SetRank(val string) {
+	s.Rank = val
+}
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
-	}
+// SetRole sets the value of Role.
+func (s *UserCreateResponseOk) SetRole(val UserCreateResponseOkRole) {
+	s.Role = val
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// SetAvatarURL sets the value of AvatarURL.
+func (s *UserCreateResponseOk) SetAvatarURL(val string) {
+	s.AvatarURL = val
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// SetBio sets the value of Bio.
+func (s *UserCreateResponseOk) SetBio(val string) {
+	s.Bio = val
+}
 
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
+// SetLocation sets the value of Location.
+func (s *UserCreateResponseOk) SetLocation(val string) {
+	s.Location = val
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
+// SetWebsite sets the value of Website.
+func (s *UserCreateResponseOk) SetWebsite(val string) {
+	s.Website = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetCreatedAt sets the value of CreatedAt.
+func (s *UserCreateResponseOk) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetLastSeenAt sets the value of LastSeenAt.
+func (s *UserCreateResponseOk) SetLastSeenAt(val OptDateTime) {
+	s.LastSeenAt = val
 }
 
-type ThreadAddPostBadRequest AuthRefreshUnauthorizedApplicationJSON
+// SetThreadsCount sets the value of ThreadsCount.
+func (s *UserCreateResponseOk) SetThreadsCount(val int) {
+	s.ThreadsCount = val
+}
 
-func (*ThreadAddPostBadRequest) threadAddPostRes() {}
+// SetPostsCount sets the value of PostsCount.
+func (s *UserCreateResponseOk) SetPostsCount(val int) {
+	s.PostsCount = val
+}
 
-type ThreadAddPostInternalServerError AuthRefreshUnauthorizedApplicationJSON
+// SetFollowersCount sets the value of FollowersCount.
+func (s *UserCreateResponseOk) SetFollowersCount(val int) {
+	s.FollowersCount = val
+}
 
-func (*ThreadAddPostInternalServerError) threadAddPostRes() {}
+// SetFollowingCount sets the value of FollowingCount.
+func (s *UserCreateResponseOk) SetFollowingCount(val int) {
+	s.FollowingCount = val
+}
 
-type ThreadCreateInternalServerError AuthRefreshUnauthorizedApplicationJSON
+func (*UserCreateResponseOk) userCreateRes() {}
+func (*UserCreateResponseOk) userGetRes()    {}
+func (*UserCreateResponseOk) userMeRes()     {}
+func (*UserCreateResponseOk) userUpdateRes() {}
 
-func (*ThreadCreateInternalServerError) threadCreateRes() {}
+// Moderators and admins manage content of other users.
+type UserCreateResponseOkRole string
 
-// Ref: #/components/schemas/ThreadCreatePostRequest
-type ThreadCreatePostRequest struct {
-	Content string `json:"content"`
-}
+const (
+	UserCreateResponseOkRoleUser      UserCreateResponseOkRole = "user"
+	UserCreateResponseOkRoleModerator UserCreateResponseOkRole = "moderator"
+	UserCreateResponseOkRoleAdmin     UserCreateResponseOkRole = "admin"
+)
 
-// GetContent returns the value of Content.
-func (s *ThreadCreatePostRequest) GetContent() string {
-	return s.Content
+// AllValues returns all UserCreateResponseOkRole values.
+func (UserCreateResponseOkRole) AllValues() []UserCreateResponseOkRole {
+	return []UserCreateResponseOkRole{
+		UserCreateResponseOkRoleUser,
+		UserCreateResponseOkRoleModerator,
+		UserCreateResponseOkRoleAdmin,
+	}
 }
 
-// SetContent sets the value of Content.
-func (s *ThreadCreatePostRequest) SetContent(val string) {
-	s.Content = val
+// MarshalText implements encoding.TextMarshaler.
+func (s UserCreateResponseOkRole) MarshalText() ([]byte, error) {
+	switch s {
+	case UserCreateResponseOkRoleUser:
+		return []byte(s), nil
+	case UserCreateResponseOkRoleModerator:
+		return []byte(s), nil
+	case UserCreateResponseOkRoleAdmin:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// Ref: #/components/schemas/ThreadCreateRequest
-type ThreadCreateRequest struct {
-	Title   string `json:"title"`
-	Content string `json:"content"`
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UserCreateResponseOkRole) UnmarshalText(data []byte) error {
+	switch UserCreateResponseOkRole(data) {
+	case UserCreateResponseOkRoleUser:
+		*s = UserCreateResponseOkRoleUser
+		return nil
+	case UserCreateResponseOkRoleModerator:
+		*s = UserCreateResponseOkRoleModerator
+		return nil
+	case UserCreateResponseOkRoleAdmin:
+		*s = UserCreateResponseOkRoleAdmin
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetTitle returns the value of Title.
-func (s *ThreadCreateRequest) GetTitle() string {
-	return s.Title
-}
+// UserDeleteNoContent is response for UserDelete operation.
+type UserDeleteNoContent struct{}
 
-// GetContent returns the value of Content.
-func (s *ThreadCreateRequest) GetContent() string {
-	return s.Content
-}
+type UserFollowBadRequest AnalyticsGraphBadRequestApplicationJSON
 
-// SetTitle sets the value of Title.
-func (s *ThreadCreateRequest) SetTitle(val string) {
-	s.Title = val
-}
+func (*UserFollowBadRequest) userFollowRes() {}
 
-// SetContent sets the value of Content.
-func (s *ThreadCreateRequest) SetContent(val string) {
-	s.Content = val
-}
+type UserFollowForbidden AnalyticsGraphBadRequestApplicationJSON
 
-type ThreadCreateUnauthorized AuthRefreshUnauthorizedApplicationJSON
+func (*UserFollowForbidden) userFollowRes() {}
 
-func (*ThreadCreateUnauthorized) threadCreateRes() {}
+type UserFollowInternalServerError AnalyticsGraphBadRequestApplicationJSON
 
-type ThreadGetBadRequest AuthRefreshUnauthorizedApplicationJSON
+func (*UserFollowInternalServerError) userFollowRes() {}
 
-func (*ThreadGetBadRequest) threadGetRes() {}
+type UserFollowNotFound AnalyticsGraphBadRequestApplicationJSON
 
-type ThreadGetInternalServerError AuthRefreshUnauthorizedApplicationJSON
+func (*UserFollowNotFound) userFollowRes() {}
 
-func (*ThreadGetInternalServerError) threadGetRes() {}
+type UserFollowUnauthorized AnalyticsGraphBadRequestApplicationJSON
 
-// Ref: #/components/schemas/ThreadListItem
-type ThreadListItem struct {
-	ID         int       `json:"id"`
-	AuthorID   int       `json:"author_id"`
-	AuthorName string    `json:"author_name"`
-	Title      string    `json:"title"`
-	Content    string    `json:"content"`
-	PostsCount int       `json:"posts_count"`
-	CreatedAt  time.Time `json:"created_at"`
+func (*UserFollowUnauthorized) userFollowRes() {}
+
+type UserFollowersBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserFollowersBadRequest) userFollowersRes() {}
+
+type UserFollowersInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserFollowersInternalServerError) userFollowersRes() {}
+
+type UserFollowingBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserFollowingBadRequest) userFollowingRes() {}
+
+type UserFollowingInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserFollowingInternalServerError) userFollowingRes() {}
+
+type UserGetBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserGetBadRequest) userGetRes() {}
+
+type UserGetInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserGetInternalServerError) userGetRes() {}
+
+type UserGetNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserGetNotFound) userGetRes() {}
+
+type UserGetUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserGetUnauthorized) userGetRes() {}
+
+// Ref: #/components/schemas/UserInfluence
+type UserInfluence struct {
+	Snapshot InfluenceSnapshot `json:"snapshot"`
+	Score    InfluenceScore    `json:"score"`
 }
 
-// GetID returns the value of ID.
-func (s *ThreadListItem) GetID() int {
-	return s.ID
+// GetSnapshot returns the value of Snapshot.
+func (s *UserInfluence) GetSnapshot() InfluenceSnapshot {
+	return s.Snapshot
 }
 
-// GetAuthorID returns the value of AuthorID.
-func (s *ThreadListItem) GetAuthorID() int {
-	return s.AuthorID
+// GetScore returns the value of Score.
+func (s *UserInfluence) GetScore() InfluenceScore {
+	return s.Score
 }
 
-// GetAuthorName returns the value of AuthorName.
-func (s *ThreadListItem) GetAuthorName() string {
-	return s.AuthorName
+// SetSnapshot sets the value of Snapshot.
+func (s *UserInfluence) SetSnapshot(val InfluenceSnapshot) {
+	s.Snapshot = val
 }
 
-// GetTitle returns the value of Title.
-func (s *ThreadListItem) GetTitle() string {
-	return s.Title
+// SetScore sets the value of Score.
+func (s *UserInfluence) SetScore(val InfluenceScore) {
+	s.Score = val
 }
 
-// GetContent returns the value of Content.
-func (s *ThreadListItem) GetContent() string {
-	return s.Content
+// Ref: #/components/schemas/UserInfluenceResponse
+type UserInfluenceResponse struct {
+	// Forum first, then communities by id.
+	Influence []UserInfluence `json:"influence"`
 }
 
-// GetPostsCount returns the value of PostsCount.
-func (s *ThreadListItem) GetPostsCount() int {
-	return s.PostsCount
+// GetInfluence returns the value of Influence.
+func (s *UserInfluenceResponse) GetInfluence() []UserInfluence {
+	return s.Influence
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *ThreadListItem) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// SetInfluence sets the value of Influence.
+func (s *UserInfluenceResponse) SetInfluence(val []UserInfluence) {
+	s.Influence = val
 }
 
-// SetID sets the value of ID.
-func (s *ThreadListItem) SetID(val int) {
-	s.ID = val
+func (*UserInfluenceResponse) analyticsUserInfluenceRes() {}
+
+type UserMeInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserMeInternalServerError) userMeRes() {}
+
+type UserMeUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserMeUnauthorized) userMeRes() {}
+
+// Ref: #/components/schemas/UserPost
+type UserPost struct {
+	ID          int       `json:"id"`
+	ThreadID    int       `json:"thread_id"`
+	ThreadTitle string    `json:"thread_title"`
+	ContentHTML string    `json:"content_html"`
+	Score       int       `json:"score"`
+	CreatedAt   time.Time `json:"created_at"`
 }
 
-// SetAuthorID sets the value of AuthorID.
-func (s *ThreadListItem) SetAuthorID(val int) {
-	s.AuthorID = val
+// GetID returns the value of ID.
+func (s *UserPost) GetID() int {
+	return s.ID
 }
 
-// SetAuthorName sets the value of AuthorName.
-func (s *ThreadListItem) SetAuthorName(val string) {
-	s.AuthorName = val
+// GetThreadID returns the value of ThreadID.
+func (s *UserPost) GetThreadID() int {
+	return s.ThreadID
 }
 
-// SetTitle sets the value of Title.
-func (s *ThreadListItem) SetTitle(val string) {
-	s.Title = val
+// GetThreadTitle returns the value of ThreadTitle.
+func (s *UserPost) GetThreadTitle() string {
+	return s.ThreadTitle
 }
 
-// SetContent sets the value of Content.
-func (s *ThreadListItem) SetContent(val string) {
-	s.Content = val
+// GetContentHTML returns the value of ContentHTML.
+func (s *UserPost) GetContentHTML() string {
+	return s.ContentHTML
 }
 
-// SetPostsCount sets the value of PostsCount.
-func (s *ThreadListItem) SetPostsCount(val int) {
-	s.PostsCount = val
+// GetScore returns the value of Score.
+func (s *UserPost) GetScore() int {
+	return s.Score
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *ThreadListItem) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *UserPost) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-func (*ThreadListItem) threadCreateRes() {}
+// SetID sets the value of ID.
+func (s *UserPost) SetID(val int) {
+	s.ID = val
+}
 
-// Ref: #/components/schemas/ThreadListResponse
-type ThreadListResponse struct {
-	Threads             []ThreadListItem `json:"threads"`
-	TotalCountEstimated int              `json:"total_count_estimated"`
-	HavePrev            bool             `json:"have_prev"`
-	HaveNext            bool             `json:"have_next"`
+// SetThreadID sets the value of ThreadID.
+func (s *UserPost) SetThreadID(val int) {
+	s.ThreadID = val
 }
 
-// GetThreads returns the value of Threads.
-func (s *ThreadListResponse) GetThreads() []ThreadListItem {
-	return s.Threads
+// SetThreadTitle sets the value of ThreadTitle.
+func (s *UserPost) SetThreadTitle(val string) {
+	s.ThreadTitle = val
 }
 
-// GetTotalCountEstimated returns the value of TotalCountEstimated.
-func (s *ThreadListResponse) GetTotalCountEstimated() int {
-	return s.TotalCountEstimated
+// SetContentHTML sets the value of ContentHTML.
+func (s *UserPost) SetContentHTML(val string) {
+	s.ContentHTML = val
 }
 
-// GetHavePrev returns the value of HavePrev.
-func (s *ThreadListResponse) GetHavePrev() bool {
-	return s.HavePrev
+// SetScore sets the value of Score.
+func (s *UserPost) SetScore(val int) {
+	s.Score = val
 }
 
-// GetHaveNext returns the value of HaveNext.
-func (s *ThreadListResponse) GetHaveNext() bool {
-	return s.HaveNext
+// SetCreatedAt sets the value of CreatedAt.
+func (s *UserPost) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// SetThreads sets the value of Threads.
-func (s *ThreadListResponse) SetThreads(val []ThreadListItem) {
-	s.Threads = val
+// Ref: #/components/schemas/UserPostListResponse
+type UserPostListResponse struct {
+	Posts    []UserPost `json:"posts"`
+	HaveNext bool       `json:"have_next"`
 }
 
-// SetTotalCountEstimated sets the value of TotalCountEstimated.
-func (s *ThreadListResponse) SetTotalCountEstimated(val int) {
-	s.TotalCountEstimated = val
+// GetPosts returns the value of Posts.
+func (s *UserPostListResponse) GetPosts() []UserPost {
+	return s.Posts
 }
 
-// SetHavePrev sets the value of HavePrev.
-func (s *ThreadListResponse) SetHavePrev(val bool) {
-	s.HavePrev = val
+// GetHaveNext returns the value of HaveNext.
+func (s *UserPostListResponse) GetHaveNext() bool {
+	return s.HaveNext
+}
+
+// SetPosts sets the value of Posts.
+func (s *UserPostListResponse) SetPosts(val []UserPost) {
+	s.Posts = val
 }
 
 // SetHaveNext sets the value of HaveNext.
-func (s *ThreadListResponse) SetHaveNext(val bool) {
+func (s *UserPostListResponse) SetHaveNext(val bool) {
 	s.HaveNext = val
 }
 
-func (*ThreadListResponse) threadsListRes() {}
+func (*UserPostListResponse) userPostsRes() {}
 
-// Ref: #/components/schemas/ThreadPostItem
-type ThreadPostItem struct {
-	ID         int       `json:"id"`
-	AuthorID   int       `json:"author_id"`
-	AuthorName string    `json:"author_name"`
-	Content    string    `json:"content"`
-	CreatedAt  time.Time `json:"created_at"`
-}
+type UserPostsBadRequest AnalyticsGraphBadRequestApplicationJSON
 
-// GetID returns the value of ID.
-func (s *ThreadPostItem) GetID() int {
-	return s.ID
+func (*UserPostsBadRequest) userPostsRes() {}
+
+type UserPostsInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserPostsInternalServerError) userPostsRes() {}
+
+type UserRankHistoryBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserRankHistoryBadRequest) userRankHistoryRes() {}
+
+type UserRankHistoryInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserRankHistoryInternalServerError) userRankHistoryRes() {}
+
+type UserRankHistoryOKApplicationJSON []RankChange
+
+func (*UserRankHistoryOKApplicationJSON) userRankHistoryRes() {}
+
+// Ref: #/components/schemas/UserRelation
+type UserRelation struct {
+	UserID    int          `json:"user_id"`
+	UserName  string       `json:"user_name"`
+	Kind      RelationKind `json:"kind"`
+	CreatedAt time.Time    `json:"created_at"`
 }
 
-// GetAuthorID returns the value of AuthorID.
-func (s *ThreadPostItem) GetAuthorID() int {
-	return s.AuthorID
+// GetUserID returns the value of UserID.
+func (s *UserRelation) GetUserID() int {
+	return s.UserID
 }
 
-// GetAuthorName returns the value of AuthorName.
-func (s *ThreadPostItem) GetAuthorName() string {
-	return s.AuthorName
+// GetUserName returns the value of UserName.
+func (s *UserRelation) GetUserName() string {
+	return s.UserName
 }
 
-// GetContent returns the value of Content.
-func (s *ThreadPostItem) GetContent() string {
-	return s.Content
+// GetKind returns the value of Kind.
+func (s *UserRelation) GetKind() RelationKind {
+	return s.Kind
 }
 
 // GetCreatedAt returns the value of CreatedAt.
-func (s *ThreadPostItem) GetCreatedAt() time.Time {
+func (s *UserRelation) GetCreatedAt() time.Time {
 	return s.CreatedAt
 }
 
-// SetID sets the value of ID.
-func (s *ThreadPostItem) SetID(val int) {
-	s.ID = val
-}
-
-// SetAuthorID sets the value of AuthorID.
-func (s *ThreadPostItem) SetAuthorID(val int) {
-	s.AuthorID = val
+// SetUserID sets the value of UserID.
+func (s *UserRelation) SetUserID(val int) {
+	s.UserID = val
 }
 
-// SetAuthorName sets the value of AuthorName.
-func (s *ThreadPostItem) SetAuthorName(val string) {
-	s.AuthorName = val
+// SetUserName sets the value of UserName.
+func (s *UserRelation) SetUserName(val string) {
+	s.UserName = val
 }
 
-// SetContent sets the value of Content.
-func (s *ThreadPostItem) SetContent(val string) {
-	s.Content = val
+// SetKind sets the value of Kind.
+func (s *UserRelation) SetKind(val RelationKind) {
+	s.Kind = val
 }
 
 // SetCreatedAt sets the value of CreatedAt.
-func (s *ThreadPostItem) SetCreatedAt(val time.Time) {
+func (s *UserRelation) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
-func (*ThreadPostItem) threadAddPostRes() {}
+func (*UserRelation) relationSetRes() {}
 
-// Ref: #/components/schemas/ThreadWithPostsListResponse
-type ThreadWithPostsListResponse struct {
-	ID         int              `json:"id"`
-	AuthorID   int              `json:"author_id"`
-	AuthorName string           `json:"author_name"`
-	Title      string           `json:"title"`
-	Content    string           `json:"content"`
-	PostsCount int              `json:"posts_count"`
-	CreatedAt  time.Time        `json:"created_at"`
-	Posts      []ThreadPostItem `json:"posts"`
+// Ref: #/components/schemas/UserThread
+type UserThread struct {
+	ID         int       `json:"id"`
+	Title      string    `json:"title"`
+	PostsCount int       `json:"posts_count"`
+	Score      int       `json:"score"`
+	CreatedAt  time.Time `json:"created_at"`
 }
 
 // GetID returns the value of ID.
-func (s *ThreadWithPostsListResponse) GetID() int {
+func (s *UserThread) GetID() int {
 	return s.ID
 }
 
-// GetAuthorID returns the value of AuthorID.
-func (s *ThreadWithPostsListResponse) GetAuthorID() int {
-	return s.AuthorID
-}
-
-// GetAuthorName returns the value of AuthorName.
-func (s *ThreadWithPostsListResponse) GetAuthorName() string {
-	return s.AuthorName
-}
-
 // GetTitle returns the value of Title.
-func (s *ThreadWithPostsListResponse) GetTitle() string {
+func (s *UserThread) GetTitle() string {
 	return s.Title
 }
 
-// GetContent returns the value of Content.
-func (s *ThreadWithPostsListResponse) GetContent() string {
-	return s.Content
-}
-
 // GetPostsCount returns the value of PostsCount.
-func (s *ThreadWithPostsListResponse) GetPostsCount() int {
+func (s *UserThread) GetPostsCount() int {
 	return s.PostsCount
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *ThreadWithPostsListResponse) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetScore returns the value of Score.
+func (s *UserThread) GetScore() int {
+	return s.Score
 }
 
-// GetPosts returns the value of Posts.
-func (s *ThreadWithPostsListResponse) GetPosts() []ThreadPostItem {
-	return s.Posts
+// GetCreatedAt returns the value of CreatedAt.
+func (s *UserThread) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
 // SetID sets the value of ID.
-func (s *ThreadWithPostsListResponse) SetID(val int) {
+func (s *UserThread) SetID(val int) {
 	s.ID = val
 }
 
-// SetAuthorID sets the value of AuthorID.
-func (s *ThreadWithPostsListResponse) SetAuthorID(val int) {
-	s.AuthorID = val
-}
-
-// SetAuthorName sets the value of AuthorName.
-func (s *ThreadWithPostsListResponse) SetAuthorName(val string) {
-	s.AuthorName = val
-}
-
 // SetTitle sets the value of Title.
-func (s *ThreadWithPostsListResponse) SetTitle(val string) {
+func (s *UserThread) SetTitle(val string) {
 	s.Title = val
 }
 
-// SetContent sets the value of Content.
-func (s *ThreadWithPostsListResponse) SetContent(val string) {
-	s.Content = val
-}
-
 // SetPostsCount sets the value of PostsCount.
-func (s *ThreadWithPostsListResponse) SetPostsCount(val int) {
+func (s *UserThread) SetPostsCount(val int) {
 	s.PostsCount = val
 }
 
+// SetScore sets the value of Score.
+func (s *UserThread) SetScore(val int) {
+	s.Score = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
-func (s *ThreadWithPostsListResponse) SetCreatedAt(val time.Time) {
+func (s *UserThread) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
-// SetPosts sets the value of Posts.
-func (s *ThreadWithPostsListResponse) SetPosts(val []ThreadPostItem) {
-	s.Posts = val
+// Ref: #/components/schemas/UserThreadListResponse
+type UserThreadListResponse struct {
+	Threads  []UserThread `json:"threads"`
+	HaveNext bool         `json:"have_next"`
 }
 
-func (*ThreadWithPostsListResponse) threadGetRes() {}
+// GetThreads returns the value of Threads.
+func (s *UserThreadListResponse) GetThreads() []UserThread {
+	return s.Threads
+}
 
-type ThreadsListInternalServerError AuthRefreshUnauthorizedApplicationJSON
+// GetHaveNext returns the value of HaveNext.
+func (s *UserThreadListResponse) GetHaveNext() bool {
+	return s.HaveNext
+}
 
-func (*ThreadsListInternalServerError) threadsListRes() {}
+// SetThreads sets the value of Threads.
+func (s *UserThreadListResponse) SetThreads(val []UserThread) {
+	s.Threads = val
+}
 
-type ThreadsListUnauthorized AuthRefreshUnauthorizedApplicationJSON
+// SetHaveNext sets the value of HaveNext.
+func (s *UserThreadListResponse) SetHaveNext(val bool) {
+	s.HaveNext = val
+}
 
-func (*ThreadsListUnauthorized) threadsListRes() {}
+func (*UserThreadListResponse) userThreadsRes() {}
 
-type UserCreateBadRequest AuthRefreshUnauthorizedApplicationJSON
+type UserThreadsBadRequest AnalyticsGraphBadRequestApplicationJSON
 
-func (*UserCreateBadRequest) userCreateRes() {}
+func (*UserThreadsBadRequest) userThreadsRes() {}
 
-type UserCreateInternalServerError AuthRefreshUnauthorizedApplicationJSON
+type UserThreadsInternalServerError AnalyticsGraphBadRequestApplicationJSON
 
-func (*UserCreateInternalServerError) userCreateRes() {}
+func (*UserThreadsInternalServerError) userThreadsRes() {}
 
-// Ref: #/components/schemas/UserCreateRequest
-type UserCreateRequest struct {
-	Name     string `json:"name"`
-	Email    string `json:"email"`
-	Password string `json:"password"`
+type UserUnfollowInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserUnfollowInternalServerError) userUnfollowRes() {}
+
+// UserUnfollowNoContent is response for UserUnfollow operation.
+type UserUnfollowNoContent struct{}
+
+func (*UserUnfollowNoContent) userUnfollowRes() {}
+
+type UserUnfollowNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserUnfollowNotFound) userUnfollowRes() {}
+
+type UserUnfollowUnauthorized AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserUnfollowUnauthorized) userUnfollowRes() {}
+
+type UserUpdateBadRequest AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserUpdateBadRequest) userUpdateRes() {}
+
+type UserUpdateForbidden AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserUpdateForbidden) userUpdateRes() {}
+
+type UserUpdateInternalServerError AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserUpdateInternalServerError) userUpdateRes() {}
+
+type UserUpdateNotFound AnalyticsGraphBadRequestApplicationJSON
+
+func (*UserUpdateNotFound) userUpdateRes() {}
+
+// Ref: #/components/schemas/UserUpdateRequest
+type UserUpdateRequest struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	// Absolute http or https url of avatar image.
+	AvatarURL OptString `json:"avatar_url"`
+	// About user (max 1000 characters).
+	Bio OptString `json:"bio"`
+	// Max 100 characters.
+	Location OptString `json:"location"`
+	// Absolute http or https url.
+	Website OptString `json:"website"`
 }
 
 // GetName returns the value of Name.
-func (s *UserCreateRequest) GetName() string {
+func (s *UserUpdateRequest) GetName() string {
 	return s.Name
 }
 
 // GetEmail returns the value of Email.
-func (s *UserCreateRequest) GetEmail() string {
+func (s *UserUpdateRequest) GetEmail() string {
 	return s.Email
 }
 
-// GetPassword returns the value of Password.
-func (s *UserCreateRequest) GetPassword() string {
-	return s.Password
+// GetAvatarURL returns the value of AvatarURL.
+func (s *UserUpdateRequest) GetAvatarURL() OptString {
+	return s.AvatarURL
+}
+
+// GetBio returns the value of Bio.
+func (s *UserUpdateRequest) GetBio() OptString {
+	return s.Bio
+}
+
+// GetLocation returns the value of Location.
+func (s *UserUpdateRequest) GetLocation() OptString {
+	return s.Location
+}
+
+// GetWebsite returns the value of Website.
+func (s *UserUpdateRequest) GetWebsite() OptString {
+	return s.Website
 }
 
 // SetName sets the value of Name.
-func (s *UserCreateRequest) SetName(val string) {
+func (s *UserUpdateRequest) SetName(val string) {
 	s.Name = val
 }
 
 // SetEmail sets the value of Email.
-func (s *UserCreateRequest) SetEmail(val string) {
+func (s *UserUpdateRequest) SetEmail(val string) {
 	s.Email = val
 }
 
-// SetPassword sets the value of Password.
-func (s *UserCreateRequest) SetPassword(val string) {
-	s.Password = val
+// SetAvatarURL sets the value of AvatarURL.
+func (s *UserUpdateRequest) SetAvatarURL(val OptString) {
+	s.AvatarURL = val
 }
 
-// Ref: #/components/schemas/UserCreateResponseOk
-type UserCreateResponseOk struct {
-	ID    int    `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+// SetBio sets the value of Bio.
+func (s *UserUpdateRequest) SetBio(val OptString) {
+	s.Bio = val
 }
 
-// GetID returns the value of ID.
-func (s *UserCreateResponseOk) GetID() int {
-	return s.ID
+// SetLocation sets the value of Location.
+func (s *UserUpdateRequest) SetLocation(val OptString) {
+	s.Location = val
 }
 
-// GetName returns the value of Name.
-func (s *UserCreateResponseOk) GetName() string {
-	return s.Name
+// SetWebsite sets the value of Website.
+func (s *UserUpdateRequest) SetWebsite(val OptString) {
+	s.Website = val
 }
 
-// GetEmail returns the value of Email.
-func (s *UserCreateResponseOk) GetEmail() string {
-	return s.Email
-}
+type UserUpdateUnauthorized AnalyticsGraphBadRequestApplicationJSON
 
-// SetID sets the value of ID.
-func (s *UserCreateResponseOk) SetID(val int) {
-	s.ID = val
-}
+func (*UserUpdateUnauthorized) userUpdateRes() {}
 
-// SetName sets the value of Name.
-func (s *UserCreateResponseOk) SetName(val string) {
-	s.Name = val
+// Ref: #/components/schemas/VoteRequest
+type VoteRequest struct {
+	Value VoteRequestValue `json:"value"`
 }
 
-// SetEmail sets the value of Email.
-func (s *UserCreateResponseOk) SetEmail(val string) {
-	s.Email = val
+// GetValue returns the value of Value.
+func (s *VoteRequest) GetValue() VoteRequestValue {
+	return s.Value
 }
 
-func (*UserCreateResponseOk) userCreateRes() {}
-func (*UserCreateResponseOk) userGetRes()    {}
-func (*UserCreateResponseOk) userMeRes()     {}
+// SetValue sets the value of Value.
+func (s *VoteRequest) SetValue(val VoteRequestValue) {
+	s.Value = val
+}
 
-// UserDeleteNoContent is response for UserDelete operation.
-type UserDeleteNoContent struct{}
+type VoteRequestValue int
 
-type UserGetBadRequest AuthRefreshUnauthorizedApplicationJSON
+const (
+	VoteRequestValueMinus1 VoteRequestValue = -1
+	VoteRequestValue0      VoteRequestValue = 0
+	VoteRequestValue1      VoteRequestValue = 1
+)
 
-func (*UserGetBadRequest) userGetRes() {}
+// AllValues returns all VoteRequestValue values.
+func (VoteRequestValue) AllValues() []VoteRequestValue {
+	return []VoteRequestValue{
+		VoteRequestValueMinus1,
+		VoteRequestValue0,
+		VoteRequestValue1,
+	}
+}
 
-type UserGetInternalServerError AuthRefreshUnauthorizedApplicationJSON
+// Ref: #/components/schemas/VoteResponse
+type VoteResponse struct {
+	// Current score of thread or post.
+	Score int `json:"score"`
+	// Current vote of user.
+	MyVote int `json:"my_vote"`
+}
 
-func (*UserGetInternalServerError) userGetRes() {}
+// GetScore returns the value of Score.
+func (s *VoteResponse) GetScore() int {
+	return s.Score
+}
 
-type UserMeInternalServerError AuthRefreshUnauthorizedApplicationJSON
+// GetMyVote returns the value of MyVote.
+func (s *VoteResponse) GetMyVote() int {
+	return s.MyVote
+}
 
-func (*UserMeInternalServerError) userMeRes() {}
+// SetScore sets the value of Score.
+func (s *VoteResponse) SetScore(val int) {
+	s.Score = val
+}
 
-type UserMeUnauthorized AuthRefreshUnauthorizedApplicationJSON
+// SetMyVote sets the value of MyVote.
+func (s *VoteResponse) SetMyVote(val int) {
+	s.MyVote = val
+}
 
-func (*UserMeUnauthorized) userMeRes() {}
+func (*VoteResponse) postVoteRes()   {}
+func (*VoteResponse) threadVoteRes() {}
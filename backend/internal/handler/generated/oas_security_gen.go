@@ -63,14 +63,73 @@ func GetRolesForCookieAuth(operation string) []string {
 
 // operationRolesJwtAuth is a private map storing roles per operation.
 var operationRolesJwtAuth = map[string][]string{
-	ThreadAddPostOperation: []string{},
-	ThreadCreateOperation:  []string{},
-	ThreadGetOperation:     []string{},
-	ThreadsListOperation:   []string{},
-	UserDeleteOperation:    []string{},
-	UserGetOperation:       []string{},
-	UserMeOperation:        []string{},
-	UserUpdateOperation:    []string{},
+	AnalyticsGraphOperation:                []string{},
+	AnalyticsGraphExportOperation:          []string{},
+	AnalyticsGraphRebuildOperation:         []string{},
+	AnalyticsInfluenceSnapshotOperation:    []string{},
+	AnalyticsLeaderboardOperation:          []string{},
+	AnalyticsUserInfluenceOperation:        []string{},
+	AttachmentGetOperation:                 []string{},
+	AttachmentUploadOperation:              []string{},
+	BookmarkCollectionCreateOperation:      []string{},
+	BookmarkCollectionDeleteOperation:      []string{},
+	BookmarkCollectionsListOperation:       []string{},
+	BookmarkCreateOperation:                []string{},
+	BookmarkDeleteOperation:                []string{},
+	BookmarksListOperation:                 []string{},
+	CommunitySubscribeOperation:            []string{},
+	CommunitySubscriptionsListOperation:    []string{},
+	CommunityUnsubscribeOperation:          []string{},
+	ConversationCreateOperation:            []string{},
+	ConversationMarkReadOperation:          []string{},
+	ConversationMessagesOperation:          []string{},
+	ConversationSendOperation:              []string{},
+	ConversationsListOperation:             []string{},
+	ConversationsUnreadCountOperation:      []string{},
+	FeedGetOperation:                       []string{},
+	MentionUsersOperation:                  []string{},
+	ModerationDecideHeldOperation:          []string{},
+	ModerationHeldOperation:                []string{},
+	ModerationLogOperation:                 []string{},
+	ModerationQueueOperation:               []string{},
+	ModerationResolveOperation:             []string{},
+	NotificationPreferencesGetOperation:    []string{},
+	NotificationPreferencesUpdateOperation: []string{},
+	NotificationsListOperation:             []string{},
+	NotificationsMarkReadOperation:         []string{},
+	NotificationsReadAllOperation:          []string{},
+	NotificationsUnreadCountOperation:      []string{},
+	PollVoteOperation:                      []string{},
+	PostEditOperation:                      []string{},
+	PostRevisionRestoreOperation:           []string{},
+	PostRevisionsOperation:                 []string{},
+	PostRevisionsDiffOperation:             []string{},
+	PostVoteOperation:                      []string{},
+	RelationDeleteOperation:                []string{},
+	RelationSetOperation:                   []string{},
+	RelationsListOperation:                 []string{},
+	ReportCreateOperation:                  []string{},
+	SubscriptionsListOperation:             []string{},
+	ThreadAcceptAnswerOperation:            []string{},
+	ThreadAddPostOperation:                 []string{},
+	ThreadCreateOperation:                  []string{},
+	ThreadEditOperation:                    []string{},
+	ThreadGetOperation:                     []string{},
+	ThreadRevisionRestoreOperation:         []string{},
+	ThreadRevisionsOperation:               []string{},
+	ThreadRevisionsDiffOperation:           []string{},
+	ThreadSetStateOperation:                []string{},
+	ThreadSubscribeOperation:               []string{},
+	ThreadSubscriptionGetOperation:         []string{},
+	ThreadUnsubscribeOperation:             []string{},
+	ThreadVoteOperation:                    []string{},
+	ThreadsListOperation:                   []string{},
+	UserDeleteOperation:                    []string{},
+	UserFollowOperation:                    []string{},
+	UserGetOperation:                       []string{},
+	UserMeOperation:                        []string{},
+	UserUnfollowOperation:                  []string{},
+	UserUpdateOperation:                    []string{},
 }
 
 // GetRolesForJwtAuth returns the required roles for the given operation.
@@ -13,6 +13,101 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AnalyticsGraph implements analyticsGraph operation.
+//
+// Directed weighted graph: edge goes from user who replied, mentioned or voted to user
+// who received it, weight is number of interactions. Time window is counted in whole UTC days.
+// The heaviest edges are returned, `truncated` is set when some edges were cut by `limit`.
+//
+// GET /api/analytics/graph
+func (UnimplementedHandler) AnalyticsGraph(ctx context.Context, params AnalyticsGraphParams) (r AnalyticsGraphRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AnalyticsGraphExport implements analyticsGraphExport operation.
+//
+// Whole graph by filter in GraphML, GEXF or zip archive with `nodes.csv` and `edges.csv`.
+// Nodes carry user name, karma, posts count, degrees and weights, edges carry total weight
+// and weights by interaction kind. The same export is made by `forum export-graph` command.
+// File is streamed as graph is read from database, graph size is not limited.
+// User name in `nodes.csv` starting with `=`, `+`, `-` or `@` is prefixed with `'`,
+// so spreadsheets show it as text and do not evaluate it as formula.
+//
+// GET /api/analytics/graph/export
+func (UnimplementedHandler) AnalyticsGraphExport(ctx context.Context, params AnalyticsGraphExportParams) (r AnalyticsGraphExportRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AnalyticsGraphRebuild implements analyticsGraphRebuild operation.
+//
+// Graph is updated incrementally as users post and vote, rebuild also drops interactions
+// with removed votes and hidden content.
+//
+// POST /api/analytics/graph/rebuild
+func (UnimplementedHandler) AnalyticsGraphRebuild(ctx context.Context) (r AnalyticsGraphRebuildRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AnalyticsInfluenceSnapshot implements analyticsInfluenceSnapshot operation.
+//
+// Compute influence snapshots of forum and communities now (admins only).
+//
+// POST /api/analytics/influence/snapshots
+func (UnimplementedHandler) AnalyticsInfluenceSnapshot(ctx context.Context) (r AnalyticsInfluenceSnapshotRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AnalyticsLeaderboard implements analyticsLeaderboard operation.
+//
+// Snapshots of forum and of every community are computed periodically, see
+// `/api/analytics/influence/snapshots` to compute them now.
+//
+// GET /api/analytics/leaderboard
+func (UnimplementedHandler) AnalyticsLeaderboard(ctx context.Context, params AnalyticsLeaderboardParams) (r AnalyticsLeaderboardRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AnalyticsUserInfluence implements analyticsUserInfluence operation.
+//
+// Influence of user in the latest snapshots of forum and communities (admins only).
+//
+// GET /api/analytics/users/{userId}
+func (UnimplementedHandler) AnalyticsUserInfluence(ctx context.Context, params AnalyticsUserInfluenceParams) (r AnalyticsUserInfluenceRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AttachmentDownload implements attachmentDownload operation.
+//
+// Url with `expires` and `signature` is returned in attachment `url`, no other authorization
+// is needed, so it can be used in `<img src>`. Images are shown inline, other files are downloaded.
+// Images are downloadable after background processing (metadata removal, thumbnails).
+//
+// GET /api/attachments/{attachmentId}/download
+func (UnimplementedHandler) AttachmentDownload(ctx context.Context, params AttachmentDownloadParams) (r AttachmentDownloadRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AttachmentGet implements attachmentGet operation.
+//
+// Not attached file is visible only to user uploaded it.
+//
+// GET /api/attachments/{attachmentId}
+func (UnimplementedHandler) AttachmentGet(ctx context.Context, params AttachmentGetParams) (r AttachmentGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AttachmentUpload implements attachmentUpload operation.
+//
+// File type is detected by content, not by name or declared type, and must be allowed by server
+// configuration. Uploaded file is attached by passing its id in `attachment_ids` of created thread
+// or post, files not attached during a day (configurable) are removed.
+// Download `url` is signed and valid until `url_expires_at`.
+//
+// POST /api/attachments
+func (UnimplementedHandler) AttachmentUpload(ctx context.Context, req *AttachmentUploadRequestMultipart) (r AttachmentUploadRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AuthLogin implements authLogin operation.
 //
 // Create access and refresh JWT tokens, send to user. The refresh token also stored in a cookie.
@@ -40,6 +135,430 @@ func (UnimplementedHandler) AuthRefresh(ctx context.Context) (r AuthRefreshRes,
 	return r, ht.ErrNotImplemented
 }
 
+// BookmarkCollectionCreate implements bookmarkCollectionCreate operation.
+//
+// Collection names of user are unique, existing name is a conflict (409).
+//
+// POST /api/bookmarks/collections
+func (UnimplementedHandler) BookmarkCollectionCreate(ctx context.Context, req *BookmarkCollectionCreateRequest) (r BookmarkCollectionCreateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BookmarkCollectionDelete implements bookmarkCollectionDelete operation.
+//
+// Delete bookmark collection, its bookmarks are kept without collection.
+//
+// DELETE /api/bookmarks/collections/{collectionId}
+func (UnimplementedHandler) BookmarkCollectionDelete(ctx context.Context, params BookmarkCollectionDeleteParams) (r BookmarkCollectionDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BookmarkCollectionsList implements bookmarkCollectionsList operation.
+//
+// List bookmark collections of current user.
+//
+// GET /api/bookmarks/collections
+func (UnimplementedHandler) BookmarkCollectionsList(ctx context.Context) (r BookmarkCollectionsListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BookmarkCreate implements bookmarkCreate operation.
+//
+// Bookmarking already bookmarked thread or post moves bookmark to given collection
+// (or out of collection if collection is not set).
+//
+// POST /api/bookmarks
+func (UnimplementedHandler) BookmarkCreate(ctx context.Context, req *BookmarkCreateRequest) (r BookmarkCreateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BookmarkDelete implements bookmarkDelete operation.
+//
+// Remove bookmark.
+//
+// DELETE /api/bookmarks/{bookmarkId}
+func (UnimplementedHandler) BookmarkDelete(ctx context.Context, params BookmarkDeleteParams) (r BookmarkDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BookmarksList implements bookmarksList operation.
+//
+// Bookmarks are ordered from newest to oldest with current info of bookmarked thread
+// (or thread of bookmarked post). For next page pass id of last bookmark as `before`.
+//
+// GET /api/bookmarks
+func (UnimplementedHandler) BookmarksList(ctx context.Context, params BookmarksListParams) (r BookmarksListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CommunitySubscribe implements communitySubscribe operation.
+//
+// New threads of subscribed communities are shown in feed. Subscribing is idempotent.
+//
+// PUT /api/communities/{communityId}/subscription
+func (UnimplementedHandler) CommunitySubscribe(ctx context.Context, params CommunitySubscribeParams) (r CommunitySubscribeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CommunitySubscriptionsList implements communitySubscriptionsList operation.
+//
+// List communities subscribed by current user, ordered by name.
+//
+// GET /api/communities/subscriptions
+func (UnimplementedHandler) CommunitySubscriptionsList(ctx context.Context) (r CommunitySubscriptionsListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CommunityUnsubscribe implements communityUnsubscribe operation.
+//
+// Unsubscribe from community.
+//
+// DELETE /api/communities/{communityId}/subscription
+func (UnimplementedHandler) CommunityUnsubscribe(ctx context.Context, params CommunityUnsubscribeParams) (r CommunityUnsubscribeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ConversationCreate implements conversationCreate operation.
+//
+// Message to one user without title is added to existing conversation of the two users
+// if there is one. Users who blocked current user can not be added to conversation.
+//
+// POST /api/conversations
+func (UnimplementedHandler) ConversationCreate(ctx context.Context, req *ConversationCreateRequest) (r ConversationCreateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ConversationMarkRead implements conversationMarkRead operation.
+//
+// Mark messages of conversation as read.
+//
+// POST /api/conversations/{conversationId}/read
+func (UnimplementedHandler) ConversationMarkRead(ctx context.Context, req OptConversationMarkReadRequest, params ConversationMarkReadParams) (r ConversationMarkReadRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ConversationMessages implements conversationMessages operation.
+//
+// Messages are ordered from newest to oldest. Conversation is visible only to its members,
+// for others it does not exist.
+//
+// GET /api/conversations/{conversationId}/messages
+func (UnimplementedHandler) ConversationMessages(ctx context.Context, params ConversationMessagesParams) (r ConversationMessagesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ConversationSend implements conversationSend operation.
+//
+// Message can not be sent if any other member blocked current user.
+//
+// POST /api/conversations/{conversationId}/messages
+func (UnimplementedHandler) ConversationSend(ctx context.Context, req *MessageSendRequest, params ConversationSendParams) (r ConversationSendRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ConversationsList implements conversationsList operation.
+//
+// Conversations are ordered by the latest message, newest first.
+//
+// GET /api/conversations
+func (UnimplementedHandler) ConversationsList(ctx context.Context, params ConversationsListParams) (r ConversationsListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ConversationsUnreadCount implements conversationsUnreadCount operation.
+//
+// Count unread private messages of current user.
+//
+// GET /api/conversations/unread-count
+func (UnimplementedHandler) ConversationsUnreadCount(ctx context.Context) (r ConversationsUnreadCountRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FeedGet implements feedGet operation.
+//
+// Threads and posts of followed users and new threads of subscribed communities, the newest first.
+// Own threads and content of blocked and ignored users are skipped.
+//
+// GET /api/feed
+func (UnimplementedHandler) FeedGet(ctx context.Context, params FeedGetParams) (r FeedGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// LiveThread implements liveThread operation.
+//
+// The same as `/api/live/threads` with events of one thread only.
+//
+// GET /api/live/threads/{threadId}
+func (UnimplementedHandler) LiveThread(ctx context.Context, params LiveThreadParams) (r LiveThreadRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// LiveThreads implements liveThreads operation.
+//
+// Events `thread_created`, `thread_deleted`, `post_created`, `post_updated`, `post_deleted`
+// and `vote_changed` are sent with increasing `id` and json data. Thread or post hidden
+// by moderator is reported as deleted. Reconnected client sends `Last-Event-ID` header
+// (or `last_event_id` query parameter) and gets missed events. If missed events are not kept
+// anymore, `reset` event is sent and client should reload data.
+// Idle connection gets `: ping` comment every heartbeat interval.
+// Client which does not read events fast enough is disconnected and should reconnect.
+//
+// GET /api/live/threads
+func (UnimplementedHandler) LiveThreads(ctx context.Context, params LiveThreadsParams) (r LiveThreadsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// MentionUsers implements mentionUsers operation.
+//
+// Prefix is case-insensitive, leading `@` is ignored.
+// Users with names which can not be mentioned (for example with spaces) are skipped.
+//
+// GET /api/mentions/users
+func (UnimplementedHandler) MentionUsers(ctx context.Context, params MentionUsersParams) (r MentionUsersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ModerationDecideHeld implements moderationDecideHeld operation.
+//
+// Approved content is published with notifications and live events of new content, rejected
+// content is hidden and author is notified. Approved edit becomes new revision, rejected edit
+// is discarded. Decision is recorded in moderation log (as approve, hide or reject for edit)
+// and trains spam classifier.
+//
+// POST /api/moderation/held/{heldId}
+func (UnimplementedHandler) ModerationDecideHeld(ctx context.Context, req *HeldDecisionRequest, params ModerationDecideHeldParams) (r ModerationDecideHeldRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ModerationHeld implements moderationHeld operation.
+//
+// Held content is visible only to moderators until decision, newest first.
+// For next page pass id of last item as `before`.
+//
+// GET /api/moderation/held
+func (UnimplementedHandler) ModerationHeld(ctx context.Context, params ModerationHeldParams) (r ModerationHeldRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ModerationLog implements moderationLog operation.
+//
+// For next page pass id of last entry as `before`.
+//
+// GET /api/moderation/log
+func (UnimplementedHandler) ModerationLog(ctx context.Context, params ModerationLogParams) (r ModerationLogRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ModerationQueue implements moderationQueue operation.
+//
+// Groups are ordered by the latest report, newest first.
+// For next page pass `last_report_id` of last group as `before`.
+//
+// GET /api/moderation/reports
+func (UnimplementedHandler) ModerationQueue(ctx context.Context, params ModerationQueueParams) (r ModerationQueueRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ModerationResolve implements moderationResolve operation.
+//
+// All open reports of target are resolved and action is recorded in moderation log.
+// Hide is allowed for threads and posts, warn and suspend apply to content author (or reported user)
+// and are not allowed for moderators. Author is notified about every action except dismiss.
+//
+// POST /api/moderation/reports/{targetType}/{targetId}/resolve
+func (UnimplementedHandler) ModerationResolve(ctx context.Context, req *ModerationResolveRequest, params ModerationResolveParams) (r ModerationResolveRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationPreferencesGet implements notificationPreferencesGet operation.
+//
+// Delivery settings of every notification type.
+//
+// GET /api/notifications/preferences
+func (UnimplementedHandler) NotificationPreferencesGet(ctx context.Context) (r NotificationPreferencesGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationPreferencesUpdate implements notificationPreferencesUpdate operation.
+//
+// Types missing in request keep current setting. Notifications of disabled types are not created.
+// Response has settings of all types.
+//
+// PUT /api/notifications/preferences
+func (UnimplementedHandler) NotificationPreferencesUpdate(ctx context.Context, req []NotificationPreference) (r NotificationPreferencesUpdateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationsList implements notificationsList operation.
+//
+// Notifications are ordered from newest to oldest.
+// For next page pass id of last notification as `before`.
+//
+// GET /api/notifications
+func (UnimplementedHandler) NotificationsList(ctx context.Context, params NotificationsListParams) (r NotificationsListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationsMarkRead implements notificationsMarkRead operation.
+//
+// Mark notifications as read.
+//
+// POST /api/notifications/read
+func (UnimplementedHandler) NotificationsMarkRead(ctx context.Context, req *NotificationMarkReadRequest) (r NotificationsMarkReadRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationsReadAll implements notificationsReadAll operation.
+//
+// Mark all notifications of current user as read.
+//
+// POST /api/notifications/read-all
+func (UnimplementedHandler) NotificationsReadAll(ctx context.Context) (r NotificationsReadAllRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationsUnreadCount implements notificationsUnreadCount operation.
+//
+// Number of unread notifications of current user.
+//
+// GET /api/notifications/unread-count
+func (UnimplementedHandler) NotificationsUnreadCount(ctx context.Context) (r NotificationsUnreadCountRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PollVote implements pollVote operation.
+//
+// User votes once, vote can not be changed. Single choice poll accepts exactly one option.
+//
+// POST /api/threads/{threadId}/poll/vote
+func (UnimplementedHandler) PollVote(ctx context.Context, req *PollVoteRequest, params PollVoteParams) (r PollVoteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PostEdit implements postEdit operation.
+//
+// Post author and moderators can edit. Every edit is kept as revision of post.
+// Edit is checked by content filters as new post: it may be censored, rejected (400)
+// or held for moderator review. Held edit does not change post, it becomes new revision
+// after approval. Suspended users can not edit.
+//
+// PATCH /api/posts/{postId}
+func (UnimplementedHandler) PostEdit(ctx context.Context, req *PostEditRequest, params PostEditParams) (r PostEditRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PostRevisionRestore implements postRevisionRestore operation.
+//
+// Only moderators can restore. Content of old revision becomes current as new revision.
+// Restored content is checked by content filters as edit.
+//
+// POST /api/posts/{postId}/revisions/{revision}/restore
+func (UnimplementedHandler) PostRevisionRestore(ctx context.Context, params PostRevisionRestoreParams) (r PostRevisionRestoreRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PostRevisions implements postRevisions operation.
+//
+// Revisions from the oldest, revision 1 is the original post. Never edited post has only revision 1.
+//
+// GET /api/posts/{postId}/revisions
+func (UnimplementedHandler) PostRevisions(ctx context.Context, params PostRevisionsParams) (r PostRevisionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PostRevisionsDiff implements postRevisionsDiff operation.
+//
+// Difference between two revisions of post.
+//
+// GET /api/posts/{postId}/revisions/diff
+func (UnimplementedHandler) PostRevisionsDiff(ctx context.Context, params PostRevisionsDiffParams) (r PostRevisionsDiffRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PostVote implements postVote operation.
+//
+// Set vote of current user for post: 1 - up, -1 - down, 0 - remove vote.
+// Repeating the same vote changes nothing. Voting for own post is not allowed.
+//
+// POST /api/posts/{postId}/vote
+func (UnimplementedHandler) PostVote(ctx context.Context, req *VoteRequest, params PostVoteParams) (r PostVoteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RelationDelete implements relationDelete operation.
+//
+// Unblock or unignore user.
+//
+// DELETE /api/relations/{userId}
+func (UnimplementedHandler) RelationDelete(ctx context.Context, params RelationDeleteParams) (r RelationDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RelationSet implements relationSet operation.
+//
+// Previous relation to user is replaced. Moderators can be ignored, but not blocked.
+//
+// PUT /api/relations/{userId}
+func (UnimplementedHandler) RelationSet(ctx context.Context, req *RelationSetRequest, params RelationSetParams) (r RelationSetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RelationsList implements relationsList operation.
+//
+// Blocked users can not reply to threads and posts of current user or mention them,
+// content of blocked and ignored users is marked with `author_ignored` and their
+// notifications are not shown.
+// Relations are ordered from newest to oldest.
+//
+// GET /api/relations
+func (UnimplementedHandler) RelationsList(ctx context.Context, params RelationsListParams) (r RelationsListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReportCreate implements reportCreate operation.
+//
+// User has one open report of the same target, repeated report is rejected until moderator resolves
+// it.
+// Reason other requires text. Own threads and posts can not be reported.
+//
+// POST /api/reports
+func (UnimplementedHandler) ReportCreate(ctx context.Context, req *ReportRequest) (r ReportCreateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// Search implements search operation.
+//
+// Search threads (title and content) and posts (content). Query uses web search syntax
+// (`"quoted phrase"`, `or`, `-excluded`) and is matched with russian and english configurations.
+// Results are ordered by rank, matched words in snippet are wrapped with `<mark>` tags,
+// the rest of snippet is html escaped.
+// For next page pass `next_cursor` from response as `cursor` with the same query and filters.
+//
+// GET /api/search
+func (UnimplementedHandler) Search(ctx context.Context, params SearchParams) (r SearchRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SubscriptionsList implements subscriptionsList operation.
+//
+// Subscriptions are ordered from newest thread to oldest with current thread info.
+// For next page pass thread id of last subscription as `before`.
+//
+// GET /api/subscriptions
+func (UnimplementedHandler) SubscriptionsList(ctx context.Context, params SubscriptionsListParams) (r SubscriptionsListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ThreadAcceptAnswer implements threadAcceptAnswer operation.
+//
+// Only thread author can accept answer, own posts can not be accepted.
+// Previously accepted post of thread is unaccepted. Accepted answers count in author rank.
+//
+// POST /api/threads/{threadId}/accept
+func (UnimplementedHandler) ThreadAcceptAnswer(ctx context.Context, req *ThreadAcceptAnswerRequest, params ThreadAcceptAnswerParams) (r ThreadAcceptAnswerRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ThreadAddPost implements threadAddPost operation.
 //
 // Add a new post to thread.
@@ -58,29 +577,149 @@ func (UnimplementedHandler) ThreadCreate(ctx context.Context, req *ThreadCreateR
 	return r, ht.ErrNotImplemented
 }
 
+// ThreadEdit implements threadEdit operation.
+//
+// Thread author and moderators can edit. Every edit is kept as revision of thread.
+// Edit is checked by content filters as new thread: it may be censored, rejected (400)
+// or held for moderator review. Held edit does not change thread, it becomes new revision
+// after approval. Suspended users can not edit.
+//
+// PATCH /api/threads/{threadId}
+func (UnimplementedHandler) ThreadEdit(ctx context.Context, req *ThreadEditRequest, params ThreadEditParams) (r ThreadEditRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ThreadGet implements threadGet operation.
 //
-// Get single thread with all posts by thread id.
+// Authorization is optional, authorized user also gets own poll vote, bookmark mark and ignored
+// authors.
 //
 // GET /api/threads/{threadId}
 func (UnimplementedHandler) ThreadGet(ctx context.Context, params ThreadGetParams) (r ThreadGetRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// ThreadRevisionRestore implements threadRevisionRestore operation.
+//
+// Only moderators can restore. Content of old revision becomes current as new revision.
+// Restored content is checked by content filters as edit.
+//
+// POST /api/threads/{threadId}/revisions/{revision}/restore
+func (UnimplementedHandler) ThreadRevisionRestore(ctx context.Context, params ThreadRevisionRestoreParams) (r ThreadRevisionRestoreRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ThreadRevisions implements threadRevisions operation.
+//
+// Revisions from the oldest, revision 1 is the original thread. Never edited thread has only
+// revision 1.
+//
+// GET /api/threads/{threadId}/revisions
+func (UnimplementedHandler) ThreadRevisions(ctx context.Context, params ThreadRevisionsParams) (r ThreadRevisionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ThreadRevisionsDiff implements threadRevisionsDiff operation.
+//
+// Difference between two revisions of thread.
+//
+// GET /api/threads/{threadId}/revisions/diff
+func (UnimplementedHandler) ThreadRevisionsDiff(ctx context.Context, params ThreadRevisionsDiffParams) (r ThreadRevisionsDiffRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ThreadSetState implements threadSetState operation.
+//
+// Only moderators can change thread state. Absent fields keep current value.
+// Locked thread does not accept new posts, archived thread is read-only.
+// In slow mode user can post to thread once in `slow_mode_seconds` (0 turns slow mode off).
+// Threads without new posts are archived automatically after period set in server config.
+//
+// PATCH /api/threads/{threadId}/state
+func (UnimplementedHandler) ThreadSetState(ctx context.Context, req *ThreadStateRequest, params ThreadSetStateParams) (r ThreadSetStateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ThreadSubscribe implements threadSubscribe operation.
+//
+// Thread author is subscribed as `watching` on thread creation, poster - on the first post
+// in thread. Level set by user is kept by automatic subscription.
+//
+// PUT /api/threads/{threadId}/subscription
+func (UnimplementedHandler) ThreadSubscribe(ctx context.Context, req *ThreadSubscriptionRequest, params ThreadSubscribeParams) (r ThreadSubscribeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ThreadSubscriptionGet implements threadSubscriptionGet operation.
+//
+// Subscription of current user to thread.
+//
+// GET /api/threads/{threadId}/subscription
+func (UnimplementedHandler) ThreadSubscriptionGet(ctx context.Context, params ThreadSubscriptionGetParams) (r ThreadSubscriptionGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ThreadUnsubscribe implements threadUnsubscribe operation.
+//
+// User is subscribed again on next post in thread, set `muted` level to stop notifications.
+//
+// DELETE /api/threads/{threadId}/subscription
+func (UnimplementedHandler) ThreadUnsubscribe(ctx context.Context, params ThreadUnsubscribeParams) (r ThreadUnsubscribeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ThreadVote implements threadVote operation.
+//
+// Set vote of current user for thread: 1 - up, -1 - down, 0 - remove vote.
+// Repeating the same vote changes nothing. Voting for own thread is not allowed.
+//
+// POST /api/threads/{threadId}/vote
+func (UnimplementedHandler) ThreadVote(ctx context.Context, req *VoteRequest, params ThreadVoteParams) (r ThreadVoteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ThreadsList implements threadsList operation.
 //
-// Get list of threads with pagination. You can use either page-based pagination (page + limit)
-// or cursor-based pagination (after or before). The response includes an estimated total count
-// of threads for better performance.
-// Only one pagination method should be used at a time.
-// If both are provided, page-based pagination will be used.
-// `page` - will return that page
-// `before` - return older threads with lower id than provided (provided id will not be included in
-// response),
-// `after` - return newer threads with higher id than provided (provided id will not be included in
-// response).
-// If no pagination parameters are provided, the first page will be returned.
-// If no limit is provided, the default limit is used.
+// Получить список веток с пагинацией. Можно
+// использовать либо постраничную пагинацию (page + limit),
+// либо курсорную пагинацию (after или before). Нужно
+// использовать только один параметр.
+// after, before или page с номером страницы. Если ни один не
+// указан - выводятся самые свежие сообщения.
+// limit - количество сообщений на страницу, по умолчанию 20.
+// С разделением на страницы есть неприятная
+// особенность. При удалении или добавлении новых
+// сообщений,
+// страницы могут "прыгать". Т.е. у нас есть список
+// (сообщений) и в него могут добавляться и удаляться
+// элементы
+// в любом месте списка. Если мы находится на странице 3 и
+// хотим 7-ю, то в ней могут быть совсем другие элементы,
+// чем на момент запроса страницы 3. Поэтому для более
+// стабильной пагинации можно использовать курсорную
+// пагинацию.
+// Навигация по номеру страницы выберает все сообщения
+// на момент запроса и отдает нужную страницу.
+// Добавление
+// или удаление сообщений сбивает это разделение.
+// Курсорная пагинация позволяет двигаться вперед и
+// назад по списку, учитывая изменеия в нем.
+// Но для нее нужно указывать минимальный или
+// максимальный id сообщения на странице, чтобы понять
+// откуда двигаться
+// дальше. И она не позволяет прыгать на конкретную
+// страницу, а только двигаться вперед и назад.
+// При этом before и after не включаются в результат, т.е. если
+// указать before=10, то в результат не попадет
+// сообщение с id 10, а только с id меньше 10. И аналогично для
+// after. В них указываются id сообщения, но
+// before - для получения более старых сообщений, а after - для
+// получения более новых сообщений по времени.
+// Более старым сообщениям (before) соответствует меньший id
+// (более старые сообщения),
+// а более новым (after) - больший id. И при этом не важно,
+// удалены эти сообщения или нет.
+// Authorization is optional, authorized user also gets bookmark marks and ignored authors.
 //
 // GET /api/threads
 func (UnimplementedHandler) ThreadsList(ctx context.Context, params ThreadsListParams) (r ThreadsListRes, _ error) {
@@ -105,9 +744,37 @@ func (UnimplementedHandler) UserDelete(ctx context.Context, params UserDeletePar
 	return ht.ErrNotImplemented
 }
 
+// UserFollow implements userFollow operation.
+//
+// Threads and posts of followed users are shown in feed. Following is idempotent,
+// user blocked by target can not follow them.
+//
+// PUT /api/user/{userId}/follow
+func (UnimplementedHandler) UserFollow(ctx context.Context, params UserFollowParams) (r UserFollowRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UserFollowers implements userFollowers operation.
+//
+// List followers of user, the latest first.
+//
+// GET /api/user/{userId}/followers
+func (UnimplementedHandler) UserFollowers(ctx context.Context, params UserFollowersParams) (r UserFollowersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UserFollowing implements userFollowing operation.
+//
+// List users followed by user, the latest first.
+//
+// GET /api/user/{userId}/following
+func (UnimplementedHandler) UserFollowing(ctx context.Context, params UserFollowingParams) (r UserFollowingRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UserGet implements userGet operation.
 //
-// Get user information.
+// Authorization is optional. Private fields (email) are returned only to the user and admins.
 //
 // GET /api/user/{userId}
 func (UnimplementedHandler) UserGet(ctx context.Context, params UserGetParams) (r UserGetRes, _ error) {
@@ -123,11 +790,47 @@ func (UnimplementedHandler) UserMe(ctx context.Context) (r UserMeRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// UserPosts implements userPosts operation.
+//
+// List visible posts of user, newest first.
+//
+// GET /api/user/{userId}/posts
+func (UnimplementedHandler) UserPosts(ctx context.Context, params UserPostsParams) (r UserPostsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UserRankHistory implements userRankHistory operation.
+//
+// Get history of user rank changes, newest first.
+//
+// GET /api/user/{userId}/rank-history
+func (UnimplementedHandler) UserRankHistory(ctx context.Context, params UserRankHistoryParams) (r UserRankHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UserThreads implements userThreads operation.
+//
+// List visible threads of user, newest first.
+//
+// GET /api/user/{userId}/threads
+func (UnimplementedHandler) UserThreads(ctx context.Context, params UserThreadsParams) (r UserThreadsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UserUnfollow implements userUnfollow operation.
+//
+// Unfollow user.
+//
+// DELETE /api/user/{userId}/follow
+func (UnimplementedHandler) UserUnfollow(ctx context.Context, params UserUnfollowParams) (r UserUnfollowRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UserUpdate implements userUpdate operation.
 //
-// Update user information.
+// Allowed to the user and admins. All profile fields are replaced, empty value clears field.
 //
 // POST /api/user/{userId}
-func (UnimplementedHandler) UserUpdate(ctx context.Context, req *UserCreateRequest, params UserUpdateParams) (r *UserCreateResponseOk, _ error) {
+func (UnimplementedHandler) UserUpdate(ctx context.Context, req *UserUpdateRequest, params UserUpdateParams) (r UserUpdateRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package dto
+
+import "time"
+
+type LiveThread struct {
+	ID          int       `json:"id"`
+	Title       string    `json:"title"`
+	Content     string    `json:"content"`
+	ContentHTML string    `json:"content_html"`
+	AuthorID    int       `json:"author_id"`
+	AuthorName  string    `json:"author_name"`
+	AuthorRank  string    `json:"author_rank"`
+	CommunityID *int      `json:"community_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type LivePost struct {
+	ID          int             `json:"id"`
+	AuthorID    int             `json:"author_id"`
+	AuthorName  string          `json:"author_name"`
+	AuthorRank  string          `json:"author_rank"`
+	ReplyToID   *int            `json:"reply_to_id,omitempty"`
+	Content     string          `json:"content"`
+	ContentHTML string          `json:"content_html"`
+	Mentions    []MentionEntity `json:"mentions"`
+	Score       int             `json:"score"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+type MentionEntity struct {
+	UserID int    `json:"user_id"`
+	Name   string `json:"name"`
+	Offset int    `json:"offset"`
+	Length int    `json:"length"`
+}
+
+// LiveEvent is data of server-sent event, event type is sent in event field
+type LiveEvent struct {
+	ThreadID int         `json:"thread_id"`
+	Thread   *LiveThread `json:"thread,omitempty"`
+	Post     *LivePost   `json:"post,omitempty"`
+	PostID   int         `json:"post_id,omitempty"`
+	Score    *int        `json:"score,omitempty"`
+	// content of thread or post is not sent, client should load it
+	Partial bool `json:"partial,omitempty"`
+}
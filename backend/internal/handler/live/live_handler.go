@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package live
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/live/dto"
+	liveService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/live"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+// LiveHandler streams thread events as server-sent events
+type LiveHandler struct {
+	liveService *liveService.LiveService
+	heartbeat   time.Duration
+}
+
+func NewLiveHandler(liveService *liveService.LiveService, heartbeat time.Duration) *LiveHandler {
+	return &LiveHandler{liveService: liveService, heartbeat: heartbeat}
+}
+
+// Threads streams events of all threads (for threads list)
+func (h *LiveHandler) Threads(w http.ResponseWriter, r *http.Request) {
+	h.stream(w, r, 0)
+}
+
+// Thread streams events of one thread
+func (h *LiveHandler) Thread(w http.ResponseWriter, r *http.Request) {
+	threadId, err := strconv.Atoi(r.PathValue("threadId"))
+	if err != nil || threadId <= 0 {
+		http.Error(w, "threadId is not a valid integer", http.StatusBadRequest)
+		return
+	}
+	h.stream(w, r, threadId)
+}
+
+func (h *LiveHandler) stream(w http.ResponseWriter, r *http.Request, threadId int) {
+	// EventSource sends Last-Event-ID on reconnect, query parameter is for the first connection
+	lastEventIdStr := r.Header.Get("Last-Event-ID")
+	if lastEventIdStr == "" {
+		lastEventIdStr = r.URL.Query().Get("last_event_id")
+	}
+	var lastEventId int64
+	if lastEventIdStr != "" {
+		var err error
+		lastEventId, err = strconv.ParseInt(lastEventIdStr, 10, 64)
+		if err != nil || lastEventId < 0 {
+			http.Error(w, "Last-Event-ID is not a valid event id", http.StatusBadRequest)
+			return
+		}
+	}
+
+	rc := http.NewResponseController(w)
+	// stream lives longer than any server write timeout
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	sub, replay, complete := h.liveService.Subscribe(threadId, lastEventId)
+	defer h.liveService.Unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if !complete {
+		// some events are lost, client must reload thread and continue from current event
+		if _, err := fmt.Fprintf(w, "id: %d\nevent: reset\ndata: {}\n\n", sub.StartID); err != nil {
+			return
+		}
+	}
+	for _, event := range replay {
+		if err := writeEvent(w, event); err != nil {
+			return
+		}
+	}
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		case event, ok := <-sub.Events:
+			if !ok {
+				// too slow client, it reconnects with Last-Event-ID and gets missed events
+				return
+			}
+			if err := writeEvent(w, event); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, event model.LiveEvent) error {
+	data, err := json.Marshal(convertEvent(event))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
+
+func convertEvent(event model.LiveEvent) dto.LiveEvent {
+	res := dto.LiveEvent{
+		ThreadID: event.ThreadID,
+		PostID:   event.PostID,
+		Partial:  event.Partial,
+	}
+	if event.Thread != nil {
+		res.Thread = &dto.LiveThread{
+			ID:          event.Thread.ID,
+			Title:       event.Thread.Title,
+			Content:     event.Thread.Content,
+			ContentHTML: event.Thread.ContentHTML,
+			AuthorID:    event.Thread.UserID,
+			AuthorName:  event.Thread.UserName,
+			AuthorRank:  event.Thread.UserRank,
+			CommunityID: event.Thread.CommunityID,
+			CreatedAt:   event.Thread.CreatedAt,
+		}
+	}
+	if event.Post != nil {
+		res.Post = &dto.LivePost{
+			ID:          event.Post.ID,
+			AuthorID:    event.Post.UserID,
+			AuthorName:  event.Post.UserName,
+			AuthorRank:  event.Post.UserRank,
+			ReplyToID:   event.Post.ReplyToID,
+			Content:     event.Post.Content,
+			ContentHTML: event.Post.ContentHTML,
+			Mentions:    make([]dto.MentionEntity, len(event.Post.Mentions)),
+			Score:       event.Post.Score,
+			CreatedAt:   event.Post.CreatedAt,
+		}
+		for i, mention := range event.Post.Mentions {
+			res.Post.Mentions[i] = dto.MentionEntity{
+				UserID: mention.UserID,
+				Name:   mention.Name,
+				Offset: mention.Offset,
+				Length: mention.Length,
+			}
+		}
+	}
+	if event.Type == model.LiveEventVoteChanged {
+		res.Score = &event.Score
+	}
+	return res
+}
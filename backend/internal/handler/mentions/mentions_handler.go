@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package mentions
+
+import (
+	"context"
+
+	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	mentionsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/mentions"
+)
+
+type MentionsHandler struct {
+	mentionsService *mentionsService.MentionsService
+}
+
+func NewMentionsHandler(mentionsService *mentionsService.MentionsService) *MentionsHandler {
+	return &MentionsHandler{mentionsService: mentionsService}
+}
+
+func (h *MentionsHandler) MentionUsers(
+	ctx context.Context, params forumApi.MentionUsersParams) (forumApi.MentionUsersRes, error) {
+
+	users, err := h.mentionsService.Autocomplete(ctx, params.Prefix, params.Limit.Or(mentionsService.DefaultLimit))
+	if err != nil {
+		return nil, err
+	}
+	res := make(forumApi.MentionUsersOKApplicationJSON, len(users))
+	for i, user := range users {
+		res[i] = forumApi.MentionUser{
+			ID:   user.ID,
+			Name: user.Name,
+			Rank: user.Rank,
+		}
+	}
+	return &res, nil
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package messages
+
+import (
+	"context"
+	"errors"
+
+	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/authctx"
+	messagesService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/messages"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+type MessagesHandler struct {
+	messagesService *messagesService.MessagesService
+}
+
+func NewMessagesHandler(messagesService *messagesService.MessagesService) *MessagesHandler {
+	return &MessagesHandler{messagesService: messagesService}
+}
+
+func (h *MessagesHandler) ConversationsList(
+	ctx context.Context, params forumApi.ConversationsListParams) (forumApi.ConversationsListRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ConversationsListUnauthorized("not authenticated")
+		return &res, nil
+	}
+	list, err := h.messagesService.List(ctx, userId, params.Before.Or(0), params.Limit.Or(messagesService.DefaultLimit))
+	if err != nil {
+		return nil, err
+	}
+	res := &forumApi.ConversationListResponse{
+		Conversations: make([]forumApi.Conversation, len(list.Conversations)),
+		HaveNext:      list.HaveNext,
+	}
+	for i, conversation := range list.Conversations {
+		members := make([]forumApi.ConversationMember, len(conversation.Members))
+		for j, member := range conversation.Members {
+			members[j] = forumApi.ConversationMember{UserID: member.UserID, UserName: member.UserName}
+		}
+		res.Conversations[i] = forumApi.Conversation{
+			ID:          conversation.ID,
+			Title:       conversation.Title,
+			Members:     members,
+			LastMessage: convertMessage(conversation.LastMessage),
+			UnreadCount: conversation.UnreadCount,
+			CreatedAt:   conversation.CreatedAt,
+		}
+	}
+	return res, nil
+}
+
+func (h *MessagesHandler) ConversationCreate(
+	ctx context.Context, req *forumApi.ConversationCreateRequest) (forumApi.ConversationCreateRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ConversationCreateUnauthorized("not authenticated")
+		return &res, nil
+	}
+	message, err := h.messagesService.Create(ctx, model.ConversationCreate{
+		CreatorID: userId,
+		MemberIDs: req.UserIds,
+		Title:     req.Title.Or(""),
+		Content:   req.Content,
+	})
+	switch {
+	case err == nil:
+		res := convertMessage(message)
+		return &res, nil
+	case errors.Is(err, messagesService.ErrNoMembers), errors.Is(err, messagesService.ErrTooManyMembers),
+		errors.Is(err, messagesService.ErrUnknownMembers), errors.Is(err, messagesService.ErrTitleTooLong),
+		errors.Is(err, messagesService.ErrEmptyMessage), errors.Is(err, messagesService.ErrMessageTooLong):
+		res := forumApi.ConversationCreateBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrBlockedByUser), errors.Is(err, model.ErrUserSuspended):
+		res := forumApi.ConversationCreateForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrRateLimited):
+		return tooManyRequests(err), nil
+	}
+	return nil, err
+}
+
+func (h *MessagesHandler) ConversationsUnreadCount(ctx context.Context) (forumApi.ConversationsUnreadCountRes, error) {
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ConversationsUnreadCountUnauthorized("not authenticated")
+		return &res, nil
+	}
+	unread, err := h.messagesService.Unread(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	return &forumApi.MessagesUnreadResponse{Conversations: unread.Conversations, Messages: unread.Messages}, nil
+}
+
+func (h *MessagesHandler) ConversationMessages(
+	ctx context.Context, params forumApi.ConversationMessagesParams) (forumApi.ConversationMessagesRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ConversationMessagesUnauthorized("not authenticated")
+		return &res, nil
+	}
+	list, err := h.messagesService.Messages(ctx, userId, params.ConversationId,
+		params.Before.Or(0), params.Limit.Or(messagesService.DefaultLimit))
+	if errors.Is(err, model.ErrNotFound) {
+		res := forumApi.ConversationMessagesNotFound("conversation not found")
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := &forumApi.DirectMessageListResponse{
+		Messages: make([]forumApi.DirectMessage, len(list.Messages)),
+		HaveNext: list.HaveNext,
+	}
+	for i, message := range list.Messages {
+		res.Messages[i] = convertMessage(message)
+	}
+	return res, nil
+}
+
+func (h *MessagesHandler) ConversationSend(ctx context.Context,
+	req *forumApi.MessageSendRequest, params forumApi.ConversationSendParams) (forumApi.ConversationSendRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ConversationSendUnauthorized("not authenticated")
+		return &res, nil
+	}
+	message, err := h.messagesService.Send(ctx, userId, params.ConversationId, req.Content)
+	switch {
+	case err == nil:
+		res := convertMessage(message)
+		return &res, nil
+	case errors.Is(err, messagesService.ErrEmptyMessage), errors.Is(err, messagesService.ErrMessageTooLong):
+		res := forumApi.ConversationSendBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrBlockedByUser), errors.Is(err, model.ErrUserSuspended):
+		res := forumApi.ConversationSendForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.ConversationSendNotFound("conversation not found")
+		return &res, nil
+	case errors.Is(err, model.ErrRateLimited):
+		return tooManyRequests(err), nil
+	}
+	return nil, err
+}
+
+func (h *MessagesHandler) ConversationMarkRead(ctx context.Context,
+	req forumApi.OptConversationMarkReadRequest, params forumApi.ConversationMarkReadParams,
+) (forumApi.ConversationMarkReadRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ConversationMarkReadUnauthorized("not authenticated")
+		return &res, nil
+	}
+	err := h.messagesService.MarkRead(ctx, userId, params.ConversationId, req.Value.MessageID.Or(0))
+	if errors.Is(err, model.ErrNotFound) {
+		res := forumApi.ConversationMarkReadNotFound("conversation not found")
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &forumApi.ConversationMarkReadNoContent{}, nil
+}
+
+func convertMessage(message model.DirectMessage) forumApi.DirectMessage {
+	return forumApi.DirectMessage{
+		ID:             message.ID,
+		ConversationID: message.ConversationID,
+		UserID:         message.UserID,
+		UserName:       message.UserName,
+		Content:        message.Content,
+		ContentHTML:    message.ContentHTML,
+		CreatedAt:      message.CreatedAt,
+	}
+}
+
+func tooManyRequests(err error) *forumApi.TooManyRequestsHeaders {
+	res := &forumApi.TooManyRequestsHeaders{Response: err.Error()}
+	var rateErr *model.RateLimitError
+	if errors.As(err, &rateErr) {
+		res.RetryAfter = rateErr.RetryAfterSeconds()
+	}
+	return res
+}
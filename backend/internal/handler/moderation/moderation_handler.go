@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package moderation
+
+import (
+	"context"
+	"errors"
+
+	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/authctx"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	moderationService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/moderation"
+)
+
+type ModerationHandler struct {
+	moderationService *moderationService.ModerationService
+}
+
+func NewModerationHandler(moderationService *moderationService.ModerationService) *ModerationHandler {
+	return &ModerationHandler{moderationService: moderationService}
+}
+
+func (h *ModerationHandler) ReportCreate(ctx context.Context, req *forumApi.ReportRequest) (forumApi.ReportCreateRes, error) {
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ReportCreateUnauthorized("not authenticated")
+		return &res, nil
+	}
+	err := h.moderationService.Report(ctx, model.ReportCreate{
+		ReporterID: userId,
+		TargetType: string(req.TargetType),
+		TargetID:   req.TargetID,
+		Reason:     string(req.Reason),
+		Text:       req.Text.Or(""),
+	})
+	switch {
+	case err == nil:
+		return &forumApi.ReportCreateNoContent{}, nil
+	case errors.Is(err, moderationService.ErrUnknownTarget),
+		errors.Is(err, moderationService.ErrUnknownReason),
+		errors.Is(err, moderationService.ErrEmptyText),
+		errors.Is(err, moderationService.ErrTextTooLong),
+		errors.Is(err, moderationService.ErrReportOwn):
+		res := forumApi.ReportCreateBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, moderationService.ErrAlreadyReported):
+		res := forumApi.ReportCreateConflict(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.ReportCreateNotFound("reported " + string(req.TargetType) + " not found")
+		return &res, nil
+	}
+	return nil, err
+}
+
+func (h *ModerationHandler) ModerationQueue(
+	ctx context.Context, params forumApi.ModerationQueueParams) (forumApi.ModerationQueueRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ModerationQueueUnauthorized("not authenticated")
+		return &res, nil
+	}
+	queue, err := h.moderationService.Queue(ctx, userId,
+		params.Before.Or(0), params.Limit.Or(moderationService.DefaultLimit))
+	if errors.Is(err, moderationService.ErrNotModerator) {
+		res := forumApi.ModerationQueueForbidden(err.Error())
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]forumApi.ReportGroup, len(queue.Groups))
+	for i, g := range queue.Groups {
+		group := forumApi.ReportGroup{
+			TargetType:     forumApi.ReportTargetType(g.TargetType),
+			TargetID:       g.TargetID,
+			TargetUserID:   g.TargetUserID,
+			TargetUserName: g.TargetUserName,
+			Excerpt:        g.Excerpt,
+			Hidden:         g.Hidden,
+			ReportsCount:   g.ReportsCount,
+			Reasons:        make([]forumApi.ReportReasonCount, len(g.Reasons)),
+			Reports:        make([]forumApi.Report, len(g.Reports)),
+			LastReportID:   g.LastReportID,
+			LastReportAt:   g.LastReportAt,
+		}
+		if g.ThreadID != nil {
+			group.ThreadID.SetTo(*g.ThreadID)
+		}
+		for j, reason := range g.Reasons {
+			group.Reasons[j] = forumApi.ReportReasonCount{
+				Reason: forumApi.ReportReason(reason.Reason),
+				Count:  reason.Count,
+			}
+		}
+		for j, report := range g.Reports {
+			group.Reports[j] = forumApi.Report{
+				ID:           report.ID,
+				ReporterID:   report.ReporterID,
+				ReporterName: report.ReporterName,
+				Reason:       forumApi.ReportReason(report.Reason),
+				Text:         report.Text,
+				CreatedAt:    report.CreatedAt,
+			}
+		}
+		groups[i] = group
+	}
+	return &forumApi.ReportQueueResponse{Groups: groups, HaveNext: queue.HaveNext}, nil
+}
+
+func (h *ModerationHandler) ModerationResolve(ctx context.Context,
+	req *forumApi.ModerationResolveRequest, params forumApi.ModerationResolveParams) (forumApi.ModerationResolveRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ModerationResolveUnauthorized("not authenticated")
+		return &res, nil
+	}
+	entry, err := h.moderationService.Resolve(ctx, model.ModerationAction{
+		ModeratorID: userId,
+		Action:      string(req.Action),
+		TargetType:  string(params.TargetType),
+		TargetID:    params.TargetId,
+		Comment:     req.Comment.Or(""),
+	}, req.SuspendDays.Or(0))
+	switch {
+	case err == nil:
+		res := convertLogEntry(entry)
+		return &res, nil
+	case errors.Is(err, moderationService.ErrUnknownTarget),
+		errors.Is(err, moderationService.ErrUnknownAction),
+		errors.Is(err, moderationService.ErrHideUser),
+		errors.Is(err, moderationService.ErrInvalidSuspension):
+		res := forumApi.ModerationResolveBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, moderationService.ErrNotModerator), errors.Is(err, moderationService.ErrModerateModerator):
+		res := forumApi.ModerationResolveForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.ModerationResolveNotFound("target has no open reports")
+		return &res, nil
+	}
+	return nil, err
+}
+
+func (h *ModerationHandler) ModerationLog(
+	ctx context.Context, params forumApi.ModerationLogParams) (forumApi.ModerationLogRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ModerationLogUnauthorized("not authenticated")
+		return &res, nil
+	}
+	moderationLog, err := h.moderationService.Log(ctx, userId,
+		params.Before.Or(0), params.Limit.Or(moderationService.DefaultLimit))
+	if errors.Is(err, moderationService.ErrNotModerator) {
+		res := forumApi.ModerationLogForbidden(err.Error())
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]forumApi.ModerationLogEntry, len(moderationLog.Entries))
+	for i, entry := range moderationLog.Entries {
+		entries[i] = convertLogEntry(entry)
+	}
+	return &forumApi.ModerationLogResponse{Entries: entries, HaveNext: moderationLog.HaveNext}, nil
+}
+
+func (h *ModerationHandler) ModerationHeld(
+	ctx context.Context, params forumApi.ModerationHeldParams) (forumApi.ModerationHeldRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ModerationHeldUnauthorized("not authenticated")
+		return &res, nil
+	}
+	held, err := h.moderationService.Held(ctx, userId,
+		params.Before.Or(0), params.Limit.Or(moderationService.DefaultLimit))
+	if errors.Is(err, moderationService.ErrNotModerator) {
+		res := forumApi.ModerationHeldForbidden(err.Error())
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	items := make([]forumApi.HeldContent, len(held.Items))
+	for i, item := range held.Items {
+		items[i] = forumApi.HeldContent{
+			ID:         item.ID,
+			TargetType: forumApi.ReportTargetType(item.TargetType),
+			TargetID:   item.TargetID,
+			ThreadID:   item.ThreadID,
+			AuthorID:   item.UserID,
+			AuthorName: item.UserName,
+			Title:      item.Title,
+			Content:    item.Content,
+			Reason:     item.Reason,
+			Edit:       item.Edit,
+			CreatedAt:  item.CreatedAt,
+		}
+	}
+	return &forumApi.HeldContentListResponse{Items: items, HaveNext: held.HaveNext}, nil
+}
+
+func (h *ModerationHandler) ModerationDecideHeld(ctx context.Context,
+	req *forumApi.HeldDecisionRequest, params forumApi.ModerationDecideHeldParams) (forumApi.ModerationDecideHeldRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ModerationDecideHeldUnauthorized("not authenticated")
+		return &res, nil
+	}
+	entry, err := h.moderationService.DecideHeld(
+		ctx, userId, params.HeldId, string(req.Decision), req.Comment.Or(""))
+	switch {
+	case err == nil:
+		res := convertLogEntry(entry)
+		return &res, nil
+	case errors.Is(err, moderationService.ErrUnknownDecision):
+		res := forumApi.ModerationDecideHeldBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, moderationService.ErrNotModerator):
+		res := forumApi.ModerationDecideHeldForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.ModerationDecideHeldNotFound("held content not found")
+		return &res, nil
+	}
+	return nil, err
+}
+
+func convertLogEntry(entry model.ModerationLogEntry) forumApi.ModerationLogEntry {
+	res := forumApi.ModerationLogEntry{
+		ID:             entry.ID,
+		ModeratorID:    entry.ModeratorID,
+		ModeratorName:  entry.ModeratorName,
+		Action:         forumApi.ModerationAction(entry.Action),
+		TargetType:     forumApi.ReportTargetType(entry.TargetType),
+		TargetID:       entry.TargetID,
+		TargetUserID:   entry.TargetUserID,
+		TargetUserName: entry.TargetUserName,
+		ReportsCount:   entry.ReportsCount,
+		Comment:        entry.Comment,
+		CreatedAt:      entry.CreatedAt,
+	}
+	if entry.SuspendedUntil != nil {
+		res.SuspendedUntil.SetTo(*entry.SuspendedUntil)
+	}
+	return res
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package notifications
+
+import (
+	"context"
+	"errors"
+
+	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/authctx"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	notificationsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/notifications"
+)
+
+type NotificationsHandler struct {
+	notificationsService *notificationsService.NotificationsService
+}
+
+func NewNotificationsHandler(notificationsService *notificationsService.NotificationsService) *NotificationsHandler {
+	return &NotificationsHandler{notificationsService: notificationsService}
+}
+
+func (h *NotificationsHandler) NotificationsList(
+	ctx context.Context, params forumApi.NotificationsListParams) (forumApi.NotificationsListRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.NotificationsListUnauthorized("not authenticated")
+		return &res, nil
+	}
+	list, err := h.notificationsService.List(ctx, userId, params.UnreadOnly.Or(false),
+		params.Before.Or(0), params.Limit.Or(notificationsService.DefaultLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]forumApi.Notification, len(list.Notifications))
+	for i, n := range list.Notifications {
+		item := forumApi.Notification{
+			ID:        n.ID,
+			Type:      forumApi.NotificationType(n.Type),
+			IsRead:    n.IsRead,
+			CreatedAt: n.CreatedAt,
+		}
+		if n.ActorID != nil {
+			item.ActorID.SetTo(*n.ActorID)
+			item.ActorName.SetTo(n.ActorName)
+		}
+		if n.ThreadID != nil {
+			item.ThreadID.SetTo(*n.ThreadID)
+		}
+		if n.PostID != nil {
+			item.PostID.SetTo(*n.PostID)
+		}
+		if n.VoteValue != 0 {
+			item.VoteValue.SetTo(n.VoteValue)
+		}
+		if n.Message != "" {
+			item.Message.SetTo(n.Message)
+		}
+		notifications[i] = item
+	}
+	return &forumApi.NotificationListResponse{
+		Notifications: notifications,
+		HaveNext:      list.HaveNext,
+	}, nil
+}
+
+func (h *NotificationsHandler) NotificationsUnreadCount(ctx context.Context) (forumApi.NotificationsUnreadCountRes, error) {
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.NotificationsUnreadCountUnauthorized("not authenticated")
+		return &res, nil
+	}
+	count, err := h.notificationsService.UnreadCount(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	return &forumApi.NotificationUnreadCount{Count: count}, nil
+}
+
+func (h *NotificationsHandler) NotificationsMarkRead(
+	ctx context.Context, req *forumApi.NotificationMarkReadRequest) (forumApi.NotificationsMarkReadRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.NotificationsMarkReadUnauthorized("not authenticated")
+		return &res, nil
+	}
+	if err := h.notificationsService.MarkRead(ctx, userId, req.Ids); err != nil {
+		return nil, err
+	}
+	return &forumApi.NotificationsMarkReadNoContent{}, nil
+}
+
+func (h *NotificationsHandler) NotificationsReadAll(ctx context.Context) (forumApi.NotificationsReadAllRes, error) {
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.NotificationsReadAllUnauthorized("not authenticated")
+		return &res, nil
+	}
+	if err := h.notificationsService.MarkAllRead(ctx, userId); err != nil {
+		return nil, err
+	}
+	return &forumApi.NotificationsReadAllNoContent{}, nil
+}
+
+func (h *NotificationsHandler) NotificationPreferencesGet(ctx context.Context) (forumApi.NotificationPreferencesGetRes, error) {
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.NotificationPreferencesGetUnauthorized("not authenticated")
+		return &res, nil
+	}
+	preferences, err := h.notificationsService.Preferences(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	res := forumApi.NotificationPreferencesGetOKApplicationJSON(convertPreferences(preferences))
+	return &res, nil
+}
+
+func (h *NotificationsHandler) NotificationPreferencesUpdate(
+	ctx context.Context, req []forumApi.NotificationPreference) (forumApi.NotificationPreferencesUpdateRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.NotificationPreferencesUpdateUnauthorized("not authenticated")
+		return &res, nil
+	}
+	changes := make([]model.NotificationPreference, len(req))
+	for i, preference := range req {
+		changes[i] = model.NotificationPreference{Type: string(preference.Type), Enabled: preference.Enabled}
+	}
+	preferences, err := h.notificationsService.SetPreferences(ctx, userId, changes)
+	if errors.Is(err, notificationsService.ErrUnknownType) {
+		res := forumApi.NotificationPreferencesUpdateBadRequest(err.Error())
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := forumApi.NotificationPreferencesUpdateOKApplicationJSON(convertPreferences(preferences))
+	return &res, nil
+}
+
+func convertPreferences(preferences []model.NotificationPreference) []forumApi.NotificationPreference {
+	res := make([]forumApi.NotificationPreference, len(preferences))
+	for i, preference := range preferences {
+		res[i] = forumApi.NotificationPreference{
+			Type:    forumApi.NotificationType(preference.Type),
+			Enabled: preference.Enabled,
+		}
+	}
+	return res
+}
@@ -5,32 +5,142 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/analytics"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/attachments"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/bookmarks"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/follows"
 	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/mentions"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/messages"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/moderation"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/notifications"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/polls"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/relations"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/revisions"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/search"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/subscriptions"
 	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/threads"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/votes"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/authctx"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/markdown"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/jwt"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/live"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/reputation"
+	"github.com/ogen-go/ogen/ogenerrors"
 
+	analyticsHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/analytics"
+	attachmentsHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/attachments"
+	bookmarksHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/bookmarks"
+	followsHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/follows"
+	mentionsHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/mentions"
+	messagesHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/messages"
+	moderationHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/moderation"
+	notificationsHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/notifications"
+	pollsHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/polls"
+	relationsHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/relations"
+	revisionsHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/revisions"
+	searchHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/search"
+	subscriptionsHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/subscriptions"
 	threadsHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/threads"
+	votesHandler "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/votes"
+	bookmarksRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/bookmarks"
+	followsRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/follows"
+	mentionsRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/mentions"
+	messagesRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/messages"
+	moderationRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/moderation"
+	notificationsRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/notifications"
 	postsRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/posts"
+	relationsRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/relations"
+	revisionsRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/revisions"
+	searchRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/search"
+	subscriptionsRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/subscriptions"
 	threadsRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/threads"
 	userRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/user"
+	votesRepo "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository/votes"
+	analyticsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/analytics"
+	attachmentsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/attachments"
+	bookmarksService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/bookmarks"
+	filterService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/filter"
+	floodService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/flood"
+	followsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/follows"
+	mentionsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/mentions"
+	messagesService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/messages"
+	moderationService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/moderation"
+	notificationsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/notifications"
+	pollsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/polls"
+	relationsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/relations"
+	revisionsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/revisions"
+	searchService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/search"
+	subscriptionsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/subscriptions"
 	threadsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/threads"
+	userService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/user"
+	votesService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/votes"
 )
 
 // OgenHandler implements forumApi.Handler.
 type OgenHandler struct {
-	threadsHandler *threads.ThreadsHandler
+	threadsHandler       *threads.ThreadsHandler
+	searchHandler        *search.SearchHandler
+	votesHandler         *votes.VotesHandler
+	bookmarksHandler     *bookmarks.BookmarksHandler
+	notificationsHandler *notifications.NotificationsHandler
+	mentionsHandler      *mentions.MentionsHandler
+	attachmentsHandler   *attachments.AttachmentsHandler
+	subscriptionsHandler *subscriptions.SubscriptionsHandler
+	revisionsHandler     *revisions.RevisionsHandler
+	pollsHandler         *polls.PollsHandler
+	moderationHandler    *moderation.ModerationHandler
+	relationsHandler     *relations.RelationsHandler
+	messagesHandler      *messages.MessagesHandler
+	followsHandler       *follows.FollowsHandler
+	analyticsHandler     *analytics.AnalyticsHandler
 	forumApi.UnimplementedHandler
 }
 
-func NewOgenHandler(threadsHandler *threads.ThreadsHandler) *OgenHandler {
+func NewOgenHandler(
+	threadsHandler *threads.ThreadsHandler,
+	searchHandler *search.SearchHandler,
+	votesHandler *votes.VotesHandler,
+	bookmarksHandler *bookmarks.BookmarksHandler,
+	notificationsHandler *notifications.NotificationsHandler,
+	mentionsHandler *mentions.MentionsHandler,
+	attachmentsHandler *attachments.AttachmentsHandler,
+	subscriptionsHandler *subscriptions.SubscriptionsHandler,
+	revisionsHandler *revisions.RevisionsHandler,
+	pollsHandler *polls.PollsHandler,
+	moderationHandler *moderation.ModerationHandler,
+	relationsHandler *relations.RelationsHandler,
+	messagesHandler *messages.MessagesHandler,
+	followsHandler *follows.FollowsHandler,
+	analyticsHandler *analytics.AnalyticsHandler) *OgenHandler {
+
 	return &OgenHandler{
-		threadsHandler: threadsHandler,
+		threadsHandler:       threadsHandler,
+		searchHandler:        searchHandler,
+		votesHandler:         votesHandler,
+		bookmarksHandler:     bookmarksHandler,
+		notificationsHandler: notificationsHandler,
+		mentionsHandler:      mentionsHandler,
+		attachmentsHandler:   attachmentsHandler,
+		subscriptionsHandler: subscriptionsHandler,
+		revisionsHandler:     revisionsHandler,
+		pollsHandler:         pollsHandler,
+		moderationHandler:    moderationHandler,
+		relationsHandler:     relationsHandler,
+		messagesHandler:      messagesHandler,
+		followsHandler:       followsHandler,
+		analyticsHandler:     analyticsHandler,
 	}
 }
 
 type securityHandler struct {
+	jwtService  *jwt.JwtAuthorizator
+	userService *userService.UserService
 }
 
 func (h *securityHandler) HandleCookieAuth(
@@ -42,11 +152,29 @@ func (h *securityHandler) HandleCookieAuth(
 func (h *securityHandler) HandleJwtAuth(
 	ctx context.Context, operationName forumApi.OperationName, t forumApi.JwtAuth) (context.Context, error) {
 
-	fmt.Printf("JWT Auth with operation name %s and token %s\n", operationName, t.Token)
-	return ctx, nil
+	claims, err := h.jwtService.ValidateToken(t.Token)
+	if err != nil {
+		return ctx, fmt.Errorf("invalid access token: %w", err)
+	}
+	h.userService.Seen(ctx, int(claims.UserID))
+	return authctx.WithUserID(ctx, int(claims.UserID)), nil
 }
 
-func RegisterOgenRoutes(mux *http.ServeMux, dsn string, userR *userRepo.UserRepo) {
+func RegisterOgenRoutes(
+	mux *http.ServeMux,
+	dsn string,
+	userR *userRepo.UserRepo,
+	userS *userService.UserService,
+	jwtS *jwt.JwtAuthorizator,
+	reputationS *reputation.ReputationService,
+	liveS *live.LiveService,
+	attachmentsS *attachmentsService.AttachmentsService,
+	pollsS *pollsService.PollsService,
+	filterS *filterService.FilterService,
+	floodS *floodService.FloodService,
+	analyticsS *analyticsService.AnalyticsService,
+	influenceS *analyticsService.InfluenceService) {
+
 	postR, err := postsRepo.NewPostsRepo(dsn)
 	if err != nil {
 		panic(err)
@@ -56,15 +184,127 @@ func RegisterOgenRoutes(mux *http.ServeMux, dsn string, userR *userRepo.UserRepo
 		panic(err)
 	}
 
-	threadsS := threadsService.NewThreadsService(threadR, postR, userR)
+	searchR, err := searchRepo.NewSearchRepo(dsn)
+	if err != nil {
+		panic(err)
+	}
+	votesR, err := votesRepo.NewVotesRepo(dsn)
+	if err != nil {
+		panic(err)
+	}
+	bookmarksR, err := bookmarksRepo.NewBookmarksRepo(dsn)
+	if err != nil {
+		panic(err)
+	}
+	notificationsR, err := notificationsRepo.NewNotificationsRepo(dsn)
+	if err != nil {
+		panic(err)
+	}
+	subscriptionsR, err := subscriptionsRepo.NewSubscriptionsRepo(dsn)
+	if err != nil {
+		panic(err)
+	}
+	mentionsR, err := mentionsRepo.NewMentionsRepo(dsn)
+	if err != nil {
+		panic(err)
+	}
+	revisionsR, err := revisionsRepo.NewRevisionsRepo(dsn)
+	if err != nil {
+		panic(err)
+	}
+	moderationR, err := moderationRepo.NewModerationRepo(dsn)
+	if err != nil {
+		panic(err)
+	}
+	relationsR, err := relationsRepo.NewRelationsRepo(dsn)
+	if err != nil {
+		panic(err)
+	}
+	messagesR, err := messagesRepo.NewMessagesRepo(dsn)
+	if err != nil {
+		panic(err)
+	}
+	followsR, err := followsRepo.NewFollowsRepo(dsn)
+	if err != nil {
+		panic(err)
+	}
+
+	notificationsS := notificationsService.NewNotificationsService(notificationsR)
+	notificationsH := notificationsHandler.NewNotificationsHandler(notificationsS)
+	mentionsS := mentionsService.NewMentionsService(mentionsR, userR)
+	mentionsH := mentionsHandler.NewMentionsHandler(mentionsS)
+	renderer := markdown.NewRenderer()
+	attachmentsH := attachmentsHandler.NewAttachmentsHandler(attachmentsS)
+	subscriptionsS := subscriptionsService.NewSubscriptionsService(subscriptionsR, userR)
+	subscriptionsH := subscriptionsHandler.NewSubscriptionsHandler(subscriptionsS)
+	pollsH := pollsHandler.NewPollsHandler(pollsS)
+	relationsS := relationsService.NewRelationsService(relationsR, userR)
+	relationsH := relationsHandler.NewRelationsHandler(relationsS)
+	analyticsH := analyticsHandler.NewAnalyticsHandler(analyticsS, influenceS)
+	threadsS := threadsService.NewThreadsService(threadR, postR, userR, bookmarksR, mentionsS, attachmentsS, pollsS,
+		subscriptionsS, filterS, floodS, relationsS, renderer, reputationS, analyticsS, notificationsS, liveS)
 	threadsH := threadsHandler.NewThreadsHandler(threadsS)
-	ogenHandler := NewOgenHandler(threadsH)
-	secHandler := &securityHandler{}
-	srv, err := forumApi.NewServer(ogenHandler, secHandler)
+	searchS := searchService.NewSearchService(searchR, userR)
+	searchH := searchHandler.NewSearchHandler(searchS)
+	votesS := votesService.NewVotesService(votesR, reputationS, analyticsS, notificationsS, liveS)
+	votesH := votesHandler.NewVotesHandler(votesS)
+	bookmarksS := bookmarksService.NewBookmarksService(bookmarksR, userR)
+	bookmarksH := bookmarksHandler.NewBookmarksHandler(bookmarksS)
+	revisionsS := revisionsService.NewRevisionsService(revisionsR, threadR, postR, userR, renderer, filterS, liveS)
+	revisionsH := revisionsHandler.NewRevisionsHandler(revisionsS)
+	moderationS := moderationService.NewModerationService(
+		moderationR, userR, notificationsS, filterS, liveS, threadsS, revisionsS)
+	moderationH := moderationHandler.NewModerationHandler(moderationS)
+	messagesS := messagesService.NewMessagesService(messagesR, userR, floodS, relationsS, renderer)
+	messagesH := messagesHandler.NewMessagesHandler(messagesS)
+	followsS := followsService.NewFollowsService(followsR, relationsS)
+	followsH := followsHandler.NewFollowsHandler(followsS)
+	ogenHandler := NewOgenHandler(threadsH, searchH, votesH, bookmarksH, notificationsH, mentionsH, attachmentsH,
+		subscriptionsH, revisionsH, pollsH, moderationH, relationsH, messagesH, followsH,
+		analyticsH)
+	secHandler := &securityHandler{jwtService: jwtS, userService: userS}
+	srv, err := forumApi.NewServer(ogenHandler, secHandler, forumApi.WithErrorHandler(errorHandler))
 	if err != nil {
 		panic(err)
 	}
+	mux.Handle("/api/threads", srv)
 	mux.Handle("/api/threads/", srv)
+	mux.Handle("/api/posts/", srv)
+	mux.Handle("GET /api/search", srv)
+	mux.Handle("/api/bookmarks", srv)
+	mux.Handle("/api/bookmarks/", srv)
+	mux.Handle("GET /api/subscriptions", srv)
+	mux.Handle("/api/notifications", srv)
+	mux.Handle("/api/notifications/", srv)
+	mux.Handle("GET /api/mentions/users", srv)
+	mux.Handle("POST /api/reports", srv)
+	mux.Handle("/api/moderation/", srv)
+	mux.Handle("/api/relations", srv)
+	mux.Handle("/api/relations/", srv)
+	mux.Handle("/api/conversations", srv)
+	mux.Handle("/api/conversations/", srv)
+	mux.Handle("PUT /api/user/{userId}/follow", srv)
+	mux.Handle("DELETE /api/user/{userId}/follow", srv)
+	mux.Handle("GET /api/user/{userId}/followers", srv)
+	mux.Handle("GET /api/user/{userId}/following", srv)
+	mux.Handle("/api/communities/", srv)
+	mux.Handle("GET /api/feed", srv)
+	mux.Handle("/api/analytics/", srv)
+	// multipart form is parsed by generated server, body limit leaves room for form headers
+	mux.Handle("POST /api/attachments", http.MaxBytesHandler(srv, attachmentsS.MaxSize()+64<<10))
+	mux.Handle("GET /api/attachments/{attachmentId}", srv)
+}
+
+// errorHandler reports too large request body as 413, other errors as generated server does
+func errorHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		_ = json.NewEncoder(w).Encode(fmt.Sprintf("request body is larger than %d bytes", maxBytesErr.Limit))
+		return
+	}
+	ogenerrors.DefaultErrorHandler(ctx, w, r, err)
 }
 
 func (h *OgenHandler) ThreadAddPost(ctx context.Context, req *forumApi.ThreadCreatePostRequest, params forumApi.ThreadAddPostParams) (forumApi.ThreadAddPostRes, error) {
@@ -79,6 +319,254 @@ func (h *OgenHandler) ThreadGet(ctx context.Context, params forumApi.ThreadGetPa
 	return h.threadsHandler.ThreadGet(ctx, params)
 }
 
+func (h *OgenHandler) ThreadAcceptAnswer(ctx context.Context, req *forumApi.ThreadAcceptAnswerRequest, params forumApi.ThreadAcceptAnswerParams) (forumApi.ThreadAcceptAnswerRes, error) {
+	return h.threadsHandler.ThreadAcceptAnswer(ctx, req, params)
+}
+
 func (h *OgenHandler) ThreadsList(ctx context.Context, params forumApi.ThreadsListParams) (forumApi.ThreadsListRes, error) {
 	return h.threadsHandler.ThreadsList(ctx, params)
 }
+
+func (h *OgenHandler) Search(ctx context.Context, params forumApi.SearchParams) (forumApi.SearchRes, error) {
+	return h.searchHandler.Search(ctx, params)
+}
+
+func (h *OgenHandler) ThreadVote(ctx context.Context, req *forumApi.VoteRequest, params forumApi.ThreadVoteParams) (forumApi.ThreadVoteRes, error) {
+	return h.votesHandler.ThreadVote(ctx, req, params)
+}
+
+func (h *OgenHandler) PostVote(ctx context.Context, req *forumApi.VoteRequest, params forumApi.PostVoteParams) (forumApi.PostVoteRes, error) {
+	return h.votesHandler.PostVote(ctx, req, params)
+}
+
+func (h *OgenHandler) BookmarksList(ctx context.Context, params forumApi.BookmarksListParams) (forumApi.BookmarksListRes, error) {
+	return h.bookmarksHandler.BookmarksList(ctx, params)
+}
+
+func (h *OgenHandler) BookmarkCreate(ctx context.Context, req *forumApi.BookmarkCreateRequest) (forumApi.BookmarkCreateRes, error) {
+	return h.bookmarksHandler.BookmarkCreate(ctx, req)
+}
+
+func (h *OgenHandler) BookmarkDelete(ctx context.Context, params forumApi.BookmarkDeleteParams) (forumApi.BookmarkDeleteRes, error) {
+	return h.bookmarksHandler.BookmarkDelete(ctx, params)
+}
+
+func (h *OgenHandler) BookmarkCollectionsList(ctx context.Context) (forumApi.BookmarkCollectionsListRes, error) {
+	return h.bookmarksHandler.BookmarkCollectionsList(ctx)
+}
+
+func (h *OgenHandler) BookmarkCollectionCreate(ctx context.Context, req *forumApi.BookmarkCollectionCreateRequest) (forumApi.BookmarkCollectionCreateRes, error) {
+	return h.bookmarksHandler.BookmarkCollectionCreate(ctx, req)
+}
+
+func (h *OgenHandler) BookmarkCollectionDelete(ctx context.Context, params forumApi.BookmarkCollectionDeleteParams) (forumApi.BookmarkCollectionDeleteRes, error) {
+	return h.bookmarksHandler.BookmarkCollectionDelete(ctx, params)
+}
+
+func (h *OgenHandler) NotificationsList(ctx context.Context, params forumApi.NotificationsListParams) (forumApi.NotificationsListRes, error) {
+	return h.notificationsHandler.NotificationsList(ctx, params)
+}
+
+func (h *OgenHandler) NotificationsUnreadCount(ctx context.Context) (forumApi.NotificationsUnreadCountRes, error) {
+	return h.notificationsHandler.NotificationsUnreadCount(ctx)
+}
+
+func (h *OgenHandler) NotificationsMarkRead(ctx context.Context, req *forumApi.NotificationMarkReadRequest) (forumApi.NotificationsMarkReadRes, error) {
+	return h.notificationsHandler.NotificationsMarkRead(ctx, req)
+}
+
+func (h *OgenHandler) NotificationsReadAll(ctx context.Context) (forumApi.NotificationsReadAllRes, error) {
+	return h.notificationsHandler.NotificationsReadAll(ctx)
+}
+
+func (h *OgenHandler) NotificationPreferencesGet(ctx context.Context) (forumApi.NotificationPreferencesGetRes, error) {
+	return h.notificationsHandler.NotificationPreferencesGet(ctx)
+}
+
+func (h *OgenHandler) NotificationPreferencesUpdate(ctx context.Context, req []forumApi.NotificationPreference) (forumApi.NotificationPreferencesUpdateRes, error) {
+	return h.notificationsHandler.NotificationPreferencesUpdate(ctx, req)
+}
+
+func (h *OgenHandler) MentionUsers(ctx context.Context, params forumApi.MentionUsersParams) (forumApi.MentionUsersRes, error) {
+	return h.mentionsHandler.MentionUsers(ctx, params)
+}
+
+func (h *OgenHandler) AttachmentUpload(ctx context.Context, req *forumApi.AttachmentUploadRequestMultipart) (forumApi.AttachmentUploadRes, error) {
+	return h.attachmentsHandler.AttachmentUpload(ctx, req)
+}
+
+func (h *OgenHandler) AttachmentGet(ctx context.Context, params forumApi.AttachmentGetParams) (forumApi.AttachmentGetRes, error) {
+	return h.attachmentsHandler.AttachmentGet(ctx, params)
+}
+
+func (h *OgenHandler) ThreadSubscriptionGet(ctx context.Context, params forumApi.ThreadSubscriptionGetParams) (forumApi.ThreadSubscriptionGetRes, error) {
+	return h.subscriptionsHandler.ThreadSubscriptionGet(ctx, params)
+}
+
+func (h *OgenHandler) ThreadSubscribe(ctx context.Context, req *forumApi.ThreadSubscriptionRequest, params forumApi.ThreadSubscribeParams) (forumApi.ThreadSubscribeRes, error) {
+	return h.subscriptionsHandler.ThreadSubscribe(ctx, req, params)
+}
+
+func (h *OgenHandler) ThreadUnsubscribe(ctx context.Context, params forumApi.ThreadUnsubscribeParams) (forumApi.ThreadUnsubscribeRes, error) {
+	return h.subscriptionsHandler.ThreadUnsubscribe(ctx, params)
+}
+
+func (h *OgenHandler) SubscriptionsList(ctx context.Context, params forumApi.SubscriptionsListParams) (forumApi.SubscriptionsListRes, error) {
+	return h.subscriptionsHandler.SubscriptionsList(ctx, params)
+}
+
+func (h *OgenHandler) ThreadEdit(ctx context.Context, req *forumApi.ThreadEditRequest, params forumApi.ThreadEditParams) (forumApi.ThreadEditRes, error) {
+	return h.revisionsHandler.ThreadEdit(ctx, req, params)
+}
+
+func (h *OgenHandler) PostEdit(ctx context.Context, req *forumApi.PostEditRequest, params forumApi.PostEditParams) (forumApi.PostEditRes, error) {
+	return h.revisionsHandler.PostEdit(ctx, req, params)
+}
+
+func (h *OgenHandler) ThreadRevisions(ctx context.Context, params forumApi.ThreadRevisionsParams) (forumApi.ThreadRevisionsRes, error) {
+	return h.revisionsHandler.ThreadRevisions(ctx, params)
+}
+
+func (h *OgenHandler) PostRevisions(ctx context.Context, params forumApi.PostRevisionsParams) (forumApi.PostRevisionsRes, error) {
+	return h.revisionsHandler.PostRevisions(ctx, params)
+}
+
+func (h *OgenHandler) ThreadRevisionsDiff(ctx context.Context, params forumApi.ThreadRevisionsDiffParams) (forumApi.ThreadRevisionsDiffRes, error) {
+	return h.revisionsHandler.ThreadRevisionsDiff(ctx, params)
+}
+
+func (h *OgenHandler) PostRevisionsDiff(ctx context.Context, params forumApi.PostRevisionsDiffParams) (forumApi.PostRevisionsDiffRes, error) {
+	return h.revisionsHandler.PostRevisionsDiff(ctx, params)
+}
+
+func (h *OgenHandler) ThreadRevisionRestore(ctx context.Context, params forumApi.ThreadRevisionRestoreParams) (forumApi.ThreadRevisionRestoreRes, error) {
+	return h.revisionsHandler.ThreadRevisionRestore(ctx, params)
+}
+
+func (h *OgenHandler) PostRevisionRestore(ctx context.Context, params forumApi.PostRevisionRestoreParams) (forumApi.PostRevisionRestoreRes, error) {
+	return h.revisionsHandler.PostRevisionRestore(ctx, params)
+}
+
+func (h *OgenHandler) PollVote(ctx context.Context, req *forumApi.PollVoteRequest, params forumApi.PollVoteParams) (forumApi.PollVoteRes, error) {
+	return h.pollsHandler.PollVote(ctx, req, params)
+}
+
+func (h *OgenHandler) ThreadSetState(ctx context.Context, req *forumApi.ThreadStateRequest, params forumApi.ThreadSetStateParams) (forumApi.ThreadSetStateRes, error) {
+	return h.threadsHandler.ThreadSetState(ctx, req, params)
+}
+
+func (h *OgenHandler) ReportCreate(ctx context.Context, req *forumApi.ReportRequest) (forumApi.ReportCreateRes, error) {
+	return h.moderationHandler.ReportCreate(ctx, req)
+}
+
+func (h *OgenHandler) ModerationQueue(ctx context.Context, params forumApi.ModerationQueueParams) (forumApi.ModerationQueueRes, error) {
+	return h.moderationHandler.ModerationQueue(ctx, params)
+}
+
+func (h *OgenHandler) ModerationResolve(ctx context.Context, req *forumApi.ModerationResolveRequest, params forumApi.ModerationResolveParams) (forumApi.ModerationResolveRes, error) {
+	return h.moderationHandler.ModerationResolve(ctx, req, params)
+}
+
+func (h *OgenHandler) ModerationLog(ctx context.Context, params forumApi.ModerationLogParams) (forumApi.ModerationLogRes, error) {
+	return h.moderationHandler.ModerationLog(ctx, params)
+}
+
+func (h *OgenHandler) ModerationHeld(ctx context.Context, params forumApi.ModerationHeldParams) (forumApi.ModerationHeldRes, error) {
+	return h.moderationHandler.ModerationHeld(ctx, params)
+}
+
+func (h *OgenHandler) ModerationDecideHeld(ctx context.Context, req *forumApi.HeldDecisionRequest, params forumApi.ModerationDecideHeldParams) (forumApi.ModerationDecideHeldRes, error) {
+	return h.moderationHandler.ModerationDecideHeld(ctx, req, params)
+}
+
+func (h *OgenHandler) RelationsList(ctx context.Context, params forumApi.RelationsListParams) (forumApi.RelationsListRes, error) {
+	return h.relationsHandler.RelationsList(ctx, params)
+}
+
+func (h *OgenHandler) RelationSet(ctx context.Context, req *forumApi.RelationSetRequest, params forumApi.RelationSetParams) (forumApi.RelationSetRes, error) {
+	return h.relationsHandler.RelationSet(ctx, req, params)
+}
+
+func (h *OgenHandler) RelationDelete(ctx context.Context, params forumApi.RelationDeleteParams) (forumApi.RelationDeleteRes, error) {
+	return h.relationsHandler.RelationDelete(ctx, params)
+}
+
+func (h *OgenHandler) ConversationsList(ctx context.Context, params forumApi.ConversationsListParams) (forumApi.ConversationsListRes, error) {
+	return h.messagesHandler.ConversationsList(ctx, params)
+}
+
+func (h *OgenHandler) ConversationCreate(ctx context.Context, req *forumApi.ConversationCreateRequest) (forumApi.ConversationCreateRes, error) {
+	return h.messagesHandler.ConversationCreate(ctx, req)
+}
+
+func (h *OgenHandler) ConversationsUnreadCount(ctx context.Context) (forumApi.ConversationsUnreadCountRes, error) {
+	return h.messagesHandler.ConversationsUnreadCount(ctx)
+}
+
+func (h *OgenHandler) ConversationMessages(ctx context.Context, params forumApi.ConversationMessagesParams) (forumApi.ConversationMessagesRes, error) {
+	return h.messagesHandler.ConversationMessages(ctx, params)
+}
+
+func (h *OgenHandler) ConversationSend(ctx context.Context, req *forumApi.MessageSendRequest, params forumApi.ConversationSendParams) (forumApi.ConversationSendRes, error) {
+	return h.messagesHandler.ConversationSend(ctx, req, params)
+}
+
+func (h *OgenHandler) ConversationMarkRead(ctx context.Context, req forumApi.OptConversationMarkReadRequest, params forumApi.ConversationMarkReadParams) (forumApi.ConversationMarkReadRes, error) {
+	return h.messagesHandler.ConversationMarkRead(ctx, req, params)
+}
+
+func (h *OgenHandler) UserFollow(ctx context.Context, params forumApi.UserFollowParams) (forumApi.UserFollowRes, error) {
+	return h.followsHandler.UserFollow(ctx, params)
+}
+
+func (h *OgenHandler) UserUnfollow(ctx context.Context, params forumApi.UserUnfollowParams) (forumApi.UserUnfollowRes, error) {
+	return h.followsHandler.UserUnfollow(ctx, params)
+}
+
+func (h *OgenHandler) UserFollowers(ctx context.Context, params forumApi.UserFollowersParams) (forumApi.UserFollowersRes, error) {
+	return h.followsHandler.UserFollowers(ctx, params)
+}
+
+func (h *OgenHandler) UserFollowing(ctx context.Context, params forumApi.UserFollowingParams) (forumApi.UserFollowingRes, error) {
+	return h.followsHandler.UserFollowing(ctx, params)
+}
+
+func (h *OgenHandler) CommunitySubscriptionsList(ctx context.Context) (forumApi.CommunitySubscriptionsListRes, error) {
+	return h.followsHandler.CommunitySubscriptionsList(ctx)
+}
+
+func (h *OgenHandler) CommunitySubscribe(ctx context.Context, params forumApi.CommunitySubscribeParams) (forumApi.CommunitySubscribeRes, error) {
+	return h.followsHandler.CommunitySubscribe(ctx, params)
+}
+
+func (h *OgenHandler) CommunityUnsubscribe(ctx context.Context, params forumApi.CommunityUnsubscribeParams) (forumApi.CommunityUnsubscribeRes, error) {
+	return h.followsHandler.CommunityUnsubscribe(ctx, params)
+}
+
+func (h *OgenHandler) FeedGet(ctx context.Context, params forumApi.FeedGetParams) (forumApi.FeedGetRes, error) {
+	return h.followsHandler.FeedGet(ctx, params)
+}
+
+func (h *OgenHandler) AnalyticsGraph(ctx context.Context, params forumApi.AnalyticsGraphParams) (forumApi.AnalyticsGraphRes, error) {
+	return h.analyticsHandler.AnalyticsGraph(ctx, params)
+}
+
+func (h *OgenHandler) AnalyticsGraphExport(ctx context.Context, params forumApi.AnalyticsGraphExportParams) (forumApi.AnalyticsGraphExportRes, error) {
+	return h.analyticsHandler.AnalyticsGraphExport(ctx, params)
+}
+
+func (h *OgenHandler) AnalyticsGraphRebuild(ctx context.Context) (forumApi.AnalyticsGraphRebuildRes, error) {
+	return h.analyticsHandler.AnalyticsGraphRebuild(ctx)
+}
+
+func (h *OgenHandler) AnalyticsLeaderboard(ctx context.Context, params forumApi.AnalyticsLeaderboardParams) (forumApi.AnalyticsLeaderboardRes, error) {
+	return h.analyticsHandler.AnalyticsLeaderboard(ctx, params)
+}
+
+func (h *OgenHandler) AnalyticsUserInfluence(ctx context.Context, params forumApi.AnalyticsUserInfluenceParams) (forumApi.AnalyticsUserInfluenceRes, error) {
+	return h.analyticsHandler.AnalyticsUserInfluence(ctx, params)
+}
+
+func (h *OgenHandler) AnalyticsInfluenceSnapshot(ctx context.Context) (forumApi.AnalyticsInfluenceSnapshotRes, error) {
+	return h.analyticsHandler.AnalyticsInfluenceSnapshot(ctx)
+}
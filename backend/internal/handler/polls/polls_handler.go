@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package polls
+
+import (
+	"context"
+	"errors"
+
+	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/authctx"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	pollsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/polls"
+)
+
+type PollsHandler struct {
+	pollsService *pollsService.PollsService
+}
+
+func NewPollsHandler(pollsService *pollsService.PollsService) *PollsHandler {
+	return &PollsHandler{pollsService: pollsService}
+}
+
+func (h *PollsHandler) PollVote(ctx context.Context,
+	req *forumApi.PollVoteRequest, params forumApi.PollVoteParams) (forumApi.PollVoteRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.PollVoteUnauthorized("not authenticated")
+		return &res, nil
+	}
+	poll, err := h.pollsService.Vote(ctx, userId, params.ThreadId, req.OptionIds)
+	switch {
+	case err == nil:
+		res := ConvertPoll(poll)
+		return &res, nil
+	case errors.Is(err, pollsService.ErrPollClosed),
+		errors.Is(err, pollsService.ErrInvalidChoice),
+		errors.Is(err, pollsService.ErrSingleChoice):
+		res := forumApi.PollVoteBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrThreadArchived):
+		res := forumApi.PollVoteForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, pollsService.ErrAlreadyVoted):
+		res := forumApi.PollVoteConflict(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.PollVoteNotFound("thread has no poll")
+		return &res, nil
+	}
+	return nil, err
+}
+
+// ConvertPoll converts poll for api, results are omitted if they are not visible to user
+func ConvertPoll(poll model.PollView) forumApi.Poll {
+	res := forumApi.Poll{
+		ID:             poll.ID,
+		Question:       poll.Question,
+		Multiple:       poll.Multiple,
+		Anonymous:      poll.Anonymous,
+		IsClosed:       poll.IsClosed,
+		VotersCount:    poll.VotersCount,
+		ResultsVisible: poll.ResultsVisible,
+		VotedOptionIds: make([]int, len(poll.VotedOptionIDs)),
+		Options:        make([]forumApi.PollOption, len(poll.Options)),
+		CreatedAt:      poll.CreatedAt,
+	}
+	copy(res.VotedOptionIds, poll.VotedOptionIDs)
+	if poll.ClosesAt != nil {
+		res.ClosesAt.SetTo(*poll.ClosesAt)
+	}
+	for i, option := range poll.Options {
+		res.Options[i] = forumApi.PollOption{
+			ID:   option.ID,
+			Text: option.Text,
+		}
+		if !poll.ResultsVisible {
+			continue
+		}
+		res.Options[i].VotesCount.SetTo(option.VotesCount)
+		if !poll.Anonymous {
+			voters := make([]forumApi.PollVoter, len(option.Voters))
+			for j, voter := range option.Voters {
+				voters[j] = forumApi.PollVoter{ID: voter.UserID, Name: voter.Name}
+			}
+			res.Options[i].Voters = voters
+		}
+	}
+	return res
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package relations
+
+import (
+	"context"
+	"errors"
+
+	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/authctx"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	relationsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/relations"
+)
+
+type RelationsHandler struct {
+	relationsService *relationsService.RelationsService
+}
+
+func NewRelationsHandler(relationsService *relationsService.RelationsService) *RelationsHandler {
+	return &RelationsHandler{relationsService: relationsService}
+}
+
+func (h *RelationsHandler) RelationsList(
+	ctx context.Context, params forumApi.RelationsListParams) (forumApi.RelationsListRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.RelationsListUnauthorized("not authenticated")
+		return &res, nil
+	}
+	relations, err := h.relationsService.List(ctx, userId, string(params.Kind.Or("")))
+	if errors.Is(err, relationsService.ErrUnknownKind) {
+		res := forumApi.RelationsListBadRequest(err.Error())
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := &forumApi.RelationListResponse{Relations: make([]forumApi.UserRelation, len(relations))}
+	for i, relation := range relations {
+		res.Relations[i] = convertRelation(relation)
+	}
+	return res, nil
+}
+
+func (h *RelationsHandler) RelationSet(ctx context.Context,
+	req *forumApi.RelationSetRequest, params forumApi.RelationSetParams) (forumApi.RelationSetRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.RelationSetUnauthorized("not authenticated")
+		return &res, nil
+	}
+	relation, err := h.relationsService.Set(ctx, userId, params.UserId, string(req.Kind))
+	switch {
+	case err == nil:
+		res := convertRelation(relation)
+		return &res, nil
+	case errors.Is(err, relationsService.ErrUnknownKind), errors.Is(err, relationsService.ErrRelateSelf):
+		res := forumApi.RelationSetBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, relationsService.ErrBlockModerator):
+		res := forumApi.RelationSetForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.RelationSetNotFound("user not found")
+		return &res, nil
+	}
+	return nil, err
+}
+
+func (h *RelationsHandler) RelationDelete(
+	ctx context.Context, params forumApi.RelationDeleteParams) (forumApi.RelationDeleteRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.RelationDeleteUnauthorized("not authenticated")
+		return &res, nil
+	}
+	err := h.relationsService.Delete(ctx, userId, params.UserId)
+	if errors.Is(err, model.ErrNotFound) {
+		res := forumApi.RelationDeleteNotFound("user is not blocked or ignored")
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &forumApi.RelationDeleteNoContent{}, nil
+}
+
+func convertRelation(relation model.UserRelation) forumApi.UserRelation {
+	return forumApi.UserRelation{
+		UserID:    relation.TargetID,
+		UserName:  relation.TargetName,
+		Kind:      forumApi.RelationKind(relation.Kind),
+		CreatedAt: relation.CreatedAt,
+	}
+}
@@ -0,0 +1,266 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package revisions
+
+import (
+	"context"
+	"errors"
+
+	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/authctx"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	revisionsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/revisions"
+)
+
+type RevisionsHandler struct {
+	revisionsService *revisionsService.RevisionsService
+}
+
+func NewRevisionsHandler(revisionsService *revisionsService.RevisionsService) *RevisionsHandler {
+	return &RevisionsHandler{revisionsService: revisionsService}
+}
+
+func (h *RevisionsHandler) ThreadEdit(ctx context.Context,
+	req *forumApi.ThreadEditRequest, params forumApi.ThreadEditParams) (forumApi.ThreadEditRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ThreadEditUnauthorized("not authenticated")
+		return &res, nil
+	}
+	var title, content *string
+	if v, ok := req.Title.Get(); ok {
+		title = &v
+	}
+	if v, ok := req.Content.Get(); ok {
+		content = &v
+	}
+	revision, err := h.revisionsService.EditThread(ctx, userId, params.ThreadId, title, content)
+	switch {
+	case err == nil:
+		res := convertRevision(revision)
+		return &res, nil
+	case errors.Is(err, revisionsService.ErrEmptyContent), errors.Is(err, revisionsService.ErrNotChanged),
+		errors.Is(err, model.ErrContentRejected):
+		res := forumApi.ThreadEditBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, revisionsService.ErrNotEditor), errors.Is(err, model.ErrThreadArchived),
+		errors.Is(err, model.ErrUserSuspended):
+		res := forumApi.ThreadEditForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.ThreadEditNotFound("thread not found")
+		return &res, nil
+	}
+	return nil, err
+}
+
+func (h *RevisionsHandler) PostEdit(ctx context.Context,
+	req *forumApi.PostEditRequest, params forumApi.PostEditParams) (forumApi.PostEditRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.PostEditUnauthorized("not authenticated")
+		return &res, nil
+	}
+	revision, err := h.revisionsService.EditPost(ctx, userId, params.PostId, req.Content)
+	switch {
+	case err == nil:
+		res := convertRevision(revision)
+		return &res, nil
+	case errors.Is(err, revisionsService.ErrEmptyContent), errors.Is(err, revisionsService.ErrNotChanged),
+		errors.Is(err, model.ErrContentRejected):
+		res := forumApi.PostEditBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, revisionsService.ErrNotEditor), errors.Is(err, model.ErrThreadArchived),
+		errors.Is(err, model.ErrUserSuspended):
+		res := forumApi.PostEditForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.PostEditNotFound("post not found")
+		return &res, nil
+	}
+	return nil, err
+}
+
+func (h *RevisionsHandler) ThreadRevisions(
+	ctx context.Context, params forumApi.ThreadRevisionsParams) (forumApi.ThreadRevisionsRes, error) {
+
+	revisions, err := h.revisionsService.List(ctx, model.RevisionTargetThread, params.ThreadId)
+	if errors.Is(err, model.ErrNotFound) {
+		res := forumApi.ThreadRevisionsNotFound("thread not found")
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &forumApi.RevisionListResponse{Revisions: convertRevisions(revisions)}, nil
+}
+
+func (h *RevisionsHandler) PostRevisions(
+	ctx context.Context, params forumApi.PostRevisionsParams) (forumApi.PostRevisionsRes, error) {
+
+	revisions, err := h.revisionsService.List(ctx, model.RevisionTargetPost, params.PostId)
+	if errors.Is(err, model.ErrNotFound) {
+		res := forumApi.PostRevisionsNotFound("post not found")
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &forumApi.RevisionListResponse{Revisions: convertRevisions(revisions)}, nil
+}
+
+func (h *RevisionsHandler) ThreadRevisionsDiff(
+	ctx context.Context, params forumApi.ThreadRevisionsDiffParams) (forumApi.ThreadRevisionsDiffRes, error) {
+
+	diff, err := h.revisionsService.Diff(ctx, model.RevisionTargetThread, params.ThreadId,
+		params.From, params.To, string(params.Mode.Or(forumApi.ThreadRevisionsDiffModeLine)))
+	switch {
+	case err == nil:
+		res := convertDiff(diff)
+		return &res, nil
+	case errors.Is(err, revisionsService.ErrUnknownMode):
+		res := forumApi.ThreadRevisionsDiffBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, revisionsService.ErrNoSuchVersion):
+		res := forumApi.ThreadRevisionsDiffNotFound(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.ThreadRevisionsDiffNotFound("thread not found")
+		return &res, nil
+	}
+	return nil, err
+}
+
+func (h *RevisionsHandler) PostRevisionsDiff(
+	ctx context.Context, params forumApi.PostRevisionsDiffParams) (forumApi.PostRevisionsDiffRes, error) {
+
+	diff, err := h.revisionsService.Diff(ctx, model.RevisionTargetPost, params.PostId,
+		params.From, params.To, string(params.Mode.Or(forumApi.PostRevisionsDiffModeLine)))
+	switch {
+	case err == nil:
+		res := convertDiff(diff)
+		return &res, nil
+	case errors.Is(err, revisionsService.ErrUnknownMode):
+		res := forumApi.PostRevisionsDiffBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, revisionsService.ErrNoSuchVersion):
+		res := forumApi.PostRevisionsDiffNotFound(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.PostRevisionsDiffNotFound("post not found")
+		return &res, nil
+	}
+	return nil, err
+}
+
+func (h *RevisionsHandler) ThreadRevisionRestore(
+	ctx context.Context, params forumApi.ThreadRevisionRestoreParams) (forumApi.ThreadRevisionRestoreRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ThreadRevisionRestoreUnauthorized("not authenticated")
+		return &res, nil
+	}
+	revision, err := h.revisionsService.Restore(ctx, userId, model.RevisionTargetThread, params.ThreadId, params.Revision)
+	switch {
+	case err == nil:
+		res := convertRevision(revision)
+		return &res, nil
+	case errors.Is(err, revisionsService.ErrNotChanged), errors.Is(err, model.ErrContentRejected):
+		res := forumApi.ThreadRevisionRestoreBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, revisionsService.ErrNotModerator), errors.Is(err, model.ErrThreadArchived),
+		errors.Is(err, model.ErrUserSuspended):
+		res := forumApi.ThreadRevisionRestoreForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, revisionsService.ErrNoSuchVersion):
+		res := forumApi.ThreadRevisionRestoreNotFound(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.ThreadRevisionRestoreNotFound("thread not found")
+		return &res, nil
+	}
+	return nil, err
+}
+
+func (h *RevisionsHandler) PostRevisionRestore(
+	ctx context.Context, params forumApi.PostRevisionRestoreParams) (forumApi.PostRevisionRestoreRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.PostRevisionRestoreUnauthorized("not authenticated")
+		return &res, nil
+	}
+	revision, err := h.revisionsService.Restore(ctx, userId, model.RevisionTargetPost, params.PostId, params.Revision)
+	switch {
+	case err == nil:
+		res := convertRevision(revision)
+		return &res, nil
+	case errors.Is(err, revisionsService.ErrNotChanged), errors.Is(err, model.ErrContentRejected):
+		res := forumApi.PostRevisionRestoreBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, revisionsService.ErrNotModerator), errors.Is(err, model.ErrThreadArchived),
+		errors.Is(err, model.ErrUserSuspended):
+		res := forumApi.PostRevisionRestoreForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, revisionsService.ErrNoSuchVersion):
+		res := forumApi.PostRevisionRestoreNotFound(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.PostRevisionRestoreNotFound("post not found")
+		return &res, nil
+	}
+	return nil, err
+}
+
+func convertRevision(revision model.Revision) forumApi.Revision {
+	res := forumApi.Revision{
+		Revision:   revision.Revision,
+		Content:    revision.Content,
+		EditorID:   revision.EditorID,
+		EditorName: revision.EditorName,
+		CreatedAt:  revision.CreatedAt,
+	}
+	if revision.Held {
+		res.Held.SetTo(true)
+	}
+	if revision.TargetType == model.RevisionTargetThread {
+		res.Title.SetTo(revision.Title)
+	}
+	if revision.RestoredFrom != nil {
+		res.RestoredFrom.SetTo(*revision.RestoredFrom)
+	}
+	return res
+}
+
+func convertRevisions(revisions []model.Revision) []forumApi.Revision {
+	res := make([]forumApi.Revision, len(revisions))
+	for i, revision := range revisions {
+		res[i] = convertRevision(revision)
+	}
+	return res
+}
+
+func convertDiff(diff model.RevisionDiff) forumApi.RevisionDiff {
+	return forumApi.RevisionDiff{
+		From:    diff.From,
+		To:      diff.To,
+		Mode:    forumApi.RevisionDiffMode(diff.Mode),
+		Title:   convertOps(diff.Title),
+		Content: convertOps(diff.Content),
+	}
+}
+
+func convertOps(ops []model.DiffOp) []forumApi.DiffOp {
+	if ops == nil {
+		return nil
+	}
+	res := make([]forumApi.DiffOp, len(ops))
+	for i, op := range ops {
+		res[i] = forumApi.DiffOp{Op: forumApi.DiffOpOp(op.Op), Text: op.Text}
+	}
+	return res
+}
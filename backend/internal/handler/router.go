@@ -15,6 +15,9 @@ type UserHandler interface {
 	Create(w http.ResponseWriter, r *http.Request)
 	Update(w http.ResponseWriter, r *http.Request)
 	Delete(w http.ResponseWriter, r *http.Request)
+	RankHistory(w http.ResponseWriter, r *http.Request)
+	Threads(w http.ResponseWriter, r *http.Request)
+	Posts(w http.ResponseWriter, r *http.Request)
 }
 type AuthHandler interface {
 	Login(w http.ResponseWriter, r *http.Request)
@@ -22,7 +25,18 @@ type AuthHandler interface {
 	Refresh(w http.ResponseWriter, r *http.Request)
 }
 
-func RegisterRoutes(mux *http.ServeMux, userH UserHandler, authH AuthHandler) {
+type LiveHandler interface {
+	Threads(w http.ResponseWriter, r *http.Request)
+	Thread(w http.ResponseWriter, r *http.Request)
+}
+
+type AttachmentsHandler interface {
+	Download(w http.ResponseWriter, r *http.Request)
+}
+
+func RegisterRoutes(
+	mux *http.ServeMux, userH UserHandler, authH AuthHandler, liveH LiveHandler, attachmentsH AttachmentsHandler) {
+
 	// Auth
 	mux.HandleFunc("POST /api/auth/login", authH.Login)
 	mux.HandleFunc("POST /api/auth/logout", authH.Logout)
@@ -33,4 +47,12 @@ func RegisterRoutes(mux *http.ServeMux, userH UserHandler, authH AuthHandler) {
 	mux.HandleFunc("POST /api/user", userH.Create)
 	mux.HandleFunc("POST /api/user/{userId}", userH.Update)
 	mux.HandleFunc("DELETE /api/user/{userId}", userH.Delete)
+	mux.HandleFunc("GET /api/user/{userId}/rank-history", userH.RankHistory)
+	mux.HandleFunc("GET /api/user/{userId}/threads", userH.Threads)
+	mux.HandleFunc("GET /api/user/{userId}/posts", userH.Posts)
+	// Live updates (server-sent events)
+	mux.HandleFunc("GET /api/live/threads", liveH.Threads)
+	mux.HandleFunc("GET /api/live/threads/{threadId}", liveH.Thread)
+	// Attachment files (signed urls, no authorization header)
+	mux.HandleFunc("GET /api/attachments/{attachmentId}/download", attachmentsH.Download)
 }
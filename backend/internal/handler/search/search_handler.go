@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package search
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	searchService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/search"
+)
+
+type SearchHandler struct {
+	searchService *searchService.SearchService
+}
+
+func NewSearchHandler(searchService *searchService.SearchService) *SearchHandler {
+	return &SearchHandler{searchService: searchService}
+}
+
+func (h *SearchHandler) Search(ctx context.Context, params forumApi.SearchParams) (forumApi.SearchRes, error) {
+	query := model.SearchQuery{
+		Query: params.Q,
+		Limit: params.Limit.Or(searchService.DefaultLimit),
+	}
+	if authorID, ok := params.AuthorID.Get(); ok {
+		query.AuthorID = &authorID
+	}
+	if communityID, ok := params.CommunityID.Get(); ok {
+		query.CommunityID = &communityID
+	}
+	if from, ok := params.From.Get(); ok {
+		query.From = &from
+	}
+	if to, ok := params.To.Get(); ok {
+		query.To = &to
+	}
+	if cursorStr, ok := params.Cursor.Get(); ok {
+		cursor, err := decodeCursor(cursorStr)
+		if err != nil {
+			res := forumApi.SearchBadRequest("invalid cursor: " + err.Error())
+			return &res, nil
+		}
+		query.Cursor = &cursor
+	}
+
+	result, err := h.searchService.Search(ctx, query)
+	if err != nil {
+		if errors.Is(err, searchService.ErrEmptyQuery) {
+			res := forumApi.SearchBadRequest(err.Error())
+			return &res, nil
+		}
+		return nil, err
+	}
+
+	items := make([]forumApi.SearchResultItem, len(result.Hits))
+	for i, hit := range result.Hits {
+		items[i] = forumApi.SearchResultItem{
+			Kind:       forumApi.SearchResultItemKind(hit.Kind),
+			ThreadID:   hit.ThreadID,
+			Title:      hit.Title,
+			Snippet:    hit.Snippet,
+			AuthorID:   hit.AuthorID,
+			AuthorName: hit.AuthorName,
+			Rank:       hit.Rank,
+			CreatedAt:  hit.CreatedAt,
+		}
+		if hit.Kind == model.SearchKindPost {
+			items[i].PostID.SetTo(hit.PostID)
+		}
+		if hit.CommunityID != nil {
+			items[i].CommunityID.SetTo(*hit.CommunityID)
+		}
+	}
+	res := &forumApi.SearchResponse{Results: items}
+	if result.NextCursor != nil {
+		res.NextCursor.SetTo(encodeCursor(*result.NextCursor))
+	}
+	return res, nil
+}
+
+// cursor is opaque for client, rank is stored as float bits to compare exactly the same value in db
+func encodeCursor(cursor model.SearchCursor) string {
+	raw := fmt.Sprintf("%08x.%s.%d", math.Float32bits(cursor.Rank), cursor.Kind, cursor.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(cursorStr string) (model.SearchCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return model.SearchCursor{}, err
+	}
+	parts := strings.SplitN(string(raw), ".", 3)
+	if len(parts) != 3 {
+		return model.SearchCursor{}, errors.New("wrong cursor format")
+	}
+	rankBits, err := strconv.ParseUint(parts[0], 16, 32)
+	if err != nil {
+		return model.SearchCursor{}, err
+	}
+	kind := parts[1]
+	if kind != model.SearchKindThread && kind != model.SearchKindPost {
+		return model.SearchCursor{}, errors.New("unknown result kind")
+	}
+	id, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return model.SearchCursor{}, err
+	}
+	return model.SearchCursor{
+		Rank: math.Float32frombits(uint32(rankBits)),
+		Kind: kind,
+		ID:   id,
+	}, nil
+}
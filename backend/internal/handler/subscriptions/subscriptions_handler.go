@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package subscriptions
+
+import (
+	"context"
+	"errors"
+
+	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/authctx"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	subscriptionsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/subscriptions"
+)
+
+type SubscriptionsHandler struct {
+	subscriptionsService *subscriptionsService.SubscriptionsService
+}
+
+func NewSubscriptionsHandler(subscriptionsService *subscriptionsService.SubscriptionsService) *SubscriptionsHandler {
+	return &SubscriptionsHandler{subscriptionsService: subscriptionsService}
+}
+
+func (h *SubscriptionsHandler) ThreadSubscriptionGet(
+	ctx context.Context, params forumApi.ThreadSubscriptionGetParams) (forumApi.ThreadSubscriptionGetRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ThreadSubscriptionGetUnauthorized("not authenticated")
+		return &res, nil
+	}
+	subscription, err := h.subscriptionsService.Get(ctx, userId, params.ThreadId)
+	if errors.Is(err, model.ErrNotFound) {
+		res := forumApi.ThreadSubscriptionGetNotFound("not subscribed to thread")
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := convertSubscription(subscription)
+	return &res, nil
+}
+
+func (h *SubscriptionsHandler) ThreadSubscribe(ctx context.Context,
+	req *forumApi.ThreadSubscriptionRequest, params forumApi.ThreadSubscribeParams) (forumApi.ThreadSubscribeRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ThreadSubscribeUnauthorized("not authenticated")
+		return &res, nil
+	}
+	subscription, err := h.subscriptionsService.Subscribe(ctx, userId, params.ThreadId, string(req.Level))
+	switch {
+	case err == nil:
+		res := convertSubscription(subscription)
+		return &res, nil
+	case errors.Is(err, subscriptionsService.ErrUnknownLevel):
+		res := forumApi.ThreadSubscribeBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.ThreadSubscribeNotFound("thread not found")
+		return &res, nil
+	}
+	return nil, err
+}
+
+func (h *SubscriptionsHandler) ThreadUnsubscribe(
+	ctx context.Context, params forumApi.ThreadUnsubscribeParams) (forumApi.ThreadUnsubscribeRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ThreadUnsubscribeUnauthorized("not authenticated")
+		return &res, nil
+	}
+	err := h.subscriptionsService.Unsubscribe(ctx, userId, params.ThreadId)
+	if errors.Is(err, model.ErrNotFound) {
+		res := forumApi.ThreadUnsubscribeNotFound("not subscribed to thread")
+		return &res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &forumApi.ThreadUnsubscribeNoContent{}, nil
+}
+
+func (h *SubscriptionsHandler) SubscriptionsList(
+	ctx context.Context, params forumApi.SubscriptionsListParams) (forumApi.SubscriptionsListRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.SubscriptionsListUnauthorized("not authenticated")
+		return &res, nil
+	}
+	list, err := h.subscriptionsService.List(ctx, userId, string(params.Level.Or("")),
+		params.Before.Or(0), params.Limit.Or(subscriptionsService.DefaultLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := make([]forumApi.ThreadSubscriptionListItem, len(list.Subscriptions))
+	for i, item := range list.Subscriptions {
+		thread := forumApi.ThreadListItem{
+			ID:           item.Thread.ID,
+			Title:        item.Thread.Title,
+			Content:      item.Thread.Content,
+			ContentHTML:  item.Thread.ContentHTML,
+			AuthorID:     item.Thread.AuthorID,
+			AuthorName:   item.Thread.AuthorName,
+			AuthorRank:   item.Thread.AuthorRank,
+			PostsCount:   item.Thread.PostsCount,
+			Score:        item.Thread.Score,
+			IsBookmarked: item.Thread.IsBookmarked,
+			CreatedAt:    item.Thread.CreatedAt,
+		}
+		if item.Thread.CommunityID != nil {
+			thread.CommunityID.SetTo(*item.Thread.CommunityID)
+		}
+		subscriptions[i] = forumApi.ThreadSubscriptionListItem{
+			Subscription: convertSubscription(item.ThreadSubscription),
+			Thread:       thread,
+		}
+	}
+	return &forumApi.ThreadSubscriptionListResponse{
+		Subscriptions: subscriptions,
+		HaveNext:      list.HaveNext,
+	}, nil
+}
+
+func convertSubscription(subscription model.ThreadSubscription) forumApi.ThreadSubscription {
+	return forumApi.ThreadSubscription{
+		ThreadID:  subscription.ThreadID,
+		Level:     forumApi.ThreadSubscriptionLevel(subscription.Level),
+		CreatedAt: subscription.CreatedAt,
+	}
+}
@@ -5,9 +5,15 @@ package threads
 
 import (
 	"context"
+	"errors"
 
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/attachments"
 	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/polls"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/authctx"
+	attachmentsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/attachments"
 	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	pollsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/polls"
 	threadsService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/threads"
 )
 
@@ -24,75 +30,216 @@ func (h *ThreadsHandler) ThreadAddPost(
 	req *forumApi.ThreadCreatePostRequest,
 	params forumApi.ThreadAddPostParams) (forumApi.ThreadAddPostRes, error) {
 
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ThreadAddPostUnauthorized("not authenticated")
+		return &res, nil
+	}
 	postCreate := model.PostCreate{
-		ThreadID: params.ThreadId,
-		// UserID:   params.UserID,
-		UserID:  1, // FIXME: get user id from auth context
-		Content: req.Content,
+		ThreadID:      params.ThreadId,
+		UserID:        userId,
+		Content:       req.Content,
+		AttachmentIDs: req.AttachmentIds,
+	}
+	if replyToID, ok := req.ReplyToID.Get(); ok {
+		postCreate.ReplyToID = &replyToID
 	}
 
 	post, err := h.threadsService.AddPost(ctx, postCreate)
-	if err != nil {
+	switch {
+	case errors.Is(err, threadsService.ErrPostNotInThread),
+		errors.Is(err, attachmentsService.ErrInvalidAttachments),
+		errors.Is(err, attachmentsService.ErrTooManyAttachments),
+		errors.Is(err, model.ErrContentRejected):
+		res := forumApi.ThreadAddPostBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrThreadLocked), errors.Is(err, model.ErrThreadArchived),
+		errors.Is(err, model.ErrUserSuspended), errors.Is(err, model.ErrBlockedByUser):
+		res := forumApi.ThreadAddPostForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.ThreadAddPostNotFound("thread or replied post not found")
+		return &res, nil
+	case errors.Is(err, model.ErrRateLimited):
+		return tooManyRequests(err), nil
+	case err != nil:
 		return nil, err
 	}
 
-	return &forumApi.ThreadPostItem{
-		ID:         post.ID,
-		AuthorID:   post.UserID,
-		AuthorName: post.UserName,
-		Content:    post.Content,
-		CreatedAt:  post.CreatedAt,
-	}, nil
+	res := &forumApi.ThreadPostItem{
+		ID:          post.ID,
+		AuthorID:    post.UserID,
+		AuthorName:  post.UserName,
+		AuthorRank:  post.UserRank,
+		Content:     post.Content,
+		ContentHTML: post.ContentHTML,
+		Mentions:    convertMentions(post.Mentions),
+		Attachments: attachments.ConvertAttachments(post.Attachments),
+		Score:       post.Score,
+		CreatedAt:   post.CreatedAt,
+	}
+	if post.ReplyToID != nil {
+		res.ReplyToID.SetTo(*post.ReplyToID)
+	}
+	if post.Held {
+		res.Held.SetTo(true)
+	}
+	return res, nil
 }
 
 func (h *ThreadsHandler) ThreadCreate(ctx context.Context, req *forumApi.ThreadCreateRequest) (forumApi.ThreadCreateRes, error) {
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ThreadCreateUnauthorized("not authenticated")
+		return &res, nil
+	}
 	modelThreadCreate := model.ThreadCreate{
-		Title:   req.Title,
-		Content: req.Content,
-		UserID:  1, // FIXME: get user id from auth context
+		Title:         req.Title,
+		Content:       req.Content,
+		UserID:        userId,
+		AttachmentIDs: req.AttachmentIds,
+	}
+	if communityID, ok := req.CommunityID.Get(); ok {
+		modelThreadCreate.CommunityID = &communityID
+	}
+	if poll, ok := req.Poll.Get(); ok {
+		modelThreadCreate.Poll = &model.PollCreate{
+			Question:  poll.Question,
+			Options:   poll.Options,
+			Multiple:  poll.Multiple.Or(false),
+			Anonymous: poll.Anonymous.Or(false),
+		}
+		if closesAt, ok := poll.ClosesAt.Get(); ok {
+			modelThreadCreate.Poll.ClosesAt = &closesAt
+		}
 	}
 
 	thread, err := h.threadsService.Create(ctx, modelThreadCreate)
-	if err != nil {
+	switch {
+	case errors.Is(err, attachmentsService.ErrInvalidAttachments),
+		errors.Is(err, attachmentsService.ErrTooManyAttachments),
+		errors.Is(err, pollsService.ErrEmptyQuestion),
+		errors.Is(err, pollsService.ErrInvalidOptions),
+		errors.Is(err, pollsService.ErrClosesInPast),
+		errors.Is(err, model.ErrContentRejected):
+		res := forumApi.ThreadCreateBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrUserSuspended):
+		res := forumApi.ThreadCreateForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrRateLimited):
+		return tooManyRequests(err), nil
+	case err != nil:
 		return nil, err
 	}
-	return &forumApi.ThreadListItem{
-		ID:         thread.ID,
-		Title:      thread.Title,
-		Content:    thread.Content,
-		AuthorID:   thread.UserID,
-		AuthorName: thread.UserName,
-		PostsCount: thread.PostsCount,
-		CreatedAt:  thread.CreatedAt,
-	}, nil
+	res := &forumApi.ThreadListItem{
+		ID:          thread.ID,
+		Title:       thread.Title,
+		Content:     thread.Content,
+		ContentHTML: thread.ContentHTML,
+		AuthorID:    thread.UserID,
+		AuthorName:  thread.UserName,
+		AuthorRank:  thread.UserRank,
+		PostsCount:  thread.PostsCount,
+		Score:       thread.Score,
+		CreatedAt:   thread.CreatedAt,
+	}
+	if thread.CommunityID != nil {
+		res.CommunityID.SetTo(*thread.CommunityID)
+	}
+	if thread.Held {
+		res.Held.SetTo(true)
+	}
+	return res, nil
 }
 
 // get thread with all posts
 func (h *ThreadsHandler) ThreadGet(ctx context.Context, params forumApi.ThreadGetParams) (forumApi.ThreadGetRes, error) {
-	threadWithPosts, err := h.threadsService.GetThreadWithPosts(ctx, params.ThreadId)
+	viewerId, _ := authctx.UserID(ctx)
+	threadWithPosts, err := h.threadsService.GetThreadWithPosts(ctx, viewerId, params.ThreadId)
+	if errors.Is(err, model.ErrNotFound) {
+		res := forumApi.ThreadGetNotFound("thread not found")
+		return &res, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 	var posts []forumApi.ThreadPostItem
 	for _, post := range threadWithPosts.Posts {
-		posts = append(posts, forumApi.ThreadPostItem{
-			ID:         post.ID,
-			AuthorID:   post.UserID,
-			AuthorName: post.UserName,
-			Content:    post.Content,
-			CreatedAt:  post.CreatedAt,
-		})
-	}
-	return &forumApi.ThreadWithPostsListResponse{
-		ID:         threadWithPosts.ID,
-		AuthorID:   threadWithPosts.AuthorID,
-		AuthorName: threadWithPosts.AuthorName,
-		Title:      threadWithPosts.Title,
-		Content:    threadWithPosts.Content,
-		PostsCount: threadWithPosts.PostsCount,
-		CreatedAt:  threadWithPosts.CreatedAt,
-		Posts:      posts,
-	}, nil
+		item := forumApi.ThreadPostItem{
+			ID:          post.ID,
+			AuthorID:    post.UserID,
+			AuthorName:  post.UserName,
+			AuthorRank:  post.UserRank,
+			Content:     post.Content,
+			ContentHTML: post.ContentHTML,
+			Mentions:    convertMentions(post.Mentions),
+			Attachments: attachments.ConvertAttachments(post.Attachments),
+			Score:       post.Score,
+			CreatedAt:   post.CreatedAt,
+		}
+		if post.ReplyToID != nil {
+			item.ReplyToID.SetTo(*post.ReplyToID)
+		}
+		if post.AuthorIgnored {
+			item.AuthorIgnored.SetTo(true)
+		}
+		posts = append(posts, item)
+	}
+	res := &forumApi.ThreadWithPostsListResponse{
+		ID:              threadWithPosts.ID,
+		AuthorID:        threadWithPosts.AuthorID,
+		AuthorName:      threadWithPosts.AuthorName,
+		AuthorRank:      threadWithPosts.AuthorRank,
+		Title:           threadWithPosts.Title,
+		Content:         threadWithPosts.Content,
+		ContentHTML:     threadWithPosts.ContentHTML,
+		Mentions:        convertMentions(threadWithPosts.Mentions),
+		Attachments:     attachments.ConvertAttachments(threadWithPosts.Attachments),
+		PostsCount:      threadWithPosts.PostsCount,
+		Score:           threadWithPosts.Score,
+		IsBookmarked:    threadWithPosts.IsBookmarked,
+		Pinned:          convertPinned(threadWithPosts.State.Pinned),
+		Locked:          threadWithPosts.State.Locked,
+		Archived:        threadWithPosts.State.Archived,
+		SlowModeSeconds: threadWithPosts.State.SlowModeSeconds,
+		CreatedAt:       threadWithPosts.CreatedAt,
+		Posts:           posts,
+	}
+	if threadWithPosts.AcceptedPostID != nil {
+		res.AcceptedPostID.SetTo(*threadWithPosts.AcceptedPostID)
+	}
+	if threadWithPosts.Poll != nil {
+		res.Poll.SetTo(polls.ConvertPoll(*threadWithPosts.Poll))
+	}
+	return res, nil
+}
+
+func (h *ThreadsHandler) ThreadAcceptAnswer(
+	ctx context.Context,
+	req *forumApi.ThreadAcceptAnswerRequest,
+	params forumApi.ThreadAcceptAnswerParams) (forumApi.ThreadAcceptAnswerRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ThreadAcceptAnswerUnauthorized("not authenticated")
+		return &res, nil
+	}
+	err := h.threadsService.AcceptAnswer(ctx, userId, params.ThreadId, req.PostID)
+	switch {
+	case err == nil:
+		return &forumApi.ThreadAcceptAnswerNoContent{}, nil
+	case errors.Is(err, threadsService.ErrNotThreadAuthor), errors.Is(err, model.ErrThreadArchived):
+		res := forumApi.ThreadAcceptAnswerForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, threadsService.ErrPostNotInThread), errors.Is(err, threadsService.ErrAcceptOwnPost):
+		res := forumApi.ThreadAcceptAnswerBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.ThreadAcceptAnswerNotFound("thread or post not found")
+		return &res, nil
+	}
+	return nil, err
 }
 
 func (h *ThreadsHandler) ThreadsList(ctx context.Context, params forumApi.ThreadsListParams) (forumApi.ThreadsListRes, error) {
@@ -100,12 +247,17 @@ func (h *ThreadsHandler) ThreadsList(ctx context.Context, params forumApi.Thread
 	if params.Limit.IsSet() {
 		limit = params.Limit.Value
 	}
+	viewerId, _ := authctx.UserID(ctx)
+	var communityId *int
+	if id, ok := params.CommunityID.Get(); ok {
+		communityId = &id
+	}
 	var err error
 	var threadList model.ThreadListResponse
 	var threadId int
 	page, ok := params.Page.Get()
 	if ok {
-		threadList, err = h.threadsService.GetThreadListByPage(ctx, page, limit)
+		threadList, err = h.threadsService.GetThreadListByPage(ctx, viewerId, communityId, page, limit)
 		if err != nil {
 			return nil, err
 		}
@@ -113,7 +265,7 @@ func (h *ThreadsHandler) ThreadsList(ctx context.Context, params forumApi.Thread
 	}
 	threadId, ok = params.Before.Get()
 	if ok {
-		threadList, err = h.threadsService.GetThreadListByOffset(ctx, threadId, limit, true)
+		threadList, err = h.threadsService.GetThreadListByOffset(ctx, viewerId, communityId, threadId, limit, true)
 		if err != nil {
 			return nil, err
 		}
@@ -121,13 +273,13 @@ func (h *ThreadsHandler) ThreadsList(ctx context.Context, params forumApi.Thread
 	}
 	threadId, ok = params.After.Get()
 	if ok {
-		threadList, err = h.threadsService.GetThreadListByOffset(ctx, threadId, limit, false)
+		threadList, err = h.threadsService.GetThreadListByOffset(ctx, viewerId, communityId, threadId, limit, false)
 		if err != nil {
 			return nil, err
 		}
 		goto GOT_THREAD_ID
 	}
-	threadList, err = h.threadsService.GetThreadListByPage(ctx, 1, limit)
+	threadList, err = h.threadsService.GetThreadListByPage(ctx, viewerId, communityId, 1, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -135,13 +287,26 @@ GOT_THREAD_ID:
 	resThreads := make([]forumApi.ThreadListItem, len(threadList.Threads))
 	for i, thread := range threadList.Threads {
 		resThreads[i] = forumApi.ThreadListItem{
-			ID:         thread.ID,
-			Title:      thread.Title,
-			Content:    thread.Content,
-			AuthorID:   thread.AuthorID,
-			AuthorName: thread.AuthorName,
-			PostsCount: thread.PostsCount,
-			CreatedAt:  thread.CreatedAt,
+			ID:           thread.ID,
+			Title:        thread.Title,
+			Content:      thread.Content,
+			ContentHTML:  thread.ContentHTML,
+			AuthorID:     thread.AuthorID,
+			AuthorName:   thread.AuthorName,
+			AuthorRank:   thread.AuthorRank,
+			PostsCount:   thread.PostsCount,
+			Score:        thread.Score,
+			IsBookmarked: thread.IsBookmarked,
+			CreatedAt:    thread.CreatedAt,
+		}
+		if thread.CommunityID != nil {
+			resThreads[i].CommunityID.SetTo(*thread.CommunityID)
+		}
+		resThreads[i].Pinned.SetTo(convertPinned(thread.State.Pinned))
+		resThreads[i].Locked.SetTo(thread.State.Locked)
+		resThreads[i].Archived.SetTo(thread.State.Archived)
+		if thread.AuthorIgnored {
+			resThreads[i].AuthorIgnored.SetTo(true)
 		}
 	}
 	return &forumApi.ThreadListResponse{
@@ -151,3 +316,81 @@ GOT_THREAD_ID:
 		HaveNext:            threadList.HaveNext,
 	}, nil
 }
+
+func (h *ThreadsHandler) ThreadSetState(ctx context.Context,
+	req *forumApi.ThreadStateRequest, params forumApi.ThreadSetStateParams) (forumApi.ThreadSetStateRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ThreadSetStateUnauthorized("not authenticated")
+		return &res, nil
+	}
+	var change model.ThreadStateChange
+	if pinned, ok := req.Pinned.Get(); ok {
+		value := ""
+		if pinned != forumApi.ThreadPinnedNone {
+			value = string(pinned)
+		}
+		change.Pinned = &value
+	}
+	if locked, ok := req.Locked.Get(); ok {
+		change.Locked = &locked
+	}
+	if archived, ok := req.Archived.Get(); ok {
+		change.Archived = &archived
+	}
+	if slowMode, ok := req.SlowModeSeconds.Get(); ok {
+		change.SlowModeSeconds = &slowMode
+	}
+	state, err := h.threadsService.SetState(ctx, userId, params.ThreadId, change)
+	switch {
+	case err == nil:
+		return &forumApi.ThreadState{
+			Pinned:          convertPinned(state.Pinned),
+			Locked:          state.Locked,
+			Archived:        state.Archived,
+			SlowModeSeconds: state.SlowModeSeconds,
+		}, nil
+	case errors.Is(err, threadsService.ErrUnknownPinned), errors.Is(err, threadsService.ErrNoCommunity),
+		errors.Is(err, threadsService.ErrInvalidSlowMode):
+		res := forumApi.ThreadSetStateBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, threadsService.ErrNotModerator):
+		res := forumApi.ThreadSetStateForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.ThreadSetStateNotFound("thread not found")
+		return &res, nil
+	}
+	return nil, err
+}
+
+func convertPinned(pinned string) forumApi.ThreadPinned {
+	if pinned == "" {
+		return forumApi.ThreadPinnedNone
+	}
+	return forumApi.ThreadPinned(pinned)
+}
+
+func convertMentions(mentions []model.MentionEntity) []forumApi.MentionEntity {
+	res := make([]forumApi.MentionEntity, len(mentions))
+	for i, mention := range mentions {
+		res[i] = forumApi.MentionEntity{
+			UserID: mention.UserID,
+			Name:   mention.Name,
+			Offset: mention.Offset,
+			Length: mention.Length,
+		}
+	}
+	return res
+}
+
+// tooManyRequests tells user when the next post is allowed
+func tooManyRequests(err error) *forumApi.TooManyRequestsHeaders {
+	res := &forumApi.TooManyRequestsHeaders{Response: err.Error()}
+	var rateErr *model.RateLimitError
+	if errors.As(err, &rateErr) {
+		res.RetryAfter = rateErr.RetryAfterSeconds()
+	}
+	return res
+}
@@ -3,18 +3,71 @@
 
 package dto
 
+import "time"
+
 type UserCreateRequest struct {
 	Name     string `json:"name"`
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
 type UserUpdateRequest struct {
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	AvatarURL string `json:"avatar_url"`
+	Bio       string `json:"bio"`
+	Location  string `json:"location"`
+	Website   string `json:"website"`
 }
 
 type UserCreateResponse struct {
-	Id    int64  `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+	// shown only to the user and admins
+	Email          string     `json:"email,omitempty"`
+	Karma          int        `json:"karma"`
+	Rank           string     `json:"rank"`
+	Role           string     `json:"role"`
+	AvatarURL      string     `json:"avatar_url"`
+	Bio            string     `json:"bio"`
+	Location       string     `json:"location"`
+	Website        string     `json:"website"`
+	CreatedAt      time.Time  `json:"created_at"`
+	LastSeenAt     *time.Time `json:"last_seen_at,omitempty"`
+	ThreadsCount   int        `json:"threads_count"`
+	PostsCount     int        `json:"posts_count"`
+	FollowersCount int        `json:"followers_count"`
+	FollowingCount int        `json:"following_count"`
+}
+
+type UserThreadResponse struct {
+	Id         int       `json:"id"`
+	Title      string    `json:"title"`
+	PostsCount int       `json:"posts_count"`
+	Score      int       `json:"score"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type UserThreadsResponse struct {
+	Threads  []UserThreadResponse `json:"threads"`
+	HaveNext bool                 `json:"have_next"`
+}
+
+type UserPostResponse struct {
+	Id          int       `json:"id"`
+	ThreadId    int       `json:"thread_id"`
+	ThreadTitle string    `json:"thread_title"`
+	ContentHTML string    `json:"content_html"`
+	Score       int       `json:"score"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type UserPostsResponse struct {
+	Posts    []UserPostResponse `json:"posts"`
+	HaveNext bool               `json:"have_next"`
+}
+
+type RankChangeResponse struct {
+	OldRank   string    `json:"old_rank"`
+	NewRank   string    `json:"new_rank"`
+	ChangedAt time.Time `json:"changed_at"`
 }
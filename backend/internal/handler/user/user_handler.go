@@ -6,6 +6,7 @@ package user
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
@@ -13,15 +14,22 @@ import (
 	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/user/dto"
 	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/jwt"
 	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	userService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/user"
 )
 
 type UserService interface {
-	Get(ctx context.Context, userId int) (*model.User, error)
+	Get(ctx context.Context, viewerId, userId int) (*model.User, error)
 	Create(ctx context.Context, name, email, password string) (*model.User, error)
-	Update(ctx context.Context, userId int, name, email string) (*model.User, error)
+	Update(ctx context.Context, viewerId int, update model.UserUpdate) (*model.User, error)
 	Delete(ctx context.Context, userId int) error
+	RankHistory(ctx context.Context, userId int) ([]model.RankChange, error)
+	Threads(ctx context.Context, userId, before, limit int) (model.UserThreadList, error)
+	Posts(ctx context.Context, userId, before, limit int) (model.UserPostList, error)
+	Seen(ctx context.Context, userId int)
 }
 
+var errNoAuthorization = errors.New("no Authorization header")
+
 type UserHandler struct {
 	userService UserService
 	jwtService  *jwt.JwtAuthorizator
@@ -31,6 +39,7 @@ func NewUserHandler(userService UserService, jwtService *jwt.JwtAuthorizator) *U
 	return &UserHandler{userService: userService, jwtService: jwtService}
 }
 
+// Get returns public profile of user, private fields only to the user and admins
 func (u *UserHandler) Get(w http.ResponseWriter, r *http.Request) {
 	userId := r.PathValue("userId")
 	if userId == "" {
@@ -43,63 +52,37 @@ func (u *UserHandler) Get(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "userId is not a valid integer", http.StatusBadRequest)
 		return
 	}
-
-	user, err := u.userService.Get(r.Context(), userIdInt)
-	if err != nil {
-		http.Error(w, "failed to get user: "+userId+": "+err.Error(), http.StatusInternalServerError)
+	// anonymous viewer sees public profile only
+	viewerId, err := u.bearerUserId(r)
+	if err != nil && !errors.Is(err, errNoAuthorization) {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
 		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
 
-	resp := dto.UserCreateResponse{
-		Id:    user.ID,
-		Name:  user.Name,
-		Email: user.Email,
+	user, err := u.userService.Get(r.Context(), viewerId, userIdInt)
+	if errors.Is(err, model.ErrNotFound) {
+		http.Error(w, "user not found: "+userId, http.StatusNotFound)
+		return
 	}
-
-	if err := json.NewEncoder(w).Encode(resp); err != nil {
-		http.Error(w, "failed to encode response: "+err.Error(), http.StatusInternalServerError)
+	if err != nil {
+		http.Error(w, "failed to get user: "+userId+": "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	writeJSON(w, http.StatusOK, userResponse(user))
 }
 func (u *UserHandler) Me(w http.ResponseWriter, r *http.Request) {
-	httpAuth := r.Header.Get("Authorization")
-	if len(httpAuth) == 0 {
-		http.Error(w, "no Authorization header", http.StatusUnauthorized)
-		return
-	}
-	if !strings.HasPrefix(httpAuth, "Bearer ") {
-		http.Error(w, "invalid Authorization header", http.StatusUnauthorized)
-		return
-	}
-	tokenStr := strings.TrimSpace(strings.TrimPrefix(httpAuth, "Bearer "))
-	jwtClaims, err := u.jwtService.ValidateToken(tokenStr)
+	userId, err := u.bearerUserId(r)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusUnauthorized)
 		return
 	}
 
-	userId := jwtClaims.UserID
-	user, err := u.userService.Get(r.Context(), int(userId))
+	user, err := u.userService.Get(r.Context(), userId, userId)
 	if err != nil {
-		userIdStr := strconv.FormatUint(uint64(userId), 10)
-		http.Error(w, "failed to get user: "+userIdStr+": "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-
-	resp := dto.UserCreateResponse{
-		Id:    user.ID,
-		Name:  user.Name,
-		Email: user.Email,
-	}
-
-	if err := json.NewEncoder(w).Encode(resp); err != nil {
-		http.Error(w, "failed to encode response: "+err.Error(), http.StatusInternalServerError)
+		http.Error(w, "failed to get user: "+strconv.Itoa(userId)+": "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	writeJSON(w, http.StatusOK, userResponse(user))
 }
 func (u *UserHandler) Create(w http.ResponseWriter, r *http.Request) {
 	var req dto.UserCreateRequest
@@ -113,21 +96,16 @@ func (u *UserHandler) Create(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "failed to create user: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
-
-	resp := dto.UserCreateResponse{
-		Id:    user.ID,
-		Name:  user.Name,
-		Email: user.Email,
-	}
+	writeJSON(w, http.StatusCreated, userResponse(user))
+}
 
-	if err := json.NewEncoder(w).Encode(resp); err != nil {
-		http.Error(w, "failed to encode response: "+err.Error(), http.StatusInternalServerError)
+// Update replaces name, email and profile of user, allowed to the user and admins
+func (u *UserHandler) Update(w http.ResponseWriter, r *http.Request) {
+	viewerId, err := u.bearerUserId(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
 		return
 	}
-}
-func (u *UserHandler) Update(w http.ResponseWriter, r *http.Request) {
 	var req dto.UserUpdateRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "failed to decode expected JSON in body: "+err.Error(), http.StatusBadRequest)
@@ -145,24 +123,34 @@ func (u *UserHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, err := u.userService.Update(r.Context(), userIdInt, req.Name, req.Email)
-	if err != nil {
-		http.Error(w, "failed to update user: "+err.Error(), http.StatusInternalServerError)
+	user, err := u.userService.Update(r.Context(), viewerId, model.UserUpdate{
+		UserID: userIdInt,
+		Name:   req.Name,
+		Email:  req.Email,
+		UserProfile: model.UserProfile{
+			AvatarURL: req.AvatarURL,
+			Bio:       req.Bio,
+			Location:  req.Location,
+			Website:   req.Website,
+		},
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, userService.ErrNotAllowed):
+		http.Error(w, err.Error(), http.StatusForbidden)
 		return
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-
-	resp := dto.UserCreateResponse{
-		Id:    user.ID,
-		Name:  user.Name,
-		Email: user.Email,
-	}
-
-	if err := json.NewEncoder(w).Encode(resp); err != nil {
-		http.Error(w, "failed to encode response: "+err.Error(), http.StatusInternalServerError)
+	case errors.Is(err, userService.ErrBioTooLong), errors.Is(err, userService.ErrLocationTooLong),
+		errors.Is(err, userService.ErrInvalidURL):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case errors.Is(err, model.ErrNotFound):
+		http.Error(w, "user not found: "+userId, http.StatusNotFound)
+		return
+	default:
+		http.Error(w, "failed to update user: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	writeJSON(w, http.StatusOK, userResponse(user))
 }
 func (u *UserHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	userId := r.PathValue("userId")
@@ -185,3 +173,154 @@ func (u *UserHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 }
+func (u *UserHandler) RankHistory(w http.ResponseWriter, r *http.Request) {
+	userId := r.PathValue("userId")
+	if userId == "" {
+		http.Error(w, "userId is required in path /api/user/{userId}/rank-history", http.StatusBadRequest)
+		return
+	}
+
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		http.Error(w, "userId is not a valid integer", http.StatusBadRequest)
+		return
+	}
+
+	changes, err := u.userService.RankHistory(r.Context(), userIdInt)
+	if err != nil {
+		http.Error(w, "failed to get rank history of user: "+userId+": "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]dto.RankChangeResponse, len(changes))
+	for i, change := range changes {
+		resp[i] = dto.RankChangeResponse{
+			OldRank:   change.OldRank,
+			NewRank:   change.NewRank,
+			ChangedAt: change.ChangedAt,
+		}
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Threads lists visible threads of user from the newest, paginated by before (thread id) and limit
+func (u *UserHandler) Threads(w http.ResponseWriter, r *http.Request) {
+	userId, before, limit, ok := listParams(w, r)
+	if !ok {
+		return
+	}
+	list, err := u.userService.Threads(r.Context(), userId, before, limit)
+	if err != nil {
+		http.Error(w, "failed to get threads of user: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := dto.UserThreadsResponse{Threads: make([]dto.UserThreadResponse, len(list.Threads)), HaveNext: list.HaveNext}
+	for i, thread := range list.Threads {
+		resp.Threads[i] = dto.UserThreadResponse{
+			Id:         thread.ID,
+			Title:      thread.Title,
+			PostsCount: thread.PostsCount,
+			Score:      thread.Score,
+			CreatedAt:  thread.CreatedAt,
+		}
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Posts lists visible posts of user from the newest, paginated by before (post id) and limit
+func (u *UserHandler) Posts(w http.ResponseWriter, r *http.Request) {
+	userId, before, limit, ok := listParams(w, r)
+	if !ok {
+		return
+	}
+	list, err := u.userService.Posts(r.Context(), userId, before, limit)
+	if err != nil {
+		http.Error(w, "failed to get posts of user: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := dto.UserPostsResponse{Posts: make([]dto.UserPostResponse, len(list.Posts)), HaveNext: list.HaveNext}
+	for i, post := range list.Posts {
+		resp.Posts[i] = dto.UserPostResponse{
+			Id:          post.ID,
+			ThreadId:    post.ThreadID,
+			ThreadTitle: post.ThreadTitle,
+			ContentHTML: post.ContentHTML,
+			Score:       post.Score,
+			CreatedAt:   post.CreatedAt,
+		}
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// listParams parses user id from path and optional before and limit from query, writes error on failure
+func listParams(w http.ResponseWriter, r *http.Request) (userId, before, limit int, ok bool) {
+	userId, err := strconv.Atoi(r.PathValue("userId"))
+	if err != nil {
+		http.Error(w, "userId is not a valid integer", http.StatusBadRequest)
+		return 0, 0, 0, false
+	}
+	query := r.URL.Query()
+	if s := query.Get("before"); s != "" {
+		if before, err = strconv.Atoi(s); err != nil {
+			http.Error(w, "before is not a valid integer", http.StatusBadRequest)
+			return 0, 0, 0, false
+		}
+	}
+	if s := query.Get("limit"); s != "" {
+		if limit, err = strconv.Atoi(s); err != nil {
+			http.Error(w, "limit is not a valid integer", http.StatusBadRequest)
+			return 0, 0, 0, false
+		}
+	}
+	return userId, before, limit, true
+}
+
+// bearerUserId returns id of user authenticated by access token, errNoAuthorization without token.
+// Authenticated request updates last seen time of the user.
+func (u *UserHandler) bearerUserId(r *http.Request) (int, error) {
+	httpAuth := r.Header.Get("Authorization")
+	if len(httpAuth) == 0 {
+		return 0, errNoAuthorization
+	}
+	if !strings.HasPrefix(httpAuth, "Bearer ") {
+		return 0, errors.New("invalid Authorization header")
+	}
+	tokenStr := strings.TrimSpace(strings.TrimPrefix(httpAuth, "Bearer "))
+	jwtClaims, err := u.jwtService.ValidateToken(tokenStr)
+	if err != nil {
+		return 0, err
+	}
+	u.userService.Seen(r.Context(), int(jwtClaims.UserID))
+	return int(jwtClaims.UserID), nil
+}
+
+func userResponse(user *model.User) dto.UserCreateResponse {
+	return dto.UserCreateResponse{
+		Id:             user.ID,
+		Name:           user.Name,
+		Email:          user.Email,
+		Karma:          user.Karma,
+		Rank:           user.Rank,
+		Role:           user.Role,
+		AvatarURL:      user.AvatarURL,
+		Bio:            user.Bio,
+		Location:       user.Location,
+		Website:        user.Website,
+		CreatedAt:      user.CreatedAt,
+		LastSeenAt:     user.LastSeenAt,
+		ThreadsCount:   user.ThreadsCount,
+		PostsCount:     user.PostsCount,
+		FollowersCount: user.FollowersCount,
+		FollowingCount: user.FollowingCount,
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, resp any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, "failed to encode response: "+err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package votes
+
+import (
+	"context"
+	"errors"
+
+	forumApi "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/handler/generated"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/authctx"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	votesService "github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/votes"
+)
+
+type VotesHandler struct {
+	votesService *votesService.VotesService
+}
+
+func NewVotesHandler(votesService *votesService.VotesService) *VotesHandler {
+	return &VotesHandler{votesService: votesService}
+}
+
+func (h *VotesHandler) ThreadVote(
+	ctx context.Context,
+	req *forumApi.VoteRequest,
+	params forumApi.ThreadVoteParams) (forumApi.ThreadVoteRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.ThreadVoteUnauthorized("not authenticated")
+		return &res, nil
+	}
+	result, err := h.votesService.Vote(ctx, model.Vote{
+		UserID:     userId,
+		TargetType: model.VoteTargetThread,
+		TargetID:   params.ThreadId,
+		Value:      int(req.Value),
+	})
+	switch {
+	case err == nil:
+		return &forumApi.VoteResponse{Score: result.Score, MyVote: result.MyVote}, nil
+	case errors.Is(err, votesService.ErrInvalidValue):
+		res := forumApi.ThreadVoteBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, votesService.ErrSelfVote):
+		res := forumApi.ThreadVoteForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.ThreadVoteNotFound("thread not found")
+		return &res, nil
+	}
+	return nil, err
+}
+
+func (h *VotesHandler) PostVote(
+	ctx context.Context,
+	req *forumApi.VoteRequest,
+	params forumApi.PostVoteParams) (forumApi.PostVoteRes, error) {
+
+	userId, ok := authctx.UserID(ctx)
+	if !ok {
+		res := forumApi.PostVoteUnauthorized("not authenticated")
+		return &res, nil
+	}
+	result, err := h.votesService.Vote(ctx, model.Vote{
+		UserID:     userId,
+		TargetType: model.VoteTargetPost,
+		TargetID:   params.PostId,
+		Value:      int(req.Value),
+	})
+	switch {
+	case err == nil:
+		return &forumApi.VoteResponse{Score: result.Score, MyVote: result.MyVote}, nil
+	case errors.Is(err, votesService.ErrInvalidValue):
+		res := forumApi.PostVoteBadRequest(err.Error())
+		return &res, nil
+	case errors.Is(err, votesService.ErrSelfVote):
+		res := forumApi.PostVoteForbidden(err.Error())
+		return &res, nil
+	case errors.Is(err, model.ErrNotFound):
+		res := forumApi.PostVoteNotFound("post not found")
+		return &res, nil
+	}
+	return nil, err
+}
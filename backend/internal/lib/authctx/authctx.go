@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+// Package authctx keeps authenticated user in request context.
+package authctx
+
+import "context"
+
+type userIDKey struct{}
+
+// WithUserID returns context with authenticated user id
+func WithUserID(ctx context.Context, userId int) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userId)
+}
+
+// UserID returns authenticated user id, ok is false for anonymous request
+func UserID(ctx context.Context) (userId int, ok bool) {
+	userId, ok = ctx.Value(userIDKey{}).(int)
+	return userId, ok
+}
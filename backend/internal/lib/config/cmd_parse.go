@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strconv"
 
 	toml "github.com/pelletier/go-toml/v2"
@@ -29,18 +30,24 @@ type CmdConfig struct {
 
 // CmdParse parses command-line arguments and returns a CmdConfig struct.
 func CmdParse() *CmdConfig {
-	configPath := flag.String("config", "", "path to config file")
-	dbHost := flag.String("database-host", "", "database host")
-	dbPort := flag.Int("database-port", 0, "database port")
-	dbUser := flag.String("database-user", "", "database user")
-	dbPassword := flag.String("database-password", "", "database password")
-	dbName := flag.String("database-name", "", "database name")
+	cmdConfig := CmdFlags(flag.CommandLine)
+	flag.Parse()
+	return cmdConfig
+}
 
-	serverHost := flag.String("server-host", "", "http server host")
-	serverPort := flag.Int("server-port", 0, "http server port")
-	serverJwtSecret := flag.String("server-jwt-secret", "", "http server jwt secret")
+// CmdFlags defines config flags in flag set (subcommands have own flag sets),
+// values are set when flag set is parsed.
+func CmdFlags(fs *flag.FlagSet) *CmdConfig {
+	configPath := fs.String("config", "", "path to config file")
+	dbHost := fs.String("database-host", "", "database host")
+	dbPort := fs.Int("database-port", 0, "database port")
+	dbUser := fs.String("database-user", "", "database user")
+	dbPassword := fs.String("database-password", "", "database password")
+	dbName := fs.String("database-name", "", "database name")
 
-	flag.Parse()
+	serverHost := fs.String("server-host", "", "http server host")
+	serverPort := fs.Int("server-port", 0, "http server port")
+	serverJwtSecret := fs.String("server-jwt-secret", "", "http server jwt secret")
 
 	return &CmdConfig{
 		Config: configPath,
@@ -118,9 +125,391 @@ func (srv *ServerConfig) check(cmd *CmdConfig) error {
 	return nil
 }
 
+// RankConfig is one reputation level, user gets it when all minimums are reached
+type RankConfig struct {
+	Name               string `toml:"name"`
+	MinKarma           int    `toml:"min_karma"`
+	MinPosts           int    `toml:"min_posts"`
+	MinAcceptedAnswers int    `toml:"min_accepted_answers"`
+	MinAccountAgeDays  int    `toml:"min_account_age_days"`
+}
+
+type ReputationConfig struct {
+	// ranks from lowest to highest
+	Ranks []RankConfig `toml:"ranks"`
+}
+
+var defaultRanks = []RankConfig{
+	{Name: "Новичок"},
+	{Name: "Участник", MinKarma: 10, MinPosts: 10, MinAccountAgeDays: 7},
+	{Name: "Знаток", MinKarma: 100, MinPosts: 50, MinAcceptedAnswers: 5, MinAccountAgeDays: 30},
+	{Name: "Эксперт", MinKarma: 500, MinPosts: 200, MinAcceptedAnswers: 25, MinAccountAgeDays: 180},
+}
+
+func (rep *ReputationConfig) check() error {
+	if len(rep.Ranks) == 0 {
+		rep.Ranks = defaultRanks
+	}
+	names := make(map[string]bool, len(rep.Ranks))
+	for i, rank := range rep.Ranks {
+		if rank.Name == "" {
+			return fmt.Errorf("rank %d name is empty", i+1)
+		}
+		if names[rank.Name] {
+			return fmt.Errorf("rank name \"%s\" is duplicated", rank.Name)
+		}
+		names[rank.Name] = true
+		if rank.MinKarma < 0 || rank.MinPosts < 0 || rank.MinAcceptedAnswers < 0 || rank.MinAccountAgeDays < 0 {
+			return fmt.Errorf("rank \"%s\" has negative minimum", rank.Name)
+		}
+	}
+	return nil
+}
+
+// LiveConfig configures live updates streaming
+type LiveConfig struct {
+	// "postgres" delivers events between instances with LISTEN/NOTIFY, "memory" - for single instance
+	PubSub string `toml:"pubsub"`
+	// number of last events kept to resume stream by Last-Event-ID
+	HistorySize int `toml:"history_size"`
+	// events queued for slow client before it is disconnected
+	SubscriberBuffer int `toml:"subscriber_buffer"`
+	HeartbeatSeconds int `toml:"heartbeat_seconds"`
+}
+
+func (live *LiveConfig) check() error {
+	if live.PubSub == "" {
+		live.PubSub = "postgres"
+	}
+	if live.PubSub != "postgres" && live.PubSub != "memory" {
+		return fmt.Errorf("live pubsub \"%s\" is unknown, must be postgres or memory", live.PubSub)
+	}
+	if live.HistorySize == 0 {
+		live.HistorySize = 1000
+	}
+	if live.SubscriberBuffer == 0 {
+		live.SubscriberBuffer = 64
+	}
+	if live.HeartbeatSeconds == 0 {
+		live.HeartbeatSeconds = 20
+	}
+	if live.HistorySize < 0 || live.SubscriberBuffer < 0 || live.HeartbeatSeconds < 0 {
+		return fmt.Errorf("live config params must be positive")
+	}
+	return nil
+}
+
+// S3Config is S3-compatible storage of attachments
+type S3Config struct {
+	// e.g. "https://s3.eu-central-1.amazonaws.com" or "http://localhost:9000"
+	Endpoint        string `toml:"endpoint"`
+	Region          string `toml:"region"`
+	Bucket          string `toml:"bucket"`
+	AccessKeyID     string `toml:"access_key_id"`
+	SecretAccessKey string `toml:"secret_access_key"`
+	// bucket in url path instead of host name
+	PathStyle bool `toml:"path_style"`
+}
+
+// ImagesConfig configures processing of uploaded images
+type ImagesConfig struct {
+	// max width and height of thumbnails
+	ThumbnailSizes []int `toml:"thumbnail_sizes"`
+	// background processing goroutines and size of their queue
+	Workers   int `toml:"workers"`
+	QueueSize int `toml:"queue_size"`
+	// larger images are not decoded
+	MaxPixels   int `toml:"max_pixels"`
+	JPEGQuality int `toml:"jpeg_quality"`
+}
+
+func (img *ImagesConfig) check() error {
+	if len(img.ThumbnailSizes) == 0 {
+		img.ThumbnailSizes = []int{320, 1280}
+	}
+	if img.Workers == 0 {
+		img.Workers = 2
+	}
+	if img.QueueSize == 0 {
+		img.QueueSize = 100
+	}
+	if img.MaxPixels == 0 {
+		img.MaxPixels = 40_000_000
+	}
+	if img.JPEGQuality == 0 {
+		img.JPEGQuality = 85
+	}
+	if img.Workers < 0 || img.QueueSize < 0 || img.MaxPixels < 0 {
+		return fmt.Errorf("attachments images config params must be positive")
+	}
+	if img.JPEGQuality < 1 || img.JPEGQuality > 100 {
+		return fmt.Errorf("attachments images jpeg_quality must be in 1-100")
+	}
+	for _, size := range img.ThumbnailSizes {
+		if size <= 0 {
+			return fmt.Errorf("attachments images thumbnail_sizes must be positive")
+		}
+	}
+	return nil
+}
+
+// AttachmentsConfig configures uploaded files
+type AttachmentsConfig struct {
+	// "local" keeps files in LocalDir, "s3" - in S3-compatible storage
+	Storage  string   `toml:"storage"`
+	LocalDir string   `toml:"local_dir"`
+	S3       S3Config `toml:"s3"`
+	// max file size in megabytes
+	MaxSizeMB int `toml:"max_size_mb"`
+	// file types detected by content
+	AllowedTypes []string `toml:"allowed_types"`
+	MaxPerPost   int      `toml:"max_per_post"`
+	// download url lifetime
+	URLTTLMinutes int `toml:"url_ttl_minutes"`
+	// files not attached to thread or post during this time are removed
+	UnlinkedTTLHours  int          `toml:"unlinked_ttl_hours"`
+	GCIntervalMinutes int          `toml:"gc_interval_minutes"`
+	Images            ImagesConfig `toml:"images"`
+}
+
+var defaultAllowedTypes = []string{
+	"image/jpeg", "image/png", "image/gif", "image/webp", "application/pdf", "text/plain", "application/zip",
+}
+
+func (att *AttachmentsConfig) check() error {
+	if att.Storage == "" {
+		att.Storage = "local"
+	}
+	switch att.Storage {
+	case "local":
+		if att.LocalDir == "" {
+			att.LocalDir = "./uploads"
+		}
+	case "s3":
+		att.S3.AccessKeyID = mergeCmdEnvCurrentDefaultString(
+			nil, "FORUM_ATTACHMENTS_S3_ACCESS_KEY_ID", att.S3.AccessKeyID, "")
+		att.S3.SecretAccessKey = mergeCmdEnvCurrentDefaultString(
+			nil, "FORUM_ATTACHMENTS_S3_SECRET_ACCESS_KEY", att.S3.SecretAccessKey, "")
+		if att.S3.Endpoint == "" || att.S3.Bucket == "" {
+			return fmt.Errorf("attachments s3 endpoint and bucket must be set")
+		}
+		if att.S3.Region == "" {
+			att.S3.Region = "us-east-1"
+		}
+		if att.S3.AccessKeyID == "" || att.S3.SecretAccessKey == "" {
+			return fmt.Errorf("attachments s3 access_key_id and secret_access_key must be set")
+		}
+	default:
+		return fmt.Errorf("attachments storage \"%s\" is unknown, must be local or s3", att.Storage)
+	}
+	if len(att.AllowedTypes) == 0 {
+		att.AllowedTypes = defaultAllowedTypes
+	}
+	if att.MaxSizeMB == 0 {
+		att.MaxSizeMB = 10
+	}
+	if att.MaxPerPost == 0 {
+		att.MaxPerPost = 10
+	}
+	if att.URLTTLMinutes == 0 {
+		att.URLTTLMinutes = 60
+	}
+	if att.UnlinkedTTLHours == 0 {
+		att.UnlinkedTTLHours = 24
+	}
+	if att.GCIntervalMinutes == 0 {
+		att.GCIntervalMinutes = 60
+	}
+	if att.MaxSizeMB < 0 || att.MaxPerPost < 0 || att.URLTTLMinutes < 0 ||
+		att.UnlinkedTTLHours < 0 || att.GCIntervalMinutes < 0 {
+		return fmt.Errorf("attachments config params must be positive")
+	}
+	return att.Images.check()
+}
+
+// PollsConfig configures polls of threads
+type PollsConfig struct {
+	MaxOptions int `toml:"max_options"`
+	// results of open poll are shown before user votes
+	ResultsBeforeVote bool `toml:"results_before_vote"`
+}
+
+func (p *PollsConfig) check() error {
+	if p.MaxOptions == 0 {
+		p.MaxOptions = 10
+	}
+	if p.MaxOptions < 2 {
+		return fmt.Errorf("polls max_options must be at least 2")
+	}
+	return nil
+}
+
+// ThreadsConfig configures automatic archiving of inactive threads
+type ThreadsConfig struct {
+	// threads without new posts during this time are archived, 0 disables archiving
+	AutoArchiveDays            int `toml:"auto_archive_days"`
+	AutoArchiveIntervalMinutes int `toml:"auto_archive_interval_minutes"`
+}
+
+func (t *ThreadsConfig) check() error {
+	if t.AutoArchiveDays < 0 {
+		return fmt.Errorf("threads auto_archive_days must not be negative")
+	}
+	if t.AutoArchiveIntervalMinutes == 0 {
+		t.AutoArchiveIntervalMinutes = 60
+	}
+	if t.AutoArchiveIntervalMinutes < 0 {
+		return fmt.Errorf("threads auto_archive_interval_minutes must be positive")
+	}
+	return nil
+}
+
+// FilterConfig configures content filters checking threads and posts before they are stored.
+// Negative values disable filters.
+type FilterConfig struct {
+	BlockedWords []string `toml:"blocked_words"`
+	// regular expressions (Go syntax)
+	BlockedPatterns []string `toml:"blocked_patterns"`
+	// censor or reject
+	BlocklistMode string `toml:"blocklist_mode"`
+	// accounts younger than this may not post more than max links
+	NewAccountDays     int `toml:"new_account_days"`
+	NewAccountMaxLinks int `toml:"new_account_max_links"`
+	// the same content posted again during this time is held
+	DuplicateWindowMinutes int `toml:"duplicate_window_minutes"`
+	DuplicateMinLength     int `toml:"duplicate_min_length"`
+	// content with spam probability not less than threshold is held
+	SpamThreshold float64 `toml:"spam_threshold"`
+	// classifier is used after this many moderator decisions of both kinds
+	SpamMinTraining int `toml:"spam_min_training"`
+}
+
+func (f *FilterConfig) check() error {
+	if f.BlocklistMode == "" {
+		f.BlocklistMode = "censor"
+	}
+	if f.BlocklistMode != "censor" && f.BlocklistMode != "reject" {
+		return fmt.Errorf("filter blocklist_mode must be censor or reject")
+	}
+	for _, pattern := range f.BlockedPatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("filter blocked_patterns: %w", err)
+		}
+	}
+	if f.NewAccountDays == 0 {
+		f.NewAccountDays = 7
+	}
+	if f.NewAccountMaxLinks == 0 {
+		f.NewAccountMaxLinks = 2
+	}
+	if f.DuplicateWindowMinutes == 0 {
+		f.DuplicateWindowMinutes = 60
+	}
+	if f.DuplicateMinLength == 0 {
+		f.DuplicateMinLength = 20
+	}
+	if f.SpamThreshold == 0 {
+		f.SpamThreshold = 0.9
+	}
+	if f.SpamThreshold >= 1 {
+		return fmt.Errorf("filter spam_threshold must be less than 1")
+	}
+	if f.SpamMinTraining == 0 {
+		f.SpamMinTraining = 20
+	}
+	return nil
+}
+
+// FloodConfig configures posting rate limits of users, moderators are not limited.
+// Negative values disable limits.
+type FloodConfig struct {
+	PostIntervalSeconds int `toml:"post_interval_seconds"`
+	ThreadsPerDay       int `toml:"threads_per_day"`
+	// accounts younger than this have stricter limits
+	NewAccountDays                int `toml:"new_account_days"`
+	NewAccountPostIntervalSeconds int `toml:"new_account_post_interval_seconds"`
+	NewAccountThreadsPerDay       int `toml:"new_account_threads_per_day"`
+	// private messages
+	MessagesPerMinute             int `toml:"messages_per_minute"`
+	ConversationsPerDay           int `toml:"conversations_per_day"`
+	NewAccountMessagesPerMinute   int `toml:"new_account_messages_per_minute"`
+	NewAccountConversationsPerDay int `toml:"new_account_conversations_per_day"`
+}
+
+func (f *FloodConfig) check() error {
+	if f.PostIntervalSeconds == 0 {
+		f.PostIntervalSeconds = 10
+	}
+	if f.ThreadsPerDay == 0 {
+		f.ThreadsPerDay = 20
+	}
+	if f.NewAccountDays == 0 {
+		f.NewAccountDays = 7
+	}
+	if f.NewAccountPostIntervalSeconds == 0 {
+		f.NewAccountPostIntervalSeconds = 60
+	}
+	if f.NewAccountThreadsPerDay == 0 {
+		f.NewAccountThreadsPerDay = 3
+	}
+	if f.MessagesPerMinute == 0 {
+		f.MessagesPerMinute = 20
+	}
+	if f.ConversationsPerDay == 0 {
+		f.ConversationsPerDay = 20
+	}
+	if f.NewAccountMessagesPerMinute == 0 {
+		f.NewAccountMessagesPerMinute = 5
+	}
+	if f.NewAccountConversationsPerDay == 0 {
+		f.NewAccountConversationsPerDay = 3
+	}
+	return nil
+}
+
+// AnalyticsConfig configures periodic influence snapshots of users
+type AnalyticsConfig struct {
+	// negative disables periodic snapshots
+	InfluenceIntervalMinutes int `toml:"influence_interval_minutes"`
+	// 0 - all interactions
+	InfluenceWindowDays int `toml:"influence_window_days"`
+	BetweennessSamples  int `toml:"betweenness_samples"`
+	KeepSnapshots       int `toml:"keep_snapshots"`
+}
+
+func (a *AnalyticsConfig) check() error {
+	if a.InfluenceIntervalMinutes == 0 {
+		a.InfluenceIntervalMinutes = 60
+	}
+	if a.InfluenceWindowDays < 0 {
+		return fmt.Errorf("analytics influence_window_days must not be negative")
+	}
+	if a.BetweennessSamples == 0 {
+		a.BetweennessSamples = 256
+	}
+	if a.BetweennessSamples < 0 {
+		return fmt.Errorf("analytics betweenness_samples must be positive")
+	}
+	if a.KeepSnapshots == 0 {
+		a.KeepSnapshots = 48
+	}
+	if a.KeepSnapshots < 0 {
+		return fmt.Errorf("analytics keep_snapshots must be positive")
+	}
+	return nil
+}
+
 type AppConfig struct {
-	Database DatabaseConfig `toml:"database"`
-	Server   ServerConfig   `toml:"server"`
+	Database    DatabaseConfig    `toml:"database"`
+	Server      ServerConfig      `toml:"server"`
+	Reputation  ReputationConfig  `toml:"reputation"`
+	Live        LiveConfig        `toml:"live"`
+	Attachments AttachmentsConfig `toml:"attachments"`
+	Polls       PollsConfig       `toml:"polls"`
+	Threads     ThreadsConfig     `toml:"threads"`
+	Filter      FilterConfig      `toml:"filter"`
+	Flood       FloodConfig       `toml:"flood"`
+	Analytics   AnalyticsConfig   `toml:"analytics"`
 }
 
 // MustReadAppConfig reads the application configuration.
@@ -149,6 +538,46 @@ func MustReadAppConfig(cmdConfig *CmdConfig) *AppConfig {
 		log.Fatalf("invalid server config from file \"%s\", environment or command-line: %v", cfgPath, err)
 	}
 
+	err = appConfig.Reputation.check()
+	if err != nil {
+		log.Fatalf("invalid reputation config from file \"%s\": %v", cfgPath, err)
+	}
+
+	err = appConfig.Live.check()
+	if err != nil {
+		log.Fatalf("invalid live config from file \"%s\": %v", cfgPath, err)
+	}
+
+	err = appConfig.Attachments.check()
+	if err != nil {
+		log.Fatalf("invalid attachments config from file \"%s\" or environment: %v", cfgPath, err)
+	}
+
+	err = appConfig.Polls.check()
+	if err != nil {
+		log.Fatalf("invalid polls config from file \"%s\": %v", cfgPath, err)
+	}
+
+	err = appConfig.Threads.check()
+	if err != nil {
+		log.Fatalf("invalid threads config from file \"%s\": %v", cfgPath, err)
+	}
+
+	err = appConfig.Filter.check()
+	if err != nil {
+		log.Fatalf("invalid filter config from file \"%s\": %v", cfgPath, err)
+	}
+
+	err = appConfig.Flood.check()
+	if err != nil {
+		log.Fatalf("invalid flood config from file \"%s\": %v", cfgPath, err)
+	}
+
+	err = appConfig.Analytics.check()
+	if err != nil {
+		log.Fatalf("invalid analytics config from file \"%s\": %v", cfgPath, err)
+	}
+
 	return &appConfig
 }
 
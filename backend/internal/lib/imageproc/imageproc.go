@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+// Package imageproc prepares uploaded photos for publishing: removes metadata (EXIF with GPS
+// coordinates, XMP, comments), applies EXIF orientation, makes thumbnails and blurhash.
+package imageproc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"slices"
+	"strconv"
+
+	_ "golang.org/x/image/webp" // register webp decoder
+)
+
+const (
+	TypeJPEG = "image/jpeg"
+	TypePNG  = "image/png"
+	TypeWebP = "image/webp"
+)
+
+var (
+	ErrUnsupported   = errors.New("image type is not supported")
+	ErrTooManyPixels = errors.New("image has too many pixels")
+)
+
+// Supported reports if images of content type can be processed
+func Supported(contentType string) bool {
+	return contentType == TypeJPEG || contentType == TypePNG || contentType == TypeWebP
+}
+
+type Options struct {
+	// images with more pixels are not decoded (decompression bomb protection)
+	MaxPixels int
+	// quality of encoded jpeg images 1-100
+	JPEGQuality int
+	// max width and height of thumbnails, thumbnail is not made if image is not larger
+	Sizes []int
+}
+
+// Variant is resized copy of image
+type Variant struct {
+	Name        string // max size, e.g. "320"
+	Data        []byte
+	ContentType string
+	Width       int
+	Height      int
+}
+
+type Result struct {
+	// image without metadata, it is re-encoded only when orientation is applied
+	Original    []byte
+	ContentType string
+	Width       int
+	Height      int
+	Blurhash    string
+	Variants    []Variant
+}
+
+// Process strips metadata of image and makes thumbnails
+func Process(data []byte, contentType string, opts Options) (Result, error) {
+	if !Supported(contentType) {
+		return Result{}, ErrUnsupported
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return Result{}, fmt.Errorf("decode image config: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > opts.MaxPixels {
+		return Result{}, ErrTooManyPixels
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Result{}, fmt.Errorf("decode image: %w", err)
+	}
+	img := toRGBA(decoded)
+
+	res := Result{ContentType: contentType}
+	orientation := 1
+	if contentType == TypeJPEG {
+		orientation = jpegOrientation(data)
+	}
+	if orientation > 1 {
+		// orientation is stored in removed EXIF, so pixels are rotated instead
+		img = orient(img, orientation)
+		res.Original, err = encodeJPEG(img, opts.JPEGQuality)
+	} else {
+		res.Original, err = stripMetadata(data, contentType)
+	}
+	if err != nil {
+		return Result{}, fmt.Errorf("strip image metadata: %w", err)
+	}
+	res.Width, res.Height = img.Bounds().Dx(), img.Bounds().Dy()
+	res.Blurhash = blurhash(resize(img, blurhashSize), 4, 3)
+
+	sizes := slices.Clone(opts.Sizes)
+	slices.Sort(sizes)
+	opaque := img.Opaque()
+	for _, size := range sizes {
+		if size <= 0 || max(res.Width, res.Height) <= size {
+			continue
+		}
+		thumb := resize(img, size)
+		variant := Variant{
+			Name:   strconv.Itoa(size),
+			Width:  thumb.Bounds().Dx(),
+			Height: thumb.Bounds().Dy(),
+		}
+		// thumbnails of transparent images keep transparency
+		if opaque {
+			variant.ContentType = TypeJPEG
+			variant.Data, err = encodeJPEG(thumb, opts.JPEGQuality)
+		} else {
+			variant.ContentType = TypePNG
+			variant.Data, err = encodePNG(thumb)
+		}
+		if err != nil {
+			return Result{}, fmt.Errorf("encode thumbnail %d: %w", size, err)
+		}
+		res.Variants = append(res.Variants, variant)
+	}
+	return res, nil
+}
+
+func encodeJPEG(img image.Image, quality int) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func encodePNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := png.Encoder{CompressionLevel: png.BestCompression}
+	if err := encoder.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
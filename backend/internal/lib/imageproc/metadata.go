@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package imageproc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+var errMalformed = errors.New("malformed image")
+
+// stripMetadata removes metadata from image file without re-encoding pixels
+func stripMetadata(data []byte, contentType string) ([]byte, error) {
+	switch contentType {
+	case TypeJPEG:
+		return stripJPEG(data)
+	case TypePNG:
+		return stripPNG(data)
+	case TypeWebP:
+		return stripWebP(data)
+	}
+	return nil, ErrUnsupported
+}
+
+// stripJPEG keeps only segments needed to show image: JFIF (APP0), ICC color profile (APP2)
+// and Adobe color transform (APP14). EXIF and XMP (APP1), IPTC (APP13), multi-picture index
+// (APP2 MPF), other application segments and comments are removed. Data after end of image
+// is dropped: phones append secondary images and gain maps there with their own EXIF.
+func stripJPEG(data []byte) ([]byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, errMalformed
+	}
+	out := make([]byte, 0, len(data))
+	out = append(out, 0xFF, 0xD8)
+	pos := 2
+	for {
+		if pos+2 > len(data) || data[pos] != 0xFF {
+			return nil, errMalformed
+		}
+		marker := data[pos+1]
+		if marker == 0xFF { // fill byte
+			pos++
+			continue
+		}
+		if marker == 0xD9 { // end of image
+			return append(out, 0xFF, 0xD9), nil
+		}
+		if pos+4 > len(data) {
+			return nil, errMalformed
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return nil, errMalformed
+		}
+		if keepJPEGSegment(marker, data[pos+4:end]) {
+			out = append(out, data[pos:end]...)
+		}
+		pos = end
+		if marker == 0xDA { // start of scan is followed by entropy-coded data
+			scanEnd := jpegScanEnd(data, pos)
+			out = append(out, data[pos:scanEnd]...)
+			if scanEnd == len(data) { // truncated file without end of image
+				return out, nil
+			}
+			pos = scanEnd
+		}
+	}
+}
+
+// keepJPEGSegment tells if segment with marker and payload is needed to show image
+func keepJPEGSegment(marker byte, payload []byte) bool {
+	switch {
+	case marker == 0xE0, marker == 0xEE:
+		return true
+	case marker == 0xE2:
+		return bytes.HasPrefix(payload, []byte("ICC_PROFILE\x00"))
+	case marker >= 0xE0 && marker <= 0xEF, marker == 0xFE:
+		return false
+	}
+	return true
+}
+
+// jpegScanEnd returns position of the marker which ends entropy-coded data starting at pos.
+// Stuffed zero bytes (0xFF 0x00) and restart markers belong to the data.
+func jpegScanEnd(data []byte, pos int) int {
+	for pos+1 < len(data) {
+		if data[pos] == 0xFF {
+			next := data[pos+1]
+			if next != 0x00 && (next < 0xD0 || next > 0xD7) {
+				return pos
+			}
+			pos += 2
+			continue
+		}
+		pos++
+	}
+	return len(data)
+}
+
+// jpegOrientation returns EXIF orientation (1-8) of jpeg image, 1 if it is not set
+func jpegOrientation(data []byte) int {
+	pos := 2
+	for pos+4 <= len(data) && data[pos] == 0xFF {
+		marker := data[pos+1]
+		if marker == 0xDA {
+			break
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			break
+		}
+		segment := data[pos+4 : end]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		pos = end
+	}
+	return 1
+}
+
+// exifOrientation reads orientation tag from first IFD of EXIF TIFF structure
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < count; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			orientation := int(order.Uint16(tiff[entry+8:]))
+			if orientation < 1 || orientation > 8 {
+				return 1
+			}
+			return orientation
+		}
+	}
+	return 1
+}
+
+// png chunks with text, time and EXIF metadata
+var pngMetadataChunks = map[string]bool{
+	"eXIf": true, "tEXt": true, "zTXt": true, "iTXt": true, "tIME": true,
+}
+
+func stripPNG(data []byte) ([]byte, error) {
+	const signature = "\x89PNG\r\n\x1a\n"
+	if !bytes.HasPrefix(data, []byte(signature)) {
+		return nil, errMalformed
+	}
+	out := make([]byte, 0, len(data))
+	out = append(out, signature...)
+	pos := len(signature)
+	for pos < len(data) {
+		if pos+12 > len(data) {
+			return nil, errMalformed
+		}
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		end := pos + 12 + length // length, type, data and crc
+		if length < 0 || end > len(data) {
+			return nil, errMalformed
+		}
+		if !pngMetadataChunks[string(data[pos+4:pos+8])] {
+			out = append(out, data[pos:end]...)
+		}
+		pos = end
+	}
+	return out, nil
+}
+
+// stripWebP removes EXIF and XMP chunks of extended webp file and their flags in VP8X header
+func stripWebP(data []byte) ([]byte, error) {
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, errMalformed
+	}
+	out := make([]byte, 12, len(data))
+	copy(out, data[:12])
+	pos := 12
+	for pos < len(data) {
+		if pos+8 > len(data) {
+			return nil, errMalformed
+		}
+		fourCC := string(data[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(data[pos+4:]))
+		end := pos + 8 + size + size%2 // chunks are padded to even size
+		if size < 0 || end > len(data) {
+			return nil, errMalformed
+		}
+		switch fourCC {
+		case "EXIF", "XMP ":
+		case "VP8X":
+			chunkStart := len(out)
+			out = append(out, data[pos:end]...)
+			if size > 0 {
+				out[chunkStart+8] &^= 0x08 | 0x04 // EXIF and XMP flags
+			}
+		default:
+			out = append(out, data[pos:end]...)
+		}
+		pos = end
+	}
+	binary.LittleEndian.PutUint32(out[4:], uint32(len(out)-8))
+	return out, nil
+}
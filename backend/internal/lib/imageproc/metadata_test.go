@@ -0,0 +1,310 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package imageproc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+// join concatenates parts of test file
+func join(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
+
+func jpegSegment(marker byte, payload string) []byte {
+	segment := []byte{0xFF, marker, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+// exifSegment is APP1 with EXIF which has only orientation tag
+func exifSegment(order binary.ByteOrder, orientation uint16) []byte {
+	tiff := make([]byte, 8+2+12)
+	if order == binary.LittleEndian {
+		copy(tiff, "II")
+	} else {
+		copy(tiff, "MM")
+	}
+	order.PutUint16(tiff[2:], 42)
+	order.PutUint32(tiff[4:], 8)
+	order.PutUint16(tiff[8:], 1)
+	order.PutUint16(tiff[10:], 0x0112)
+	order.PutUint16(tiff[12:], 3) // SHORT
+	order.PutUint32(tiff[14:], 1)
+	order.PutUint16(tiff[18:], orientation)
+	return jpegSegment(0xE1, "Exif\x00\x00"+string(tiff))
+}
+
+var (
+	jpegSOI  = []byte{0xFF, 0xD8}
+	jpegEOI  = []byte{0xFF, 0xD9}
+	jfif     = jpegSegment(0xE0, "JFIF\x00\x01\x01")
+	icc      = jpegSegment(0xE2, "ICC_PROFILE\x00\x01\x01profile")
+	mpf      = jpegSegment(0xE2, "MPF\x00index")
+	xmp      = jpegSegment(0xE1, "http://ns.adobe.com/xap/1.0/\x00<x:xmpmeta/>")
+	iptc     = jpegSegment(0xED, "Photoshop 3.0\x00")
+	adobe    = jpegSegment(0xEE, "Adobe\x00")
+	comment  = jpegSegment(0xFE, "made with camera")
+	quant    = jpegSegment(0xDB, "\x00tables")
+	frame    = jpegSegment(0xC0, "\x08\x00\x01\x00\x01\x01\x01\x11\x00")
+	scan     = jpegSegment(0xDA, "\x01\x01\x00\x00\x3F\x00")
+	entropy  = []byte{0x12, 0xFF, 0x00, 0x34, 0xFF, 0xD0, 0x56}
+	trailing = join(jpegSOI, jpegSegment(0xE1, "Exif\x00\x00gain map"), jpegEOI)
+)
+
+func TestStripJPEG(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want []byte
+	}{
+		{
+			name: "without metadata",
+			data: join(jpegSOI, jfif, quant, frame, scan, entropy, jpegEOI),
+			want: join(jpegSOI, jfif, quant, frame, scan, entropy, jpegEOI),
+		},
+		{
+			name: "metadata removed",
+			data: join(jpegSOI, jfif, exifSegment(binary.BigEndian, 6), xmp, iptc, comment, quant, frame,
+				scan, entropy, jpegEOI),
+			want: join(jpegSOI, jfif, quant, frame, scan, entropy, jpegEOI),
+		},
+		{
+			name: "color profile and transform kept, multi-picture index removed",
+			data: join(jpegSOI, jfif, icc, mpf, adobe, quant, frame, scan, entropy, jpegEOI),
+			want: join(jpegSOI, jfif, icc, adobe, quant, frame, scan, entropy, jpegEOI),
+		},
+		{
+			name: "data after end of image dropped",
+			data: join(jpegSOI, jfif, quant, frame, scan, entropy, jpegEOI, trailing),
+			want: join(jpegSOI, jfif, quant, frame, scan, entropy, jpegEOI),
+		},
+		{
+			name: "progressive scans",
+			data: join(jpegSOI, frame, scan, entropy, quant, scan, entropy, jpegEOI),
+			want: join(jpegSOI, frame, scan, entropy, quant, scan, entropy, jpegEOI),
+		},
+		{
+			name: "fill bytes before marker",
+			data: join(jpegSOI, []byte{0xFF}, comment, frame, scan, entropy, []byte{0xFF}, jpegEOI),
+			want: join(jpegSOI, frame, scan, entropy, jpegEOI),
+		},
+		{
+			name: "truncated scan",
+			data: join(jpegSOI, exifSegment(binary.BigEndian, 6), frame, scan, entropy),
+			want: join(jpegSOI, frame, scan, entropy),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := stripJPEG(tt.data)
+			if err != nil {
+				t.Fatalf("stripJPEG() error = %v", err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("stripJPEG() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripJPEGMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"not jpeg", []byte("GIF89a....")},
+		{"no end of image", join(jpegSOI, jfif)},
+		{"garbage between segments", join(jpegSOI, jfif, []byte{0x00, 0x01}, jpegEOI)},
+		{"segment longer than file", join(jpegSOI, jfif[:len(jfif)-2])},
+		{"segment length too small", join(jpegSOI, []byte{0xFF, 0xE1, 0x00, 0x01}, jpegEOI)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := stripJPEG(tt.data); !errors.Is(err, errMalformed) {
+				t.Errorf("stripJPEG() error = %v, want %v", err, errMalformed)
+			}
+		})
+	}
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{
+			name: "big endian",
+			data: join(jpegSOI, jfif, exifSegment(binary.BigEndian, 6), frame, scan, entropy, jpegEOI),
+			want: 6,
+		},
+		{
+			name: "little endian",
+			data: join(jpegSOI, exifSegment(binary.LittleEndian, 8), frame, scan, entropy, jpegEOI),
+			want: 8,
+		},
+		{
+			name: "no exif",
+			data: join(jpegSOI, jfif, frame, scan, entropy, jpegEOI),
+			want: 1,
+		},
+		{
+			name: "invalid orientation",
+			data: join(jpegSOI, exifSegment(binary.BigEndian, 9), jpegEOI),
+			want: 1,
+		},
+		{
+			name: "xmp is not exif",
+			data: join(jpegSOI, xmp, jpegEOI),
+			want: 1,
+		},
+		{
+			name: "exif after start of scan",
+			data: join(jpegSOI, frame, scan, exifSegment(binary.BigEndian, 3), jpegEOI),
+			want: 1,
+		},
+		{
+			name: "truncated exif",
+			data: join(jpegSOI, jpegSegment(0xE1, "Exif\x00\x00MM\x00\x2A\x00\x00\x00\x08\x00\x05")),
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jpegOrientation(tt.data); got != tt.want {
+				t.Errorf("jpegOrientation() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+const pngSignature = "\x89PNG\r\n\x1a\n"
+
+// pngChunk has zero crc, it is not checked by stripPNG
+func pngChunk(chunkType, data string) []byte {
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(data)))
+	chunk = append(chunk, chunkType...)
+	chunk = append(chunk, data...)
+	return append(chunk, 0, 0, 0, 0)
+}
+
+func TestStripPNG(t *testing.T) {
+	ihdr := pngChunk("IHDR", "\x00\x00\x00\x01\x00\x00\x00\x01\x08\x02\x00\x00\x00")
+	iccp := pngChunk("iCCP", "profile\x00\x00data")
+	idat := pngChunk("IDAT", "pixels")
+	iend := pngChunk("IEND", "")
+	tests := []struct {
+		name string
+		data []byte
+		want []byte
+	}{
+		{
+			name: "without metadata",
+			data: join([]byte(pngSignature), ihdr, iccp, idat, iend),
+			want: join([]byte(pngSignature), ihdr, iccp, idat, iend),
+		},
+		{
+			name: "metadata removed",
+			data: join([]byte(pngSignature), ihdr, pngChunk("eXIf", "MM\x00\x2A"), pngChunk("tEXt", "Author\x00me"),
+				pngChunk("tIME", "\x07\xe9\x01\x01\x00\x00\x00"), idat, pngChunk("zTXt", "c\x00\x00z"),
+				pngChunk("iTXt", "XML:com.adobe.xmp\x00\x00\x00\x00\x00<x/>"), iend),
+			want: join([]byte(pngSignature), ihdr, idat, iend),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := stripPNG(tt.data)
+			if err != nil {
+				t.Fatalf("stripPNG() error = %v", err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("stripPNG() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	for _, data := range [][]byte{
+		[]byte("not png"),
+		join([]byte(pngSignature), ihdr[:10]),
+		join([]byte(pngSignature), ihdr, pngChunk("IDAT", "pixels")[:12]),
+	} {
+		if _, err := stripPNG(data); !errors.Is(err, errMalformed) {
+			t.Errorf("stripPNG(%q) error = %v, want %v", data, err, errMalformed)
+		}
+	}
+}
+
+// riffChunk is padded to even size
+func riffChunk(fourCC, data string) []byte {
+	chunk := append([]byte(fourCC), binary.LittleEndian.AppendUint32(nil, uint32(len(data)))...)
+	chunk = append(chunk, data...)
+	if len(data)%2 == 1 {
+		chunk = append(chunk, 0)
+	}
+	return chunk
+}
+
+func webpFile(chunks ...[]byte) []byte {
+	body := join(chunks...)
+	header := append([]byte("RIFF"), binary.LittleEndian.AppendUint32(nil, uint32(len(body)+4))...)
+	return join(header, []byte("WEBP"), body)
+}
+
+func TestStripWebP(t *testing.T) {
+	// flags: ICC 0x20, alpha 0x10, EXIF 0x08, XMP 0x04
+	vp8x := func(flags byte) []byte {
+		return riffChunk("VP8X", string([]byte{flags, 0, 0, 0, 0, 0, 0, 0, 0, 0}))
+	}
+	iccp := riffChunk("ICCP", "profile")
+	image := riffChunk("VP8 ", "frame")
+	tests := []struct {
+		name string
+		data []byte
+		want []byte
+	}{
+		{
+			name: "simple format",
+			data: webpFile(image),
+			want: webpFile(image),
+		},
+		{
+			name: "metadata and flags removed",
+			data: webpFile(vp8x(0x20|0x10|0x08|0x04), iccp, image, riffChunk("EXIF", "MM\x00\x2A\x00"),
+				riffChunk("XMP ", "<x:xmpmeta/>")),
+			want: webpFile(vp8x(0x20|0x10), iccp, image),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := stripWebP(tt.data)
+			if err != nil {
+				t.Fatalf("stripWebP() error = %v", err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("stripWebP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	for _, data := range [][]byte{
+		[]byte("RIFF\x00\x00\x00\x00WAVE"),
+		webpFile(image)[:len(webpFile(image))-2],
+		join(webpFile(image), []byte("VP8")),
+	} {
+		if _, err := stripWebP(data); !errors.Is(err, errMalformed) {
+			t.Errorf("stripWebP(%q) error = %v, want %v", data, err, errMalformed)
+		}
+	}
+}
+
+func TestStripMetadataUnsupported(t *testing.T) {
+	if _, err := stripMetadata([]byte("GIF89a"), "image/gif"); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("stripMetadata() error = %v, want %v", err, ErrUnsupported)
+	}
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package imageproc
+
+import (
+	"image"
+	"slices"
+	"testing"
+)
+
+// testImage makes image with rows of pixels, red channel of pixel is its value
+func testImage(rows [][]uint8) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, len(rows[0]), len(rows)))
+	for y, row := range rows {
+		for x, value := range row {
+			img.Pix[img.PixOffset(x, y)] = value
+		}
+	}
+	return img
+}
+
+func imageRows(img *image.RGBA) [][]uint8 {
+	rows := make([][]uint8, img.Bounds().Dy())
+	for y := range rows {
+		rows[y] = make([]uint8, img.Bounds().Dx())
+		for x := range rows[y] {
+			rows[y][x] = img.Pix[img.PixOffset(x, y)]
+		}
+	}
+	return rows
+}
+
+func TestOrient(t *testing.T) {
+	src := [][]uint8{
+		{0, 1, 2},
+		{3, 4, 5},
+	}
+	tests := []struct {
+		orientation int
+		want        [][]uint8
+	}{
+		{1, [][]uint8{{0, 1, 2}, {3, 4, 5}}},
+		{2, [][]uint8{{2, 1, 0}, {5, 4, 3}}},
+		{3, [][]uint8{{5, 4, 3}, {2, 1, 0}}},
+		{4, [][]uint8{{3, 4, 5}, {0, 1, 2}}},
+		{5, [][]uint8{{0, 3}, {1, 4}, {2, 5}}},
+		{6, [][]uint8{{3, 0}, {4, 1}, {5, 2}}},
+		{7, [][]uint8{{5, 2}, {4, 1}, {3, 0}}},
+		{8, [][]uint8{{2, 5}, {1, 4}, {0, 3}}},
+	}
+	for _, tt := range tests {
+		got := imageRows(orient(testImage(src), tt.orientation))
+		if !slices.EqualFunc(got, tt.want, slices.Equal) {
+			t.Errorf("orient(%d) = %v, want %v", tt.orientation, got, tt.want)
+		}
+	}
+}
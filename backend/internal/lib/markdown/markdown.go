@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+// Package markdown renders user content (CommonMark + GFM) to sanitized HTML.
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// Renderer converts markdown source to HTML safe to insert into a page.
+// Raw HTML in source is never passed through, rendered result is additionally
+// cleaned by allow-list sanitizer. Renderer is safe for concurrent use.
+type Renderer struct {
+	md     goldmark.Markdown
+	policy *bluemonday.Policy
+}
+
+// code block language hint produced by fenced code blocks: ```go -> class="language-go"
+var languageClassRe = regexp.MustCompile(`^language-[a-zA-Z0-9+#_-]+$`)
+
+func NewRenderer() *Renderer {
+	md := goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+	)
+
+	policy := bluemonday.UGCPolicy()
+	policy.AllowAttrs("class").Matching(languageClassRe).OnElements("code")
+	policy.AllowAttrs("type", "checked", "disabled").OnElements("input") // GFM task lists
+	policy.RequireNoFollowOnLinks(true)
+	policy.AddTargetBlankToFullyQualifiedLinks(false)
+
+	return &Renderer{md: md, policy: policy}
+}
+
+// Render returns sanitized HTML of markdown source. On rendering error source
+// is returned as escaped text.
+func (r *Renderer) Render(source string) string {
+	var buf bytes.Buffer
+	if err := r.md.Convert([]byte(source), &buf); err != nil {
+		return "<p>" + html.EscapeString(source) + "</p>"
+	}
+	return r.policy.Sanitize(buf.String())
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+// Package mention finds @username mentions in text.
+package mention
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// name may contain letters, digits, "_", "." and "-", but not end with "." or "-"
+// (mention at the end of sentence), mention must not be part of a word or email
+var mentionRe = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_.@-])@([\p{L}\p{N}_.\-]+)`)
+
+// Span is one @name occurrence in text. Offset and Length are in unicode code points
+// and include leading "@".
+type Span struct {
+	Name   string
+	Offset int
+	Length int
+}
+
+// Find returns all mentions of text in order of occurrence
+func Find(text string) []Span {
+	var spans []Span
+	for _, match := range mentionRe.FindAllStringSubmatchIndex(text, -1) {
+		nameStart, nameEnd := match[2], match[3]
+		name := strings.TrimRight(text[nameStart:nameEnd], ".-")
+		if name == "" {
+			continue
+		}
+		spans = append(spans, Span{
+			Name:   name,
+			Offset: utf8.RuneCountInString(text[:nameStart-1]),
+			Length: utf8.RuneCountInString(name) + 1,
+		})
+	}
+	return spans
+}
+
+// Names returns unique mentioned names of text
+func Names(text string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, span := range Find(text) {
+		if !seen[span.Name] {
+			seen[span.Name] = true
+			names = append(names, span.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package mention
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []Span
+	}{
+		{name: "no mentions", text: "hello world"},
+		{name: "start of text", text: "@bob hi", want: []Span{{"bob", 0, 4}}},
+		{name: "after space", text: "hi @bob", want: []Span{{"bob", 3, 4}}},
+		{
+			name: "several",
+			text: "@alice, @bob.",
+			want: []Span{{"alice", 0, 6}, {"bob", 8, 4}},
+		},
+		{name: "end of sentence", text: "thanks @bob-.", want: []Span{{"bob", 7, 4}}},
+		{name: "dots and dashes inside", text: "@john.doe-2_x", want: []Span{{"john.doe-2_x", 0, 13}}},
+		{name: "in brackets", text: "(@bob)", want: []Span{{"bob", 1, 4}}},
+		{name: "unicode offset", text: "привет @Вася!", want: []Span{{"Вася", 7, 5}}},
+		{name: "email", text: "mail me at bob@example.com"},
+		{name: "part of word", text: "a@bob"},
+		{name: "double at", text: "@@bob"},
+		{name: "only at", text: "@ @. @-"},
+		{name: "after newline", text: "line\n@bob", want: []Span{{"bob", 5, 4}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Find(tt.text); !slices.Equal(got, tt.want) {
+				t.Errorf("Find(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	got := Names("@bob and @alice, @bob again")
+	want := []string{"bob", "alice"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+}
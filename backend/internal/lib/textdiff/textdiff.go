@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+// Package textdiff finds line or word differences between two texts with Myers algorithm
+// (An O(ND) Difference Algorithm and Its Variations, 1986).
+package textdiff
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	OpEqual  = "equal"
+	OpInsert = "insert"
+	OpDelete = "delete"
+)
+
+// Op is a piece of text equal in both texts, inserted into new text or deleted from old one.
+// Concatenation of equal and deleted pieces is old text, of equal and inserted - new text.
+type Op struct {
+	Kind string
+	Text string
+}
+
+// Lines compares texts line by line, line includes its ending newline
+func Lines(oldText, newText string) []Op {
+	return diff(splitLines(oldText), splitLines(newText))
+}
+
+// Words compares texts word by word, whitespace runs between words are compared as separate tokens
+func Words(oldText, newText string) []Op {
+	return diff(splitWords(oldText), splitWords(newText))
+}
+
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// splitWords splits text into runs of whitespace and runs of other characters
+func splitWords(text string) []string {
+	var words []string
+	start := 0
+	for i, r := range text {
+		if i > start {
+			prev, _ := utf8.DecodeLastRuneInString(text[:i])
+			if unicode.IsSpace(prev) != unicode.IsSpace(r) {
+				words = append(words, text[start:i])
+				start = i
+			}
+		}
+	}
+	if start < len(text) {
+		words = append(words, text[start:])
+	}
+	return words
+}
+
+// diff returns shortest edit script of token lists with adjacent operations of the same kind merged
+func diff(a, b []string) []Op {
+	// common prefix and suffix are cut, edits of texts are usually small
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var res []Op
+	add := func(kind string, tokens []string) {
+		if len(tokens) == 0 {
+			return
+		}
+		text := strings.Join(tokens, "")
+		if len(res) > 0 && res[len(res)-1].Kind == kind {
+			res[len(res)-1].Text += text
+			return
+		}
+		res = append(res, Op{Kind: kind, Text: text})
+	}
+	add(OpEqual, a[:prefix])
+	myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix], add)
+	add(OpEqual, a[len(a)-suffix:])
+	return res
+}
+
+// myers reports edit script of a to b in text order
+func myers(a, b []string, add func(kind string, tokens []string)) {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		add(OpDelete, a)
+		add(OpInsert, b)
+		return
+	}
+	maxD := n + m
+	offset := maxD
+	// v[k+offset] is the furthest x on diagonal k, trace keeps v of every d to restore the path
+	v := make([]int, 2*maxD+2)
+	var trace [][]int
+	found := false
+	for d := 0; d <= maxD && !found; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || k != d && v[k-1+offset] < v[k+1+offset] {
+				x = v[k+1+offset] // down: insertion
+			} else {
+				x = v[k-1+offset] + 1 // right: deletion
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[k+offset] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+	}
+
+	// path is restored from the end, operations are collected in reverse order
+	type step struct {
+		kind       string
+		start, end int // token range in a (equal, delete) or b (insert)
+	}
+	var steps []step
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || k != d && v[k-1+offset] < v[k+1+offset] {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[prevK+offset]
+		prevY := prevX - prevK
+		// snake of equal tokens after the edit
+		startX := 0
+		if d > 0 {
+			startX = prevX
+			if prevK != k+1 {
+				startX++
+			}
+		}
+		if x > startX {
+			steps = append(steps, step{OpEqual, startX, x})
+		}
+		if d > 0 {
+			if prevK == k+1 {
+				steps = append(steps, step{OpInsert, prevY, prevY + 1})
+			} else {
+				steps = append(steps, step{OpDelete, prevX, prevX + 1})
+			}
+		}
+		x, y = prevX, prevY
+	}
+	for i := len(steps) - 1; i >= 0; i-- {
+		s := steps[i]
+		if s.kind == OpInsert {
+			add(s.kind, b[s.start:s.end])
+		} else {
+			add(s.kind, a[s.start:s.end])
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package textdiff
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// texts restores old and new text from operations
+func texts(ops []Op) (string, string) {
+	var oldText, newText strings.Builder
+	for _, op := range ops {
+		if op.Kind != OpInsert {
+			oldText.WriteString(op.Text)
+		}
+		if op.Kind != OpDelete {
+			newText.WriteString(op.Text)
+		}
+	}
+	return oldText.String(), newText.String()
+}
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		oldText string
+		newText string
+		want    []Op
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:    "equal",
+			oldText: "a\nb\n",
+			newText: "a\nb\n",
+			want:    []Op{{OpEqual, "a\nb\n"}},
+		},
+		{
+			name:    "insert into empty",
+			newText: "a\nb\n",
+			want:    []Op{{OpInsert, "a\nb\n"}},
+		},
+		{
+			name:    "delete all",
+			oldText: "a\nb\n",
+			want:    []Op{{OpDelete, "a\nb\n"}},
+		},
+		{
+			name:    "changed line",
+			oldText: "a\nb\nc\n",
+			newText: "a\nx\nc\n",
+			want:    []Op{{OpEqual, "a\n"}, {OpDelete, "b\n"}, {OpInsert, "x\n"}, {OpEqual, "c\n"}},
+		},
+		{
+			name:    "inserted lines",
+			oldText: "a\nd\n",
+			newText: "a\nb\nc\nd\n",
+			want:    []Op{{OpEqual, "a\n"}, {OpInsert, "b\nc\n"}, {OpEqual, "d\n"}},
+		},
+		{
+			name:    "deleted and inserted apart",
+			oldText: "a\nb\nc\nd\n",
+			newText: "b\nc\nd\ne\n",
+			want:    []Op{{OpDelete, "a\n"}, {OpEqual, "b\nc\nd\n"}, {OpInsert, "e\n"}},
+		},
+		{
+			name:    "added newline at end",
+			oldText: "a\nb",
+			newText: "a\nb\n",
+			want:    []Op{{OpEqual, "a\n"}, {OpDelete, "b"}, {OpInsert, "b\n"}},
+		},
+		{
+			name:    "moved line",
+			oldText: "a\nb\nc\n",
+			newText: "b\nc\na\n",
+			want:    []Op{{OpDelete, "a\n"}, {OpEqual, "b\nc\n"}, {OpInsert, "a\n"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Lines(tt.oldText, tt.newText)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Lines() = %q, want %q", got, tt.want)
+			}
+			if oldText, newText := texts(got); oldText != tt.oldText || newText != tt.newText {
+				t.Errorf("operations restore %q and %q", oldText, newText)
+			}
+		})
+	}
+}
+
+func TestWords(t *testing.T) {
+	tests := []struct {
+		name    string
+		oldText string
+		newText string
+		want    []Op
+	}{
+		{
+			name:    "inserted word",
+			oldText: "hello world",
+			newText: "hello there world",
+			want:    []Op{{OpEqual, "hello "}, {OpInsert, "there "}, {OpEqual, "world"}},
+		},
+		{
+			name:    "deleted word",
+			oldText: "a b c",
+			newText: "a c",
+			want:    []Op{{OpEqual, "a "}, {OpDelete, "b "}, {OpEqual, "c"}},
+		},
+		{
+			name:    "replaced word",
+			oldText: "the quick fox",
+			newText: "the slow fox",
+			want:    []Op{{OpEqual, "the "}, {OpDelete, "quick"}, {OpInsert, "slow"}, {OpEqual, " fox"}},
+		},
+		{
+			name:    "whitespace is token",
+			oldText: "привет  мир",
+			newText: "привет\tмир",
+			want:    []Op{{OpEqual, "привет"}, {OpDelete, "  "}, {OpInsert, "\t"}, {OpEqual, "мир"}},
+		},
+		{
+			name:    "leading and trailing whitespace",
+			oldText: " word",
+			newText: "word\n",
+			want:    []Op{{OpDelete, " "}, {OpEqual, "word"}, {OpInsert, "\n"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Words(tt.oldText, tt.newText)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Words() = %q, want %q", got, tt.want)
+			}
+			if oldText, newText := texts(got); oldText != tt.oldText || newText != tt.newText {
+				t.Errorf("operations restore %q and %q", oldText, newText)
+			}
+		})
+	}
+}
+
+// edit script is the shortest one: changed tokens are not reported as equal and vice versa
+func TestDiffIsShortest(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		edits int
+	}{
+		{"abcabba", "cbabac", 5},
+		{"abc", "abc", 0},
+		{"abc", "", 3},
+		{"abcd", "acbd", 2},
+		{"xaxbxc", "abc", 3},
+	}
+	for _, tt := range tests {
+		ops := diff(strings.Split(tt.a, ""), strings.Split(tt.b, ""))
+		edits := 0
+		for _, op := range ops {
+			if op.Kind != OpEqual {
+				edits += len(op.Text)
+			}
+		}
+		if edits != tt.edits {
+			t.Errorf("diff(%q, %q) = %q has %d edits, want %d", tt.a, tt.b, ops, edits, tt.edits)
+		}
+		if oldText, newText := texts(ops); oldText != tt.a || newText != tt.b {
+			t.Errorf("diff(%q, %q) restores %q and %q", tt.a, tt.b, oldText, newText)
+		}
+	}
+}
@@ -0,0 +1,516 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AnalyticsRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewAnalyticsRepo(dsn string) (*AnalyticsRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &AnalyticsRepo{dbpool: pool}, nil
+}
+
+// Record adds interactions to daily edges of graph, interactions of user with own content are skipped
+func (r *AnalyticsRepo) Record(ctx context.Context, interactions []model.Interaction) error {
+	if len(interactions) == 0 {
+		return nil
+	}
+	sources := make([]int, len(interactions))
+	targets := make([]int, len(interactions))
+	kinds := make([]string, len(interactions))
+	threads := make([]int, len(interactions))
+	days := make([]time.Time, len(interactions))
+	for i, interaction := range interactions {
+		sources[i] = interaction.SourceID
+		targets[i] = interaction.TargetID
+		kinds[i] = interaction.Kind
+		threads[i] = interaction.ThreadID
+		days[i] = interaction.At.UTC().Truncate(24 * time.Hour)
+	}
+	_, err := r.dbpool.Exec(ctx,
+		`INSERT INTO interaction_edges (source_id, target_id, kind, community_id, day, weight)
+		SELECT i.source_id, i.target_id, i.kind, COALESCE(t.community_id, 0), i.day, count(*)
+		FROM unnest($1::integer[], $2::integer[], $3::text[], $4::integer[], $5::date[])
+				AS i(source_id, target_id, kind, thread_id, day)
+			JOIN threads t ON t.id = i.thread_id
+		WHERE i.source_id <> i.target_id
+		GROUP BY i.source_id, i.target_id, i.kind, COALESCE(t.community_id, 0), i.day
+		ON CONFLICT (source_id, target_id, kind, community_id, day)
+		DO UPDATE SET weight = interaction_edges.weight + EXCLUDED.weight`,
+		sources, targets, kinds, threads, days)
+	return err
+}
+
+// Remove takes back interactions recorded before, edges left without weight are deleted
+func (r *AnalyticsRepo) Remove(ctx context.Context, interactions []model.Interaction) error {
+	if len(interactions) == 0 {
+		return nil
+	}
+	sources := make([]int, len(interactions))
+	targets := make([]int, len(interactions))
+	kinds := make([]string, len(interactions))
+	threads := make([]int, len(interactions))
+	days := make([]time.Time, len(interactions))
+	for i, interaction := range interactions {
+		sources[i] = interaction.SourceID
+		targets[i] = interaction.TargetID
+		kinds[i] = interaction.Kind
+		threads[i] = interaction.ThreadID
+		days[i] = interaction.At.UTC().Truncate(24 * time.Hour)
+	}
+
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx,
+		`UPDATE interaction_edges e SET weight = e.weight - r.weight
+		FROM (
+			SELECT i.source_id, i.target_id, i.kind, COALESCE(t.community_id, 0) AS community_id, i.day,
+				count(*) AS weight
+			FROM unnest($1::integer[], $2::integer[], $3::text[], $4::integer[], $5::date[])
+					AS i(source_id, target_id, kind, thread_id, day)
+				JOIN threads t ON t.id = i.thread_id
+			GROUP BY i.source_id, i.target_id, i.kind, COALESCE(t.community_id, 0), i.day
+		) r
+		WHERE e.source_id = r.source_id AND e.target_id = r.target_id AND e.kind = r.kind
+			AND e.community_id = r.community_id AND e.day = r.day`,
+		sources, targets, kinds, threads, days)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `DELETE FROM interaction_edges WHERE source_id = ANY($1) AND weight <= 0`, sources)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// Rebuild replaces graph with edges calculated from visible posts, their mentions and current votes.
+// Returns number of stored edges.
+func (r *AnalyticsRepo) Rebuild(ctx context.Context) (int, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	// concurrent Record waits for rebuild, its interactions are counted once
+	if _, err := tx.Exec(ctx, `LOCK TABLE interaction_edges IN EXCLUSIVE MODE`); err != nil {
+		return 0, err
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM interaction_edges`); err != nil {
+		return 0, err
+	}
+	tag, err := tx.Exec(ctx,
+		`WITH visible_threads AS (
+			SELECT id, user_id, COALESCE(community_id, 0) AS community_id
+			FROM threads WHERE hidden_at IS NULL AND held_at IS NULL
+		), visible_posts AS (
+			SELECT p.id, p.user_id, p.thread_id, p.reply_to_id, p.created_at
+			FROM posts p JOIN visible_threads t ON t.id = p.thread_id
+			WHERE p.hidden_at IS NULL AND p.held_at IS NULL
+		), interactions AS (
+			SELECT p.user_id AS source_id, COALESCE(parent.user_id, t.user_id) AS target_id,
+				'reply' AS kind, t.community_id, p.created_at
+			FROM visible_posts p
+				JOIN visible_threads t ON t.id = p.thread_id
+				LEFT JOIN posts parent ON parent.id = p.reply_to_id
+			UNION ALL
+			SELECT m.author_id, m.user_id, 'mention', t.community_id, m.created_at
+			FROM mentions m JOIN visible_threads t ON t.id = m.thread_id
+			WHERE m.target_type = 'thread'
+				OR (m.target_type = 'post' AND m.target_id IN (SELECT id FROM visible_posts))
+			UNION ALL
+			SELECT v.user_id, v.target_user_id, 'vote', t.community_id, v.created_at
+			FROM votes v
+				LEFT JOIN visible_posts p ON v.target_type = 'post' AND p.id = v.target_id
+				JOIN visible_threads t
+					ON t.id = CASE WHEN v.target_type = 'thread' THEN v.target_id ELSE p.thread_id END
+		)
+		INSERT INTO interaction_edges (source_id, target_id, kind, community_id, day, weight)
+		SELECT source_id, target_id, kind, community_id, (created_at AT TIME ZONE 'UTC')::date, count(*)
+		FROM interactions
+		WHERE source_id <> target_id
+		GROUP BY source_id, target_id, kind, community_id, (created_at AT TIME ZONE 'UTC')::date`)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), tx.Commit(ctx)
+}
+
+// aggregatedEdges sums daily edges selected by filter into graph edges.
+// Arguments: $1 - first day, $2 - day after window, $3 - kinds, $4 - community, $5 - min weight.
+const aggregatedEdges = `SELECT source_id, target_id, sum(weight) AS weight,
+		COALESCE(sum(weight) FILTER (WHERE kind = 'reply'), 0) AS replies,
+		COALESCE(sum(weight) FILTER (WHERE kind = 'mention'), 0) AS mentions,
+		COALESCE(sum(weight) FILTER (WHERE kind = 'vote'), 0) AS votes
+	FROM interaction_edges
+	WHERE ($1::date IS NULL OR day >= $1) AND ($2::date IS NULL OR day < $2)
+		AND kind = ANY($3) AND ($4::integer IS NULL OR community_id = $4)
+	GROUP BY source_id, target_id
+	HAVING sum(weight) >= $5`
+
+// filterArgs are arguments of aggregatedEdges for filter
+func filterArgs(filter model.GraphFilter) []any {
+	var from, to *time.Time
+	if filter.From != nil {
+		day := filter.From.UTC().Truncate(24 * time.Hour)
+		from = &day
+	}
+	if filter.To != nil {
+		// the day of window end is included if window ends after its midnight
+		day := filter.To.UTC().Add(24*time.Hour - time.Nanosecond).Truncate(24 * time.Hour)
+		to = &day
+	}
+	return []any{from, to, filter.Kinds, filter.CommunityID, filter.MinWeight}
+}
+
+// Edges returns the heaviest edges of graph by filter (with kinds given), true if there are more edges
+// than filter limit
+func (r *AnalyticsRepo) Edges(ctx context.Context, filter model.GraphFilter) ([]model.GraphEdge, bool, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT source_id, target_id, weight, replies, mentions, votes
+		FROM (`+aggregatedEdges+`) e
+		ORDER BY weight DESC, source_id, target_id
+		LIMIT $6`,
+		append(filterArgs(filter), filter.Limit+1)...)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	edges := make([]model.GraphEdge, 0, filter.Limit+1)
+	for rows.Next() {
+		e, err := scanEdge(rows)
+		if err != nil {
+			return nil, false, err
+		}
+		edges = append(edges, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+	if len(edges) > filter.Limit {
+		return edges[:filter.Limit], true, nil
+	}
+	return edges, false, nil
+}
+
+// Export reads whole graph by filter (with kinds given, limit is ignored): all nodes ordered by user id
+// and then all edges ordered by source and target. They are passed to node and edge as rows are read,
+// so graph is never kept in memory. Nodes and edges are read from one snapshot of data.
+func (r *AnalyticsRepo) Export(ctx context.Context, filter model.GraphFilter,
+	node func(model.GraphNode) error, edge func(model.GraphEdge) error) error {
+
+	tx, err := r.dbpool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	args := filterArgs(filter)
+	rows, err := tx.Query(ctx,
+		`WITH edges AS (`+aggregatedEdges+`), degrees AS (
+			SELECT user_id, sum(in_degree) AS in_degree, sum(out_degree) AS out_degree,
+				sum(in_weight) AS in_weight, sum(out_weight) AS out_weight
+			FROM (
+				SELECT target_id AS user_id, 1 AS in_degree, 0 AS out_degree, weight AS in_weight, 0 AS out_weight
+				FROM edges
+				UNION ALL
+				SELECT source_id, 0, 1, 0, weight FROM edges
+			) d
+			GROUP BY user_id
+		)
+		SELECT d.user_id, COALESCE(u.name, ''), COALESCE(u.karma, 0),
+			(SELECT count(*) FROM posts p JOIN threads t ON t.id = p.thread_id
+			WHERE p.user_id = d.user_id AND p.hidden_at IS NULL AND p.held_at IS NULL
+				AND t.hidden_at IS NULL AND t.held_at IS NULL),
+			d.in_degree, d.out_degree, d.in_weight, d.out_weight
+		FROM degrees d LEFT JOIN users u ON u.id = d.user_id
+		ORDER BY d.user_id`,
+		args...)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var n model.GraphNode
+		err := rows.Scan(&n.UserID, &n.Name, &n.Karma, &n.PostsCount,
+			&n.InDegree, &n.OutDegree, &n.InWeight, &n.OutWeight)
+		if err == nil {
+			err = node(n)
+		}
+		if err != nil {
+			rows.Close()
+			return err
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	rows, err = tx.Query(ctx,
+		`SELECT source_id, target_id, weight, replies, mentions, votes
+		FROM (`+aggregatedEdges+`) e
+		ORDER BY source_id, target_id`,
+		args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		e, err := scanEdge(rows)
+		if err == nil {
+			err = edge(e)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func scanEdge(row pgx.Row) (model.GraphEdge, error) {
+	var e model.GraphEdge
+	err := row.Scan(&e.SourceID, &e.TargetID, &e.Weight, &e.Replies, &e.Mentions, &e.Votes)
+	return e, err
+}
+
+// Users returns attributes of existing users for graph nodes
+func (r *AnalyticsRepo) Users(ctx context.Context, userIds []int) (map[int]model.GraphUser, error) {
+	res := make(map[int]model.GraphUser, len(userIds))
+	if len(userIds) == 0 {
+		return res, nil
+	}
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT id, name, karma,
+			(SELECT count(*) FROM posts p JOIN threads t ON t.id = p.thread_id
+			WHERE p.user_id = users.id AND p.hidden_at IS NULL AND p.held_at IS NULL
+				AND t.hidden_at IS NULL AND t.held_at IS NULL)
+		FROM users WHERE id = ANY($1)`,
+		userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		var user model.GraphUser
+		if err := rows.Scan(&id, &user.Name, &user.Karma, &user.PostsCount); err != nil {
+			return nil, err
+		}
+		res[id] = user
+	}
+	return res, rows.Err()
+}
+
+// Communities returns communities with interactions since from (nil - all time)
+func (r *AnalyticsRepo) Communities(ctx context.Context, from *time.Time) ([]int, error) {
+	var day *time.Time
+	if from != nil {
+		d := from.UTC().Truncate(24 * time.Hour)
+		day = &d
+	}
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT DISTINCT community_id FROM interaction_edges
+		WHERE community_id > 0 AND ($1::date IS NULL OR day >= $1)
+		ORDER BY community_id`,
+		day)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		res = append(res, id)
+	}
+	return res, rows.Err()
+}
+
+// SaveSnapshot stores snapshot with scores of users and deletes snapshots of the same community
+// older than the last keep ones. Returns id of snapshot.
+func (r *AnalyticsRepo) SaveSnapshot(
+	ctx context.Context, snapshot model.InfluenceSnapshot, scores []model.InfluenceScore, keep int) (int, error) {
+
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var id int
+	err = tx.QueryRow(ctx,
+		`INSERT INTO influence_snapshots (community_id, window_from, users_count, edges_count, duration_ms)
+		VALUES ($1, $2, $3, $4, $5) RETURNING id`,
+		snapshot.CommunityID, snapshot.From, snapshot.UsersCount, snapshot.EdgesCount,
+		snapshot.Duration.Milliseconds()).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	n := len(scores)
+	users, ranks := make([]int, n), make([]int, n)
+	pageRanks, betweenness := make([]float64, n), make([]float64, n)
+	inDegrees, outDegrees, inWeights, outWeights := make([]int, n), make([]int, n), make([]int, n), make([]int, n)
+	for i, score := range scores {
+		users[i], ranks[i] = score.UserID, score.Rank
+		pageRanks[i], betweenness[i] = score.PageRank, score.Betweenness
+		inDegrees[i], outDegrees[i] = score.InDegree, score.OutDegree
+		inWeights[i], outWeights[i] = score.InWeight, score.OutWeight
+	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO influence_scores (snapshot_id, user_id, rank, pagerank, betweenness,
+			in_degree, out_degree, in_weight, out_weight)
+		SELECT $1::integer, * FROM unnest($2::integer[], $3::integer[], $4::double precision[], $5::double precision[],
+			$6::integer[], $7::integer[], $8::integer[], $9::integer[])`,
+		id, users, ranks, pageRanks, betweenness, inDegrees, outDegrees, inWeights, outWeights)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(ctx,
+		`WITH old AS (
+			SELECT id FROM influence_snapshots
+			WHERE community_id IS NOT DISTINCT FROM $1
+			ORDER BY id DESC OFFSET $2
+		), deleted_scores AS (
+			DELETE FROM influence_scores WHERE snapshot_id IN (SELECT id FROM old)
+		)
+		DELETE FROM influence_snapshots WHERE id IN (SELECT id FROM old)`,
+		snapshot.CommunityID, keep)
+	if err != nil {
+		return 0, err
+	}
+	return id, tx.Commit(ctx)
+}
+
+const snapshotColumns = `s.id, s.community_id, s.window_from, s.users_count, s.edges_count, s.duration_ms, s.created_at`
+
+func scanSnapshot(row pgx.Row, dest ...any) (model.InfluenceSnapshot, error) {
+	var snapshot model.InfluenceSnapshot
+	var durationMs int64
+	err := row.Scan(append([]any{&snapshot.ID, &snapshot.CommunityID, &snapshot.From, &snapshot.UsersCount,
+		&snapshot.EdgesCount, &durationMs, &snapshot.CreatedAt}, dest...)...)
+	snapshot.Duration = time.Duration(durationMs) * time.Millisecond
+	return snapshot, err
+}
+
+// LatestSnapshot returns the latest snapshot of community (nil - whole forum),
+// model.ErrNotFound if there is no snapshot
+func (r *AnalyticsRepo) LatestSnapshot(ctx context.Context, communityId *int) (model.InfluenceSnapshot, error) {
+	snapshot, err := scanSnapshot(r.dbpool.QueryRow(ctx,
+		`SELECT `+snapshotColumns+` FROM influence_snapshots s
+		WHERE s.community_id IS NOT DISTINCT FROM $1
+		ORDER BY s.id DESC LIMIT 1`,
+		communityId))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.InfluenceSnapshot{}, model.ErrNotFound
+	}
+	return snapshot, err
+}
+
+// order of leaderboard by metric, ties are ordered by PageRank position
+var metricOrder = map[string]string{
+	model.MetricPageRank:       "sc.rank",
+	model.MetricBetweenness:    "sc.betweenness DESC, sc.rank",
+	model.MetricInDegree:       "sc.in_degree DESC, sc.rank",
+	model.MetricOutDegree:      "sc.out_degree DESC, sc.rank",
+	model.MetricWeightedDegree: "sc.in_weight + sc.out_weight DESC, sc.rank",
+}
+
+const scoreColumns = `sc.user_id, COALESCE(u.name, ''), sc.rank, sc.pagerank, sc.betweenness,
+	sc.in_degree, sc.out_degree, sc.in_weight, sc.out_weight`
+
+func scoreDest(score *model.InfluenceScore) []any {
+	return []any{&score.UserID, &score.Name, &score.Rank, &score.PageRank, &score.Betweenness,
+		&score.InDegree, &score.OutDegree, &score.InWeight, &score.OutWeight}
+}
+
+// Leaderboard returns the first limit users of snapshot ordered by metric
+func (r *AnalyticsRepo) Leaderboard(
+	ctx context.Context, snapshotId int, metric string, limit int) ([]model.InfluenceScore, error) {
+
+	order, ok := metricOrder[metric]
+	if !ok {
+		return nil, fmt.Errorf("unknown metric %q", metric)
+	}
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT `+scoreColumns+`
+		FROM influence_scores sc LEFT JOIN users u ON u.id = sc.user_id
+		WHERE sc.snapshot_id = $1
+		ORDER BY `+order+`
+		LIMIT $2`,
+		snapshotId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]model.InfluenceScore, 0, limit)
+	for rows.Next() {
+		var score model.InfluenceScore
+		if err := rows.Scan(scoreDest(&score)...); err != nil {
+			return nil, err
+		}
+		res = append(res, score)
+	}
+	return res, rows.Err()
+}
+
+// UserInfluence returns scores of user in the latest snapshots of forum and communities,
+// forum first and then communities by id
+func (r *AnalyticsRepo) UserInfluence(ctx context.Context, userId int) ([]model.UserInfluence, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`WITH latest AS (
+			SELECT DISTINCT ON (community_id) *
+			FROM influence_snapshots
+			ORDER BY community_id, id DESC
+		)
+		SELECT `+snapshotColumns+`, `+scoreColumns+`
+		FROM latest s
+			JOIN influence_scores sc ON sc.snapshot_id = s.id AND sc.user_id = $1
+			LEFT JOIN users u ON u.id = sc.user_id
+		ORDER BY s.community_id NULLS FIRST`,
+		userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.UserInfluence
+	for rows.Next() {
+		var influence model.UserInfluence
+		influence.Snapshot, err = scanSnapshot(rows, scoreDest(&influence.Score)...)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, influence)
+	}
+	return res, rows.Err()
+}
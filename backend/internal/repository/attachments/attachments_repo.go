@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package attachments
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AttachmentsRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewAttachmentsRepo(dsn string) (*AttachmentsRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &AttachmentsRepo{dbpool: pool}, nil
+}
+
+const attachmentColumns = `id, user_id, thread_id, post_id, storage_key, file_name, content_type, size, created_at,
+	status, width, height, blurhash`
+
+func scanAttachment(row pgx.Row) (model.Attachment, error) {
+	var a model.Attachment
+	err := row.Scan(&a.ID, &a.UserID, &a.ThreadID, &a.PostID, &a.StorageKey, &a.FileName,
+		&a.ContentType, &a.Size, &a.CreatedAt, &a.Status, &a.Width, &a.Height, &a.Blurhash)
+	return a, err
+}
+
+func collectAttachments(rows pgx.Rows) ([]model.Attachment, error) {
+	defer rows.Close()
+
+	var res []model.Attachment
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, attachment)
+	}
+	return res, rows.Err()
+}
+
+func (r *AttachmentsRepo) Create(ctx context.Context, attachment model.AttachmentCreate) (model.Attachment, error) {
+	row := r.dbpool.QueryRow(ctx,
+		`INSERT INTO attachments (user_id, storage_key, file_name, content_type, size, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING `+attachmentColumns,
+		attachment.UserID, attachment.StorageKey, attachment.FileName, attachment.ContentType, attachment.Size,
+		attachment.Status)
+	return scanAttachment(row)
+}
+
+func (r *AttachmentsRepo) Get(ctx context.Context, attachmentId int) (model.Attachment, error) {
+	row := r.dbpool.QueryRow(ctx,
+		`SELECT `+attachmentColumns+` FROM attachments WHERE id = $1`, attachmentId)
+	attachment, err := scanAttachment(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Attachment{}, model.ErrNotFound
+		}
+		return model.Attachment{}, err
+	}
+	attachments := []model.Attachment{attachment}
+	if err := r.loadVariants(ctx, attachments); err != nil {
+		return model.Attachment{}, err
+	}
+	return attachments[0], nil
+}
+
+// CountUnlinked returns how many of attachments are uploaded by user and not linked yet
+func (r *AttachmentsRepo) CountUnlinked(ctx context.Context, userId int, attachmentIds []int) (int, error) {
+	var count int
+	err := r.dbpool.QueryRow(ctx,
+		`SELECT count(*) FROM attachments WHERE id = ANY($1) AND user_id = $2 AND thread_id IS NULL`,
+		attachmentIds, userId).Scan(&count)
+	return count, err
+}
+
+// Link attaches unlinked attachments of user to thread (postId is nil) or post of thread.
+// Returns linked attachments, attachments of other users or already linked are skipped.
+func (r *AttachmentsRepo) Link(
+	ctx context.Context, userId int, attachmentIds []int, threadId int, postId *int) ([]model.Attachment, error) {
+
+	rows, err := r.dbpool.Query(ctx,
+		`UPDATE attachments SET thread_id = $3, post_id = $4
+		WHERE id = ANY($1) AND user_id = $2 AND thread_id IS NULL
+		RETURNING `+attachmentColumns,
+		attachmentIds, userId, threadId, postId)
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := collectAttachments(rows)
+	if err != nil {
+		return nil, err
+	}
+	return attachments, r.loadVariants(ctx, attachments)
+}
+
+// ListByThread returns attachments of thread and its posts in upload order
+func (r *AttachmentsRepo) ListByThread(ctx context.Context, threadId int) ([]model.Attachment, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT `+attachmentColumns+` FROM attachments WHERE thread_id = $1 ORDER BY id`, threadId)
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := collectAttachments(rows)
+	if err != nil {
+		return nil, err
+	}
+	return attachments, r.loadVariants(ctx, attachments)
+}
+
+// DeleteUnlinked removes up to limit attachments never linked to thread or post and uploaded
+// before given time with their variants. Returns storage keys of removed attachments and variants
+// to delete files. Concurrent collectors (several instances) skip rows locked by each other.
+func (r *AttachmentsRepo) DeleteUnlinked(ctx context.Context, before time.Time, limit int) ([]string, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`WITH removed AS (
+			DELETE FROM attachments WHERE id IN (
+				SELECT id FROM attachments WHERE thread_id IS NULL AND created_at < $1
+				ORDER BY id LIMIT $2 FOR UPDATE SKIP LOCKED)
+			RETURNING id, storage_key
+		), removed_variants AS (
+			DELETE FROM attachment_variants WHERE attachment_id IN (SELECT id FROM removed)
+			RETURNING storage_key
+		)
+		SELECT storage_key FROM removed UNION ALL SELECT storage_key FROM removed_variants`,
+		before, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// Claim marks attachment as processed by caller. Pending attachment or attachment processed
+// longer than staleAfter (its worker died) can be claimed, returns false if it is claimed by other worker
+// or already processed.
+func (r *AttachmentsRepo) Claim(ctx context.Context, attachmentId int, staleAfter time.Duration) (bool, error) {
+	tag, err := r.dbpool.Exec(ctx,
+		`UPDATE attachments SET status = 'processing', processing_started_at = now()
+		WHERE id = $1 AND (status = 'pending'
+			OR status = 'processing' AND processing_started_at < now() - $2 * interval '1 second')`,
+		attachmentId, staleAfter.Seconds())
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListUnprocessed returns ids of up to limit pending attachments uploaded before given time
+// and attachments processed longer than staleAfter
+func (r *AttachmentsRepo) ListUnprocessed(
+	ctx context.Context, before time.Time, staleAfter time.Duration, limit int) ([]int, error) {
+
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT id FROM attachments
+		WHERE status = 'pending' AND created_at < $1
+			OR status = 'processing' AND processing_started_at < now() - $2 * interval '1 second'
+		ORDER BY id LIMIT $3`,
+		before, staleAfter.Seconds(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// SaveProcessed stores result of image processing and marks attachment ready
+func (r *AttachmentsRepo) SaveProcessed(
+	ctx context.Context, attachmentId int, processed model.AttachmentProcessed) error {
+
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx,
+		`UPDATE attachments SET status = 'ready', size = $2, width = $3, height = $4, blurhash = $5,
+			processing_started_at = NULL
+		WHERE id = $1`,
+		attachmentId, processed.Size, processed.Width, processed.Height, processed.Blurhash)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	// variants of previous attempt are replaced (files are overwritten under the same keys)
+	_, err = tx.Exec(ctx, `DELETE FROM attachment_variants WHERE attachment_id = $1`, attachmentId)
+	if err != nil {
+		return err
+	}
+	for _, variant := range processed.Variants {
+		_, err = tx.Exec(ctx,
+			`INSERT INTO attachment_variants (attachment_id, name, storage_key, content_type, width, height, size)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			attachmentId, variant.Name, variant.StorageKey, variant.ContentType,
+			variant.Width, variant.Height, variant.Size)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// SetFailed marks attachment which can not be processed
+func (r *AttachmentsRepo) SetFailed(ctx context.Context, attachmentId int) error {
+	_, err := r.dbpool.Exec(ctx,
+		`UPDATE attachments SET status = 'failed', processing_started_at = NULL WHERE id = $1`, attachmentId)
+	return err
+}
+
+// loadVariants sets variants of attachments ordered from smallest
+func (r *AttachmentsRepo) loadVariants(ctx context.Context, attachments []model.Attachment) error {
+	if len(attachments) == 0 {
+		return nil
+	}
+	byId := make(map[int]*model.Attachment, len(attachments))
+	ids := make([]int, len(attachments))
+	for i := range attachments {
+		byId[attachments[i].ID] = &attachments[i]
+		ids[i] = attachments[i].ID
+	}
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT attachment_id, name, storage_key, content_type, width, height, size
+		FROM attachment_variants WHERE attachment_id = ANY($1)
+		ORDER BY attachment_id, width`, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var attachmentId int
+		var v model.AttachmentVariant
+		err := rows.Scan(&attachmentId, &v.Name, &v.StorageKey, &v.ContentType, &v.Width, &v.Height, &v.Size)
+		if err != nil {
+			return err
+		}
+		attachment := byId[attachmentId]
+		attachment.Variants = append(attachment.Variants, v)
+	}
+	return rows.Err()
+}
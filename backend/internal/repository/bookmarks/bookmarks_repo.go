@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package bookmarks
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BookmarksRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewBookmarksRepo(dsn string) (*BookmarksRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &BookmarksRepo{dbpool: pool}, nil
+}
+
+// Create bookmarks thread or post. Bookmarking the same target again moves bookmark to new collection.
+func (r *BookmarksRepo) Create(ctx context.Context, bookmark model.BookmarkCreate) (model.Bookmark, error) {
+	var query string
+	switch bookmark.TargetType {
+	case model.BookmarkTargetThread:
+		query = `INSERT INTO bookmarks (user_id, target_type, target_id, thread_id, collection_id)
+			SELECT $1, 'thread', t.id, t.id, $3 FROM threads t
+			WHERE t.id = $2 AND t.hidden_at IS NULL AND t.held_at IS NULL
+			ON CONFLICT (user_id, target_type, target_id) DO UPDATE SET collection_id = EXCLUDED.collection_id
+			RETURNING id, user_id, target_type, target_id, thread_id, collection_id, created_at`
+	case model.BookmarkTargetPost:
+		query = `INSERT INTO bookmarks (user_id, target_type, target_id, thread_id, collection_id)
+			SELECT $1, 'post', p.id, p.thread_id, $3 FROM posts p JOIN threads t ON t.id = p.thread_id
+			WHERE p.id = $2 AND p.hidden_at IS NULL AND p.held_at IS NULL
+				AND t.hidden_at IS NULL AND t.held_at IS NULL
+			ON CONFLICT (user_id, target_type, target_id) DO UPDATE SET collection_id = EXCLUDED.collection_id
+			RETURNING id, user_id, target_type, target_id, thread_id, collection_id, created_at`
+	default:
+		return model.Bookmark{}, errors.New("unknown bookmark target type " + bookmark.TargetType)
+	}
+	row := r.dbpool.QueryRow(ctx, query, bookmark.UserID, bookmark.TargetID, bookmark.CollectionID)
+
+	var res model.Bookmark
+	err := row.Scan(&res.ID, &res.UserID, &res.TargetType, &res.TargetID, &res.ThreadID,
+		&res.CollectionID, &res.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Bookmark{}, model.ErrNotFound
+		}
+		return model.Bookmark{}, err
+	}
+	return res, nil
+}
+
+func (r *BookmarksRepo) Delete(ctx context.Context, userId, bookmarkId int) error {
+	tag, err := r.dbpool.Exec(ctx, `DELETE FROM bookmarks WHERE id = $1 AND user_id = $2`, bookmarkId, userId)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// List user bookmarks with current thread info, newest first. Bookmarks with id less than
+// before are returned (before 0 - from newest). collectionId nil - bookmarks from all collections.
+// Bookmarks of hidden and held threads and posts are skipped.
+func (r *BookmarksRepo) List(
+	ctx context.Context, userId int, collectionId *int, before, limit int) (model.BookmarkListRepo, error) {
+
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT b.id, b.user_id, b.target_type, b.target_id, b.thread_id, b.collection_id, b.created_at,
+			t.id, t.title, t.content, t.content_html, t.user_id, t.community_id, t.posts_count, t.score, t.created_at
+		FROM bookmarks b JOIN threads t ON t.id = b.thread_id
+			LEFT JOIN posts p ON b.target_type = 'post' AND p.id = b.target_id
+		WHERE b.user_id = $1 AND t.hidden_at IS NULL AND t.held_at IS NULL
+			AND p.hidden_at IS NULL AND p.held_at IS NULL
+			AND ($2::integer IS NULL OR b.collection_id = $2)
+			AND ($3 = 0 OR b.id < $3)
+		ORDER BY b.id DESC LIMIT $4`,
+		userId, collectionId, before, limit+1)
+	if err != nil {
+		return model.BookmarkListRepo{}, err
+	}
+	defer rows.Close()
+
+	items := make([]model.BookmarkRepoItem, 0, limit+1)
+	for rows.Next() {
+		var item model.BookmarkRepoItem
+		err := rows.Scan(&item.ID, &item.UserID, &item.TargetType, &item.TargetID, &item.ThreadID,
+			&item.CollectionID, &item.CreatedAt,
+			&item.Thread.ID, &item.Thread.Title, &item.Thread.Content, &item.Thread.ContentHTML, &item.Thread.UserID,
+			&item.Thread.CommunityID, &item.Thread.PostsCount, &item.Thread.Score, &item.Thread.CreatedAt)
+		if err != nil {
+			return model.BookmarkListRepo{}, err
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return model.BookmarkListRepo{}, err
+	}
+
+	res := model.BookmarkListRepo{Bookmarks: items}
+	if len(items) > limit {
+		res.Bookmarks = items[:limit]
+		res.HaveNext = true
+	}
+	return res, nil
+}
+
+// ThreadsBookmarked returns set of thread ids bookmarked by user from given ids
+func (r *BookmarksRepo) ThreadsBookmarked(ctx context.Context, userId int, threadIds []int) (map[int]bool, error) {
+	res := make(map[int]bool)
+	if userId == 0 || len(threadIds) == 0 {
+		return res, nil
+	}
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT target_id FROM bookmarks
+		WHERE user_id = $1 AND target_type = 'thread' AND target_id = ANY($2)`,
+		userId, threadIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var threadID int
+		if err := rows.Scan(&threadID); err != nil {
+			return nil, err
+		}
+		res[threadID] = true
+	}
+	return res, rows.Err()
+}
+
+// CreateCollection stores named collection of user, false is returned if user already has collection
+// with this name
+func (r *BookmarksRepo) CreateCollection(
+	ctx context.Context, userId int, name string) (model.BookmarkCollection, bool, error) {
+
+	row := r.dbpool.QueryRow(ctx,
+		`INSERT INTO bookmark_collections (user_id, name) VALUES ($1, $2)
+		ON CONFLICT (user_id, name) DO NOTHING
+		RETURNING id, user_id, name, created_at`,
+		userId, name)
+
+	var id int
+	var userID int
+	var createdAt time.Time
+	if err := row.Scan(&id, &userID, &name, &createdAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.BookmarkCollection{}, false, nil
+		}
+		return model.BookmarkCollection{}, false, err
+	}
+	return model.BookmarkCollection{
+		ID:        id,
+		UserID:    userID,
+		Name:      name,
+		CreatedAt: createdAt,
+	}, true, nil
+}
+
+func (r *BookmarksRepo) GetCollection(ctx context.Context, collectionId int) (model.BookmarkCollection, error) {
+	row := r.dbpool.QueryRow(ctx,
+		`SELECT id, user_id, name, created_at FROM bookmark_collections WHERE id = $1`, collectionId)
+
+	var id int
+	var userID int
+	var name string
+	var createdAt time.Time
+	if err := row.Scan(&id, &userID, &name, &createdAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.BookmarkCollection{}, model.ErrNotFound
+		}
+		return model.BookmarkCollection{}, err
+	}
+	return model.BookmarkCollection{
+		ID:        id,
+		UserID:    userID,
+		Name:      name,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+func (r *BookmarksRepo) ListCollections(ctx context.Context, userId int) ([]model.BookmarkCollection, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT id, user_id, name, created_at FROM bookmark_collections WHERE user_id = $1 ORDER BY name`,
+		userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	collections := make([]model.BookmarkCollection, 0)
+	for rows.Next() {
+		var id int
+		var userID int
+		var name string
+		var createdAt time.Time
+		if err := rows.Scan(&id, &userID, &name, &createdAt); err != nil {
+			return nil, err
+		}
+		collections = append(collections, model.BookmarkCollection{
+			ID:        id,
+			UserID:    userID,
+			Name:      name,
+			CreatedAt: createdAt,
+		})
+	}
+	return collections, rows.Err()
+}
+
+// DeleteCollection removes collection, its bookmarks are kept without collection
+func (r *BookmarksRepo) DeleteCollection(ctx context.Context, userId, collectionId int) error {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `DELETE FROM bookmark_collections WHERE id = $1 AND user_id = $2`,
+		collectionId, userId)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	_, err = tx.Exec(ctx, `UPDATE bookmarks SET collection_id = NULL WHERE collection_id = $1`, collectionId)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
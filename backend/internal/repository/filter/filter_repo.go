@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package filter
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type FilterRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewFilterRepo(dsn string) (*FilterRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &FilterRepo{dbpool: pool}, nil
+}
+
+// AccountCreatedAt returns registration time of user
+func (r *FilterRepo) AccountCreatedAt(ctx context.Context, userId int) (time.Time, error) {
+	var createdAt time.Time
+	err := r.dbpool.QueryRow(ctx, `SELECT created_at FROM users WHERE id = $1`, userId).Scan(&createdAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return time.Time{}, model.ErrNotFound
+	}
+	return createdAt, err
+}
+
+// RecordFingerprint stores fingerprint of content and returns how many times it was stored since given time
+// before this one. Fingerprints older than since are removed, they are not needed anymore.
+func (r *FilterRepo) RecordFingerprint(
+	ctx context.Context, fingerprint string, userId int, since time.Time) (int, error) {
+
+	var count int
+	err := r.dbpool.QueryRow(ctx,
+		`WITH expired AS (
+			DELETE FROM content_fingerprints WHERE created_at < $3
+		), inserted AS (
+			INSERT INTO content_fingerprints (fingerprint, user_id) VALUES ($1, $2)
+		)
+		SELECT count(*) FROM content_fingerprints WHERE fingerprint = $1 AND created_at >= $3`,
+		fingerprint, userId, since).Scan(&count)
+	return count, err
+}
+
+// SpamDocs returns number of trained spam and ham documents
+func (r *FilterRepo) SpamDocs(ctx context.Context) (model.TokenCount, error) {
+	var docs model.TokenCount
+	err := r.dbpool.QueryRow(ctx,
+		`SELECT COALESCE(sum(docs) FILTER (WHERE class = 'spam'), 0), COALESCE(sum(docs) FILTER (WHERE class = 'ham'), 0)
+		FROM spam_classes`).Scan(&docs.Spam, &docs.Ham)
+	return docs, err
+}
+
+// SpamTokens returns counts of trained documents with tokens, unknown tokens are absent
+func (r *FilterRepo) SpamTokens(ctx context.Context, tokens []string) (map[string]model.TokenCount, error) {
+	res := make(map[string]model.TokenCount)
+	if len(tokens) == 0 {
+		return res, nil
+	}
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT token, spam_count, ham_count FROM spam_tokens WHERE token = ANY($1)`, tokens)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var token string
+		var count model.TokenCount
+		if err := rows.Scan(&token, &count.Spam, &count.Ham); err != nil {
+			return nil, err
+		}
+		res[token] = count
+	}
+	return res, rows.Err()
+}
+
+// TrainSpam adds document with tokens to spam or ham class
+func (r *FilterRepo) TrainSpam(ctx context.Context, tokens []string, spam bool) error {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	class := model.SpamClassHam
+	if spam {
+		class = model.SpamClassSpam
+	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO spam_classes (class, docs) VALUES ($1, 1)
+		ON CONFLICT (class) DO UPDATE SET docs = spam_classes.docs + 1`,
+		class)
+	if err != nil {
+		return err
+	}
+	// tokens are sorted by database, concurrent training locks rows in the same order
+	_, err = tx.Exec(ctx,
+		`INSERT INTO spam_tokens (token, spam_count, ham_count)
+		SELECT t, CASE WHEN $2 THEN 1 ELSE 0 END, CASE WHEN $2 THEN 0 ELSE 1 END
+		FROM unnest($1::text[]) AS t ORDER BY t
+		ON CONFLICT (token) DO UPDATE SET
+			spam_count = spam_tokens.spam_count + EXCLUDED.spam_count,
+			ham_count = spam_tokens.ham_count + EXCLUDED.ham_count`,
+		tokens, spam)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
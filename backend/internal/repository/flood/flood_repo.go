@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package flood
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type FloodRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewFloodRepo(dsn string) (*FloodRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &FloodRepo{dbpool: pool}, nil
+}
+
+// AccountCreatedAt returns registration time of user
+func (r *FloodRepo) AccountCreatedAt(ctx context.Context, userId int) (time.Time, error) {
+	var createdAt time.Time
+	err := r.dbpool.QueryRow(ctx, `SELECT created_at FROM users WHERE id = $1`, userId).Scan(&createdAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return time.Time{}, model.ErrNotFound
+	}
+	return createdAt, err
+}
+
+// TakeSlots moves the next allowed post time of user by slot intervals if all slots are free.
+// Otherwise nothing changes and the longest wait is returned. Slot row is locked by update,
+// so concurrent posts of user (on any instance) can not take the same slot.
+func (r *FloodRepo) TakeSlots(ctx context.Context, userId int, slots []model.PostingSlot) (time.Duration, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var wait time.Duration
+	for _, slot := range slots {
+		var taken bool
+		err := tx.QueryRow(ctx,
+			`INSERT INTO posting_slots (user_id, thread_id, next_at) VALUES ($1, $2, now() + $3::interval)
+			ON CONFLICT (user_id, thread_id) DO UPDATE SET next_at = EXCLUDED.next_at
+				WHERE posting_slots.next_at <= now()
+			RETURNING TRUE`,
+			userId, slot.ThreadID, slot.Interval).Scan(&taken)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return 0, err
+		}
+		var waitMs int64
+		err = tx.QueryRow(ctx,
+			`SELECT GREATEST(CEIL(EXTRACT(EPOCH FROM next_at - now()) * 1000), 1)::bigint
+			FROM posting_slots WHERE user_id = $1 AND thread_id = $2`,
+			userId, slot.ThreadID).Scan(&waitMs)
+		if err != nil {
+			return 0, err
+		}
+		wait = max(wait, time.Duration(waitMs)*time.Millisecond)
+	}
+	if wait > 0 {
+		return wait, nil
+	}
+	return 0, tx.Commit(ctx)
+}
+
+// ThreadsWait returns time until user can create thread without exceeding maxThreads during the last day,
+// 0 if user can create thread now
+func (r *FloodRepo) ThreadsWait(ctx context.Context, userId, maxThreads int) (time.Duration, error) {
+	// the oldest of the latest maxThreads threads leaves the day window first
+	var waitMs int64
+	err := r.dbpool.QueryRow(ctx,
+		`SELECT GREATEST(CEIL(EXTRACT(EPOCH FROM created_at + interval '1 day' - now()) * 1000), 1)::bigint
+		FROM threads
+		WHERE user_id = $1 AND created_at > now() - interval '1 day'
+		ORDER BY created_at DESC OFFSET $2 LIMIT 1`,
+		userId, maxThreads-1).Scan(&waitMs)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	return time.Duration(waitMs) * time.Millisecond, err
+}
+
+// TakeAction records action of user if there were less than max actions of the kind during window.
+// Otherwise nothing is recorded and time until the oldest of them leaves window is returned.
+// Actions are counted under transaction lock of user and kind, so concurrent requests of user
+// (on any instance) can not exceed the limit.
+func (r *FloodRepo) TakeAction(
+	ctx context.Context, userId int, kind string, max int, window time.Duration) (time.Duration, error) {
+
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($2), $1)`, userId, kind); err != nil {
+		return 0, err
+	}
+	// the oldest of the latest max actions leaves the window first
+	var waitMs int64
+	err = tx.QueryRow(ctx,
+		`SELECT GREATEST(CEIL(EXTRACT(EPOCH FROM created_at + $3::interval - now()) * 1000), 1)::bigint
+		FROM flood_actions
+		WHERE user_id = $1 AND kind = $2 AND created_at > now() - $3::interval
+		ORDER BY created_at DESC OFFSET $4 LIMIT 1`,
+		userId, kind, window, max-1).Scan(&waitMs)
+	if err == nil {
+		return time.Duration(waitMs) * time.Millisecond, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return 0, err
+	}
+	_, err = tx.Exec(ctx,
+		`WITH expired AS (
+			DELETE FROM flood_actions
+			WHERE user_id = $1 AND kind = $2 AND created_at <= now() - $3::interval
+		)
+		INSERT INTO flood_actions (user_id, kind) VALUES ($1, $2)`,
+		userId, kind, window)
+	if err != nil {
+		return 0, err
+	}
+	return 0, tx.Commit(ctx)
+}
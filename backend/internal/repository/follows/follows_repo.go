@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package follows
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type FollowsRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewFollowsRepo(dsn string) (*FollowsRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &FollowsRepo{dbpool: pool}, nil
+}
+
+// Follow makes user follower of target, repeated follow returns existing one.
+// model.ErrNotFound if target does not exist.
+func (r *FollowsRepo) Follow(ctx context.Context, userId, targetId int) (model.Follow, error) {
+	follow := model.Follow{User: model.Author{ID: targetId}}
+	err := r.dbpool.QueryRow(ctx,
+		`WITH target AS (
+			SELECT id, name, rank FROM users WHERE id = $2
+		), upserted AS (
+			INSERT INTO user_follows (user_id, target_id)
+			SELECT $1, id FROM target
+			ON CONFLICT (user_id, target_id) DO UPDATE SET user_id = EXCLUDED.user_id
+			RETURNING id, created_at
+		)
+		SELECT upserted.id, target.name, target.rank, upserted.created_at FROM target, upserted`,
+		userId, targetId).Scan(&follow.ID, &follow.User.Name, &follow.User.Rank, &follow.FollowedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Follow{}, model.ErrNotFound
+	}
+	return follow, err
+}
+
+// Unfollow returns false if user did not follow target
+func (r *FollowsRepo) Unfollow(ctx context.Context, userId, targetId int) (bool, error) {
+	tag, err := r.dbpool.Exec(ctx,
+		`DELETE FROM user_follows WHERE user_id = $1 AND target_id = $2`, userId, targetId)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// Followers returns followers of user from the latest. For next page pass id of last follow as before.
+func (r *FollowsRepo) Followers(ctx context.Context, userId, before, limit int) (model.FollowList, error) {
+	return r.list(ctx, "target_id", "user_id", userId, before, limit)
+}
+
+// Following returns users followed by user from the latest. For next page pass id of last follow as before.
+func (r *FollowsRepo) Following(ctx context.Context, userId, before, limit int) (model.FollowList, error) {
+	return r.list(ctx, "user_id", "target_id", userId, before, limit)
+}
+
+// list follows where userColumn is user, listed users are in otherColumn (column names are constants)
+func (r *FollowsRepo) list(
+	ctx context.Context, userColumn, otherColumn string, userId, before, limit int) (model.FollowList, error) {
+
+	var res model.FollowList
+	err := r.dbpool.QueryRow(ctx,
+		`SELECT count(*) FROM user_follows WHERE `+userColumn+` = $1`, userId).Scan(&res.Total)
+	if err != nil {
+		return model.FollowList{}, err
+	}
+
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT f.id, f.`+otherColumn+`, COALESCE(u.name, ''), COALESCE(u.rank, ''), f.created_at
+		FROM user_follows f LEFT JOIN users u ON u.id = f.`+otherColumn+`
+		WHERE f.`+userColumn+` = $1 AND ($2 = 0 OR f.id < $2)
+		ORDER BY f.id DESC LIMIT $3`,
+		userId, before, limit+1)
+	if err != nil {
+		return model.FollowList{}, err
+	}
+	defer rows.Close()
+
+	follows := make([]model.Follow, 0, limit+1)
+	for rows.Next() {
+		var f model.Follow
+		if err := rows.Scan(&f.ID, &f.User.ID, &f.User.Name, &f.User.Rank, &f.FollowedAt); err != nil {
+			return model.FollowList{}, err
+		}
+		follows = append(follows, f)
+	}
+	if err := rows.Err(); err != nil {
+		return model.FollowList{}, err
+	}
+
+	res.Follows = follows
+	if len(follows) > limit {
+		res.Follows = follows[:limit]
+		res.HaveNext = true
+	}
+	return res, nil
+}
+
+// SubscribeCommunity subscribes user to new threads of community, model.ErrNotFound if community does not exist
+func (r *FollowsRepo) SubscribeCommunity(
+	ctx context.Context, userId, communityId int) (model.CommunitySubscription, error) {
+
+	subscription := model.CommunitySubscription{CommunityID: communityId}
+	err := r.dbpool.QueryRow(ctx,
+		`WITH community AS (
+			SELECT id, name FROM communities WHERE id = $2
+		), upserted AS (
+			INSERT INTO community_subscriptions (user_id, community_id)
+			SELECT $1, id FROM community
+			ON CONFLICT (user_id, community_id) DO UPDATE SET user_id = EXCLUDED.user_id
+			RETURNING created_at
+		)
+		SELECT community.name, upserted.created_at FROM community, upserted`,
+		userId, communityId).Scan(&subscription.CommunityName, &subscription.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.CommunitySubscription{}, model.ErrNotFound
+	}
+	return subscription, err
+}
+
+// UnsubscribeCommunity returns false if user was not subscribed to community
+func (r *FollowsRepo) UnsubscribeCommunity(ctx context.Context, userId, communityId int) (bool, error) {
+	tag, err := r.dbpool.Exec(ctx,
+		`DELETE FROM community_subscriptions WHERE user_id = $1 AND community_id = $2`, userId, communityId)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// CommunitySubscriptions returns communities subscribed by user ordered by name
+func (r *FollowsRepo) CommunitySubscriptions(ctx context.Context, userId int) ([]model.CommunitySubscription, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT s.community_id, c.name, s.created_at
+		FROM community_subscriptions s JOIN communities c ON c.id = s.community_id
+		WHERE s.user_id = $1
+		ORDER BY c.name`,
+		userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.CommunitySubscription
+	for rows.Next() {
+		var s model.CommunitySubscription
+		if err := rows.Scan(&s.CommunityID, &s.CommunityName, &s.CreatedAt); err != nil {
+			return nil, err
+		}
+		res = append(res, s)
+	}
+	return res, rows.Err()
+}
+
+// Feed returns threads and posts of followed users and new threads of subscribed communities older than
+// cursor (nil - from the newest). Feed is built on read: every source (followed user or community) gives
+// at most limit+1 of its latest items by index, then sources are merged. Content of ignored and blocked
+// users and own threads are skipped.
+func (r *FollowsRepo) Feed(ctx context.Context, userId int, cursor *model.FeedCursor, limit int) (model.FeedList, error) {
+	var before *time.Time
+	var beforeKind string
+	var beforeId int
+	if cursor != nil {
+		before, beforeKind, beforeId = &cursor.CreatedAt, cursor.Kind, cursor.ID
+	}
+	rows, err := r.dbpool.Query(ctx,
+		`WITH ignored AS (
+			SELECT target_id FROM user_relations WHERE user_id = $1
+		), followed AS (
+			SELECT target_id FROM user_follows WHERE user_id = $1 AND target_id NOT IN (SELECT target_id FROM ignored)
+		), subscribed AS (
+			SELECT community_id FROM community_subscriptions WHERE user_id = $1
+		), items AS (
+			SELECT i.* FROM followed f CROSS JOIN LATERAL (
+				SELECT 'thread' AS kind, t.id, t.id AS thread_id, t.title, t.community_id, t.content_html,
+					t.user_id, t.created_at
+				FROM threads t
+				WHERE t.user_id = f.target_id AND t.hidden_at IS NULL AND t.held_at IS NULL
+					AND ($2::timestamptz IS NULL
+						OR (t.created_at <= $2 AND (t.created_at, 'thread', t.id) < ($2, $3::text, $4::integer)))
+				ORDER BY t.created_at DESC, t.id DESC LIMIT $5
+			) i
+			UNION
+			SELECT i.* FROM followed f CROSS JOIN LATERAL (
+				SELECT 'post' AS kind, p.id, p.thread_id, t.title, t.community_id, p.content_html,
+					p.user_id, p.created_at
+				FROM posts p JOIN threads t ON t.id = p.thread_id
+				WHERE p.user_id = f.target_id AND p.hidden_at IS NULL AND p.held_at IS NULL
+					AND t.hidden_at IS NULL AND t.held_at IS NULL
+					AND ($2::timestamptz IS NULL
+						OR (p.created_at <= $2 AND (p.created_at, 'post', p.id) < ($2, $3::text, $4::integer)))
+				ORDER BY p.created_at DESC, p.id DESC LIMIT $5
+			) i
+			UNION
+			SELECT i.* FROM subscribed s CROSS JOIN LATERAL (
+				SELECT 'thread' AS kind, t.id, t.id AS thread_id, t.title, t.community_id, t.content_html,
+					t.user_id, t.created_at
+				FROM threads t
+				WHERE t.community_id = s.community_id AND t.hidden_at IS NULL AND t.held_at IS NULL
+					AND t.user_id <> $1 AND t.user_id NOT IN (SELECT target_id FROM ignored)
+					AND ($2::timestamptz IS NULL
+						OR (t.created_at <= $2 AND (t.created_at, 'thread', t.id) < ($2, $3::text, $4::integer)))
+				ORDER BY t.created_at DESC, t.id DESC LIMIT $5
+			) i
+		)
+		SELECT i.kind, i.id, i.thread_id, i.title, i.community_id, i.content_html,
+			i.user_id, COALESCE(u.name, ''), COALESCE(u.rank, ''), i.created_at
+		FROM items i LEFT JOIN users u ON u.id = i.user_id
+		ORDER BY i.created_at DESC, i.kind DESC, i.id DESC LIMIT $5`,
+		userId, before, beforeKind, beforeId, limit+1)
+	if err != nil {
+		return model.FeedList{}, err
+	}
+	defer rows.Close()
+
+	items := make([]model.FeedItem, 0, limit+1)
+	for rows.Next() {
+		var item model.FeedItem
+		err := rows.Scan(&item.Kind, &item.ID, &item.ThreadID, &item.ThreadTitle, &item.CommunityID,
+			&item.ContentHTML, &item.Author.ID, &item.Author.Name, &item.Author.Rank, &item.CreatedAt)
+		if err != nil {
+			return model.FeedList{}, err
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return model.FeedList{}, err
+	}
+
+	res := model.FeedList{Items: items}
+	if len(items) > limit {
+		res.Items = items[:limit]
+		last := res.Items[limit-1]
+		res.Next = &model.FeedCursor{CreatedAt: last.CreatedAt, Kind: last.Kind, ID: last.ID}
+	}
+	return res, nil
+}
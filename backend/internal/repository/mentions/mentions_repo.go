@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package mentions
+
+import (
+	"context"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type MentionsRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewMentionsRepo(dsn string) (*MentionsRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &MentionsRepo{dbpool: pool}, nil
+}
+
+// Create stores users mentioned in thread or post
+func (r *MentionsRepo) Create(ctx context.Context, target model.MentionTarget, users []model.MentionedUser) error {
+	if len(users) == 0 {
+		return nil
+	}
+	userIds := make([]int, len(users))
+	names := make([]string, len(users))
+	for i, user := range users {
+		userIds[i] = user.UserID
+		names[i] = user.Name
+	}
+	_, err := r.dbpool.Exec(ctx,
+		`INSERT INTO mentions (target_type, target_id, thread_id, author_id, user_id, name)
+		SELECT $1, $2, $3, $4, m.user_id, m.name FROM unnest($5::integer[], $6::text[]) AS m(user_id, name)
+		ON CONFLICT (target_type, target_id, user_id) DO NOTHING`,
+		target.Type, target.ID, target.ThreadID, target.AuthorID, userIds, names)
+	return err
+}
+
+// ListByTargets returns mentioned users of threads or posts by target id
+func (r *MentionsRepo) ListByTargets(
+	ctx context.Context, targetType string, targetIds []int) (map[int][]model.MentionedUser, error) {
+
+	res := make(map[int][]model.MentionedUser)
+	if len(targetIds) == 0 {
+		return res, nil
+	}
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT target_id, user_id, name FROM mentions WHERE target_type = $1 AND target_id = ANY($2)`,
+		targetType, targetIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var targetID int
+		var user model.MentionedUser
+		if err := rows.Scan(&targetID, &user.UserID, &user.Name); err != nil {
+			return nil, err
+		}
+		res[targetID] = append(res[targetID], user)
+	}
+	return res, rows.Err()
+}
@@ -0,0 +1,341 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package messages
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type MessagesRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewMessagesRepo(dsn string) (*MessagesRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &MessagesRepo{dbpool: pool}, nil
+}
+
+// UsersExist returns which of given users exist
+func (r *MessagesRepo) UsersExist(ctx context.Context, userIds []int) (map[int]bool, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT id FROM users WHERE id = ANY($1)`, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make(map[int]bool, len(userIds))
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		res[id] = true
+	}
+	return res, rows.Err()
+}
+
+// FindDirect returns conversation of exactly two users, model.ErrNotFound if they have none
+func (r *MessagesRepo) FindDirect(ctx context.Context, userId, otherId int) (int, error) {
+	var id int
+	err := r.dbpool.QueryRow(ctx,
+		`SELECT c.id FROM conversations c
+			JOIN conversation_members a ON a.conversation_id = c.id AND a.user_id = $1
+			JOIN conversation_members b ON b.conversation_id = c.id AND b.user_id = $2
+		WHERE c.members_count = 2
+		ORDER BY c.id LIMIT 1`,
+		userId, otherId).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, model.ErrNotFound
+	}
+	return id, err
+}
+
+// Create stores conversation with members and the first message, the message is read by creator
+func (r *MessagesRepo) Create(ctx context.Context, conversation model.ConversationCreate) (model.DirectMessage, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return model.DirectMessage{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var conversationId int
+	err = tx.QueryRow(ctx,
+		`INSERT INTO conversations (title, creator_id, members_count) VALUES ($1, $2, $3) RETURNING id`,
+		conversation.Title, conversation.CreatorID, len(conversation.MemberIDs)+1).Scan(&conversationId)
+	if err != nil {
+		return model.DirectMessage{}, err
+	}
+	memberIds := append([]int{conversation.CreatorID}, conversation.MemberIDs...)
+	_, err = tx.Exec(ctx,
+		`INSERT INTO conversation_members (conversation_id, user_id)
+		SELECT $1, unnest($2::integer[])`,
+		conversationId, memberIds)
+	if err != nil {
+		return model.DirectMessage{}, err
+	}
+	message, err := send(ctx, tx, conversationId, conversation.CreatorID, conversation.Content, conversation.ContentHTML)
+	if err != nil {
+		return model.DirectMessage{}, err
+	}
+	return message, tx.Commit(ctx)
+}
+
+// Send stores message to conversation, the message is read by sender
+func (r *MessagesRepo) Send(
+	ctx context.Context, conversationId, userId int, content, contentHTML string) (model.DirectMessage, error) {
+
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return model.DirectMessage{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	message, err := send(ctx, tx, conversationId, userId, content, contentHTML)
+	if err != nil {
+		return model.DirectMessage{}, err
+	}
+	return message, tx.Commit(ctx)
+}
+
+func send(
+	ctx context.Context, tx pgx.Tx, conversationId, userId int, content, contentHTML string) (model.DirectMessage, error) {
+
+	message := model.DirectMessage{
+		ConversationID: conversationId,
+		UserID:         userId,
+		Content:        content,
+		ContentHTML:    contentHTML,
+	}
+	err := tx.QueryRow(ctx,
+		`INSERT INTO direct_messages (conversation_id, user_id, content, content_html)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, COALESCE((SELECT name FROM users WHERE id = $2), '')`,
+		conversationId, userId, content, contentHTML).Scan(&message.ID, &message.CreatedAt, &message.UserName)
+	if err != nil {
+		return model.DirectMessage{}, err
+	}
+	// concurrent messages update conversation in commit order, the latest id wins
+	_, err = tx.Exec(ctx,
+		`UPDATE conversations SET last_message_id = GREATEST(last_message_id, $2) WHERE id = $1`,
+		conversationId, message.ID)
+	if err != nil {
+		return model.DirectMessage{}, err
+	}
+	_, err = tx.Exec(ctx,
+		`UPDATE conversation_members SET last_read_message_id = GREATEST(last_read_message_id, $3)
+		WHERE conversation_id = $1 AND user_id = $2`,
+		conversationId, userId, message.ID)
+	if err != nil {
+		return model.DirectMessage{}, err
+	}
+	return message, nil
+}
+
+// Members returns members of conversation if user is its member, otherwise model.ErrNotFound.
+// This is the only way to access conversation, it is hidden from non-members.
+func (r *MessagesRepo) Members(ctx context.Context, conversationId, userId int) ([]model.ConversationMember, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT m.user_id, COALESCE(u.name, '')
+		FROM conversation_members m LEFT JOIN users u ON u.id = m.user_id
+		WHERE m.conversation_id = $1
+			AND EXISTS (SELECT 1 FROM conversation_members v WHERE v.conversation_id = $1 AND v.user_id = $2)
+		ORDER BY m.user_id`,
+		conversationId, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []model.ConversationMember
+	for rows.Next() {
+		var member model.ConversationMember
+		if err := rows.Scan(&member.UserID, &member.UserName); err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, model.ErrNotFound
+	}
+	return members, nil
+}
+
+// List returns conversations of user from the latest message. For next page pass id of last message
+// of last conversation as before.
+func (r *MessagesRepo) List(ctx context.Context, userId, before, limit int) (model.ConversationList, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT c.id, c.title, c.created_at, c.last_message_id,
+			(SELECT count(*) FROM direct_messages d
+			WHERE d.conversation_id = c.id AND d.id > m.last_read_message_id AND d.user_id <> m.user_id)
+		FROM conversation_members m JOIN conversations c ON c.id = m.conversation_id
+		WHERE m.user_id = $1 AND ($2 = 0 OR c.last_message_id < $2)
+		ORDER BY c.last_message_id DESC LIMIT $3`,
+		userId, before, limit+1)
+	if err != nil {
+		return model.ConversationList{}, err
+	}
+	defer rows.Close()
+
+	conversations := make([]model.Conversation, 0, limit+1)
+	for rows.Next() {
+		var c model.Conversation
+		if err := rows.Scan(&c.ID, &c.Title, &c.CreatedAt, &c.LastMessage.ID, &c.UnreadCount); err != nil {
+			return model.ConversationList{}, err
+		}
+		conversations = append(conversations, c)
+	}
+	if err := rows.Err(); err != nil {
+		return model.ConversationList{}, err
+	}
+	rows.Close()
+
+	res := model.ConversationList{Conversations: conversations}
+	if len(conversations) > limit {
+		res.Conversations = conversations[:limit]
+		res.HaveNext = true
+	}
+	if err := r.fillConversations(ctx, res.Conversations); err != nil {
+		return model.ConversationList{}, err
+	}
+	return res, nil
+}
+
+// fillConversations loads members and the last message of conversations
+func (r *MessagesRepo) fillConversations(ctx context.Context, conversations []model.Conversation) error {
+	if len(conversations) == 0 {
+		return nil
+	}
+	byId := make(map[int]*model.Conversation, len(conversations))
+	byMessage := make(map[int]*model.Conversation, len(conversations))
+	ids := make([]int, len(conversations))
+	messageIds := make([]int, len(conversations))
+	for i := range conversations {
+		c := &conversations[i]
+		byId[c.ID] = c
+		byMessage[c.LastMessage.ID] = c
+		ids[i] = c.ID
+		messageIds[i] = c.LastMessage.ID
+	}
+
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT m.conversation_id, m.user_id, COALESCE(u.name, '')
+		FROM conversation_members m LEFT JOIN users u ON u.id = m.user_id
+		WHERE m.conversation_id = ANY($1)
+		ORDER BY m.conversation_id, m.user_id`,
+		ids)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var conversationId int
+		var member model.ConversationMember
+		if err := rows.Scan(&conversationId, &member.UserID, &member.UserName); err != nil {
+			rows.Close()
+			return err
+		}
+		c := byId[conversationId]
+		c.Members = append(c.Members, member)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	rows, err = r.dbpool.Query(ctx,
+		`SELECT `+messageColumns+`
+		FROM direct_messages d LEFT JOIN users u ON u.id = d.user_id
+		WHERE d.id = ANY($1)`,
+		messageIds)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		message, err := scanMessage(rows)
+		if err != nil {
+			return err
+		}
+		byMessage[message.ID].LastMessage = message
+	}
+	return rows.Err()
+}
+
+// columns scanned by scanMessage
+const messageColumns = `d.id, d.conversation_id, d.user_id, COALESCE(u.name, ''), d.content, d.content_html, d.created_at`
+
+func scanMessage(row pgx.Row) (model.DirectMessage, error) {
+	var m model.DirectMessage
+	err := row.Scan(&m.ID, &m.ConversationID, &m.UserID, &m.UserName, &m.Content, &m.ContentHTML, &m.CreatedAt)
+	return m, err
+}
+
+// Messages returns messages of conversation from the latest one. For next page pass id of last message as before.
+// Membership of reader must be checked by Members.
+func (r *MessagesRepo) Messages(ctx context.Context, conversationId, before, limit int) (model.DirectMessageList, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT `+messageColumns+`
+		FROM direct_messages d LEFT JOIN users u ON u.id = d.user_id
+		WHERE d.conversation_id = $1 AND ($2 = 0 OR d.id < $2)
+		ORDER BY d.id DESC LIMIT $3`,
+		conversationId, before, limit+1)
+	if err != nil {
+		return model.DirectMessageList{}, err
+	}
+	defer rows.Close()
+
+	messages := make([]model.DirectMessage, 0, limit+1)
+	for rows.Next() {
+		message, err := scanMessage(rows)
+		if err != nil {
+			return model.DirectMessageList{}, err
+		}
+		messages = append(messages, message)
+	}
+	if err := rows.Err(); err != nil {
+		return model.DirectMessageList{}, err
+	}
+
+	res := model.DirectMessageList{Messages: messages}
+	if len(messages) > limit {
+		res.Messages = messages[:limit]
+		res.HaveNext = true
+	}
+	return res, nil
+}
+
+// MarkRead marks messages of conversation up to messageId (0 - all) as read by member
+func (r *MessagesRepo) MarkRead(ctx context.Context, conversationId, userId, messageId int) error {
+	_, err := r.dbpool.Exec(ctx,
+		`UPDATE conversation_members m SET last_read_message_id = GREATEST(m.last_read_message_id,
+			CASE WHEN $3 = 0 THEN c.last_message_id ELSE LEAST($3, c.last_message_id) END)
+		FROM conversations c
+		WHERE c.id = m.conversation_id AND m.conversation_id = $1 AND m.user_id = $2`,
+		conversationId, userId, messageId)
+	return err
+}
+
+// Unread returns number of conversations with unread messages and number of unread messages of user
+func (r *MessagesRepo) Unread(ctx context.Context, userId int) (model.MessagesUnread, error) {
+	var unread model.MessagesUnread
+	err := r.dbpool.QueryRow(ctx,
+		`SELECT count(DISTINCT d.conversation_id), count(*)
+		FROM conversation_members m
+			JOIN direct_messages d ON d.conversation_id = m.conversation_id
+				AND d.id > m.last_read_message_id AND d.user_id <> m.user_id
+		WHERE m.user_id = $1`,
+		userId).Scan(&unread.Conversations, &unread.Messages)
+	return unread, err
+}
@@ -0,0 +1,498 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package moderation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// reports shown in every group of moderation queue
+const groupReportsLimit = 10
+
+type ModerationRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewModerationRepo(dsn string) (*ModerationRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &ModerationRepo{dbpool: pool}, nil
+}
+
+// TargetUser returns author of thread or post, or reported user itself.
+// Hidden content is found too, moderator may act on it again.
+func (r *ModerationRepo) TargetUser(ctx context.Context, targetType string, targetId int) (int, error) {
+	var query string
+	switch targetType {
+	case model.ReportTargetThread:
+		query = `SELECT user_id FROM threads WHERE id = $1`
+	case model.ReportTargetPost:
+		query = `SELECT user_id FROM posts WHERE id = $1`
+	default:
+		query = `SELECT id FROM users WHERE id = $1`
+	}
+	var userId int
+	err := r.dbpool.QueryRow(ctx, query, targetId).Scan(&userId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, model.ErrNotFound
+	}
+	return userId, err
+}
+
+// Report stores open report, false is returned if reporter already has open report of target
+func (r *ModerationRepo) Report(ctx context.Context, report model.ReportCreate) (bool, error) {
+	tag, err := r.dbpool.Exec(ctx,
+		`INSERT INTO reports (reporter_id, target_type, target_id, reason, text)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (reporter_id, target_type, target_id) WHERE resolved_at IS NULL DO NOTHING`,
+		report.ReporterID, report.TargetType, report.TargetID, report.Reason, report.Text)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// Queue returns open reports grouped by target, groups with the latest reports first.
+// For next page pass last report id of last group as before.
+func (r *ModerationRepo) Queue(ctx context.Context, before, limit int) (model.ReportQueue, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`WITH g AS (
+			SELECT target_type, target_id, count(*) AS reports_count, max(id) AS last_id, max(created_at) AS last_at
+			FROM reports
+			WHERE resolved_at IS NULL
+			GROUP BY target_type, target_id
+			HAVING $1 = 0 OR max(id) < $1
+			ORDER BY last_id DESC LIMIT $2
+		), target AS (
+			SELECT g.*, t.id AS thread_id, t.title, t.hidden_at AS thread_hidden_at,
+				p.thread_id AS post_thread_id, left(p.content, 200) AS post_excerpt, p.hidden_at AS post_hidden_at,
+				CASE g.target_type WHEN 'user' THEN g.target_id ELSE COALESCE(t.user_id, p.user_id, 0) END AS user_id
+			FROM g
+				LEFT JOIN threads t ON g.target_type = 'thread' AND t.id = g.target_id
+				LEFT JOIN posts p ON g.target_type = 'post' AND p.id = g.target_id
+		)
+		SELECT target.target_type, target.target_id, target.user_id, COALESCE(u.name, ''),
+			COALESCE(target.thread_id, target.post_thread_id), COALESCE(target.title, target.post_excerpt, u.name, ''),
+			COALESCE(target.thread_hidden_at, target.post_hidden_at) IS NOT NULL,
+			target.reports_count, target.last_id, target.last_at
+		FROM target LEFT JOIN users u ON u.id = target.user_id
+		ORDER BY target.last_id DESC`,
+		before, limit+1)
+	if err != nil {
+		return model.ReportQueue{}, err
+	}
+	defer rows.Close()
+
+	groups := make([]model.ReportGroup, 0, limit+1)
+	for rows.Next() {
+		var g model.ReportGroup
+		err := rows.Scan(&g.TargetType, &g.TargetID, &g.TargetUserID, &g.TargetUserName, &g.ThreadID,
+			&g.Excerpt, &g.Hidden, &g.ReportsCount, &g.LastReportID, &g.LastReportAt)
+		if err != nil {
+			return model.ReportQueue{}, err
+		}
+		groups = append(groups, g)
+	}
+	if err := rows.Err(); err != nil {
+		return model.ReportQueue{}, err
+	}
+
+	res := model.ReportQueue{Groups: groups}
+	if len(groups) > limit {
+		res.Groups = groups[:limit]
+		res.HaveNext = true
+	}
+	for i := range res.Groups {
+		if err := r.groupReports(ctx, &res.Groups[i]); err != nil {
+			return model.ReportQueue{}, err
+		}
+	}
+	return res, nil
+}
+
+// groupReports loads reason counts and the latest open reports of target
+func (r *ModerationRepo) groupReports(ctx context.Context, g *model.ReportGroup) error {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT reason, count(*) FROM reports
+		WHERE resolved_at IS NULL AND target_type = $1 AND target_id = $2
+		GROUP BY reason ORDER BY count(*) DESC, reason`,
+		g.TargetType, g.TargetID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var reason model.ReasonCount
+		if err := rows.Scan(&reason.Reason, &reason.Count); err != nil {
+			return err
+		}
+		g.Reasons = append(g.Reasons, reason)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	rows, err = r.dbpool.Query(ctx,
+		`SELECT r.id, r.reporter_id, u.name, r.reason, r.text, r.created_at
+		FROM reports r JOIN users u ON u.id = r.reporter_id
+		WHERE r.resolved_at IS NULL AND r.target_type = $1 AND r.target_id = $2
+		ORDER BY r.id DESC LIMIT $3`,
+		g.TargetType, g.TargetID, groupReportsLimit)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var report model.Report
+		err := rows.Scan(&report.ID, &report.ReporterID, &report.ReporterName, &report.Reason, &report.Text,
+			&report.CreatedAt)
+		if err != nil {
+			return err
+		}
+		g.Reports = append(g.Reports, report)
+	}
+	return rows.Err()
+}
+
+// Resolve applies moderator action, resolves open reports of target and records action in moderation log.
+// model.ErrNotFound is returned if target has no open reports.
+func (r *ModerationRepo) Resolve(ctx context.Context, action model.ModerationAction) (model.ModerationLogEntry, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return model.ModerationLogEntry{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	// open reports are locked, concurrent resolution of the same target waits and finds nothing
+	var reportIds []int
+	rows, err := tx.Query(ctx,
+		`SELECT id FROM reports
+		WHERE resolved_at IS NULL AND target_type = $1 AND target_id = $2
+		FOR UPDATE`,
+		action.TargetType, action.TargetID)
+	if err != nil {
+		return model.ModerationLogEntry{}, err
+	}
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return model.ModerationLogEntry{}, err
+		}
+		reportIds = append(reportIds, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return model.ModerationLogEntry{}, err
+	}
+	if len(reportIds) == 0 {
+		return model.ModerationLogEntry{}, model.ErrNotFound
+	}
+
+	var threadID int
+	switch action.Action {
+	case model.ModerationHide:
+		query := `UPDATE threads SET hidden_at = COALESCE(hidden_at, now()) WHERE id = $1 RETURNING id`
+		if action.TargetType == model.ReportTargetPost {
+			query = `UPDATE posts SET hidden_at = COALESCE(hidden_at, now()) WHERE id = $1 RETURNING thread_id`
+		}
+		if err := tx.QueryRow(ctx, query, action.TargetID).Scan(&threadID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return model.ModerationLogEntry{}, model.ErrNotFound
+			}
+			return model.ModerationLogEntry{}, err
+		}
+	case model.ModerationSuspend:
+		// the longer suspension wins
+		_, err := tx.Exec(ctx,
+			`UPDATE users SET suspended_until = GREATEST(suspended_until, $2) WHERE id = $1`,
+			action.TargetUserID, action.SuspendedUntil)
+		if err != nil {
+			return model.ModerationLogEntry{}, err
+		}
+	}
+
+	entry := model.ModerationLogEntry{
+		ModeratorID:    action.ModeratorID,
+		Action:         action.Action,
+		TargetType:     action.TargetType,
+		TargetID:       action.TargetID,
+		TargetUserID:   action.TargetUserID,
+		ReportsCount:   len(reportIds),
+		Comment:        action.Comment,
+		SuspendedUntil: action.SuspendedUntil,
+		ThreadID:       threadID,
+	}
+	err = tx.QueryRow(ctx,
+		`INSERT INTO moderation_log (moderator_id, action, target_type, target_id, target_user_id,
+			reports_count, comment, suspended_until)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at`,
+		entry.ModeratorID, entry.Action, entry.TargetType, entry.TargetID, entry.TargetUserID,
+		entry.ReportsCount, entry.Comment, entry.SuspendedUntil).Scan(&entry.ID, &entry.CreatedAt)
+	if err != nil {
+		return model.ModerationLogEntry{}, err
+	}
+	_, err = tx.Exec(ctx,
+		`UPDATE reports SET resolved_at = now(), resolution_id = $2 WHERE id = ANY($1)`,
+		reportIds, entry.ID)
+	if err != nil {
+		return model.ModerationLogEntry{}, err
+	}
+	err = tx.QueryRow(ctx,
+		`SELECT COALESCE((SELECT name FROM users WHERE id = $1), ''),
+			COALESCE((SELECT name FROM users WHERE id = $2), '')`,
+		entry.ModeratorID, entry.TargetUserID).Scan(&entry.ModeratorName, &entry.TargetUserName)
+	if err != nil {
+		return model.ModerationLogEntry{}, err
+	}
+	return entry, tx.Commit(ctx)
+}
+
+// Log returns moderation log from the latest action. For next page pass id of last entry as before.
+func (r *ModerationRepo) Log(ctx context.Context, before, limit int) (model.ModerationLog, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT l.id, l.moderator_id, COALESCE(m.name, ''), l.action, l.target_type, l.target_id,
+			l.target_user_id, COALESCE(u.name, ''), l.reports_count, l.comment, l.suspended_until, l.created_at
+		FROM moderation_log l
+			LEFT JOIN users m ON m.id = l.moderator_id
+			LEFT JOIN users u ON u.id = l.target_user_id
+		WHERE $1 = 0 OR l.id < $1
+		ORDER BY l.id DESC LIMIT $2`,
+		before, limit+1)
+	if err != nil {
+		return model.ModerationLog{}, err
+	}
+	defer rows.Close()
+
+	entries := make([]model.ModerationLogEntry, 0, limit+1)
+	for rows.Next() {
+		var e model.ModerationLogEntry
+		err := rows.Scan(&e.ID, &e.ModeratorID, &e.ModeratorName, &e.Action, &e.TargetType, &e.TargetID,
+			&e.TargetUserID, &e.TargetUserName, &e.ReportsCount, &e.Comment, &e.SuspendedUntil, &e.CreatedAt)
+		if err != nil {
+			return model.ModerationLog{}, err
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return model.ModerationLog{}, err
+	}
+
+	res := model.ModerationLog{Entries: entries}
+	if len(entries) > limit {
+		res.Entries = entries[:limit]
+		res.HaveNext = true
+	}
+	return res, nil
+}
+
+// HeldList returns content and edits held by content filter from the latest one, held edit has edited
+// title and content. For next page pass id of last item as before.
+func (r *ModerationRepo) HeldList(ctx context.Context, before, limit int) (model.HeldContentList, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT h.id, h.target_type, h.target_id, h.thread_id, h.user_id, COALESCE(u.name, ''),
+			CASE h.target_type WHEN 'thread' THEN COALESCE(e.title, t.title, '') ELSE '' END,
+			COALESCE(e.content, CASE h.target_type WHEN 'thread' THEN t.content ELSE p.content END, ''),
+			h.reason, h.created_at, e.held_id IS NOT NULL
+		FROM held_content h
+			LEFT JOIN held_edits e ON e.held_id = h.id
+			LEFT JOIN threads t ON t.id = h.thread_id
+			LEFT JOIN posts p ON h.target_type = 'post' AND p.id = h.target_id
+			LEFT JOIN users u ON u.id = h.user_id
+		WHERE $1 = 0 OR h.id < $1
+		ORDER BY h.id DESC LIMIT $2`,
+		before, limit+1)
+	if err != nil {
+		return model.HeldContentList{}, err
+	}
+	defer rows.Close()
+
+	items := make([]model.HeldContent, 0, limit+1)
+	for rows.Next() {
+		var h model.HeldContent
+		err := rows.Scan(&h.ID, &h.TargetType, &h.TargetID, &h.ThreadID, &h.UserID, &h.UserName,
+			&h.Title, &h.Content, &h.Reason, &h.CreatedAt, &h.Edit)
+		if err != nil {
+			return model.HeldContentList{}, err
+		}
+		items = append(items, h)
+	}
+	if err := rows.Err(); err != nil {
+		return model.HeldContentList{}, err
+	}
+
+	res := model.HeldContentList{Items: items}
+	if len(items) > limit {
+		res.Items = items[:limit]
+		res.HaveNext = true
+	}
+	return res, nil
+}
+
+// DecideHeld removes content from held queue and publishes (approve) or hides (reject) it,
+// held edit is applied or discarded. Decision is recorded in moderation log as approve, hide
+// (rejected new content) or reject (rejected edit) action.
+func (r *ModerationRepo) DecideHeld(
+	ctx context.Context, heldId, moderatorId int, decision, comment string) (model.HeldContent, model.ModerationLogEntry, error) {
+
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return model.HeldContent{}, model.ModerationLogEntry{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	// concurrent decision on the same content waits for delete and finds nothing
+	var held model.HeldContent
+	err = tx.QueryRow(ctx,
+		`DELETE FROM held_content WHERE id = $1
+		RETURNING id, target_type, target_id, thread_id, user_id, reason, created_at`,
+		heldId).Scan(&held.ID, &held.TargetType, &held.TargetID, &held.ThreadID, &held.UserID,
+		&held.Reason, &held.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.HeldContent{}, model.ModerationLogEntry{}, model.ErrNotFound
+	}
+	if err != nil {
+		return model.HeldContent{}, model.ModerationLogEntry{}, err
+	}
+
+	// held edit is applied as new revision (approve) or discarded (reject), target stays visible
+	var edit model.RevisionCreate
+	err = tx.QueryRow(ctx,
+		`DELETE FROM held_edits WHERE held_id = $1
+		RETURNING title, content, content_html, editor_id, restored_from`,
+		heldId).Scan(&edit.Title, &edit.Content, &edit.ContentHTML, &edit.EditorID, &edit.RestoredFrom)
+	var action string
+	switch {
+	case err == nil:
+		held.Edit = true
+		held.Title, held.Content = edit.Title, edit.Content
+		action = model.ModerationReject
+		if decision == model.HeldApprove {
+			action = model.ModerationApprove
+			edit.TargetType, edit.TargetID = held.TargetType, held.TargetID
+			err = applyEdit(ctx, tx, edit)
+		}
+	case errors.Is(err, pgx.ErrNoRows):
+		action, err = decideHeldContent(ctx, tx, &held, decision)
+	}
+	if err != nil {
+		return model.HeldContent{}, model.ModerationLogEntry{}, err
+	}
+
+	entry := model.ModerationLogEntry{
+		ModeratorID:  moderatorId,
+		Action:       action,
+		TargetType:   held.TargetType,
+		TargetID:     held.TargetID,
+		TargetUserID: held.UserID,
+		Comment:      comment,
+	}
+	err = tx.QueryRow(ctx,
+		`INSERT INTO moderation_log (moderator_id, action, target_type, target_id, target_user_id, comment)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at`,
+		entry.ModeratorID, entry.Action, entry.TargetType, entry.TargetID, entry.TargetUserID,
+		entry.Comment).Scan(&entry.ID, &entry.CreatedAt)
+	if err != nil {
+		return model.HeldContent{}, model.ModerationLogEntry{}, err
+	}
+	err = tx.QueryRow(ctx,
+		`SELECT COALESCE((SELECT name FROM users WHERE id = $1), ''),
+			COALESCE((SELECT name FROM users WHERE id = $2), '')`,
+		entry.ModeratorID, entry.TargetUserID).Scan(&entry.ModeratorName, &entry.TargetUserName)
+	if err != nil {
+		return model.HeldContent{}, model.ModerationLogEntry{}, err
+	}
+	held.UserName = entry.TargetUserName
+	return held, entry, tx.Commit(ctx)
+}
+
+// decideHeldContent publishes (approve) or hides (reject) held new thread or post, returns logged action.
+// Approved content is counted in thread and user stats.
+func decideHeldContent(ctx context.Context, tx pgx.Tx, held *model.HeldContent, decision string) (string, error) {
+	action := model.ModerationApprove
+	query := `WITH thread AS (
+			UPDATE threads SET held_at = NULL WHERE id = $1 RETURNING title, content, user_id
+		), author AS (
+			UPDATE users SET posts_count = posts_count + 1 WHERE id = (SELECT user_id FROM thread)
+		)
+		SELECT title, content FROM thread`
+	if held.TargetType == model.ReportTargetPost {
+		query = `WITH post AS (
+				UPDATE posts SET held_at = NULL WHERE id = $1 RETURNING content, thread_id, user_id
+			), thread AS (
+				UPDATE threads SET posts_count = posts_count + 1, last_activity_at = now()
+				WHERE id = (SELECT thread_id FROM post)
+			), author AS (
+				UPDATE users SET posts_count = posts_count + 1 WHERE id = (SELECT user_id FROM post)
+			)
+			SELECT '', content FROM post`
+	}
+	if decision == model.HeldReject {
+		action = model.ModerationHide
+		query = `UPDATE threads SET held_at = NULL, hidden_at = COALESCE(hidden_at, now()) WHERE id = $1
+			RETURNING title, content`
+		if held.TargetType == model.ReportTargetPost {
+			query = `UPDATE posts SET held_at = NULL, hidden_at = COALESCE(hidden_at, now()) WHERE id = $1
+				RETURNING '', content`
+		}
+	}
+	err := tx.QueryRow(ctx, query, held.TargetID).Scan(&held.Title, &held.Content)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", model.ErrNotFound
+	}
+	return action, err
+}
+
+// applyEdit changes thread or post by approved held edit and appends new revision to its history.
+// Original content is stored as revision 1 on the first edit, as revisions repository does.
+func applyEdit(ctx context.Context, tx pgx.Tx, edit model.RevisionCreate) error {
+	// row lock serializes with edits of users, so revision numbers have no gaps
+	lockQuery := `SELECT title, content, user_id, created_at FROM threads WHERE id = $1 FOR UPDATE`
+	updateQuery := `UPDATE threads SET title = $2, content = $3, content_html = $4, updated_at = now()
+		WHERE id = $1`
+	updateArgs := []any{edit.TargetID, edit.Title, edit.Content, edit.ContentHTML}
+	if edit.TargetType == model.ReportTargetPost {
+		lockQuery = `SELECT '', content, user_id, created_at FROM posts WHERE id = $1 FOR UPDATE`
+		updateQuery = `UPDATE posts SET content = $2, content_html = $3, updated_at = now() WHERE id = $1`
+		updateArgs = []any{edit.TargetID, edit.Content, edit.ContentHTML}
+	}
+	var title, content string
+	var authorID int
+	var createdAt time.Time
+	err := tx.QueryRow(ctx, lockQuery, edit.TargetID).Scan(&title, &content, &authorID, &createdAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO post_revisions (target_type, target_id, revision, title, content, editor_id, created_at)
+		SELECT $1, $2, 1, $3, $4, $5, $6
+		WHERE NOT EXISTS (SELECT 1 FROM post_revisions WHERE target_type = $1 AND target_id = $2)`,
+		edit.TargetType, edit.TargetID, title, content, authorID, createdAt)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, updateQuery, updateArgs...); err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO post_revisions (target_type, target_id, revision, title, content, editor_id, restored_from)
+		SELECT $1, $2, max(revision) + 1, $3, $4, $5, $6
+		FROM post_revisions WHERE target_type = $1 AND target_id = $2`,
+		edit.TargetType, edit.TargetID, edit.Title, edit.Content, edit.EditorID, edit.RestoredFrom)
+	return err
+}
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package notifications
+
+import (
+	"context"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type NotificationsRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewNotificationsRepo(dsn string) (*NotificationsRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &NotificationsRepo{dbpool: pool}, nil
+}
+
+// Create stores notification if recipient has not disabled its type and has not muted its thread.
+// Returns false if notification was dropped by user preferences.
+func (r *NotificationsRepo) Create(ctx context.Context, n model.NotificationCreate) (bool, error) {
+	var actorID *int
+	if n.ActorID != 0 {
+		actorID = &n.ActorID
+	}
+	tag, err := r.dbpool.Exec(ctx,
+		`INSERT INTO notifications (user_id, type, actor_id, thread_id, post_id, vote_value, message)
+		SELECT $1, $2, $3, $4, $5, $6, $7
+		WHERE NOT EXISTS (
+			SELECT 1 FROM notification_preferences WHERE user_id = $1 AND type = $2 AND NOT enabled
+		) AND NOT EXISTS (
+			SELECT 1 FROM thread_subscriptions WHERE user_id = $1 AND thread_id = $4 AND level = 'muted'
+		)`,
+		n.UserID, n.Type, actorID, n.ThreadID, n.PostID, n.VoteValue, n.Message)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// CreateForWatchers stores notification for every user watching thread of notification except
+// excluded users and users who disabled its type. Fan-out is done by one query, so threads
+// with thousands of watchers do not need thousands of round trips. Returns number of created notifications.
+func (r *NotificationsRepo) CreateForWatchers(
+	ctx context.Context, n model.NotificationCreate, excludeUserIds []int) (int, error) {
+
+	var actorID *int
+	if n.ActorID != 0 {
+		actorID = &n.ActorID
+	}
+	tag, err := r.dbpool.Exec(ctx,
+		`INSERT INTO notifications (user_id, type, actor_id, thread_id, post_id, vote_value, message)
+		SELECT s.user_id, $1, $2, s.thread_id, $4, $5, $6 FROM thread_subscriptions s
+		WHERE s.thread_id = $3 AND s.level = 'watching' AND s.user_id <> ALL($7)
+			AND NOT EXISTS (
+				SELECT 1 FROM notification_preferences p WHERE p.user_id = s.user_id AND p.type = $1 AND NOT p.enabled
+			)`,
+		n.Type, actorID, n.ThreadID, n.PostID, n.VoteValue, n.Message, excludeUserIds)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// notIgnored filters out notifications n about actions of users blocked or ignored by recipient,
+// moderator actions are always shown
+const notIgnored = `(n.type = 'moderation' OR NOT EXISTS (
+	SELECT 1 FROM user_relations r WHERE r.user_id = n.user_id AND r.target_id = n.actor_id))`
+
+// List user notifications newest first. Notifications with id less than before are returned
+// (before 0 - from newest).
+func (r *NotificationsRepo) List(
+	ctx context.Context, userId int, unreadOnly bool, before, limit int) (model.NotificationList, error) {
+
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT n.id, n.user_id, n.type, n.actor_id, COALESCE(u.name, ''), n.thread_id, n.post_id,
+			n.vote_value, n.message, n.is_read, n.created_at
+		FROM notifications n LEFT JOIN users u ON u.id = n.actor_id
+		WHERE n.user_id = $1 AND `+notIgnored+`
+			AND (NOT $2 OR NOT n.is_read)
+			AND ($3 = 0 OR n.id < $3)
+		ORDER BY n.id DESC LIMIT $4`,
+		userId, unreadOnly, before, limit+1)
+	if err != nil {
+		return model.NotificationList{}, err
+	}
+	defer rows.Close()
+
+	notifications := make([]model.Notification, 0, limit+1)
+	for rows.Next() {
+		var n model.Notification
+		err := rows.Scan(&n.ID, &n.UserID, &n.Type, &n.ActorID, &n.ActorName, &n.ThreadID, &n.PostID,
+			&n.VoteValue, &n.Message, &n.IsRead, &n.CreatedAt)
+		if err != nil {
+			return model.NotificationList{}, err
+		}
+		notifications = append(notifications, n)
+	}
+	if err := rows.Err(); err != nil {
+		return model.NotificationList{}, err
+	}
+
+	res := model.NotificationList{Notifications: notifications}
+	if len(notifications) > limit {
+		res.Notifications = notifications[:limit]
+		res.HaveNext = true
+	}
+	return res, nil
+}
+
+// MarkRead marks given notifications of user as read, ids of other users are ignored
+func (r *NotificationsRepo) MarkRead(ctx context.Context, userId int, ids []int) error {
+	_, err := r.dbpool.Exec(ctx,
+		`UPDATE notifications SET is_read = TRUE WHERE user_id = $1 AND id = ANY($2) AND NOT is_read`,
+		userId, ids)
+	return err
+}
+
+func (r *NotificationsRepo) MarkAllRead(ctx context.Context, userId int) error {
+	_, err := r.dbpool.Exec(ctx,
+		`UPDATE notifications SET is_read = TRUE WHERE user_id = $1 AND NOT is_read`, userId)
+	return err
+}
+
+func (r *NotificationsRepo) UnreadCount(ctx context.Context, userId int) (int, error) {
+	var count int
+	err := r.dbpool.QueryRow(ctx,
+		`SELECT count(*) FROM notifications n WHERE n.user_id = $1 AND NOT n.is_read AND `+notIgnored,
+		userId).Scan(&count)
+	return count, err
+}
+
+// Preferences returns only types changed by user, types without row are enabled
+func (r *NotificationsRepo) Preferences(ctx context.Context, userId int) (map[string]bool, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT type, enabled FROM notification_preferences WHERE user_id = $1`, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make(map[string]bool)
+	for rows.Next() {
+		var notificationType string
+		var enabled bool
+		if err := rows.Scan(&notificationType, &enabled); err != nil {
+			return nil, err
+		}
+		res[notificationType] = enabled
+	}
+	return res, rows.Err()
+}
+
+func (r *NotificationsRepo) SetPreferences(
+	ctx context.Context, userId int, preferences []model.NotificationPreference) error {
+
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for _, preference := range preferences {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO notification_preferences (user_id, type, enabled) VALUES ($1, $2, $3)
+			ON CONFLICT (user_id, type) DO UPDATE SET enabled = EXCLUDED.enabled`,
+			userId, preference.Type, preference.Enabled)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
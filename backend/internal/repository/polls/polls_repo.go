@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package polls
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PollsRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewPollsRepo(dsn string) (*PollsRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &PollsRepo{dbpool: pool}, nil
+}
+
+// Create stores poll of thread with its options in given order
+func (r *PollsRepo) Create(ctx context.Context, threadId int, poll model.PollCreate) (int, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var pollId int
+	err = tx.QueryRow(ctx,
+		`INSERT INTO polls (thread_id, question, multiple, anonymous, closes_at)
+		VALUES ($1, $2, $3, $4, $5) RETURNING id`,
+		threadId, poll.Question, poll.Multiple, poll.Anonymous, poll.ClosesAt).Scan(&pollId)
+	if err != nil {
+		return 0, err
+	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO poll_options (poll_id, position, text)
+		SELECT $1, o.position, o.text FROM unnest($2::text[]) WITH ORDINALITY AS o(text, position)`,
+		pollId, poll.Options)
+	if err != nil {
+		return 0, err
+	}
+	return pollId, tx.Commit(ctx)
+}
+
+// GetByThread returns poll of visible thread with votes counts of options
+func (r *PollsRepo) GetByThread(ctx context.Context, threadId int) (model.Poll, error) {
+	var poll model.Poll
+	err := r.dbpool.QueryRow(ctx,
+		`SELECT p.id, p.thread_id, p.question, p.multiple, p.anonymous, p.closes_at, p.created_at,
+			(SELECT count(*) FROM poll_voters v WHERE v.poll_id = p.id), t.archived_at IS NOT NULL
+		FROM polls p JOIN threads t ON t.id = p.thread_id
+		WHERE p.thread_id = $1 AND t.hidden_at IS NULL AND t.held_at IS NULL`,
+		threadId).Scan(&poll.ID, &poll.ThreadID, &poll.Question, &poll.Multiple, &poll.Anonymous,
+		&poll.ClosesAt, &poll.CreatedAt, &poll.VotersCount, &poll.ThreadArchived)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Poll{}, model.ErrNotFound
+		}
+		return model.Poll{}, err
+	}
+
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT o.id, o.text, (SELECT count(*) FROM poll_votes v WHERE v.option_id = o.id)
+		FROM poll_options o WHERE o.poll_id = $1
+		ORDER BY o.position`,
+		poll.ID)
+	if err != nil {
+		return model.Poll{}, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var option model.PollOption
+		if err := rows.Scan(&option.ID, &option.Text, &option.VotesCount); err != nil {
+			return model.Poll{}, err
+		}
+		poll.Options = append(poll.Options, option)
+	}
+	return poll, rows.Err()
+}
+
+// Voters returns voters of poll by option id
+func (r *PollsRepo) Voters(ctx context.Context, pollId int) (map[int][]model.PollVoter, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT v.option_id, v.user_id, u.name
+		FROM poll_votes v JOIN users u ON u.id = v.user_id
+		WHERE v.poll_id = $1
+		ORDER BY v.created_at, v.user_id`,
+		pollId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make(map[int][]model.PollVoter)
+	for rows.Next() {
+		var optionId int
+		var voter model.PollVoter
+		if err := rows.Scan(&optionId, &voter.UserID, &voter.Name); err != nil {
+			return nil, err
+		}
+		res[optionId] = append(res[optionId], voter)
+	}
+	return res, rows.Err()
+}
+
+// UserVotes returns options chosen by user, empty if user did not vote
+func (r *PollsRepo) UserVotes(ctx context.Context, pollId, userId int) ([]int, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT option_id FROM poll_votes WHERE poll_id = $1 AND user_id = $2 ORDER BY option_id`,
+		pollId, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []int
+	for rows.Next() {
+		var optionId int
+		if err := rows.Scan(&optionId); err != nil {
+			return nil, err
+		}
+		res = append(res, optionId)
+	}
+	return res, rows.Err()
+}
+
+// Vote stores options chosen by user. User votes once, false is returned if user already voted.
+func (r *PollsRepo) Vote(ctx context.Context, pollId, userId int, optionIds []int) (bool, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	// primary key of voters allows single vote even for concurrent requests
+	tag, err := tx.Exec(ctx,
+		`INSERT INTO poll_voters (poll_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+		pollId, userId)
+	if err != nil {
+		return false, err
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO poll_votes (poll_id, option_id, user_id)
+		SELECT $1, o.id, $2 FROM poll_options o WHERE o.poll_id = $1 AND o.id = ANY($3)`,
+		pollId, userId, optionIds)
+	if err != nil {
+		return false, err
+	}
+	return true, tx.Commit(ctx)
+}
@@ -6,9 +6,11 @@ package posts
 import (
 	"context"
 	"database/sql"
+	"errors"
 
 	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
 	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -24,34 +26,85 @@ func NewPostsRepo(dsn string) (*PostsRepo, error) {
 	return &PostsRepo{dbpool: pool}, nil
 }
 
-// create post in thread
+// create post in thread, held post is added to moderation queue and counted in thread and user stats
+// after approval
 func (r *PostsRepo) Create(ctx context.Context, post model.PostCreate) (model.Post, error) {
 	row := r.dbpool.QueryRow(ctx,
-		`INSERT INTO posts (thread_id, user_id, content) VALUES ($1, $2, $3)
-		RETURNING id, thread_id, user_id, content, created_at`,
-		post.ThreadID, post.UserID, post.Content)
+		`WITH post AS (
+			INSERT INTO posts (thread_id, user_id, reply_to_id, content, content_html, held_at)
+			VALUES ($1, $2, $3, $4, $5, CASE WHEN $6::text <> '' THEN now() END)
+			RETURNING id, thread_id, user_id, reply_to_id, content, content_html, score, created_at
+		), thread AS (
+			UPDATE threads SET posts_count = posts_count + 1, last_activity_at = now() WHERE id = $1 AND $6 = ''
+		), author AS (
+			UPDATE users SET posts_count = posts_count + 1 WHERE id = $2 AND $6 = ''
+		), held AS (
+			INSERT INTO held_content (target_type, target_id, thread_id, user_id, reason)
+			SELECT 'post', id, thread_id, user_id, $6 FROM post WHERE $6 <> ''
+		)
+		SELECT id, thread_id, user_id, reply_to_id, content, content_html, score, created_at FROM post`,
+		post.ThreadID, post.UserID, post.ReplyToID, post.Content, post.ContentHTML, post.HeldReason)
 
 	var id int
 	var threadID int
 	var userID int
+	var replyToID *int
 	var content string
+	var contentHTML string
+	var score int
 	var createdAt sql.NullTime
-	if err := row.Scan(&id, &threadID, &userID, &content, &createdAt); err != nil {
+	if err := row.Scan(&id, &threadID, &userID, &replyToID, &content, &contentHTML, &score, &createdAt); err != nil {
 		return model.Post{}, err
 	}
 	return model.Post{
-		ID:        id,
-		ThreadID:  threadID,
-		UserID:    userID,
-		Content:   content,
-		CreatedAt: createdAt.Time,
+		ID:          id,
+		ThreadID:    threadID,
+		UserID:      userID,
+		ReplyToID:   replyToID,
+		Content:     content,
+		ContentHTML: contentHTML,
+		Score:       score,
+		CreatedAt:   createdAt.Time,
 	}, nil
 }
 
-// list posts by thread id
+// Get returns post, hidden and held posts are not found
+func (r *PostsRepo) Get(ctx context.Context, postId int) (model.Post, error) {
+	row := r.dbpool.QueryRow(ctx,
+		`SELECT id, thread_id, user_id, reply_to_id, content, content_html, score, created_at FROM posts WHERE id = $1 AND hidden_at IS NULL AND held_at IS NULL`, postId)
+
+	var id int
+	var threadID int
+	var userID int
+	var replyToID *int
+	var content string
+	var contentHTML string
+	var score int
+	var createdAt sql.NullTime
+	if err := row.Scan(&id, &threadID, &userID, &replyToID, &content, &contentHTML, &score, &createdAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Post{}, model.ErrNotFound
+		}
+		return model.Post{}, err
+	}
+	return model.Post{
+		ID:          id,
+		ThreadID:    threadID,
+		UserID:      userID,
+		ReplyToID:   replyToID,
+		Content:     content,
+		ContentHTML: contentHTML,
+		Score:       score,
+		CreatedAt:   createdAt.Time,
+	}, nil
+}
+
+// list posts by thread id, hidden and held posts are skipped
 func (r *PostsRepo) List(ctx context.Context, threadId int) ([]model.Post, error) {
 	rows, err := r.dbpool.Query(ctx,
-		`SELECT id, thread_id, user_id, content, created_at FROM posts WHERE thread_id = $1`, threadId)
+		`SELECT id, thread_id, user_id, reply_to_id, content, content_html, score, created_at
+		FROM posts WHERE thread_id = $1 AND hidden_at IS NULL AND held_at IS NULL ORDER BY id`,
+		threadId)
 	if err != nil {
 		return nil, err
 	}
@@ -62,15 +115,23 @@ func (r *PostsRepo) List(ctx context.Context, threadId int) ([]model.Post, error
 		var id int
 		var threadID int
 		var userID int
+		var replyToID *int
 		var content string
-		if err := rows.Scan(&id, &threadID, &userID, &content); err != nil {
+		var contentHTML string
+		var score int
+		var createdAt sql.NullTime
+		if err := rows.Scan(&id, &threadID, &userID, &replyToID, &content, &contentHTML, &score, &createdAt); err != nil {
 			return nil, err
 		}
 		posts = append(posts, model.Post{
-			ID:       id,
-			ThreadID: threadID,
-			UserID:   userID,
-			Content:  content,
+			ID:          id,
+			ThreadID:    threadID,
+			UserID:      userID,
+			ReplyToID:   replyToID,
+			Content:     content,
+			ContentHTML: contentHTML,
+			Score:       score,
+			CreatedAt:   createdAt.Time,
 		})
 	}
 	return posts, nil
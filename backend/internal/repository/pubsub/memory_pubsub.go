@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package pubsub
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+// MemoryPubSub delivers messages inside one process, for single instance setup and tests
+type MemoryPubSub struct {
+	mu        sync.Mutex
+	lastID    int64
+	listeners map[string]map[*memoryListener]struct{}
+}
+
+type memoryListener struct {
+	handle func(model.PubSubMessage)
+}
+
+func NewMemoryPubSub() *MemoryPubSub {
+	return &MemoryPubSub{listeners: make(map[string]map[*memoryListener]struct{})}
+}
+
+// Publish calls listeners of channel synchronously, returns message id
+func (p *MemoryPubSub) Publish(ctx context.Context, channel string, payload []byte) (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lastID++
+	message := model.PubSubMessage{ID: p.lastID, Payload: payload}
+	for listener := range p.listeners[channel] {
+		listener.handle(message)
+	}
+	return message.ID, nil
+}
+
+// Listen calls handle for every message of channel until ctx is done.
+// Memory delivery is never broken, so reconnected is never called.
+func (p *MemoryPubSub) Listen(
+	ctx context.Context, channel string, handle func(model.PubSubMessage), reconnected func()) error {
+
+	listener := &memoryListener{handle: handle}
+	p.mu.Lock()
+	if p.listeners[channel] == nil {
+		p.listeners[channel] = make(map[*memoryListener]struct{})
+	}
+	p.listeners[channel][listener] = struct{}{}
+	p.mu.Unlock()
+
+	<-ctx.Done()
+
+	p.mu.Lock()
+	delete(p.listeners[channel], listener)
+	p.mu.Unlock()
+	return ctx.Err()
+}
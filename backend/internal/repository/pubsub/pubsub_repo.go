@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package pubsub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// postgres limits NOTIFY payload to 8000 bytes, part of it is taken by message id
+	MaxPayloadSize = 7900
+
+	minReconnectDelay = 100 * time.Millisecond
+	maxReconnectDelay = 10 * time.Second
+)
+
+// PubSubRepo delivers messages between forum instances with postgres LISTEN/NOTIFY
+type PubSubRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewPubSubRepo(dsn string) (*PubSubRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &PubSubRepo{dbpool: pool}, nil
+}
+
+// Publish sends message to listeners of channel on all instances, returns message id.
+// Listeners receive messages of channel in order of ids: id is taken and message is queued
+// under transaction lock of channel, notifications are queued in order of commits.
+func (r *PubSubRepo) Publish(ctx context.Context, channel string, payload []byte) (int64, error) {
+	if len(payload) > MaxPayloadSize {
+		return 0, fmt.Errorf("pubsub message of %d bytes is too large", len(payload))
+	}
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, channel); err != nil {
+		return 0, err
+	}
+	var id int64
+	if err := tx.QueryRow(ctx, `SELECT nextval('pubsub_message_id_seq')`).Scan(&id); err != nil {
+		return 0, err
+	}
+	_, err = tx.Exec(ctx, `SELECT pg_notify($1, $2)`, channel, strconv.FormatInt(id, 10)+" "+string(payload))
+	if err != nil {
+		return 0, err
+	}
+	return id, tx.Commit(ctx)
+}
+
+// Listen calls handle for every message of channel until ctx is done.
+// Broken connection is restored with growing delay, messages sent while instance
+// was not listening are lost, so reconnected is called after every restore.
+func (r *PubSubRepo) Listen(
+	ctx context.Context, channel string, handle func(model.PubSubMessage), reconnected func()) error {
+
+	delay := minReconnectDelay
+	listening := false
+	for {
+		err := r.listen(ctx, channel, handle, func() {
+			if listening {
+				reconnected()
+			}
+			listening = true
+			delay = minReconnectDelay
+		})
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log.Printf("pubsub: listening channel %s failed: %v, reconnecting in %s", channel, err, delay)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+func (r *PubSubRepo) listen(
+	ctx context.Context, channel string, handle func(model.PubSubMessage), connected func()) error {
+
+	conn, err := r.dbpool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// connection in LISTEN state must not be reused by pool
+	pgConn := conn.Hijack()
+	defer pgConn.Close(context.Background())
+
+	if _, err := pgConn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+		return err
+	}
+	connected()
+
+	for {
+		notification, err := pgConn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		idStr, payload, _ := strings.Cut(notification.Payload, " ")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			log.Printf("pubsub: skipped malformed message of channel %s: %v", channel, err)
+			continue
+		}
+		handle(model.PubSubMessage{ID: id, Payload: []byte(payload)})
+	}
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package relations
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RelationsRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewRelationsRepo(dsn string) (*RelationsRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &RelationsRepo{dbpool: pool}, nil
+}
+
+// Set creates or changes relation of user to target, model.ErrNotFound if target does not exist
+func (r *RelationsRepo) Set(ctx context.Context, userId, targetId int, kind string) (model.UserRelation, error) {
+	relation := model.UserRelation{TargetID: targetId, Kind: kind}
+	err := r.dbpool.QueryRow(ctx,
+		`WITH target AS (
+			SELECT id, name FROM users WHERE id = $2
+		), upserted AS (
+			INSERT INTO user_relations (user_id, target_id, kind)
+			SELECT $1, id, $3 FROM target
+			ON CONFLICT (user_id, target_id) DO UPDATE SET kind = EXCLUDED.kind,
+				created_at = CASE WHEN user_relations.kind = EXCLUDED.kind
+					THEN user_relations.created_at ELSE now() END
+			RETURNING created_at
+		)
+		SELECT target.name, upserted.created_at FROM target, upserted`,
+		userId, targetId, kind).Scan(&relation.TargetName, &relation.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.UserRelation{}, model.ErrNotFound
+	}
+	return relation, err
+}
+
+// Delete removes relation of user to target, returns false if there was no relation
+func (r *RelationsRepo) Delete(ctx context.Context, userId, targetId int) (bool, error) {
+	tag, err := r.dbpool.Exec(ctx,
+		`DELETE FROM user_relations WHERE user_id = $1 AND target_id = $2`, userId, targetId)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// List returns relations of user of given kind ("" - all kinds), the latest first
+func (r *RelationsRepo) List(ctx context.Context, userId int, kind string) ([]model.UserRelation, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT r.target_id, COALESCE(u.name, ''), r.kind, r.created_at
+		FROM user_relations r LEFT JOIN users u ON u.id = r.target_id
+		WHERE r.user_id = $1 AND ($2 = '' OR r.kind = $2)
+		ORDER BY r.created_at DESC, r.target_id DESC`,
+		userId, kind)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.UserRelation
+	for rows.Next() {
+		var relation model.UserRelation
+		if err := rows.Scan(&relation.TargetID, &relation.TargetName, &relation.Kind, &relation.CreatedAt); err != nil {
+			return nil, err
+		}
+		res = append(res, relation)
+	}
+	return res, rows.Err()
+}
+
+// Targets returns ids of all users blocked or ignored by user
+func (r *RelationsRepo) Targets(ctx context.Context, userId int) (map[int]bool, error) {
+	rows, err := r.dbpool.Query(ctx, `SELECT target_id FROM user_relations WHERE user_id = $1`, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make(map[int]bool)
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		res[id] = true
+	}
+	return res, rows.Err()
+}
+
+// BlockedBy returns which of given users blocked user
+func (r *RelationsRepo) BlockedBy(ctx context.Context, userId int, byIds []int) (map[int]bool, error) {
+	res := make(map[int]bool)
+	if len(byIds) == 0 {
+		return res, nil
+	}
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT user_id FROM user_relations WHERE target_id = $1 AND kind = 'block' AND user_id = ANY($2)`,
+		userId, byIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		res[id] = true
+	}
+	return res, rows.Err()
+}
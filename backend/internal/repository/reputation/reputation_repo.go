@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package reputation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReputationRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewReputationRepo(dsn string) (*ReputationRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &ReputationRepo{dbpool: pool}, nil
+}
+
+func (r *ReputationRepo) Stats(ctx context.Context, userId int) (model.UserStats, error) {
+	row := r.dbpool.QueryRow(ctx,
+		`SELECT id, karma, posts_count, accepted_answers, rank, created_at FROM users WHERE id = $1`,
+		userId)
+
+	var id int
+	var karma int
+	var postsCount int
+	var acceptedAnswers int
+	var rank string
+	var createdAt time.Time
+	if err := row.Scan(&id, &karma, &postsCount, &acceptedAnswers, &rank, &createdAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.UserStats{}, model.ErrNotFound
+		}
+		return model.UserStats{}, err
+	}
+	return model.UserStats{
+		UserID:          id,
+		Karma:           karma,
+		PostsCount:      postsCount,
+		AcceptedAnswers: acceptedAnswers,
+		Rank:            rank,
+		CreatedAt:       createdAt,
+	}, nil
+}
+
+// SetRank stores new user rank and appends rank change to history.
+// Returns false if user already has this rank.
+func (r *ReputationRepo) SetRank(ctx context.Context, userId int, rank string) (bool, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	var oldRank string
+	err = tx.QueryRow(ctx, `SELECT rank FROM users WHERE id = $1 FOR UPDATE`, userId).Scan(&oldRank)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, model.ErrNotFound
+		}
+		return false, err
+	}
+	if oldRank == rank {
+		return false, nil
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE users SET rank = $2 WHERE id = $1`, userId, rank)
+	if err != nil {
+		return false, err
+	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO rank_history (user_id, old_rank, new_rank) VALUES ($1, $2, $3)`,
+		userId, oldRank, rank)
+	if err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit(ctx)
+}
+
+// rank changes of user, newest first
+func (r *ReputationRepo) History(ctx context.Context, userId int) ([]model.RankChange, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT user_id, old_rank, new_rank, changed_at FROM rank_history
+		WHERE user_id = $1 ORDER BY id DESC`, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := make([]model.RankChange, 0)
+	for rows.Next() {
+		var userID int
+		var oldRank string
+		var newRank string
+		var changedAt time.Time
+		if err := rows.Scan(&userID, &oldRank, &newRank, &changedAt); err != nil {
+			return nil, err
+		}
+		changes = append(changes, model.RankChange{
+			UserID:    userID,
+			OldRank:   oldRank,
+			NewRank:   newRank,
+			ChangedAt: changedAt,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
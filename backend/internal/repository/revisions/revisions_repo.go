@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package revisions
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RevisionsRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewRevisionsRepo(dsn string) (*RevisionsRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &RevisionsRepo{dbpool: pool}, nil
+}
+
+// Edit changes thread or post and appends new revision to its history in one transaction.
+// Original content is stored as revision 1 on the first edit. Held edit does not change target,
+// it is added to moderation queue and returned without revision number.
+func (r *RevisionsRepo) Edit(ctx context.Context, edit model.RevisionCreate) (model.Revision, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return model.Revision{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	// row lock serializes concurrent edits, so revision numbers have no gaps
+	var lockQuery, updateQuery string
+	var updateArgs []any
+	switch edit.TargetType {
+	case model.RevisionTargetThread:
+		lockQuery = `SELECT title, content, user_id, id, created_at FROM threads WHERE id = $1 FOR UPDATE`
+		updateQuery = `UPDATE threads SET title = $2, content = $3, content_html = $4, updated_at = now()
+			WHERE id = $1`
+		updateArgs = []any{edit.TargetID, edit.Title, edit.Content, edit.ContentHTML}
+	case model.RevisionTargetPost:
+		lockQuery = `SELECT '', content, user_id, thread_id, created_at FROM posts WHERE id = $1 FOR UPDATE`
+		updateQuery = `UPDATE posts SET content = $2, content_html = $3, updated_at = now() WHERE id = $1`
+		updateArgs = []any{edit.TargetID, edit.Content, edit.ContentHTML}
+	default:
+		return model.Revision{}, errors.New("unknown revision target type " + edit.TargetType)
+	}
+	var title, content string
+	var authorID, threadID int
+	var createdAt time.Time
+	err = tx.QueryRow(ctx, lockQuery, edit.TargetID).Scan(&title, &content, &authorID, &threadID, &createdAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Revision{}, model.ErrNotFound
+		}
+		return model.Revision{}, err
+	}
+	if edit.HeldReason != "" {
+		revision, err := scanRevision(tx.QueryRow(ctx,
+			`WITH held AS (
+				INSERT INTO held_content (target_type, target_id, thread_id, user_id, reason)
+				VALUES ($1, $2, $3, $4, $5)
+				RETURNING id, created_at
+			), held_edit AS (
+				INSERT INTO held_edits (held_id, title, content, content_html, editor_id, restored_from)
+				SELECT id, $6, $7, $8, $9, $10 FROM held
+			)
+			SELECT 0, $1, $2, 0, $6, $7, $9, COALESCE(u.name, ''), $10::integer, h.created_at
+			FROM held h LEFT JOIN users u ON u.id = $9`,
+			edit.TargetType, edit.TargetID, threadID, authorID, edit.HeldReason,
+			edit.Title, edit.Content, edit.ContentHTML, edit.EditorID, edit.RestoredFrom))
+		if err != nil {
+			return model.Revision{}, err
+		}
+		revision.Held = true
+		return revision, tx.Commit(ctx)
+	}
+
+	var last int
+	err = tx.QueryRow(ctx,
+		`SELECT COALESCE(max(revision), 0) FROM post_revisions WHERE target_type = $1 AND target_id = $2`,
+		edit.TargetType, edit.TargetID).Scan(&last)
+	if err != nil {
+		return model.Revision{}, err
+	}
+	if last == 0 {
+		_, err = tx.Exec(ctx,
+			`INSERT INTO post_revisions (target_type, target_id, revision, title, content, editor_id, created_at)
+			VALUES ($1, $2, 1, $3, $4, $5, $6)`,
+			edit.TargetType, edit.TargetID, title, content, authorID, createdAt)
+		if err != nil {
+			return model.Revision{}, err
+		}
+		last = 1
+	}
+
+	if _, err := tx.Exec(ctx, updateQuery, updateArgs...); err != nil {
+		return model.Revision{}, err
+	}
+	row := tx.QueryRow(ctx,
+		`WITH revision AS (
+			INSERT INTO post_revisions (target_type, target_id, revision, title, content, editor_id, restored_from)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			RETURNING id, target_type, target_id, revision, title, content, editor_id, restored_from, created_at
+		)
+		SELECT r.id, r.target_type, r.target_id, r.revision, r.title, r.content, r.editor_id,
+			COALESCE(u.name, ''), r.restored_from, r.created_at
+		FROM revision r LEFT JOIN users u ON u.id = r.editor_id`,
+		edit.TargetType, edit.TargetID, last+1, edit.Title, edit.Content, edit.EditorID, edit.RestoredFrom)
+	revision, err := scanRevision(row)
+	if err != nil {
+		return model.Revision{}, err
+	}
+	return revision, tx.Commit(ctx)
+}
+
+// List returns revisions of thread or post from the oldest, empty if it was never edited
+func (r *RevisionsRepo) List(ctx context.Context, targetType string, targetId int) ([]model.Revision, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT r.id, r.target_type, r.target_id, r.revision, r.title, r.content, r.editor_id,
+			COALESCE(u.name, ''), r.restored_from, r.created_at
+		FROM post_revisions r LEFT JOIN users u ON u.id = r.editor_id
+		WHERE r.target_type = $1 AND r.target_id = $2
+		ORDER BY r.revision`,
+		targetType, targetId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.Revision
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, revision)
+	}
+	return res, rows.Err()
+}
+
+func scanRevision(row pgx.Row) (model.Revision, error) {
+	var r model.Revision
+	err := row.Scan(&r.ID, &r.TargetType, &r.TargetID, &r.Revision, &r.Title, &r.Content, &r.EditorID,
+		&r.EditorName, &r.RestoredFrom, &r.CreatedAt)
+	return r, err
+}
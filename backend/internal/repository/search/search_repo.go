@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package search
+
+import (
+	"context"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const headlineOptions = `StartSel="` + model.SearchHighlightStart + `", StopSel="` + model.SearchHighlightStop + `", ` +
+	`MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=" … "`
+
+type SearchRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewSearchRepo(dsn string) (*SearchRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &SearchRepo{dbpool: pool}, nil
+}
+
+// search threads and posts, query is parsed with russian and english configurations and
+// results from both are merged. Hits are ordered by rank, cursor continues after last hit.
+func (r *SearchRepo) Search(ctx context.Context, query model.SearchQuery) ([]model.SearchHitRepo, error) {
+	var cursorRank *float32
+	var cursorKind *string
+	var cursorID *int
+	if query.Cursor != nil {
+		cursorRank = &query.Cursor.Rank
+		cursorKind = &query.Cursor.Kind
+		cursorID = &query.Cursor.ID
+	}
+
+	rows, err := r.dbpool.Query(ctx,
+		`WITH q AS (
+			SELECT websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1) AS query
+		), hits AS (
+			SELECT 'thread' AS kind, t.id AS id, t.id AS thread_id, 0 AS post_id, t.title, t.content,
+				t.user_id, t.community_id, t.created_at, ts_rank_cd(t.search_vector, q.query) AS rank
+			FROM threads t, q
+			WHERE t.search_vector @@ q.query AND t.hidden_at IS NULL AND t.held_at IS NULL
+				AND ($2::integer IS NULL OR t.user_id = $2)
+				AND ($3::integer IS NULL OR t.community_id = $3)
+				AND ($4::timestamptz IS NULL OR t.created_at >= $4)
+				AND ($5::timestamptz IS NULL OR t.created_at < $5)
+			UNION ALL
+			SELECT 'post' AS kind, p.id AS id, p.thread_id, p.id AS post_id, t.title, p.content,
+				p.user_id, t.community_id, p.created_at, ts_rank_cd(p.search_vector, q.query) AS rank
+			FROM posts p JOIN threads t ON t.id = p.thread_id, q
+			WHERE p.search_vector @@ q.query AND p.hidden_at IS NULL AND p.held_at IS NULL
+				AND t.hidden_at IS NULL AND t.held_at IS NULL
+				AND ($2::integer IS NULL OR p.user_id = $2)
+				AND ($3::integer IS NULL OR t.community_id = $3)
+				AND ($4::timestamptz IS NULL OR p.created_at >= $4)
+				AND ($5::timestamptz IS NULL OR p.created_at < $5)
+		), page AS (
+			SELECT * FROM hits
+			WHERE $6::real IS NULL OR (rank, kind, id) < ($6::real, $7::text, $8::integer)
+			ORDER BY rank DESC, kind DESC, id DESC
+			LIMIT $9
+		)
+		SELECT page.kind, page.thread_id, page.post_id, page.title,
+			ts_headline('russian', page.content, q.query, $10),
+			page.user_id, page.community_id, page.created_at, page.rank
+		FROM page, q
+		ORDER BY page.rank DESC, page.kind DESC, page.id DESC`,
+		query.Query, query.AuthorID, query.CommunityID, query.From, query.To,
+		cursorRank, cursorKind, cursorID, query.Limit, headlineOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hits := make([]model.SearchHitRepo, 0, query.Limit)
+	for rows.Next() {
+		var kind string
+		var threadID int
+		var postID int
+		var title string
+		var snippet string
+		var userID int
+		var communityID *int
+		var createdAt time.Time
+		var rank float32
+		if err := rows.Scan(&kind, &threadID, &postID, &title, &snippet,
+			&userID, &communityID, &createdAt, &rank); err != nil {
+			return nil, err
+		}
+		hits = append(hits, model.SearchHitRepo{
+			Kind:        kind,
+			ThreadID:    threadID,
+			PostID:      postID,
+			Title:       title,
+			Snippet:     snippet,
+			UserID:      userID,
+			CommunityID: communityID,
+			CreatedAt:   createdAt,
+			Rank:        rank,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package subscriptions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SubscriptionsRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewSubscriptionsRepo(dsn string) (*SubscriptionsRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &SubscriptionsRepo{dbpool: pool}, nil
+}
+
+// Set subscribes user to thread or changes level of subscription
+func (r *SubscriptionsRepo) Set(ctx context.Context, userId, threadId int, level string) (model.ThreadSubscription, error) {
+	row := r.dbpool.QueryRow(ctx,
+		`INSERT INTO thread_subscriptions (user_id, thread_id, level)
+		SELECT $1, t.id, $3 FROM threads t WHERE t.id = $2 AND t.hidden_at IS NULL AND t.held_at IS NULL
+		ON CONFLICT (user_id, thread_id) DO UPDATE SET level = EXCLUDED.level
+		RETURNING user_id, thread_id, level, created_at`,
+		userId, threadId, level)
+	var res model.ThreadSubscription
+	err := row.Scan(&res.UserID, &res.ThreadID, &res.Level, &res.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ThreadSubscription{}, model.ErrNotFound
+		}
+		return model.ThreadSubscription{}, err
+	}
+	return res, nil
+}
+
+// SetIfAbsent subscribes user to thread, subscription chosen by user is kept
+func (r *SubscriptionsRepo) SetIfAbsent(ctx context.Context, userId, threadId int, level string) error {
+	_, err := r.dbpool.Exec(ctx,
+		`INSERT INTO thread_subscriptions (user_id, thread_id, level) VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, thread_id) DO NOTHING`,
+		userId, threadId, level)
+	return err
+}
+
+func (r *SubscriptionsRepo) Get(ctx context.Context, userId, threadId int) (model.ThreadSubscription, error) {
+	row := r.dbpool.QueryRow(ctx,
+		`SELECT user_id, thread_id, level, created_at FROM thread_subscriptions
+		WHERE user_id = $1 AND thread_id = $2`,
+		userId, threadId)
+	var res model.ThreadSubscription
+	err := row.Scan(&res.UserID, &res.ThreadID, &res.Level, &res.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ThreadSubscription{}, model.ErrNotFound
+		}
+		return model.ThreadSubscription{}, err
+	}
+	return res, nil
+}
+
+func (r *SubscriptionsRepo) Delete(ctx context.Context, userId, threadId int) error {
+	tag, err := r.dbpool.Exec(ctx,
+		`DELETE FROM thread_subscriptions WHERE user_id = $1 AND thread_id = $2`, userId, threadId)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// List user subscriptions with current thread info, newest threads first. Subscriptions to threads
+// with id less than before are returned (before 0 - from newest). Empty level - all levels.
+// Hidden and held threads are skipped.
+func (r *SubscriptionsRepo) List(
+	ctx context.Context, userId int, level string, before, limit int) (model.ThreadSubscriptionListRepo, error) {
+
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT s.user_id, s.thread_id, s.level, s.created_at,
+			t.id, t.title, t.content, t.content_html, t.user_id, t.community_id, t.posts_count, t.score, t.created_at
+		FROM thread_subscriptions s JOIN threads t ON t.id = s.thread_id
+		WHERE s.user_id = $1 AND t.hidden_at IS NULL AND t.held_at IS NULL
+			AND ($2 = '' OR s.level = $2)
+			AND ($3 = 0 OR s.thread_id < $3)
+		ORDER BY s.thread_id DESC LIMIT $4`,
+		userId, level, before, limit+1)
+	if err != nil {
+		return model.ThreadSubscriptionListRepo{}, err
+	}
+	defer rows.Close()
+
+	items := make([]model.ThreadSubscriptionRepoItem, 0, limit+1)
+	for rows.Next() {
+		var item model.ThreadSubscriptionRepoItem
+		err := rows.Scan(&item.UserID, &item.ThreadID, &item.Level, &item.CreatedAt,
+			&item.Thread.ID, &item.Thread.Title, &item.Thread.Content, &item.Thread.ContentHTML, &item.Thread.UserID,
+			&item.Thread.CommunityID, &item.Thread.PostsCount, &item.Thread.Score, &item.Thread.CreatedAt)
+		if err != nil {
+			return model.ThreadSubscriptionListRepo{}, err
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return model.ThreadSubscriptionListRepo{}, err
+	}
+
+	res := model.ThreadSubscriptionListRepo{Subscriptions: items}
+	if len(items) > limit {
+		res.Subscriptions = items[:limit]
+		res.HaveNext = true
+	}
+	return res, nil
+}
@@ -5,10 +5,12 @@ package threads
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
 	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -24,115 +26,121 @@ func NewThreadsRepo(dsn string) (*ThreadsRepo, error) {
 	return &ThreadsRepo{dbpool: pool}, nil
 }
 
-// create thread
+// create thread, held thread is added to moderation queue and counted in user stats after approval
 func (r *ThreadsRepo) Create(ctx context.Context, thread model.ThreadCreate) (model.ThreadRepoInfo, error) {
 	row := r.dbpool.QueryRow(ctx,
-		`INSERT INTO threads (title, content, user_id, posts_count) VALUES ($1, $2, $3, $4)
-		RETURNING id, title, content, posts_count, user_id, created_at`,
-		thread.Title, thread.Content, thread.UserID, 1)
+		`WITH thread AS (
+			INSERT INTO threads (title, content, content_html, user_id, community_id, posts_count, held_at)
+			VALUES ($1, $2, $6, $3, $4, $5, CASE WHEN $7::text <> '' THEN now() END)
+			RETURNING id, title, content, content_html, posts_count, score, user_id, community_id, created_at
+		), author AS (
+			UPDATE users SET posts_count = posts_count + 1 WHERE id = $3 AND $7 = ''
+		), held AS (
+			INSERT INTO held_content (target_type, target_id, thread_id, user_id, reason)
+			SELECT 'thread', id, id, user_id, $7 FROM thread WHERE $7 <> ''
+		)
+		SELECT id, title, content, content_html, posts_count, score, user_id, community_id, created_at FROM thread`,
+		thread.Title, thread.Content, thread.UserID, thread.CommunityID, 1, thread.ContentHTML, thread.HeldReason)
 
 	var id int
 	var userID int
 	var content string
+	var contentHTML string
 	var title string
+	var communityID *int
 	var postsCount int
+	var score int
 	var createdAt time.Time
-	if err := row.Scan(&id, &title, &content, &postsCount, &userID, &createdAt); err != nil {
+	if err := row.Scan(&id, &title, &content, &contentHTML, &postsCount, &score, &userID, &communityID, &createdAt); err != nil {
 		return model.ThreadRepoInfo{}, err
 	}
 	return model.ThreadRepoInfo{
-		ID:         id,
-		UserID:     userID,
-		Title:      title,
-		Content:    content,
-		PostsCount: postsCount,
-		CreatedAt:  createdAt,
+		ID:          id,
+		UserID:      userID,
+		Title:       title,
+		Content:     content,
+		ContentHTML: contentHTML,
+		CommunityID: communityID,
+		PostsCount:  postsCount,
+		Score:       score,
+		CreatedAt:   createdAt,
 	}, nil
 }
 
-// list threads page
-func (r *ThreadsRepo) PageByPageID(ctx context.Context, page, limit int) (model.ThreadListRepo, error) {
+// columns scanned by scanThread
+const threadColumns = `id, title, content, content_html, user_id, community_id, posts_count, score, accepted_post_id,
+	COALESCE(pinned, ''), locked, archived_at IS NOT NULL, slow_mode_seconds, created_at`
+
+// listFilter selects visible (not hidden and not held) threads of community ($1, all threads if NULL) without threads pinned
+// in this list, pinned threads are listed separately before others
+const listFilter = `hidden_at IS NULL AND held_at IS NULL AND ($1::integer IS NULL OR community_id = $1)
+	AND NOT COALESCE(pinned = 'global' OR (pinned = 'community' AND community_id = $1), FALSE)`
+
+func scanThread(row pgx.Row) (model.ThreadRepoInfo, error) {
+	var t model.ThreadRepoInfo
+	err := row.Scan(&t.ID, &t.Title, &t.Content, &t.ContentHTML, &t.UserID, &t.CommunityID, &t.PostsCount, &t.Score,
+		&t.AcceptedPostID, &t.State.Pinned, &t.State.Locked, &t.State.Archived,
+		&t.State.SlowModeSeconds, &t.CreatedAt)
+	return t, err
+}
+
+// list threads page, communityId nil lists threads of all communities
+func (r *ThreadsRepo) PageByPageID(ctx context.Context, communityId *int, page, limit int) (model.ThreadListRepo, error) {
 	rows, err := r.dbpool.Query(ctx,
-		`SELECT id, title, content, user_id, posts_count, created_at
+		`SELECT `+threadColumns+`
 		FROM threads
-		ORDER BY id DESC LIMIT $1 OFFSET $2`, limit, (page-1)*limit)
+		WHERE `+listFilter+`
+		ORDER BY id DESC LIMIT $2 OFFSET $3`, communityId, limit, (page-1)*limit)
 	if err != nil {
 		return model.ThreadListRepo{}, err
 	}
-	defer rows.Close()
-
-	threads := make([]model.ThreadRepoInfo, 0, limit)
-	for rows.Next() {
-		var id int
-		var userID int
-		var content string
-		var title string
-		var postsCount int
-		var createdAt time.Time
-		if err := rows.Scan(&id, &title, &content, &userID, &postsCount, &createdAt); err != nil {
-			return model.ThreadListRepo{}, err
-		}
-		threads = append(threads, model.ThreadRepoInfo{
-			ID:         id,
-			UserID:     userID,
-			Title:      title,
-			Content:    content,
-			PostsCount: postsCount,
-			CreatedAt:  createdAt,
-		})
-	}
-	res, err := r.threadListInfo(ctx, threads[len(threads)-1].ID, threads[0].ID)
-	if err != nil {
-		return model.ThreadListRepo{}, err
-	}
-	res.Threads = threads
-
-	return res, nil
+	return r.threadList(ctx, communityId, rows, limit)
 }
 
 // list threads page by page id, with next and prev page info
-func (r *ThreadsRepo) PageByOffset(ctx context.Context, threadId, limit int, before bool) (model.ThreadListRepo, error) {
-	getBeforeQuery := `SELECT id, title, content, user_id, posts_count, created_at
+func (r *ThreadsRepo) PageByOffset(
+	ctx context.Context, communityId *int, threadId, limit int, before bool) (model.ThreadListRepo, error) {
+
+	getBeforeQuery := `SELECT ` + threadColumns + `
 		FROM threads
-		WHERE id < $1
-		ORDER BY id DESC LIMIT $2`
-	getAfterQuery := `SELECT id, title, content, user_id, posts_count, created_at
+		WHERE ` + listFilter + ` AND id < $2
+		ORDER BY id DESC LIMIT $3`
+	getAfterQuery := `SELECT ` + threadColumns + `
 		FROM threads
-		WHERE id > $1
-		ORDER BY id DESC LIMIT $2`
+		WHERE ` + listFilter + ` AND id > $2
+		ORDER BY id DESC LIMIT $3`
 	var query string
 	if before {
 		query = getBeforeQuery
 	} else {
 		query = getAfterQuery
 	}
-	rows, err := r.dbpool.Query(ctx, query, threadId, limit)
+	rows, err := r.dbpool.Query(ctx, query, communityId, threadId, limit)
 	if err != nil {
 		return model.ThreadListRepo{}, err
 	}
-	defer rows.Close()
+	return r.threadList(ctx, communityId, rows, limit)
+}
 
+func (r *ThreadsRepo) threadList(
+	ctx context.Context, communityId *int, rows pgx.Rows, limit int) (model.ThreadListRepo, error) {
+
+	defer rows.Close()
 	threads := make([]model.ThreadRepoInfo, 0, limit)
 	for rows.Next() {
-		var id int
-		var userID int
-		var content string
-		var title string
-		var postsCount int
-		var createdAt time.Time
-		if err := rows.Scan(&id, &title, &content, &userID, &postsCount, &createdAt); err != nil {
+		thread, err := scanThread(rows)
+		if err != nil {
 			return model.ThreadListRepo{}, err
 		}
-		threads = append(threads, model.ThreadRepoInfo{
-			ID:         id,
-			UserID:     userID,
-			Title:      title,
-			Content:    content,
-			PostsCount: postsCount,
-			CreatedAt:  createdAt,
-		})
-	}
-	res, err := r.threadListInfo(ctx, threads[len(threads)-1].ID, threads[0].ID)
+		threads = append(threads, thread)
+	}
+	if err := rows.Err(); err != nil {
+		return model.ThreadListRepo{}, err
+	}
+	if len(threads) == 0 {
+		return model.ThreadListRepo{Threads: threads}, nil
+	}
+	res, err := r.threadListInfo(ctx, communityId, threads[len(threads)-1].ID, threads[0].ID)
 	if err != nil {
 		return model.ThreadListRepo{}, err
 	}
@@ -141,9 +149,9 @@ func (r *ThreadsRepo) PageByOffset(ctx context.Context, threadId, limit int, bef
 	return res, nil
 }
 
-func (r *ThreadsRepo) threadListInfo(ctx context.Context, minId, maxId int) (model.ThreadListRepo, error) {
+func (r *ThreadsRepo) threadListInfo(ctx context.Context, communityId *int, minId, maxId int) (model.ThreadListRepo, error) {
 	row := r.dbpool.QueryRow(ctx,
-		`SELECT COUNT(*) FROM threads`)
+		`SELECT COUNT(*) FROM threads WHERE `+listFilter, communityId)
 
 	var count int
 	if err := row.Scan(&count); err != nil {
@@ -153,10 +161,10 @@ func (r *ThreadsRepo) threadListInfo(ctx context.Context, minId, maxId int) (mod
 		TotalCountEstimated: count,
 	}
 	row = r.dbpool.QueryRow(ctx,
-		`SELECT id FROM threads WHERE id < $1 LIMIT 1`, minId)
+		`SELECT id FROM threads WHERE `+listFilter+` AND id < $2 LIMIT 1`, communityId, minId)
 	var prevId int
 	if err := row.Scan(&prevId); err != nil {
-		if err.Error() == "no rows in result set" { // FIXME: this is not a good way to check for no rows, but pgx does not export the error type
+		if errors.Is(err, pgx.ErrNoRows) {
 			res.HaveNext = false
 		} else {
 			return model.ThreadListRepo{}, err
@@ -166,10 +174,10 @@ func (r *ThreadsRepo) threadListInfo(ctx context.Context, minId, maxId int) (mod
 	}
 
 	row = r.dbpool.QueryRow(ctx,
-		`SELECT id FROM threads WHERE id > $1 LIMIT 1`, maxId)
+		`SELECT id FROM threads WHERE `+listFilter+` AND id > $2 LIMIT 1`, communityId, maxId)
 	var nextId int
 	if err := row.Scan(&nextId); err != nil {
-		if err.Error() == "no rows in result set" { // FIXME: this is not a good way to check for no rows, but pgx does not export the error type
+		if errors.Is(err, pgx.ErrNoRows) {
 			res.HavePrev = false
 		} else {
 			return model.ThreadListRepo{}, err
@@ -181,25 +189,132 @@ func (r *ThreadsRepo) threadListInfo(ctx context.Context, minId, maxId int) (mod
 	return res, nil
 }
 
+// Pinned returns threads pinned globally and pinned in community (if not nil), the latest pinned first
+func (r *ThreadsRepo) Pinned(ctx context.Context, communityId *int) ([]model.ThreadRepoInfo, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT `+threadColumns+`
+		FROM threads
+		WHERE hidden_at IS NULL AND held_at IS NULL AND (pinned = 'global' OR (pinned = 'community' AND community_id = $1))
+		ORDER BY pinned_at DESC, id DESC`, communityId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []model.ThreadRepoInfo
+	for rows.Next() {
+		thread, err := scanThread(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, thread)
+	}
+	return res, rows.Err()
+}
+
+// Get returns thread, hidden and held threads are not found
 func (r *ThreadsRepo) Get(ctx context.Context, threadId int) (*model.ThreadRepoInfo, error) {
 	row := r.dbpool.QueryRow(ctx,
-		`SELECT id, title, content, user_id, posts_count, created_at FROM threads WHERE id = $1`, threadId)
-
-	var id int
-	var userID int
-	var content string
-	var title string
-	var postsCount int
-	var createdAt time.Time
-	if err := row.Scan(&id, &title, &content, &userID, &postsCount, &createdAt); err != nil {
+		`SELECT `+threadColumns+` FROM threads WHERE id = $1 AND hidden_at IS NULL AND held_at IS NULL`, threadId)
+	thread, err := scanThread(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrNotFound
+		}
 		return nil, err
 	}
-	return &model.ThreadRepoInfo{
-		ID:         id,
-		UserID:     userID,
-		Title:      title,
-		Content:    content,
-		PostsCount: postsCount,
-		CreatedAt:  createdAt,
-	}, nil
+	return &thread, nil
+}
+
+// SetState changes pinned, locked, archived state and slow mode of thread. Unarchived thread is considered
+// active, so it is not archived again by the next auto-archive run.
+func (r *ThreadsRepo) SetState(ctx context.Context, threadId int, change model.ThreadStateChange) (model.ThreadState, error) {
+	row := r.dbpool.QueryRow(ctx,
+		`UPDATE threads SET
+			pinned = CASE WHEN $2::text IS NULL THEN pinned ELSE NULLIF($2, '') END,
+			pinned_at = CASE WHEN $2::text IS NULL THEN pinned_at WHEN $2 = '' THEN NULL ELSE now() END,
+			locked = COALESCE($3, locked),
+			archived_at = CASE WHEN $4::boolean IS NULL THEN archived_at
+				WHEN $4 THEN COALESCE(archived_at, now()) ELSE NULL END,
+			last_activity_at = CASE WHEN $4 = FALSE AND archived_at IS NOT NULL THEN now() ELSE last_activity_at END,
+			slow_mode_seconds = COALESCE($5, slow_mode_seconds)
+		WHERE id = $1
+		RETURNING COALESCE(pinned, ''), locked, archived_at IS NOT NULL, slow_mode_seconds`,
+		threadId, change.Pinned, change.Locked, change.Archived, change.SlowModeSeconds)
+	var state model.ThreadState
+	if err := row.Scan(&state.Pinned, &state.Locked, &state.Archived, &state.SlowModeSeconds); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ThreadState{}, model.ErrNotFound
+		}
+		return model.ThreadState{}, err
+	}
+	return state, nil
+}
+
+// ArchiveInactive archives up to limit not pinned threads without activity since before,
+// rows locked by other instance are skipped. Returns number of archived threads.
+func (r *ThreadsRepo) ArchiveInactive(ctx context.Context, before time.Time, limit int) (int, error) {
+	tag, err := r.dbpool.Exec(ctx,
+		`UPDATE threads SET archived_at = now()
+		WHERE id IN (
+			SELECT id FROM threads
+			WHERE archived_at IS NULL AND pinned IS NULL AND last_activity_at < $1
+			ORDER BY last_activity_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)`, before, limit)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// AcceptAnswer sets accepted post of thread and moves accepted answers counter
+// from previously accepted post author to new one
+func (r *ThreadsRepo) AcceptAnswer(ctx context.Context, threadId, postId int) (model.AcceptedAnswerChange, error) {
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return model.AcceptedAnswerChange{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var oldPostID *int
+	err = tx.QueryRow(ctx, `SELECT accepted_post_id FROM threads WHERE id = $1 FOR UPDATE`, threadId).
+		Scan(&oldPostID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.AcceptedAnswerChange{}, model.ErrNotFound
+		}
+		return model.AcceptedAnswerChange{}, err
+	}
+	if oldPostID != nil && *oldPostID == postId {
+		return model.AcceptedAnswerChange{}, nil
+	}
+
+	var change model.AcceptedAnswerChange
+	if oldPostID != nil {
+		err = tx.QueryRow(ctx,
+			`UPDATE users SET accepted_answers = accepted_answers - 1
+			WHERE id = (SELECT user_id FROM posts WHERE id = $1) RETURNING id`, *oldPostID).
+			Scan(&change.OldAuthorID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return model.AcceptedAnswerChange{}, err
+		}
+	}
+	err = tx.QueryRow(ctx,
+		`UPDATE users SET accepted_answers = accepted_answers + 1
+		WHERE id = (SELECT user_id FROM posts WHERE id = $1) RETURNING id`, postId).
+		Scan(&change.NewAuthorID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.AcceptedAnswerChange{}, model.ErrNotFound
+		}
+		return model.AcceptedAnswerChange{}, err
+	}
+	_, err = tx.Exec(ctx, `UPDATE threads SET accepted_post_id = $2 WHERE id = $1`, threadId, postId)
+	if err != nil {
+		return model.AcceptedAnswerChange{}, err
+	}
+
+	return change, tx.Commit(ctx)
 }
@@ -5,9 +5,13 @@ package user
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
 	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
 	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -23,21 +27,29 @@ func NewUserRepo(dsn string) (*UserRepo, error) {
 	return &UserRepo{dbpool: pool}, nil
 }
 
+// Get returns user with profile and activity stats, model.ErrNotFound if user does not exist
 func (r *UserRepo) Get(ctx context.Context, userId int) (*model.User, error) {
-	row := r.dbpool.QueryRow(ctx,
-		`SELECT id, name, email FROM users WHERE id = $1`,
-		userId)
-
-	var id int64
-	var name, email string
-	if err := row.Scan(&id, &name, &email); err != nil {
-		return nil, err // TODO: not found error
+	var user model.User
+	err := r.dbpool.QueryRow(ctx,
+		`SELECT id, name, email, karma, rank, role, avatar_url, bio, location, website, created_at, last_seen_at,
+			(SELECT count(*) FROM threads t
+			WHERE t.user_id = users.id AND t.hidden_at IS NULL AND t.held_at IS NULL),
+			(SELECT count(*) FROM posts p JOIN threads t ON t.id = p.thread_id
+			WHERE p.user_id = users.id AND p.hidden_at IS NULL AND p.held_at IS NULL
+				AND t.hidden_at IS NULL AND t.held_at IS NULL),
+			(SELECT count(*) FROM user_follows WHERE target_id = users.id),
+			(SELECT count(*) FROM user_follows WHERE user_id = users.id)
+		FROM users WHERE id = $1`,
+		userId).Scan(&user.ID, &user.Name, &user.Email, &user.Karma, &user.Rank, &user.Role,
+		&user.AvatarURL, &user.Bio, &user.Location, &user.Website, &user.CreatedAt, &user.LastSeenAt,
+		&user.ThreadsCount, &user.PostsCount, &user.FollowersCount, &user.FollowingCount)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrNotFound
 	}
-	return &model.User{
-		ID:    id,
-		Name:  name,
-		Email: email,
-	}, nil
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
 }
 func (r *UserRepo) GetNameById(ctx context.Context, userId int) (string, error) {
 	// TODO: optimize with cache
@@ -52,37 +64,203 @@ func (r *UserRepo) GetNameById(ctx context.Context, userId int) (string, error)
 	return name, nil
 }
 
-func (r *UserRepo) Create(ctx context.Context, name, email string) (*model.User, error) {
+// name and rank of thread or post author
+func (r *UserRepo) GetAuthor(ctx context.Context, userId int) (model.Author, error) {
 	row := r.dbpool.QueryRow(ctx,
-		`INSERT INTO users (name, email) VALUES ($1, $2) RETURNING id, name, email`,
-		name, email)
+		`SELECT id, name, rank FROM users WHERE id = $1`,
+		userId)
 
-	var id int64
-	if err := row.Scan(&id, &name, &email); err != nil {
-		return nil, err
+	var id int
+	var name, rank string
+	if err := row.Scan(&id, &name, &rank); err != nil {
+		return model.Author{}, err
 	}
-	return &model.User{
-		ID:    id,
-		Name:  name,
-		Email: email,
+	return model.Author{
+		ID:   id,
+		Name: name,
+		Rank: rank,
 	}, nil
 }
 
-func (r *UserRepo) Update(ctx context.Context, userId int, name, email string) (*model.User, error) {
+// GetRole returns role of user, model.ErrNotFound if user does not exist
+func (r *UserRepo) GetRole(ctx context.Context, userId int) (string, error) {
+	var role string
+	err := r.dbpool.QueryRow(ctx, `SELECT role FROM users WHERE id = $1`, userId).Scan(&role)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", model.ErrNotFound
+	}
+	return role, err
+}
+
+// SuspendedUntil returns end of user suspension, nil if user was never suspended
+func (r *UserRepo) SuspendedUntil(ctx context.Context, userId int) (*time.Time, error) {
+	var until *time.Time
+	err := r.dbpool.QueryRow(ctx, `SELECT suspended_until FROM users WHERE id = $1`, userId).Scan(&until)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrNotFound
+	}
+	return until, err
+}
+
+// IdsByNames returns ids of existing users with given names, unknown names are skipped
+func (r *UserRepo) IdsByNames(ctx context.Context, names []string) (map[string]int, error) {
+	res := make(map[string]int)
+	if len(names) == 0 {
+		return res, nil
+	}
+	rows, err := r.dbpool.Query(ctx, `SELECT id, name FROM users WHERE name = ANY($1)`, names)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+		res[name] = id
+	}
+	return res, rows.Err()
+}
+
+// ListMentionableByPrefix returns users with name starting with prefix (case-insensitive),
+// ordered by name. Names which can not be @mentioned (with spaces and so on) are skipped,
+// the same rule is in mention package.
+func (r *UserRepo) ListMentionableByPrefix(ctx context.Context, prefix string, limit int) ([]model.Author, error) {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(strings.ToLower(prefix))
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT id, name, rank FROM users
+		WHERE lower(name) LIKE $1 || '%' AND name ~ '^[[:alnum:]_.-]*[[:alnum:]_]$'
+		ORDER BY lower(name) LIMIT $2`,
+		escaped, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []model.Author
+	for rows.Next() {
+		var user model.Author
+		if err := rows.Scan(&user.ID, &user.Name, &user.Rank); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
+
+func (r *UserRepo) Create(ctx context.Context, name, email string) (*model.User, error) {
 	row := r.dbpool.QueryRow(ctx,
-		`UPDATE users SET name = $1, email = $2 WHERE id = $3 RETURNING id, name, email`,
-		name, email, userId)
+		`INSERT INTO users (name, email) VALUES ($1, $2) RETURNING id, name, email, karma, rank, role`,
+		name, email)
 
 	var id int64
-	if err := row.Scan(&id, &name, &email); err != nil {
+	var karma int
+	var rank, role string
+	if err := row.Scan(&id, &name, &email, &karma, &rank, &role); err != nil {
 		return nil, err
 	}
 	return &model.User{
 		ID:    id,
 		Name:  name,
 		Email: email,
+		Karma: karma,
+		Rank:  rank,
+		Role:  role,
 	}, nil
 }
+
+// Update replaces name, email and profile of user, model.ErrNotFound if user does not exist
+func (r *UserRepo) Update(ctx context.Context, update model.UserUpdate) error {
+	tag, err := r.dbpool.Exec(ctx,
+		`UPDATE users SET name = $2, email = $3, avatar_url = $4, bio = $5, location = $6, website = $7,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`,
+		update.UserID, update.Name, update.Email, update.AvatarURL, update.Bio, update.Location, update.Website)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// SetLastSeen stores time of the last user activity
+func (r *UserRepo) SetLastSeen(ctx context.Context, userId int, seenAt time.Time) error {
+	_, err := r.dbpool.Exec(ctx, `UPDATE users SET last_seen_at = $2 WHERE id = $1`, userId, seenAt)
+	return err
+}
+
+// Threads returns visible threads of user from the newest. For next page pass id of last thread as before.
+func (r *UserRepo) Threads(ctx context.Context, userId, before, limit int) (model.UserThreadList, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT id, title, posts_count, score, created_at FROM threads
+		WHERE user_id = $1 AND hidden_at IS NULL AND held_at IS NULL AND ($2 = 0 OR id < $2)
+		ORDER BY id DESC LIMIT $3`,
+		userId, before, limit+1)
+	if err != nil {
+		return model.UserThreadList{}, err
+	}
+	defer rows.Close()
+
+	threads := make([]model.UserThread, 0, limit+1)
+	for rows.Next() {
+		var t model.UserThread
+		if err := rows.Scan(&t.ID, &t.Title, &t.PostsCount, &t.Score, &t.CreatedAt); err != nil {
+			return model.UserThreadList{}, err
+		}
+		threads = append(threads, t)
+	}
+	if err := rows.Err(); err != nil {
+		return model.UserThreadList{}, err
+	}
+
+	res := model.UserThreadList{Threads: threads}
+	if len(threads) > limit {
+		res.Threads = threads[:limit]
+		res.HaveNext = true
+	}
+	return res, nil
+}
+
+// Posts returns visible posts of user in visible threads from the newest.
+// For next page pass id of last post as before.
+func (r *UserRepo) Posts(ctx context.Context, userId, before, limit int) (model.UserPostList, error) {
+	rows, err := r.dbpool.Query(ctx,
+		`SELECT p.id, p.thread_id, t.title, p.content_html, p.score, p.created_at
+		FROM posts p JOIN threads t ON t.id = p.thread_id
+		WHERE p.user_id = $1 AND p.hidden_at IS NULL AND p.held_at IS NULL
+			AND t.hidden_at IS NULL AND t.held_at IS NULL AND ($2 = 0 OR p.id < $2)
+		ORDER BY p.id DESC LIMIT $3`,
+		userId, before, limit+1)
+	if err != nil {
+		return model.UserPostList{}, err
+	}
+	defer rows.Close()
+
+	posts := make([]model.UserPost, 0, limit+1)
+	for rows.Next() {
+		var p model.UserPost
+		if err := rows.Scan(&p.ID, &p.ThreadID, &p.ThreadTitle, &p.ContentHTML, &p.Score, &p.CreatedAt); err != nil {
+			return model.UserPostList{}, err
+		}
+		posts = append(posts, p)
+	}
+	if err := rows.Err(); err != nil {
+		return model.UserPostList{}, err
+	}
+
+	res := model.UserPostList{Posts: posts}
+	if len(posts) > limit {
+		res.Posts = posts[:limit]
+		res.HaveNext = true
+	}
+	return res, nil
+}
+
 func (r *UserRepo) Delete(ctx context.Context, userId int) error {
 	_, err := r.dbpool.Exec(ctx, `DELETE FROM users WHERE id = $1`, userId)
 	return err
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package votes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/repository"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type VotesRepo struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewVotesRepo(dsn string) (*VotesRepo, error) {
+	pool, err := repository.PgPool(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &VotesRepo{dbpool: pool}, nil
+}
+
+// table with score column for vote target, target type is never taken from user input directly
+func targetTable(targetType string) (string, error) {
+	switch targetType {
+	case model.VoteTargetThread:
+		return "threads", nil
+	case model.VoteTargetPost:
+		return "posts", nil
+	}
+	return "", fmt.Errorf("unknown vote target type %q", targetType)
+}
+
+// author of voted thread or post, hidden and held targets are not found
+func (r *VotesRepo) TargetAuthor(ctx context.Context, targetType string, targetId int) (int, error) {
+	table, err := targetTable(targetType)
+	if err != nil {
+		return 0, err
+	}
+	row := r.dbpool.QueryRow(ctx,
+		`SELECT user_id FROM `+table+` WHERE id = $1 AND hidden_at IS NULL AND held_at IS NULL`, targetId)
+
+	var userID int
+	if err := row.Scan(&userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, model.ErrNotFound
+		}
+		return 0, err
+	}
+	return userID, nil
+}
+
+// Vote sets user vote for thread or post and updates target score and author karma.
+// Target row is locked for the whole transaction, so concurrent votes for the same target
+// are applied one by one and counters always match votes table. Hidden and held targets are not found.
+func (r *VotesRepo) Vote(ctx context.Context, vote model.Vote) (model.VoteResult, error) {
+	table, err := targetTable(vote.TargetType)
+	if err != nil {
+		return model.VoteResult{}, err
+	}
+
+	tx, err := r.dbpool.Begin(ctx)
+	if err != nil {
+		return model.VoteResult{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	threadColumn := "id"
+	if vote.TargetType == model.VoteTargetPost {
+		threadColumn = "thread_id"
+	}
+	var authorID int
+	var threadID int
+	var score int
+	err = tx.QueryRow(ctx,
+		`SELECT user_id, `+threadColumn+`, score FROM `+table+`
+		WHERE id = $1 AND hidden_at IS NULL AND held_at IS NULL FOR UPDATE`, vote.TargetID).
+		Scan(&authorID, &threadID, &score)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.VoteResult{}, model.ErrNotFound
+		}
+		return model.VoteResult{}, err
+	}
+
+	var oldValue int
+	var oldVotedAt time.Time
+	err = tx.QueryRow(ctx,
+		`SELECT value, created_at FROM votes WHERE target_type = $1 AND target_id = $2 AND user_id = $3`,
+		vote.TargetType, vote.TargetID, vote.UserID).Scan(&oldValue, &oldVotedAt)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return model.VoteResult{}, err
+	}
+
+	delta := vote.Value - oldValue
+	if delta != 0 {
+		if vote.Value == 0 {
+			_, err = tx.Exec(ctx,
+				`DELETE FROM votes WHERE target_type = $1 AND target_id = $2 AND user_id = $3`,
+				vote.TargetType, vote.TargetID, vote.UserID)
+		} else {
+			// changed vote keeps its time, interaction graph counts it on the day of the first vote
+			_, err = tx.Exec(ctx,
+				`INSERT INTO votes (target_type, target_id, user_id, target_user_id, value)
+				VALUES ($1, $2, $3, $4, $5)
+				ON CONFLICT (target_type, target_id, user_id)
+				DO UPDATE SET value = EXCLUDED.value`,
+				vote.TargetType, vote.TargetID, vote.UserID, authorID, vote.Value)
+		}
+		if err != nil {
+			return model.VoteResult{}, err
+		}
+
+		err = tx.QueryRow(ctx, `UPDATE `+table+` SET score = score + $2 WHERE id = $1 RETURNING score`,
+			vote.TargetID, delta).Scan(&score)
+		if err != nil {
+			return model.VoteResult{}, err
+		}
+		_, err = tx.Exec(ctx, `UPDATE users SET karma = karma + $2 WHERE id = $1`, authorID, delta)
+		if err != nil {
+			return model.VoteResult{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return model.VoteResult{}, err
+	}
+	return model.VoteResult{
+		TargetType: vote.TargetType,
+		TargetID:   vote.TargetID,
+		ThreadID:   threadID,
+		AuthorID:   authorID,
+		Score:      score,
+		MyVote:     vote.Value,
+		OldVote:    oldValue,
+		Changed:    delta != 0,
+		OldVotedAt: oldVotedAt,
+	}, nil
+}
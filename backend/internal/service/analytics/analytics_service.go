@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const (
+	DefaultEdgesLimit = 1000
+	MaxEdgesLimit     = 10000
+	// whole graph is loaded in memory for influence metrics, export is streamed without limit
+	MaxWholeGraphEdges = 1000000
+)
+
+var (
+	ErrNotAdmin      = errors.New("only admin can access analytics")
+	ErrUnknownKind   = errors.New("interaction kind must be reply, mention or vote")
+	ErrInvalidWindow = errors.New("time window start must be before its end")
+	ErrUnknownFormat = errors.New("graph format must be graphml, gexf or csv")
+	ErrGraphTooLarge = fmt.Errorf("graph has more than %d edges, narrow time window or raise min weight",
+		MaxWholeGraphEdges)
+)
+
+// all interaction kinds, graph of all kinds is built by default
+var interactionKinds = []string{model.InteractionReply, model.InteractionMention, model.InteractionVote}
+
+type AnalyticsRepo interface {
+	Record(ctx context.Context, interactions []model.Interaction) error
+	Remove(ctx context.Context, interactions []model.Interaction) error
+	Rebuild(ctx context.Context) (int, error)
+	Edges(ctx context.Context, filter model.GraphFilter) ([]model.GraphEdge, bool, error)
+	Export(ctx context.Context, filter model.GraphFilter,
+		node func(model.GraphNode) error, edge func(model.GraphEdge) error) error
+	Users(ctx context.Context, userIds []int) (map[int]model.GraphUser, error)
+}
+
+type UserRepo interface {
+	GetRole(ctx context.Context, userId int) (string, error)
+}
+
+// AnalyticsService builds interaction graph of users. Graph is updated incrementally on every new reply,
+// mention and vote, full rebuild recalculates it from stored content (e.g. after moderation).
+type AnalyticsService struct {
+	analyticsRepo AnalyticsRepo
+	userRepo      UserRepo
+}
+
+func NewAnalyticsService(analyticsRepo AnalyticsRepo, userRepo UserRepo) *AnalyticsService {
+	return &AnalyticsService{analyticsRepo: analyticsRepo, userRepo: userRepo}
+}
+
+// Record adds new interactions to graph
+func (s *AnalyticsService) Record(ctx context.Context, interactions []model.Interaction) error {
+	return s.analyticsRepo.Record(ctx, interactions)
+}
+
+// Remove takes back withdrawn interactions, e.g. removed vote
+func (s *AnalyticsService) Remove(ctx context.Context, interactions []model.Interaction) error {
+	return s.analyticsRepo.Remove(ctx, interactions)
+}
+
+// Graph returns interaction graph by filter, only for admins
+func (s *AnalyticsService) Graph(
+	ctx context.Context, userId int, filter model.GraphFilter) (model.InteractionGraph, error) {
+
+	if err := checkAdmin(ctx, s.userRepo, userId); err != nil {
+		return model.InteractionGraph{}, err
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultEdgesLimit
+	}
+	filter.Limit = min(filter.Limit, MaxEdgesLimit)
+	return s.LoadGraph(ctx, filter)
+}
+
+// CheckExport checks that user can export graph (only admins can) and that filter is valid,
+// so errors are reported before export is started
+func (s *AnalyticsService) CheckExport(ctx context.Context, userId int, filter model.GraphFilter, format string) error {
+	if err := checkAdmin(ctx, s.userRepo, userId); err != nil {
+		return err
+	}
+	if !slices.Contains(GraphFormats, format) {
+		return ErrUnknownFormat
+	}
+	_, err := checkFilter(filter)
+	return err
+}
+
+// WriteGraph writes whole graph by filter to w in format. Nodes and edges are written as they
+// are read from database, so graph of any size is exported. It does not check access,
+// command-line tools use it directly.
+func (s *AnalyticsService) WriteGraph(
+	ctx context.Context, w io.Writer, filter model.GraphFilter, format string) (model.GraphExport, error) {
+
+	filter, err := checkFilter(filter)
+	if err != nil {
+		return model.GraphExport{}, err
+	}
+	graph, err := newGraphWriter(w, format)
+	if err != nil {
+		return model.GraphExport{}, err
+	}
+	var res model.GraphExport
+	err = s.analyticsRepo.Export(ctx, filter,
+		func(node model.GraphNode) error {
+			res.Nodes++
+			return graph.Node(node)
+		},
+		func(edge model.GraphEdge) error {
+			res.Edges++
+			return graph.Edge(edge)
+		})
+	if err != nil {
+		return res, err
+	}
+	return res, graph.Close()
+}
+
+// LoadWholeGraph returns all edges of graph by filter, ErrGraphTooLarge if there are too many of them.
+// It does not check access.
+func (s *AnalyticsService) LoadWholeGraph(
+	ctx context.Context, filter model.GraphFilter) (model.InteractionGraph, error) {
+
+	filter.Limit = MaxWholeGraphEdges
+	graph, err := s.LoadGraph(ctx, filter)
+	if err != nil {
+		return model.InteractionGraph{}, err
+	}
+	if graph.Truncated {
+		return model.InteractionGraph{}, ErrGraphTooLarge
+	}
+	return graph, nil
+}
+
+// LoadGraph returns the heaviest edges of graph by filter with user attributes of nodes,
+// it does not check access
+func (s *AnalyticsService) LoadGraph(ctx context.Context, filter model.GraphFilter) (model.InteractionGraph, error) {
+	filter, err := checkFilter(filter)
+	if err != nil {
+		return model.InteractionGraph{}, err
+	}
+	edges, truncated, err := s.analyticsRepo.Edges(ctx, filter)
+	if err != nil {
+		return model.InteractionGraph{}, err
+	}
+	graph := NewGraph(edges)
+	graph.Truncated = truncated
+
+	ids := make([]int, len(graph.Nodes))
+	for i, node := range graph.Nodes {
+		ids[i] = node.UserID
+	}
+	users, err := s.analyticsRepo.Users(ctx, ids)
+	if err != nil {
+		return model.InteractionGraph{}, err
+	}
+	for i := range graph.Nodes {
+		graph.Nodes[i].GraphUser = users[graph.Nodes[i].UserID]
+	}
+	return graph, nil
+}
+
+// Rebuild recalculates whole graph from posts, mentions and votes, only for admins
+func (s *AnalyticsService) Rebuild(ctx context.Context, userId int) (model.GraphRebuild, error) {
+	if err := checkAdmin(ctx, s.userRepo, userId); err != nil {
+		return model.GraphRebuild{}, err
+	}
+	start := time.Now()
+	edges, err := s.analyticsRepo.Rebuild(ctx)
+	if err != nil {
+		return model.GraphRebuild{}, err
+	}
+	return model.GraphRebuild{Edges: edges, Duration: time.Since(start)}, nil
+}
+
+func checkAdmin(ctx context.Context, userRepo UserRepo, userId int) error {
+	role, err := userRepo.GetRole(ctx, userId)
+	if errors.Is(err, model.ErrNotFound) {
+		return ErrNotAdmin
+	}
+	if err != nil {
+		return err
+	}
+	if role != model.RoleAdmin {
+		return ErrNotAdmin
+	}
+	return nil
+}
+
+func checkFilter(filter model.GraphFilter) (model.GraphFilter, error) {
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return filter, ErrInvalidWindow
+	}
+	if len(filter.Kinds) == 0 {
+		filter.Kinds = interactionKinds
+	}
+	for _, kind := range filter.Kinds {
+		if !slices.Contains(interactionKinds, kind) {
+			return filter, ErrUnknownKind
+		}
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultEdgesLimit
+	}
+	return filter, nil
+}
@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package analytics
+
+import (
+	"cmp"
+	"math"
+	"math/rand/v2"
+	"runtime"
+	"slices"
+	"sync"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const (
+	pageRankDamping = 0.85
+	// iterations stop when sum of rank changes is below tolerance
+	pageRankTolerance     = 1e-9
+	pageRankMaxIterations = 100
+)
+
+// adjacency is graph in compressed sparse row form: out edges of node i are
+// targets[offsets[i]:offsets[i+1]] with the same weights
+type adjacency struct {
+	offsets []int
+	targets []int
+	weights []float64
+}
+
+func newAdjacency(graph model.InteractionGraph, index map[int]int) adjacency {
+	n := len(graph.Nodes)
+	adj := adjacency{
+		offsets: make([]int, n+1),
+		targets: make([]int, len(graph.Edges)),
+		weights: make([]float64, len(graph.Edges)),
+	}
+	for _, edge := range graph.Edges {
+		adj.offsets[index[edge.SourceID]+1]++
+	}
+	for i := range n {
+		adj.offsets[i+1] += adj.offsets[i]
+	}
+	next := slices.Clone(adj.offsets[:n])
+	for _, edge := range graph.Edges {
+		source := index[edge.SourceID]
+		adj.targets[next[source]] = index[edge.TargetID]
+		adj.weights[next[source]] = float64(edge.Weight)
+		next[source]++
+	}
+	return adj
+}
+
+// Centrality computes influence scores of all nodes of graph: weighted PageRank, degrees and
+// betweenness. Betweenness is exact for graphs up to samples nodes, for bigger graphs it is estimated
+// from shortest paths of samples random sources. Scores are ordered by PageRank, rank starts from 1.
+func Centrality(graph model.InteractionGraph, samples int) []model.InfluenceScore {
+	n := len(graph.Nodes)
+	if n == 0 {
+		return nil
+	}
+	index := make(map[int]int, n)
+	for i, node := range graph.Nodes {
+		index[node.UserID] = i
+	}
+	adj := newAdjacency(graph, index)
+	pageRank := pageRank(adj, n)
+	betweenness := betweenness(adj, n, samples)
+
+	scores := make([]model.InfluenceScore, n)
+	for i, node := range graph.Nodes {
+		scores[i] = model.InfluenceScore{
+			UserID:      node.UserID,
+			PageRank:    pageRank[i],
+			Betweenness: betweenness[i],
+			InDegree:    node.InDegree,
+			OutDegree:   node.OutDegree,
+			InWeight:    node.InWeight,
+			OutWeight:   node.OutWeight,
+		}
+	}
+	slices.SortFunc(scores, func(a, b model.InfluenceScore) int {
+		if a.PageRank != b.PageRank {
+			return cmp.Compare(b.PageRank, a.PageRank)
+		}
+		return a.UserID - b.UserID
+	})
+	for i := range scores {
+		scores[i].Rank = i + 1
+	}
+	return scores
+}
+
+// pageRank is weighted PageRank, rank of node without out edges is spread over all nodes. Sum of ranks is 1.
+func pageRank(adj adjacency, n int) []float64 {
+	outWeight := make([]float64, n)
+	for i := range n {
+		for e := adj.offsets[i]; e < adj.offsets[i+1]; e++ {
+			outWeight[i] += adj.weights[e]
+		}
+	}
+	rank := make([]float64, n)
+	for i := range rank {
+		rank[i] = 1 / float64(n)
+	}
+	next := make([]float64, n)
+	for range pageRankMaxIterations {
+		dangling := 0.0
+		for i := range n {
+			if outWeight[i] == 0 {
+				dangling += rank[i]
+			}
+		}
+		base := (1-pageRankDamping)/float64(n) + pageRankDamping*dangling/float64(n)
+		for i := range next {
+			next[i] = base
+		}
+		for i := range n {
+			if outWeight[i] == 0 {
+				continue
+			}
+			share := pageRankDamping * rank[i] / outWeight[i]
+			for e := adj.offsets[i]; e < adj.offsets[i+1]; e++ {
+				next[adj.targets[e]] += share * adj.weights[e]
+			}
+		}
+		diff := 0.0
+		for i := range n {
+			diff += math.Abs(next[i] - rank[i])
+		}
+		rank, next = next, rank
+		if diff < pageRankTolerance {
+			break
+		}
+	}
+	return rank
+}
+
+// betweenness is Brandes algorithm on unweighted directed graph, normalized to [0, 1].
+// Shortest paths from sources are counted in parallel.
+func betweenness(adj adjacency, n, samples int) []float64 {
+	sources := make([]int, n)
+	for i := range sources {
+		sources[i] = i
+	}
+	if samples > 0 && samples < n {
+		// fixed seed gives the same estimate for the same graph
+		random := rand.New(rand.NewPCG(uint64(n), uint64(len(adj.targets))))
+		random.Shuffle(n, func(i, j int) { sources[i], sources[j] = sources[j], sources[i] })
+		sources = sources[:samples]
+	}
+
+	workers := min(runtime.GOMAXPROCS(0), len(sources))
+	partial := make([][]float64, workers)
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			partial[w] = brandes(adj, n, sources[w*len(sources)/workers:(w+1)*len(sources)/workers])
+		}()
+	}
+	wg.Wait()
+
+	res := make([]float64, n)
+	for _, part := range partial {
+		for i, value := range part {
+			res[i] += value
+		}
+	}
+	if n <= 2 {
+		return res
+	}
+	// estimate from sampled sources is scaled to all sources
+	scale := float64(n) / float64(len(sources)) / float64((n-1)*(n-2))
+	for i := range res {
+		res[i] *= scale
+	}
+	return res
+}
+
+// brandes returns dependencies of nodes accumulated over shortest paths from sources
+func brandes(adj adjacency, n int, sources []int) []float64 {
+	res := make([]float64, n)
+	dist := make([]int, n)
+	sigma := make([]float64, n)
+	delta := make([]float64, n)
+	// nodes in order of visit, breadth-first queue and then stack for dependencies
+	order := make([]int, 0, n)
+	for i := range dist {
+		dist[i] = -1
+	}
+	for _, source := range sources {
+		order = order[:0]
+		dist[source] = 0
+		sigma[source] = 1
+		order = append(order, source)
+		for head := 0; head < len(order); head++ {
+			v := order[head]
+			for e := adj.offsets[v]; e < adj.offsets[v+1]; e++ {
+				w := adj.targets[e]
+				if dist[w] < 0 {
+					dist[w] = dist[v] + 1
+					order = append(order, w)
+				}
+				if dist[w] == dist[v]+1 {
+					sigma[w] += sigma[v]
+				}
+			}
+		}
+		for i := len(order) - 1; i >= 0; i-- {
+			v := order[i]
+			for e := adj.offsets[v]; e < adj.offsets[v+1]; e++ {
+				w := adj.targets[e]
+				if dist[w] == dist[v]+1 {
+					delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+				}
+			}
+			if v != source {
+				res[v] += delta[v]
+			}
+		}
+		// only visited nodes are reset, sampled sources usually reach a part of graph
+		for _, v := range order {
+			dist[v] = -1
+			sigma[v] = 0
+			delta[v] = 0
+		}
+	}
+	return res
+}
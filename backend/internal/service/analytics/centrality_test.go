@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package analytics
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const scoreTolerance = 1e-6
+
+// testGraph builds graph from edges {source, target, weight}, nodes are users of edges
+func testGraph(edges [][3]int) model.InteractionGraph {
+	var graph model.InteractionGraph
+	nodes := map[int]*model.GraphNode{}
+	node := func(userId int) *model.GraphNode {
+		if nodes[userId] == nil {
+			nodes[userId] = &model.GraphNode{UserID: userId}
+		}
+		return nodes[userId]
+	}
+	for _, e := range edges {
+		graph.Edges = append(graph.Edges, model.GraphEdge{SourceID: e[0], TargetID: e[1], Weight: e[2]})
+		node(e[0]).OutDegree++
+		node(e[0]).OutWeight += e[2]
+		node(e[1]).InDegree++
+		node(e[1]).InWeight += e[2]
+	}
+	ids := make([]int, 0, len(nodes))
+	for id := range nodes {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		graph.Nodes = append(graph.Nodes, *nodes[id])
+	}
+	return graph
+}
+
+func scoresByUser(scores []model.InfluenceScore) map[int]model.InfluenceScore {
+	res := make(map[int]model.InfluenceScore, len(scores))
+	for _, score := range scores {
+		res[score.UserID] = score
+	}
+	return res
+}
+
+func TestPageRank(t *testing.T) {
+	tests := []struct {
+		name  string
+		edges [][3]int
+		want  map[int]float64
+	}{
+		{
+			name:  "cycle",
+			edges: [][3]int{{1, 2, 1}, {2, 3, 1}, {3, 1, 1}},
+			want:  map[int]float64{1: 1.0 / 3, 2: 1.0 / 3, 3: 1.0 / 3},
+		},
+		{
+			name:  "cycle weight does not matter",
+			edges: [][3]int{{1, 2, 5}, {2, 3, 1}, {3, 1, 2}},
+			want:  map[int]float64{1: 1.0 / 3, 2: 1.0 / 3, 3: 1.0 / 3},
+		},
+		{
+			// rank of node 2 without out edges is spread over all nodes
+			name:  "dangling node",
+			edges: [][3]int{{1, 2, 1}},
+			want:  map[int]float64{1: 0.5 / 1.425, 2: 1 - 0.5/1.425},
+		},
+		{
+			name:  "weighted",
+			edges: [][3]int{{1, 2, 3}, {1, 3, 1}, {2, 1, 1}, {3, 1, 1}},
+			want: map[int]float64{
+				1: 0.135 / 0.2775,
+				2: 0.05 + 0.6375*0.135/0.2775,
+				3: 0.05 + 0.2125*0.135/0.2775,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scores := scoresByUser(Centrality(testGraph(tt.edges), 0))
+			sum := 0.0
+			for userId, want := range tt.want {
+				got := scores[userId].PageRank
+				if math.Abs(got-want) > scoreTolerance {
+					t.Errorf("PageRank of %d = %v, want %v", userId, got, want)
+				}
+				sum += got
+			}
+			if math.Abs(sum-1) > scoreTolerance {
+				t.Errorf("sum of PageRank = %v, want 1", sum)
+			}
+		})
+	}
+}
+
+func TestBetweenness(t *testing.T) {
+	tests := []struct {
+		name  string
+		edges [][3]int
+		want  map[int]float64
+	}{
+		{
+			name:  "path",
+			edges: [][3]int{{1, 2, 1}, {2, 3, 1}},
+			want:  map[int]float64{1: 0, 2: 0.5, 3: 0},
+		},
+		{
+			name:  "cycle",
+			edges: [][3]int{{1, 2, 1}, {2, 3, 1}, {3, 1, 1}},
+			want:  map[int]float64{1: 0.5, 2: 0.5, 3: 0.5},
+		},
+		{
+			// path from 1 to 4 is split between two shortest paths
+			name:  "diamond",
+			edges: [][3]int{{1, 2, 1}, {1, 3, 1}, {2, 4, 1}, {3, 4, 1}},
+			want:  map[int]float64{1: 0, 2: 1.0 / 12, 3: 1.0 / 12, 4: 0},
+		},
+		{
+			name:  "weight is ignored",
+			edges: [][3]int{{1, 2, 10}, {2, 3, 1}, {1, 3, 1}},
+			want:  map[int]float64{1: 0, 2: 0, 3: 0},
+		},
+		{
+			name:  "star",
+			edges: [][3]int{{2, 1, 1}, {3, 1, 1}, {4, 1, 1}},
+			want:  map[int]float64{1: 0, 2: 0, 3: 0, 4: 0},
+		},
+		{
+			name:  "two nodes",
+			edges: [][3]int{{1, 2, 1}, {2, 1, 1}},
+			want:  map[int]float64{1: 0, 2: 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := testGraph(tt.edges)
+			// samples not less than nodes count give exact betweenness
+			for _, samples := range []int{0, len(graph.Nodes)} {
+				scores := scoresByUser(Centrality(graph, samples))
+				for userId, want := range tt.want {
+					if got := scores[userId].Betweenness; math.Abs(got-want) > scoreTolerance {
+						t.Errorf("samples %d: betweenness of %d = %v, want %v", samples, userId, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCentrality(t *testing.T) {
+	if scores := Centrality(model.InteractionGraph{}, 0); scores != nil {
+		t.Errorf("Centrality of empty graph = %v, want nil", scores)
+	}
+
+	graph := testGraph([][3]int{{1, 3, 1}, {2, 3, 1}, {3, 4, 2}, {4, 3, 1}, {5, 1, 1}})
+	scores := Centrality(graph, 0)
+	if len(scores) != len(graph.Nodes) {
+		t.Fatalf("got %d scores, want %d", len(scores), len(graph.Nodes))
+	}
+	for i, score := range scores {
+		if score.Rank != i+1 {
+			t.Errorf("rank of score %d = %d, want %d", i, score.Rank, i+1)
+		}
+		if i > 0 && score.PageRank > scores[i-1].PageRank {
+			t.Errorf("score %d is ordered after lower PageRank", score.UserID)
+		}
+	}
+	if scores[0].UserID != 3 {
+		t.Errorf("top user = %d, want 3", scores[0].UserID)
+	}
+	top := scores[0]
+	if top.InDegree != 3 || top.OutDegree != 1 || top.InWeight != 3 || top.OutWeight != 2 {
+		t.Errorf("degrees of user 3 = %+v, want copied from node", top)
+	}
+}
+
+func TestCentralitySampledIsStable(t *testing.T) {
+	graph := syntheticGraph(500, 5)
+	first := Centrality(graph, 50)
+	second := Centrality(graph, 50)
+	if !slices.Equal(first, second) {
+		t.Error("sampled betweenness differs between runs on the same graph")
+	}
+}
+
+// syntheticGraph returns graph of users with outEdges random interactions each,
+// popular users (low ids) get more interactions like on real forum
+func syntheticGraph(users, outEdges int) model.InteractionGraph {
+	random := rand.New(rand.NewPCG(1, 2))
+	graph := model.InteractionGraph{Nodes: make([]model.GraphNode, users)}
+	for i := range graph.Nodes {
+		graph.Nodes[i].UserID = i + 1
+	}
+	for source := range users {
+		seen := map[int]bool{source: true}
+		for range outEdges {
+			target := int(float64(users) * math.Pow(random.Float64(), 3))
+			if seen[target] {
+				continue
+			}
+			seen[target] = true
+			weight := 1 + random.IntN(10)
+			graph.Edges = append(graph.Edges,
+				model.GraphEdge{SourceID: source + 1, TargetID: target + 1, Weight: weight})
+			graph.Nodes[source].OutDegree++
+			graph.Nodes[source].OutWeight += weight
+			graph.Nodes[target].InDegree++
+			graph.Nodes[target].InWeight += weight
+		}
+	}
+	return graph
+}
+
+// BenchmarkCentrality computes influence of forum with tens of thousands of users,
+// betweenness is sampled like with default analytics config
+func BenchmarkCentrality(b *testing.B) {
+	for _, users := range []int{10000, 50000} {
+		graph := syntheticGraph(users, 10)
+		b.Run(strconv.Itoa(users), func(b *testing.B) {
+			for b.Loop() {
+				Centrality(graph, 256)
+			}
+		})
+	}
+}
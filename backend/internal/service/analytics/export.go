@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package analytics
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+// GraphFormats are supported export formats
+var GraphFormats = []string{model.GraphFormatGraphML, model.GraphFormatGEXF, model.GraphFormatCSV}
+
+// attributes of exported nodes and edges, in order of columns and keys
+var (
+	nodeAttributes = []string{"karma", "posts_count", "in_degree", "out_degree", "in_weight", "out_weight"}
+	edgeAttributes = []string{"replies", "mentions", "votes"}
+)
+
+func nodeValues(node model.GraphNode) []int {
+	return []int{node.Karma, node.PostsCount, node.InDegree, node.OutDegree, node.InWeight, node.OutWeight}
+}
+
+func edgeValues(edge model.GraphEdge) []int {
+	return []int{edge.Replies, edge.Mentions, edge.Votes}
+}
+
+// graphWriter writes graph file in export format: all nodes, then all edges
+type graphWriter interface {
+	Node(node model.GraphNode) error
+	Edge(edge model.GraphEdge) error
+	// Close finishes file after the last edge
+	Close() error
+}
+
+// newGraphWriter starts graph file in format: GraphML, GEXF or zip archive with CSV node and edge lists
+func newGraphWriter(w io.Writer, format string) (graphWriter, error) {
+	switch format {
+	case model.GraphFormatGraphML:
+		return newGraphMLWriter(w), nil
+	case model.GraphFormatGEXF:
+		return newGEXFWriter(w), nil
+	case model.GraphFormatCSV:
+		return newCSVWriter(w)
+	}
+	return nil, ErrUnknownFormat
+}
+
+// graphMLWriter writes graph in GraphML, it is read by Gephi, NetworkX (read_graphml) and others.
+// bufio writer keeps the first write error, it is returned by all next writes.
+type graphMLWriter struct {
+	bw *bufio.Writer
+}
+
+func newGraphMLWriter(w io.Writer) *graphMLWriter {
+	bw := bufio.NewWriter(w)
+	fmt.Fprint(bw, xml.Header)
+	fmt.Fprintln(bw, `<graphml xmlns="http://graphml.graphdrawing.org/xmlns">`)
+	fmt.Fprintln(bw, `  <key id="name" for="node" attr.name="name" attr.type="string"/>`)
+	for _, attr := range nodeAttributes {
+		fmt.Fprintf(bw, "  <key id=\"%s\" for=\"node\" attr.name=\"%s\" attr.type=\"int\"/>\n", attr, attr)
+	}
+	fmt.Fprintln(bw, `  <key id="weight" for="edge" attr.name="weight" attr.type="int"/>`)
+	for _, attr := range edgeAttributes {
+		fmt.Fprintf(bw, "  <key id=\"%s\" for=\"edge\" attr.name=\"%s\" attr.type=\"int\"/>\n", attr, attr)
+	}
+	fmt.Fprintln(bw, `  <graph id="interactions" edgedefault="directed">`)
+	return &graphMLWriter{bw: bw}
+}
+
+func (g *graphMLWriter) Node(node model.GraphNode) error {
+	fmt.Fprintf(g.bw, "    <node id=\"%d\">\n", node.UserID)
+	fmt.Fprintf(g.bw, "      <data key=\"name\">%s</data>\n", escapeXML(node.Name))
+	for i, value := range nodeValues(node) {
+		fmt.Fprintf(g.bw, "      <data key=\"%s\">%d</data>\n", nodeAttributes[i], value)
+	}
+	_, err := fmt.Fprintln(g.bw, "    </node>")
+	return err
+}
+
+func (g *graphMLWriter) Edge(edge model.GraphEdge) error {
+	fmt.Fprintf(g.bw, "    <edge source=\"%d\" target=\"%d\">\n", edge.SourceID, edge.TargetID)
+	fmt.Fprintf(g.bw, "      <data key=\"weight\">%d</data>\n", edge.Weight)
+	for i, value := range edgeValues(edge) {
+		fmt.Fprintf(g.bw, "      <data key=\"%s\">%d</data>\n", edgeAttributes[i], value)
+	}
+	_, err := fmt.Fprintln(g.bw, "    </edge>")
+	return err
+}
+
+func (g *graphMLWriter) Close() error {
+	fmt.Fprintln(g.bw, "  </graph>")
+	fmt.Fprintln(g.bw, "</graphml>")
+	return g.bw.Flush()
+}
+
+// gexfWriter writes graph in GEXF 1.3, native format of Gephi
+type gexfWriter struct {
+	bw *bufio.Writer
+	// number of written edges, it is id of the next edge
+	edges int
+}
+
+func newGEXFWriter(w io.Writer) *gexfWriter {
+	bw := bufio.NewWriter(w)
+	fmt.Fprint(bw, xml.Header)
+	fmt.Fprintln(bw, `<gexf xmlns="http://gexf.net/1.3" version="1.3">`)
+	fmt.Fprintln(bw, `  <graph mode="static" defaultedgetype="directed">`)
+	fmt.Fprintln(bw, `    <attributes class="node">`)
+	for i, attr := range nodeAttributes {
+		fmt.Fprintf(bw, "      <attribute id=\"%d\" title=\"%s\" type=\"integer\"/>\n", i, attr)
+	}
+	fmt.Fprintln(bw, `    </attributes>`)
+	fmt.Fprintln(bw, `    <attributes class="edge">`)
+	for i, attr := range edgeAttributes {
+		fmt.Fprintf(bw, "      <attribute id=\"%d\" title=\"%s\" type=\"integer\"/>\n", i, attr)
+	}
+	fmt.Fprintln(bw, `    </attributes>`)
+	fmt.Fprintln(bw, "    <nodes>")
+	return &gexfWriter{bw: bw}
+}
+
+func (g *gexfWriter) Node(node model.GraphNode) error {
+	fmt.Fprintf(g.bw, "      <node id=\"%d\" label=\"%s\">\n", node.UserID, escapeXML(node.Name))
+	fmt.Fprintln(g.bw, "        <attvalues>")
+	for i, value := range nodeValues(node) {
+		fmt.Fprintf(g.bw, "          <attvalue for=\"%d\" value=\"%d\"/>\n", i, value)
+	}
+	fmt.Fprintln(g.bw, "        </attvalues>")
+	_, err := fmt.Fprintln(g.bw, "      </node>")
+	return err
+}
+
+func (g *gexfWriter) Edge(edge model.GraphEdge) error {
+	if g.edges == 0 {
+		g.startEdges()
+	}
+	fmt.Fprintf(g.bw, "      <edge id=\"%d\" source=\"%d\" target=\"%d\" weight=\"%d\">\n",
+		g.edges, edge.SourceID, edge.TargetID, edge.Weight)
+	g.edges++
+	fmt.Fprintln(g.bw, "        <attvalues>")
+	for i, value := range edgeValues(edge) {
+		fmt.Fprintf(g.bw, "          <attvalue for=\"%d\" value=\"%d\"/>\n", i, value)
+	}
+	fmt.Fprintln(g.bw, "        </attvalues>")
+	_, err := fmt.Fprintln(g.bw, "      </edge>")
+	return err
+}
+
+func (g *gexfWriter) startEdges() {
+	fmt.Fprintln(g.bw, "    </nodes>")
+	fmt.Fprintln(g.bw, "    <edges>")
+}
+
+func (g *gexfWriter) Close() error {
+	if g.edges == 0 {
+		g.startEdges()
+	}
+	fmt.Fprintln(g.bw, "    </edges>")
+	fmt.Fprintln(g.bw, "  </graph>")
+	fmt.Fprintln(g.bw, "</gexf>")
+	return g.bw.Flush()
+}
+
+// csvWriter writes zip archive with nodes.csv and edges.csv, column names are recognized by Gephi
+// spreadsheet import and are easy to load with pandas for NetworkX
+type csvWriter struct {
+	zw    *zip.Writer
+	nodes *csv.Writer
+	// nil until the first edge
+	edges *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	zw := zip.NewWriter(w)
+	nodesFile, err := zw.Create("nodes.csv")
+	if err != nil {
+		return nil, err
+	}
+	nodes := csv.NewWriter(nodesFile)
+	nodes.Write(append([]string{"Id", "Label"}, nodeAttributes...))
+	return &csvWriter{zw: zw, nodes: nodes}, nil
+}
+
+func (c *csvWriter) Node(node model.GraphNode) error {
+	return c.nodes.Write(append([]string{strconv.Itoa(node.UserID), csvText(node.Name)}, itoa(nodeValues(node))...))
+}
+
+func (c *csvWriter) Edge(edge model.GraphEdge) error {
+	if c.edges == nil {
+		if err := c.startEdges(); err != nil {
+			return err
+		}
+	}
+	row := []string{strconv.Itoa(edge.SourceID), strconv.Itoa(edge.TargetID), "Directed", strconv.Itoa(edge.Weight)}
+	return c.edges.Write(append(row, itoa(edgeValues(edge))...))
+}
+
+// startEdges finishes nodes.csv, files of zip archive are written one after another
+func (c *csvWriter) startEdges() error {
+	c.nodes.Flush()
+	if err := c.nodes.Error(); err != nil {
+		return err
+	}
+	edgesFile, err := c.zw.Create("edges.csv")
+	if err != nil {
+		return err
+	}
+	c.edges = csv.NewWriter(edgesFile)
+	return c.edges.Write(append([]string{"Source", "Target", "Type", "Weight"}, edgeAttributes...))
+}
+
+func (c *csvWriter) Close() error {
+	if c.edges == nil {
+		if err := c.startEdges(); err != nil {
+			return err
+		}
+	}
+	c.edges.Flush()
+	if err := c.edges.Error(); err != nil {
+		return err
+	}
+	return c.zw.Close()
+}
+
+func escapeXML(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// csvText prefixes text starting with formula character by "'", so spreadsheet shows user name
+// as text instead of evaluating it (CSV injection)
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func itoa(values []int) []string {
+	res := make([]string, len(values))
+	for i, value := range values {
+		res[i] = strconv.Itoa(value)
+	}
+	return res
+}
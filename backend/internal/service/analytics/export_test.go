@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package analytics
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+func TestCSVWriterNodeNames(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"alice", "alice"},
+		{"=HYPERLINK(\"http://evil\")", "'=HYPERLINK(\"http://evil\")"},
+		{"+1", "'+1"},
+		{"-bob", "'-bob"},
+		{"@bob", "'@bob"},
+		{"bob=1", "bob=1"},
+		{"", ""},
+	}
+	var buf bytes.Buffer
+	w, err := newCSVWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, tt := range tests {
+		node := model.GraphNode{UserID: i + 1, GraphUser: model.GraphUser{Name: tt.name}}
+		if err := w.Node(node); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := archive.Open("nodes.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	rows, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != len(tests)+1 {
+		t.Fatalf("nodes.csv has %d rows, want %d", len(rows), len(tests)+1)
+	}
+	for i, tt := range tests {
+		if got := rows[i+1][1]; got != tt.want {
+			t.Errorf("label of %q = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package analytics
+
+import (
+	"slices"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+// NewGraph builds graph with nodes of all users of edges ordered by id
+func NewGraph(edges []model.GraphEdge) model.InteractionGraph {
+	nodes := make(map[int]*model.GraphNode)
+	node := func(userId int) *model.GraphNode {
+		n, ok := nodes[userId]
+		if !ok {
+			n = &model.GraphNode{UserID: userId}
+			nodes[userId] = n
+		}
+		return n
+	}
+	for _, edge := range edges {
+		source := node(edge.SourceID)
+		source.OutDegree++
+		source.OutWeight += edge.Weight
+		target := node(edge.TargetID)
+		target.InDegree++
+		target.InWeight += edge.Weight
+	}
+
+	graph := model.InteractionGraph{Nodes: make([]model.GraphNode, 0, len(nodes)), Edges: edges}
+	for _, n := range nodes {
+		graph.Nodes = append(graph.Nodes, *n)
+	}
+	slices.SortFunc(graph.Nodes, func(a, b model.GraphNode) int { return a.UserID - b.UserID })
+	return graph
+}
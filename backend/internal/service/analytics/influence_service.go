@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package analytics
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const (
+	DefaultLeaderboardLimit = 20
+	MaxLeaderboardLimit     = 100
+)
+
+var ErrUnknownMetric = errors.New("metric must be pagerank, betweenness, in_degree, out_degree or weighted_degree")
+
+var influenceMetrics = []string{model.MetricPageRank, model.MetricBetweenness, model.MetricInDegree,
+	model.MetricOutDegree, model.MetricWeightedDegree}
+
+type InfluenceRepo interface {
+	Communities(ctx context.Context, from *time.Time) ([]int, error)
+	SaveSnapshot(ctx context.Context,
+		snapshot model.InfluenceSnapshot, scores []model.InfluenceScore, keep int) (int, error)
+	// LatestSnapshot returns model.ErrNotFound if there is no snapshot
+	LatestSnapshot(ctx context.Context, communityId *int) (model.InfluenceSnapshot, error)
+	Leaderboard(ctx context.Context, snapshotId int, metric string, limit int) ([]model.InfluenceScore, error)
+	UserInfluence(ctx context.Context, userId int) ([]model.UserInfluence, error)
+}
+
+type GraphLoader interface {
+	LoadWholeGraph(ctx context.Context, filter model.GraphFilter) (model.InteractionGraph, error)
+}
+
+type InfluenceOptions struct {
+	// graph of interactions during this time before snapshot, 0 - all interactions
+	Window time.Duration
+	// betweenness of bigger graphs is estimated from shortest paths of this many users
+	BetweennessSamples int
+	// snapshots kept for forum and every community
+	KeepSnapshots int
+}
+
+// InfluenceService computes influence metrics of users (PageRank, degrees, betweenness) on interaction
+// graph of forum and of every community and stores them as snapshots
+type InfluenceService struct {
+	influenceRepo InfluenceRepo
+	graphs        GraphLoader
+	userRepo      UserRepo
+	options       InfluenceOptions
+
+	// one computation at a time, periodic and requested by admin
+	computeMu sync.Mutex
+}
+
+func NewInfluenceService(
+	influenceRepo InfluenceRepo, graphs GraphLoader, userRepo UserRepo, options InfluenceOptions) *InfluenceService {
+
+	return &InfluenceService{influenceRepo: influenceRepo, graphs: graphs, userRepo: userRepo, options: options}
+}
+
+// Run computes snapshots every interval until context is done. Snapshot is skipped if other
+// instance made it recently, so it is safe to run on every instance.
+func (s *InfluenceService) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if s.due(ctx, interval) {
+			if _, err := s.compute(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("failed to compute influence snapshots: %v", err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *InfluenceService) due(ctx context.Context, interval time.Duration) bool {
+	latest, err := s.influenceRepo.LatestSnapshot(ctx, nil)
+	if errors.Is(err, model.ErrNotFound) {
+		return true
+	}
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("failed to get the latest influence snapshot: %v", err)
+		}
+		return false
+	}
+	return time.Since(latest.CreatedAt) > interval/2
+}
+
+// Snapshot computes snapshots of forum and communities now, only for admins
+func (s *InfluenceService) Snapshot(ctx context.Context, userId int) ([]model.InfluenceSnapshot, error) {
+	if err := checkAdmin(ctx, s.userRepo, userId); err != nil {
+		return nil, err
+	}
+	return s.compute(ctx)
+}
+
+// compute makes snapshot of whole forum and of every community with interactions in time window
+func (s *InfluenceService) compute(ctx context.Context) ([]model.InfluenceSnapshot, error) {
+	s.computeMu.Lock()
+	defer s.computeMu.Unlock()
+
+	var from *time.Time
+	if s.options.Window > 0 {
+		start := time.Now().Add(-s.options.Window)
+		from = &start
+	}
+	communities, err := s.influenceRepo.Communities(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]model.InfluenceSnapshot, 0, len(communities)+1)
+	snapshot, err := s.snapshot(ctx, from, nil)
+	if err != nil {
+		return nil, err
+	}
+	snapshots = append(snapshots, snapshot)
+	for _, communityId := range communities {
+		snapshot, err := s.snapshot(ctx, from, &communityId)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+func (s *InfluenceService) snapshot(
+	ctx context.Context, from *time.Time, communityId *int) (model.InfluenceSnapshot, error) {
+
+	start := time.Now()
+	graph, err := s.graphs.LoadWholeGraph(ctx, model.GraphFilter{From: from, CommunityID: communityId, MinWeight: 1})
+	if err != nil {
+		return model.InfluenceSnapshot{}, err
+	}
+	scores := Centrality(graph, s.options.BetweennessSamples)
+	snapshot := model.InfluenceSnapshot{
+		CommunityID: communityId,
+		From:        from,
+		UsersCount:  len(graph.Nodes),
+		EdgesCount:  len(graph.Edges),
+		Duration:    time.Since(start),
+		CreatedAt:   time.Now(),
+	}
+	snapshot.ID, err = s.influenceRepo.SaveSnapshot(ctx, snapshot, scores, max(s.options.KeepSnapshots, 1))
+	if err != nil {
+		return model.InfluenceSnapshot{}, err
+	}
+	return snapshot, nil
+}
+
+// Leaderboard returns users of the latest snapshot of community (nil - whole forum) ordered by metric,
+// model.ErrNotFound if there is no snapshot yet. Only for admins.
+func (s *InfluenceService) Leaderboard(
+	ctx context.Context, userId int, communityId *int, metric string, limit int) (model.Leaderboard, error) {
+
+	if err := checkAdmin(ctx, s.userRepo, userId); err != nil {
+		return model.Leaderboard{}, err
+	}
+	if metric == "" {
+		metric = model.MetricPageRank
+	}
+	if !slices.Contains(influenceMetrics, metric) {
+		return model.Leaderboard{}, ErrUnknownMetric
+	}
+	if limit <= 0 {
+		limit = DefaultLeaderboardLimit
+	}
+	limit = min(limit, MaxLeaderboardLimit)
+
+	snapshot, err := s.influenceRepo.LatestSnapshot(ctx, communityId)
+	if err != nil {
+		return model.Leaderboard{}, err
+	}
+	scores, err := s.influenceRepo.Leaderboard(ctx, snapshot.ID, metric, limit)
+	if err != nil {
+		return model.Leaderboard{}, err
+	}
+	return model.Leaderboard{Snapshot: snapshot, Metric: metric, Scores: scores}, nil
+}
+
+// UserInfluence returns scores of user in the latest snapshots of forum and communities, only for admins
+func (s *InfluenceService) UserInfluence(
+	ctx context.Context, viewerId, userId int) ([]model.UserInfluence, error) {
+
+	if err := checkAdmin(ctx, s.userRepo, viewerId); err != nil {
+		return nil, err
+	}
+	return s.influenceRepo.UserInfluence(ctx, userId)
+}
@@ -0,0 +1,387 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package attachments
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/imageproc"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+var (
+	ErrEmptyFile          = errors.New("file is empty")
+	ErrTooLarge           = errors.New("file is too large")
+	ErrTypeNotAllowed     = errors.New("file type is not allowed")
+	ErrTooManyAttachments = errors.New("too many attachments")
+	ErrInvalidAttachments = errors.New("attachments are not found or already attached")
+	ErrInvalidSignature   = errors.New("download url is invalid or expired")
+	ErrNotReady           = errors.New("attachment is not processed yet or processing failed")
+)
+
+// unlinked attachments removed by garbage collector in one query
+const gcBatchSize = 100
+
+type AttachmentsRepo interface {
+	Create(ctx context.Context, attachment model.AttachmentCreate) (model.Attachment, error)
+	Get(ctx context.Context, attachmentId int) (model.Attachment, error)
+	CountUnlinked(ctx context.Context, userId int, attachmentIds []int) (int, error)
+	Link(ctx context.Context, userId int, attachmentIds []int, threadId int, postId *int) ([]model.Attachment, error)
+	ListByThread(ctx context.Context, threadId int) ([]model.Attachment, error)
+	DeleteUnlinked(ctx context.Context, before time.Time, limit int) ([]string, error)
+	Claim(ctx context.Context, attachmentId int, staleAfter time.Duration) (bool, error)
+	ListUnprocessed(ctx context.Context, before time.Time, staleAfter time.Duration, limit int) ([]int, error)
+	SaveProcessed(ctx context.Context, attachmentId int, processed model.AttachmentProcessed) error
+	SetFailed(ctx context.Context, attachmentId int) error
+}
+
+// BlobStore keeps file contents by key. Get returns model.ErrNotFound for missing file,
+// Delete of missing file is not an error.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type Limits struct {
+	MaxSize int64
+	// media types detected by content (not by name or client), e.g. "image/png"
+	AllowedTypes []string
+	// attachments of one thread or post
+	MaxPerTarget int
+}
+
+type AttachmentsService struct {
+	attachmentsRepo AttachmentsRepo
+	blobStore       BlobStore
+	limits          Limits
+	allowedTypes    map[string]bool
+	images          ImageOptions
+	// ids of uploaded images waiting for processing
+	queue  chan int
+	urlKey []byte
+	urlTTL time.Duration
+}
+
+// NewAttachmentsService creates service, urlSecret signs download urls valid for urlTTL
+func NewAttachmentsService(
+	attachmentsRepo AttachmentsRepo,
+	blobStore BlobStore,
+	limits Limits,
+	images ImageOptions,
+	urlSecret string,
+	urlTTL time.Duration) *AttachmentsService {
+
+	allowedTypes := make(map[string]bool, len(limits.AllowedTypes))
+	for _, t := range limits.AllowedTypes {
+		allowedTypes[strings.ToLower(t)] = true
+	}
+	// separate key, so download signature is never valid as anything else signed with the secret
+	urlKey := hmacSHA256([]byte(urlSecret), "attachment download url")
+	return &AttachmentsService{
+		attachmentsRepo: attachmentsRepo,
+		blobStore:       blobStore,
+		limits:          limits,
+		allowedTypes:    allowedTypes,
+		images:          images,
+		queue:           make(chan int, images.QueueSize),
+		urlKey:          urlKey,
+		urlTTL:          urlTTL,
+	}
+}
+
+// MaxSize is the largest allowed file size
+func (s *AttachmentsService) MaxSize() int64 {
+	return s.limits.MaxSize
+}
+
+// Upload stores file of user. File is buffered in temporary file to check size and type,
+// type is detected by content. Attachment stays unlinked until thread or post is created with it.
+// Images are processed in background, they can be linked but not downloaded before processing.
+func (s *AttachmentsService) Upload(ctx context.Context, userId int, fileName string, file io.Reader) (model.Attachment, error) {
+	tmp, err := os.CreateTemp("", "forum-upload-*")
+	if err != nil {
+		return model.Attachment{}, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	size, err := io.Copy(tmp, io.LimitReader(file, s.limits.MaxSize+1))
+	if err != nil {
+		return model.Attachment{}, err
+	}
+	if size == 0 {
+		return model.Attachment{}, ErrEmptyFile
+	}
+	if size > s.limits.MaxSize {
+		return model.Attachment{}, ErrTooLarge
+	}
+
+	head := make([]byte, 512)
+	n, err := tmp.ReadAt(head, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return model.Attachment{}, err
+	}
+	contentType := http.DetectContentType(head[:n])
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || !s.allowedTypes[mediaType] {
+		return model.Attachment{}, ErrTypeNotAllowed
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return model.Attachment{}, err
+	}
+	status := model.AttachmentReady
+	if imageproc.Supported(mediaType) {
+		status = model.AttachmentPending
+	}
+	key := uuid.NewString()
+	if err := s.blobStore.Put(ctx, key, tmp, size, contentType); err != nil {
+		return model.Attachment{}, fmt.Errorf("store attachment file: %w", err)
+	}
+	attachment, err := s.attachmentsRepo.Create(ctx, model.AttachmentCreate{
+		UserID:      userId,
+		StorageKey:  key,
+		FileName:    cleanFileName(fileName),
+		ContentType: contentType,
+		Size:        size,
+		Status:      status,
+	})
+	if err != nil {
+		if delErr := s.blobStore.Delete(ctx, key); delErr != nil {
+			log.Printf("failed to delete file %s of not created attachment: %v", key, delErr)
+		}
+		return model.Attachment{}, err
+	}
+	if status == model.AttachmentPending {
+		s.enqueue(attachment.ID)
+	}
+	s.signURL(&attachment)
+	return attachment, nil
+}
+
+// Get returns attachment with download url. Unlinked attachment is visible only to uploader.
+func (s *AttachmentsService) Get(ctx context.Context, viewerId, attachmentId int) (model.Attachment, error) {
+	attachment, err := s.attachmentsRepo.Get(ctx, attachmentId)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+	if attachment.ThreadID == nil && attachment.UserID != viewerId {
+		return model.Attachment{}, model.ErrNotFound
+	}
+	s.signURL(&attachment)
+	return attachment, nil
+}
+
+// Open checks download url signature and returns attachment with content of its variant
+// (original file if variant is empty), caller closes content. Content type and size of returned
+// attachment are of the variant.
+func (s *AttachmentsService) Open(ctx context.Context, attachmentId int, variant string,
+	expires int64, signature string) (model.Attachment, io.ReadCloser, error) {
+
+	if time.Now().Unix() > expires {
+		return model.Attachment{}, nil, ErrInvalidSignature
+	}
+	expected := s.signature(attachmentId, variant, expires)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return model.Attachment{}, nil, ErrInvalidSignature
+	}
+	attachment, err := s.attachmentsRepo.Get(ctx, attachmentId)
+	if err != nil {
+		return model.Attachment{}, nil, err
+	}
+	// not processed image may still have metadata
+	if attachment.Status != model.AttachmentReady {
+		return model.Attachment{}, nil, ErrNotReady
+	}
+	key := attachment.StorageKey
+	if variant != "" {
+		i := slices.IndexFunc(attachment.Variants, func(v model.AttachmentVariant) bool { return v.Name == variant })
+		if i < 0 {
+			return model.Attachment{}, nil, model.ErrNotFound
+		}
+		key = attachment.Variants[i].StorageKey
+		attachment.ContentType = attachment.Variants[i].ContentType
+		attachment.Size = attachment.Variants[i].Size
+	}
+	content, err := s.blobStore.Get(ctx, key)
+	if err != nil {
+		return model.Attachment{}, nil, err
+	}
+	return attachment, content, nil
+}
+
+// Validate checks that attachments can be linked to new thread or post of user
+func (s *AttachmentsService) Validate(ctx context.Context, userId int, attachmentIds []int) error {
+	if len(attachmentIds) == 0 {
+		return nil
+	}
+	if len(attachmentIds) > s.limits.MaxPerTarget {
+		return ErrTooManyAttachments
+	}
+	unique := make(map[int]bool, len(attachmentIds))
+	for _, id := range attachmentIds {
+		if unique[id] {
+			return ErrInvalidAttachments
+		}
+		unique[id] = true
+	}
+	count, err := s.attachmentsRepo.CountUnlinked(ctx, userId, attachmentIds)
+	if err != nil {
+		return err
+	}
+	if count != len(attachmentIds) {
+		return ErrInvalidAttachments
+	}
+	return nil
+}
+
+// Link attaches validated attachments to thread (postId is nil) or post of thread
+func (s *AttachmentsService) Link(
+	ctx context.Context, userId int, attachmentIds []int, threadId int, postId *int) ([]model.Attachment, error) {
+
+	if len(attachmentIds) == 0 {
+		return nil, nil
+	}
+	linked, err := s.attachmentsRepo.Link(ctx, userId, attachmentIds, threadId, postId)
+	if err != nil {
+		return nil, err
+	}
+	if len(linked) != len(attachmentIds) {
+		// attachment was linked concurrently to another post
+		return nil, ErrInvalidAttachments
+	}
+	for i := range linked {
+		s.signURL(&linked[i])
+	}
+	return linked, nil
+}
+
+// ThreadAttachments returns attachments of thread itself and attachments of its posts by post id
+func (s *AttachmentsService) ThreadAttachments(
+	ctx context.Context, threadId int) ([]model.Attachment, map[int][]model.Attachment, error) {
+
+	attachments, err := s.attachmentsRepo.ListByThread(ctx, threadId)
+	if err != nil {
+		return nil, nil, err
+	}
+	var threadAttachments []model.Attachment
+	postAttachments := make(map[int][]model.Attachment)
+	for _, attachment := range attachments {
+		s.signURL(&attachment)
+		if attachment.PostID == nil {
+			threadAttachments = append(threadAttachments, attachment)
+		} else {
+			postAttachments[*attachment.PostID] = append(postAttachments[*attachment.PostID], attachment)
+		}
+	}
+	return threadAttachments, postAttachments, nil
+}
+
+// RunGC removes attachments not linked to thread or post during maxAge after upload,
+// every interval until context is done
+func (s *AttachmentsService) RunGC(ctx context.Context, interval, maxAge time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.collectGarbage(ctx, maxAge)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *AttachmentsService) collectGarbage(ctx context.Context, maxAge time.Duration) {
+	before := time.Now().Add(-maxAge)
+	removed := 0
+	for {
+		// rows are removed first, file without row is never visible, so it is only logged on failure
+		keys, err := s.attachmentsRepo.DeleteUnlinked(ctx, before, gcBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("failed to remove unlinked attachments: %v", err)
+			}
+			break
+		}
+		for _, key := range keys {
+			if err := s.blobStore.Delete(ctx, key); err != nil {
+				log.Printf("failed to delete file %s of unlinked attachment: %v", key, err)
+			}
+		}
+		removed += len(keys)
+		if len(keys) < gcBatchSize {
+			break
+		}
+	}
+	if removed > 0 {
+		log.Printf("removed %d unlinked attachments", removed)
+	}
+}
+
+func (s *AttachmentsService) signURL(attachment *model.Attachment) {
+	expiresAt := time.Now().Add(s.urlTTL).Truncate(time.Second)
+	expires := expiresAt.Unix()
+	attachment.URL = fmt.Sprintf("/api/attachments/%d/download?expires=%d&signature=%s",
+		attachment.ID, expires, s.signature(attachment.ID, "", expires))
+	attachment.URLExpiresAt = expiresAt
+	for i := range attachment.Variants {
+		variant := &attachment.Variants[i]
+		variant.URL = fmt.Sprintf("/api/attachments/%d/download?variant=%s&expires=%d&signature=%s",
+			attachment.ID, url.QueryEscape(variant.Name), expires, s.signature(attachment.ID, variant.Name, expires))
+	}
+}
+
+func (s *AttachmentsService) signature(attachmentId int, variant string, expires int64) string {
+	mac := hmacSHA256(s.urlKey, fmt.Sprintf("%d:%s:%d", attachmentId, variant, expires))
+	return base64.RawURLEncoding.EncodeToString(mac)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// max file name length in bytes
+const maxFileNameLen = 255
+
+// cleanFileName strips path and control characters from client file name
+func cleanFileName(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, `\`, "/"))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == '"' || r == utf8.RuneError {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	for len(name) > maxFileNameLen {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	if name == "" || name == "." || name == "/" {
+		return "file"
+	}
+	return name
+}
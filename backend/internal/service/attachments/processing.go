@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package attachments
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/imageproc"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const (
+	// image processed longer is considered abandoned (instance stopped) and processed again
+	processingTimeout = 5 * time.Minute
+	// how often database is checked for images not queued in memory (queue was full or instance restarted)
+	sweepInterval = time.Minute
+	// images queued by one sweep
+	sweepBatchSize = 100
+)
+
+type ImageOptions struct {
+	// processing goroutines
+	Workers int
+	// images waiting for processing in memory
+	QueueSize int
+	// images with more pixels are not processed
+	MaxPixels   int
+	JPEGQuality int
+	// max width and height of thumbnails
+	ThumbnailSizes []int
+}
+
+// enqueue adds image to processing queue, if queue is full image is picked up by sweep later
+func (s *AttachmentsService) enqueue(attachmentId int) {
+	select {
+	case s.queue <- attachmentId:
+	default:
+	}
+}
+
+// RunProcessing processes uploaded images until context is done. Images uploaded to other
+// instances and not processed by them are found in database and processed too.
+func (s *AttachmentsService) RunProcessing(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for range max(s.images.Workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case attachmentId := <-s.queue:
+					s.process(ctx, attachmentId)
+				}
+			}
+		}()
+	}
+	defer wg.Wait()
+
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		// recently uploaded images are most likely in queue of uploading instance
+		ids, err := s.attachmentsRepo.ListUnprocessed(ctx, time.Now().Add(-sweepInterval), processingTimeout, sweepBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("failed to list not processed attachments: %v", err)
+			}
+			continue
+		}
+		for _, id := range ids {
+			s.enqueue(id)
+		}
+	}
+}
+
+// process makes image ready for publishing. Failed storage or database operation leaves
+// attachment in processing status, it is retried after processingTimeout.
+func (s *AttachmentsService) process(ctx context.Context, attachmentId int) {
+	claimed, err := s.attachmentsRepo.Claim(ctx, attachmentId, processingTimeout)
+	if err != nil {
+		log.Printf("failed to claim attachment %d for processing: %v", attachmentId, err)
+		return
+	}
+	if !claimed {
+		// processed by other worker or instance
+		return
+	}
+	attachment, err := s.attachmentsRepo.Get(ctx, attachmentId)
+	if err != nil {
+		log.Printf("failed to get attachment %d for processing: %v", attachmentId, err)
+		return
+	}
+	data, err := s.readBlob(ctx, attachment.StorageKey)
+	if err != nil {
+		log.Printf("failed to read file of attachment %d: %v", attachmentId, err)
+		return
+	}
+	result, err := imageproc.Process(data, attachment.ContentType, imageproc.Options{
+		MaxPixels:   s.images.MaxPixels,
+		JPEGQuality: s.images.JPEGQuality,
+		Sizes:       s.images.ThumbnailSizes,
+	})
+	if err != nil {
+		log.Printf("failed to process image of attachment %d: %v", attachmentId, err)
+		if err := s.attachmentsRepo.SetFailed(ctx, attachmentId); err != nil {
+			log.Printf("failed to mark attachment %d failed: %v", attachmentId, err)
+		}
+		return
+	}
+
+	processed := model.AttachmentProcessed{
+		Size:     int64(len(result.Original)),
+		Width:    result.Width,
+		Height:   result.Height,
+		Blurhash: result.Blurhash,
+	}
+	// original is replaced, it is not downloadable until attachment is ready
+	err = s.blobStore.Put(ctx, attachment.StorageKey,
+		bytes.NewReader(result.Original), processed.Size, result.ContentType)
+	if err != nil {
+		log.Printf("failed to store processed image of attachment %d: %v", attachmentId, err)
+		return
+	}
+	for _, variant := range result.Variants {
+		stored := model.AttachmentVariant{
+			Name:        variant.Name,
+			StorageKey:  attachment.StorageKey + "-" + variant.Name,
+			ContentType: variant.ContentType,
+			Width:       variant.Width,
+			Height:      variant.Height,
+			Size:        int64(len(variant.Data)),
+		}
+		err := s.blobStore.Put(ctx, stored.StorageKey,
+			bytes.NewReader(variant.Data), stored.Size, stored.ContentType)
+		if err != nil {
+			log.Printf("failed to store thumbnail of attachment %d: %v", attachmentId, err)
+			return
+		}
+		processed.Variants = append(processed.Variants, stored)
+	}
+
+	err = s.attachmentsRepo.SaveProcessed(ctx, attachmentId, processed)
+	if errors.Is(err, model.ErrNotFound) {
+		// removed by garbage collector during processing: thumbnails are not known to it
+		// and original was written again after collector deleted it
+		for _, variant := range processed.Variants {
+			if err := s.blobStore.Delete(ctx, variant.StorageKey); err != nil {
+				log.Printf("failed to delete thumbnail %s of removed attachment: %v", variant.StorageKey, err)
+			}
+		}
+		if err := s.blobStore.Delete(ctx, attachment.StorageKey); err != nil {
+			log.Printf("failed to delete file %s of removed attachment: %v", attachment.StorageKey, err)
+		}
+		return
+	}
+	if err != nil {
+		log.Printf("failed to save processed attachment %d: %v", attachmentId, err)
+	}
+}
+
+func (s *AttachmentsService) readBlob(ctx context.Context, key string) ([]byte, error) {
+	content, err := s.blobStore.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+	data, err := io.ReadAll(io.LimitReader(content, s.limits.MaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > s.limits.MaxSize {
+		return nil, fmt.Errorf("file is larger than %d bytes", s.limits.MaxSize)
+	}
+	return data, nil
+}
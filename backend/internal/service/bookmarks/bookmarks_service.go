@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package bookmarks
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var (
+	ErrInvalidTarget  = errors.New("bookmark target must be thread or post")
+	ErrEmptyName      = errors.New("collection name is empty")
+	ErrNotOwnedByUser = errors.New("collection belongs to another user")
+	ErrNameTaken      = errors.New("collection with this name already exists")
+)
+
+type BookmarksRepo interface {
+	Create(ctx context.Context, bookmark model.BookmarkCreate) (model.Bookmark, error)
+	Delete(ctx context.Context, userId, bookmarkId int) error
+	List(ctx context.Context, userId int, collectionId *int, before, limit int) (model.BookmarkListRepo, error)
+	CreateCollection(ctx context.Context, userId int, name string) (model.BookmarkCollection, bool, error)
+	GetCollection(ctx context.Context, collectionId int) (model.BookmarkCollection, error)
+	ListCollections(ctx context.Context, userId int) ([]model.BookmarkCollection, error)
+	DeleteCollection(ctx context.Context, userId, collectionId int) error
+	ThreadsBookmarked(ctx context.Context, userId int, threadIds []int) (map[int]bool, error)
+}
+type UserRepo interface {
+	GetAuthor(ctx context.Context, userId int) (model.Author, error)
+}
+
+type BookmarksService struct {
+	bookmarksRepo BookmarksRepo
+	userRepo      UserRepo
+}
+
+func NewBookmarksService(bookmarksRepo BookmarksRepo, userRepo UserRepo) *BookmarksService {
+	return &BookmarksService{bookmarksRepo: bookmarksRepo, userRepo: userRepo}
+}
+
+func (s *BookmarksService) Create(ctx context.Context, bookmark model.BookmarkCreate) (model.Bookmark, error) {
+	if bookmark.TargetType != model.BookmarkTargetThread && bookmark.TargetType != model.BookmarkTargetPost {
+		return model.Bookmark{}, ErrInvalidTarget
+	}
+	if bookmark.CollectionID != nil {
+		if err := s.checkCollectionOwner(ctx, bookmark.UserID, *bookmark.CollectionID); err != nil {
+			return model.Bookmark{}, err
+		}
+	}
+	return s.bookmarksRepo.Create(ctx, bookmark)
+}
+
+func (s *BookmarksService) Delete(ctx context.Context, userId, bookmarkId int) error {
+	return s.bookmarksRepo.Delete(ctx, userId, bookmarkId)
+}
+
+// List user bookmarks with current info of bookmarked (or containing bookmarked post) threads
+func (s *BookmarksService) List(
+	ctx context.Context, userId int, collectionId *int, before, limit int) (model.BookmarkListResponse, error) {
+
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+	if collectionId != nil {
+		if err := s.checkCollectionOwner(ctx, userId, *collectionId); err != nil {
+			return model.BookmarkListResponse{}, err
+		}
+	}
+	list, err := s.bookmarksRepo.List(ctx, userId, collectionId, before, limit)
+	if err != nil {
+		return model.BookmarkListResponse{}, err
+	}
+
+	// thread of bookmarked post may be bookmarked too
+	threadIds := make([]int, len(list.Bookmarks))
+	for i, item := range list.Bookmarks {
+		threadIds[i] = item.Thread.ID
+	}
+	bookmarked, err := s.bookmarksRepo.ThreadsBookmarked(ctx, userId, threadIds)
+	if err != nil {
+		return model.BookmarkListResponse{}, err
+	}
+
+	res := model.BookmarkListResponse{
+		Bookmarks: make([]model.BookmarkListItem, 0, len(list.Bookmarks)),
+		HaveNext:  list.HaveNext,
+	}
+	for _, item := range list.Bookmarks {
+		author, err := s.userRepo.GetAuthor(ctx, item.Thread.UserID)
+		if err != nil {
+			return model.BookmarkListResponse{}, err
+		}
+		res.Bookmarks = append(res.Bookmarks, model.BookmarkListItem{
+			Bookmark: item.Bookmark,
+			Thread: model.ThreadInfoResponse{
+				ID:           item.Thread.ID,
+				Title:        item.Thread.Title,
+				Content:      item.Thread.Content,
+				ContentHTML:  item.Thread.ContentHTML,
+				AuthorID:     item.Thread.UserID,
+				AuthorName:   author.Name,
+				AuthorRank:   author.Rank,
+				CommunityID:  item.Thread.CommunityID,
+				PostsCount:   item.Thread.PostsCount,
+				Score:        item.Thread.Score,
+				IsBookmarked: bookmarked[item.Thread.ID],
+				CreatedAt:    item.Thread.CreatedAt,
+			},
+		})
+	}
+	return res, nil
+}
+
+func (s *BookmarksService) CreateCollection(ctx context.Context, userId int, name string) (model.BookmarkCollection, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return model.BookmarkCollection{}, ErrEmptyName
+	}
+	collection, created, err := s.bookmarksRepo.CreateCollection(ctx, userId, name)
+	if err != nil {
+		return model.BookmarkCollection{}, err
+	}
+	if !created {
+		return model.BookmarkCollection{}, ErrNameTaken
+	}
+	return collection, nil
+}
+
+func (s *BookmarksService) ListCollections(ctx context.Context, userId int) ([]model.BookmarkCollection, error) {
+	return s.bookmarksRepo.ListCollections(ctx, userId)
+}
+
+func (s *BookmarksService) DeleteCollection(ctx context.Context, userId, collectionId int) error {
+	return s.bookmarksRepo.DeleteCollection(ctx, userId, collectionId)
+}
+
+func (s *BookmarksService) checkCollectionOwner(ctx context.Context, userId, collectionId int) error {
+	collection, err := s.bookmarksRepo.GetCollection(ctx, collectionId)
+	if err != nil {
+		return err
+	}
+	if collection.UserID != userId {
+		return ErrNotOwnedByUser
+	}
+	return nil
+}
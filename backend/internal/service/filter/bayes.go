@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package filter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const (
+	minTokenLength = 2
+	maxTokenLength = 32
+	// long texts are classified by their beginning
+	maxTokens = 300
+)
+
+type SpamRepo interface {
+	// SpamDocs returns number of trained spam and ham documents
+	SpamDocs(ctx context.Context) (model.TokenCount, error)
+	// SpamTokens returns counts of trained documents with tokens, unknown tokens are absent
+	SpamTokens(ctx context.Context, tokens []string) (map[string]model.TokenCount, error)
+	TrainSpam(ctx context.Context, tokens []string, spam bool) error
+}
+
+// Bayes is naive Bayes spam classifier, token counts are kept in database
+// and shared by all forum instances
+type Bayes struct {
+	repo SpamRepo
+	// content with spam probability not less than threshold is held, 0 disables classification
+	threshold float64
+	// classifier is not used until it has this many documents of both classes
+	minDocs int
+}
+
+func NewBayes(repo SpamRepo, threshold float64, minDocs int) *Bayes {
+	return &Bayes{repo: repo, threshold: threshold, minDocs: minDocs}
+}
+
+func (b *Bayes) Check(ctx context.Context, content *model.FilterContent) (model.FilterResult, error) {
+	if b.threshold <= 0 {
+		return model.FilterResult{Verdict: model.FilterAllow}, nil
+	}
+	docs, err := b.repo.SpamDocs(ctx)
+	if err != nil {
+		return model.FilterResult{}, err
+	}
+	if docs.Spam < b.minDocs || docs.Ham < b.minDocs {
+		return model.FilterResult{Verdict: model.FilterAllow}, nil
+	}
+	tokens := tokenize(content.Text())
+	counts, err := b.repo.SpamTokens(ctx, tokens)
+	if err != nil {
+		return model.FilterResult{}, err
+	}
+	probability := spamProbability(docs, counts)
+	if probability < b.threshold {
+		return model.FilterResult{Verdict: model.FilterAllow}, nil
+	}
+	return model.FilterResult{
+		Verdict: model.FilterHold,
+		Reason:  fmt.Sprintf("spam probability %.2f", probability),
+	}, nil
+}
+
+// Train adds document to spam or ham class
+func (b *Bayes) Train(ctx context.Context, text string, spam bool) error {
+	return b.repo.TrainSpam(ctx, tokenize(text), spam)
+}
+
+// spamProbability combines token likelihoods with Laplace smoothing in log space,
+// tokens not seen in training are ignored
+func spamProbability(docs model.TokenCount, counts map[string]model.TokenCount) float64 {
+	logOdds := math.Log(float64(docs.Spam) / float64(docs.Ham))
+	for _, count := range counts {
+		pSpam := float64(count.Spam+1) / float64(docs.Spam+2)
+		pHam := float64(count.Ham+1) / float64(docs.Ham+2)
+		logOdds += math.Log(pSpam / pHam)
+	}
+	return 1 / (1 + math.Exp(-logOdds))
+}
+
+// tokenize returns distinct lowercase words of text, the document model is set of words
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	seen := make(map[string]bool, len(words))
+	tokens := make([]string, 0, min(len(words), maxTokens))
+	for _, word := range words {
+		length := utf8.RuneCountInString(word)
+		if length < minTokenLength || length > maxTokenLength || seen[word] {
+			continue
+		}
+		seen[word] = true
+		tokens = append(tokens, word)
+		if len(tokens) == maxTokens {
+			break
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package filter
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "empty", text: "", want: []string{}},
+		{name: "lowercase and distinct", text: "Hello, WORLD! hello", want: []string{"hello", "world"}},
+		{name: "short words skipped", text: "a I go x", want: []string{"go"}},
+		{name: "numbers", text: "buy 100 pills", want: []string{"buy", "100", "pills"}},
+		{name: "unicode", text: "Купите СЕЙЧАС, купите!", want: []string{"купите", "сейчас"}},
+		{name: "punctuation splits", text: "spam_bot.com/buy-now", want: []string{"spam", "bot", "com", "buy", "now"}},
+		{
+			name: "long words skipped",
+			text: strings.Repeat("a", maxTokenLength) + " " + strings.Repeat("b", maxTokenLength+1),
+			want: []string{strings.Repeat("a", maxTokenLength)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tokenize(tt.text); !slices.Equal(got, tt.want) {
+				t.Errorf("tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenizeLimit(t *testing.T) {
+	words := make([]string, maxTokens+100)
+	for i := range words {
+		words[i] = fmt.Sprintf("w%d", i)
+	}
+	tokens := tokenize(strings.Join(words, " "))
+	if !slices.Equal(tokens, words[:maxTokens]) {
+		t.Errorf("tokenize() returned %d tokens, want the first %d words", len(tokens), maxTokens)
+	}
+}
+
+func TestSpamProbability(t *testing.T) {
+	tests := []struct {
+		name   string
+		docs   model.TokenCount
+		counts map[string]model.TokenCount
+		want   float64
+	}{
+		{
+			name: "no known tokens",
+			docs: model.TokenCount{Spam: 10, Ham: 10},
+			want: 0.5,
+		},
+		{
+			name: "prior of classes",
+			docs: model.TokenCount{Spam: 30, Ham: 10},
+			want: 0.75,
+		},
+		{
+			name:   "neutral token",
+			docs:   model.TokenCount{Spam: 10, Ham: 10},
+			counts: map[string]model.TokenCount{"hello": {Spam: 5, Ham: 5}},
+			want:   0.5,
+		},
+		{
+			// smoothed likelihoods 9/12 and 1/12
+			name:   "spam token",
+			docs:   model.TokenCount{Spam: 10, Ham: 10},
+			counts: map[string]model.TokenCount{"casino": {Spam: 8}},
+			want:   0.9,
+		},
+		{
+			name:   "ham token",
+			docs:   model.TokenCount{Spam: 10, Ham: 10},
+			counts: map[string]model.TokenCount{"golang": {Ham: 8}},
+			want:   0.1,
+		},
+		{
+			name:   "tokens are combined",
+			docs:   model.TokenCount{Spam: 10, Ham: 10},
+			counts: map[string]model.TokenCount{"casino": {Spam: 8}, "bonus": {Spam: 8}},
+			want:   81.0 / 82,
+		},
+		{
+			name:   "opposite tokens cancel",
+			docs:   model.TokenCount{Spam: 10, Ham: 10},
+			counts: map[string]model.TokenCount{"casino": {Spam: 8}, "golang": {Ham: 8}},
+			want:   0.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := spamProbability(tt.docs, tt.counts); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("spamProbability() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package filter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+// blocklist modes
+const (
+	BlocklistCensor = "censor" // blocked text is replaced by *
+	BlocklistReject = "reject" // content with blocked text is not accepted
+)
+
+// words are runs of letters and digits
+var wordRe = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+// Blocklist finds blocked words (case-insensitive) and regular expressions in content
+type Blocklist struct {
+	words    map[string]bool
+	patterns []*regexp.Regexp
+	reject   bool
+}
+
+func NewBlocklist(words, patterns []string, mode string) (*Blocklist, error) {
+	if mode != BlocklistCensor && mode != BlocklistReject {
+		return nil, fmt.Errorf("blocklist mode \"%s\" is unknown", mode)
+	}
+	b := &Blocklist{words: make(map[string]bool, len(words)), reject: mode == BlocklistReject}
+	for _, word := range words {
+		b.words[strings.ToLower(word)] = true
+	}
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("blocked pattern \"%s\": %w", pattern, err)
+		}
+		b.patterns = append(b.patterns, re)
+	}
+	return b, nil
+}
+
+func (b *Blocklist) Check(_ context.Context, content *model.FilterContent) (model.FilterResult, error) {
+	title, titleBlocked := b.censor(content.Title)
+	text, textBlocked := b.censor(content.Content)
+	if !titleBlocked && !textBlocked {
+		return model.FilterResult{Verdict: model.FilterAllow}, nil
+	}
+	if b.reject {
+		return model.FilterResult{Verdict: model.FilterReject, Reason: "content contains blocked words"}, nil
+	}
+	content.Title, content.Content = title, text
+	return model.FilterResult{Verdict: model.FilterAllow}, nil
+}
+
+// censor replaces blocked words and patterns with *, reports if anything was found
+func (b *Blocklist) censor(text string) (string, bool) {
+	found := false
+	replace := func(s string) string {
+		found = true
+		return strings.Repeat("*", utf8.RuneCountInString(s))
+	}
+	if len(b.words) > 0 {
+		text = wordRe.ReplaceAllStringFunc(text, func(word string) string {
+			if b.words[strings.ToLower(word)] {
+				return replace(word)
+			}
+			return word
+		})
+	}
+	for _, re := range b.patterns {
+		text = re.ReplaceAllStringFunc(text, replace)
+	}
+	return text, found
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package filter
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+type FingerprintRepo interface {
+	// RecordFingerprint stores fingerprint of content and returns how many times it was stored since given time
+	// before this one. Older fingerprints are removed.
+	RecordFingerprint(ctx context.Context, fingerprint string, userId int, since time.Time) (int, error)
+}
+
+// Duplicates holds content already posted recently by anyone, e.g. the same spam from several accounts.
+// Short content ("thanks", "+1") is not checked.
+type Duplicates struct {
+	repo      FingerprintRepo
+	window    time.Duration
+	minLength int
+}
+
+func NewDuplicates(repo FingerprintRepo, window time.Duration, minLength int) *Duplicates {
+	return &Duplicates{repo: repo, window: window, minLength: minLength}
+}
+
+func (d *Duplicates) Check(ctx context.Context, content *model.FilterContent) (model.FilterResult, error) {
+	// case and spacing changes do not make content different
+	normalized := strings.Join(strings.Fields(strings.ToLower(content.Content)), " ")
+	if utf8.RuneCountInString(normalized) < d.minLength {
+		return model.FilterResult{Verdict: model.FilterAllow}, nil
+	}
+	sum := sha256.Sum256([]byte(normalized))
+	count, err := d.repo.RecordFingerprint(ctx, hex.EncodeToString(sum[:]), content.UserID, time.Now().Add(-d.window))
+	if err != nil {
+		return model.FilterResult{}, err
+	}
+	if count == 0 {
+		return model.FilterResult{Verdict: model.FilterAllow}, nil
+	}
+	return model.FilterResult{Verdict: model.FilterHold, Reason: "the same content was posted recently"}, nil
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package filter
+
+import (
+	"context"
+	"log"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+// Filter checks thread or post before it is stored, it may change content text
+type Filter interface {
+	Check(ctx context.Context, content *model.FilterContent) (model.FilterResult, error)
+}
+
+// FilterService runs chain of content filters and trains spam classifier from moderator decisions
+type FilterService struct {
+	filters []Filter
+	bayes   *Bayes
+}
+
+// NewFilterService creates service running filters in given order
+func NewFilterService(bayes *Bayes, filters ...Filter) *FilterService {
+	return &FilterService{filters: filters, bayes: bayes}
+}
+
+// Check runs filters in order. Rejection stops the chain, held content is checked by the rest of filters
+// (so blocked words are censored in it too), reason of the first hold is returned.
+// Failed filter is skipped: broken filter must not block all posting.
+func (s *FilterService) Check(ctx context.Context, content *model.FilterContent) model.FilterResult {
+	res := model.FilterResult{Verdict: model.FilterAllow}
+	for _, filter := range s.filters {
+		r, err := filter.Check(ctx, content)
+		if err != nil {
+			log.Printf("content filter %T failed for user %d, skipped: %v", filter, content.UserID, err)
+			continue
+		}
+		switch r.Verdict {
+		case model.FilterReject:
+			return r
+		case model.FilterHold:
+			if res.Verdict == model.FilterAllow {
+				res = r
+			}
+		}
+	}
+	return res
+}
+
+// Train teaches spam classifier with text marked as spam or not by moderator
+func (s *FilterService) Train(ctx context.Context, text string, spam bool) error {
+	return s.bayes.Train(ctx, text, spam)
+}
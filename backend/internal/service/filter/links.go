@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package filter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+// urls in plain text and markdown links
+var linkRe = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>()\[\]]+`)
+
+type AccountRepo interface {
+	AccountCreatedAt(ctx context.Context, userId int) (time.Time, error)
+}
+
+// LinkLimit holds content of new accounts with too many links, typical for spam bots
+type LinkLimit struct {
+	repo     AccountRepo
+	newFor   time.Duration
+	maxLinks int
+}
+
+func NewLinkLimit(repo AccountRepo, newFor time.Duration, maxLinks int) *LinkLimit {
+	return &LinkLimit{repo: repo, newFor: newFor, maxLinks: maxLinks}
+}
+
+func (l *LinkLimit) Check(ctx context.Context, content *model.FilterContent) (model.FilterResult, error) {
+	links := len(linkRe.FindAllStringIndex(content.Text(), -1))
+	if links <= l.maxLinks {
+		return model.FilterResult{Verdict: model.FilterAllow}, nil
+	}
+	createdAt, err := l.repo.AccountCreatedAt(ctx, content.UserID)
+	if err != nil {
+		return model.FilterResult{}, err
+	}
+	if time.Since(createdAt) >= l.newFor {
+		return model.FilterResult{Verdict: model.FilterAllow}, nil
+	}
+	return model.FilterResult{
+		Verdict: model.FilterHold,
+		Reason:  fmt.Sprintf("%d links from new account (max %d)", links, l.maxLinks),
+	}, nil
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package flood
+
+import (
+	"context"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+// FloodRepo keeps posting state in database, so limits work across forum instances
+type FloodRepo interface {
+	AccountCreatedAt(ctx context.Context, userId int) (time.Time, error)
+	// TakeSlots takes all posting slots of user or returns the longest wait without taking any
+	TakeSlots(ctx context.Context, userId int, slots []model.PostingSlot) (time.Duration, error)
+	// ThreadsWait returns time until user can create thread within maxThreads a day, 0 if now
+	ThreadsWait(ctx context.Context, userId, maxThreads int) (time.Duration, error)
+	// TakeAction records action of user within max actions of kind during window or returns wait
+	// without recording it
+	TakeAction(ctx context.Context, userId int, kind string, max int, window time.Duration) (time.Duration, error)
+}
+
+type UserRepo interface {
+	GetRole(ctx context.Context, userId int) (string, error)
+}
+
+// Limits of user posting, zero value disables limit
+type Limits struct {
+	// minimal interval between posts (threads too) of user
+	PostInterval  time.Duration
+	ThreadsPerDay int
+	// private messages
+	MessagesPerMinute   int
+	ConversationsPerDay int
+}
+
+type Options struct {
+	Limits Limits
+	// accounts younger than NewAccountAge have NewAccountLimits
+	NewAccountAge    time.Duration
+	NewAccountLimits Limits
+}
+
+// FloodService limits posting rate of users and applies slow mode of threads. Moderators are not limited.
+type FloodService struct {
+	floodRepo FloodRepo
+	userRepo  UserRepo
+	options   Options
+}
+
+func NewFloodService(floodRepo FloodRepo, userRepo UserRepo, options Options) *FloodService {
+	return &FloodService{floodRepo: floodRepo, userRepo: userRepo, options: options}
+}
+
+// AllowPost takes posting slot of user for post in thread with slow mode (0 if off).
+// Returns *model.RateLimitError if user posts too often.
+func (s *FloodService) AllowPost(ctx context.Context, userId, threadId int, slowMode time.Duration) error {
+	limits, err := s.limits(ctx, userId)
+	if err != nil || limits == nil {
+		return err
+	}
+	var slots []model.PostingSlot
+	if limits.PostInterval > 0 {
+		slots = append(slots, model.PostingSlot{Interval: limits.PostInterval})
+	}
+	if slowMode > 0 {
+		slots = append(slots, model.PostingSlot{ThreadID: threadId, Interval: slowMode})
+	}
+	return s.takeSlots(ctx, userId, slots, "posts are too frequent")
+}
+
+// AllowThread checks threads per day limit of user and takes posting slot for thread.
+// Returns *model.RateLimitError if user posts too often.
+func (s *FloodService) AllowThread(ctx context.Context, userId int) error {
+	limits, err := s.limits(ctx, userId)
+	if err != nil || limits == nil {
+		return err
+	}
+	if limits.ThreadsPerDay > 0 {
+		wait, err := s.floodRepo.ThreadsWait(ctx, userId, limits.ThreadsPerDay)
+		if err != nil {
+			return err
+		}
+		if wait > 0 {
+			return &model.RateLimitError{Reason: "too many threads during the last day", RetryAfter: wait}
+		}
+	}
+	var slots []model.PostingSlot
+	if limits.PostInterval > 0 {
+		slots = append(slots, model.PostingSlot{Interval: limits.PostInterval})
+	}
+	return s.takeSlots(ctx, userId, slots, "posts are too frequent")
+}
+
+// AllowMessage counts private message of user within messages per minute limit.
+// Returns *model.RateLimitError if user sends messages too often.
+func (s *FloodService) AllowMessage(ctx context.Context, userId int) error {
+	limits, err := s.limits(ctx, userId)
+	if err != nil || limits == nil || limits.MessagesPerMinute <= 0 {
+		return err
+	}
+	wait, err := s.floodRepo.TakeAction(ctx, userId, model.FloodActionMessage, limits.MessagesPerMinute, time.Minute)
+	if err != nil {
+		return err
+	}
+	if wait > 0 {
+		return &model.RateLimitError{Reason: "messages are too frequent", RetryAfter: wait}
+	}
+	return nil
+}
+
+// AllowConversation counts conversation started by user within conversations per day limit
+// and its first message within messages limit.
+// Returns *model.RateLimitError if user starts conversations too often.
+func (s *FloodService) AllowConversation(ctx context.Context, userId int) error {
+	limits, err := s.limits(ctx, userId)
+	if err != nil || limits == nil {
+		return err
+	}
+	if limits.ConversationsPerDay > 0 {
+		wait, err := s.floodRepo.TakeAction(
+			ctx, userId, model.FloodActionConversation, limits.ConversationsPerDay, 24*time.Hour)
+		if err != nil {
+			return err
+		}
+		if wait > 0 {
+			return &model.RateLimitError{Reason: "too many conversations during the last day", RetryAfter: wait}
+		}
+	}
+	return s.AllowMessage(ctx, userId)
+}
+
+func (s *FloodService) takeSlots(ctx context.Context, userId int, slots []model.PostingSlot, reason string) error {
+	if len(slots) == 0 {
+		return nil
+	}
+	wait, err := s.floodRepo.TakeSlots(ctx, userId, slots)
+	if err != nil {
+		return err
+	}
+	if wait > 0 {
+		return &model.RateLimitError{Reason: reason, RetryAfter: wait}
+	}
+	return nil
+}
+
+// limits returns limits of user, nil for moderator
+func (s *FloodService) limits(ctx context.Context, userId int) (*Limits, error) {
+	role, err := s.userRepo.GetRole(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if model.IsModerator(role) {
+		return nil, nil
+	}
+	if s.options.NewAccountAge > 0 {
+		createdAt, err := s.floodRepo.AccountCreatedAt(ctx, userId)
+		if err != nil {
+			return nil, err
+		}
+		if time.Since(createdAt) < s.options.NewAccountAge {
+			return &s.options.NewAccountLimits, nil
+		}
+	}
+	return &s.options.Limits, nil
+}
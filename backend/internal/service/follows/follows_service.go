@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package follows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var (
+	ErrFollowSelf    = errors.New("user can not follow own account")
+	ErrInvalidCursor = errors.New("invalid feed cursor")
+)
+
+type FollowsRepo interface {
+	Follow(ctx context.Context, userId, targetId int) (model.Follow, error)
+	Unfollow(ctx context.Context, userId, targetId int) (bool, error)
+	Followers(ctx context.Context, userId, before, limit int) (model.FollowList, error)
+	Following(ctx context.Context, userId, before, limit int) (model.FollowList, error)
+	SubscribeCommunity(ctx context.Context, userId, communityId int) (model.CommunitySubscription, error)
+	UnsubscribeCommunity(ctx context.Context, userId, communityId int) (bool, error)
+	CommunitySubscriptions(ctx context.Context, userId int) ([]model.CommunitySubscription, error)
+	Feed(ctx context.Context, userId int, cursor *model.FeedCursor, limit int) (model.FeedList, error)
+}
+
+// Relations are block lists of users
+type Relations interface {
+	// BlockedBy returns which of given users blocked user
+	BlockedBy(ctx context.Context, userId int, byIds []int) (map[int]bool, error)
+}
+
+// FollowsService manages follow graph of users, community subscriptions and feed built from them
+type FollowsService struct {
+	followsRepo FollowsRepo
+	relations   Relations
+}
+
+func NewFollowsService(followsRepo FollowsRepo, relations Relations) *FollowsService {
+	return &FollowsService{followsRepo: followsRepo, relations: relations}
+}
+
+// Follow makes user follower of target, user blocked by target can not follow them
+func (s *FollowsService) Follow(ctx context.Context, userId, targetId int) (model.Follow, error) {
+	if userId == targetId {
+		return model.Follow{}, ErrFollowSelf
+	}
+	blocked, err := s.relations.BlockedBy(ctx, userId, []int{targetId})
+	if err != nil {
+		return model.Follow{}, err
+	}
+	if blocked[targetId] {
+		return model.Follow{}, model.ErrBlockedByUser
+	}
+	return s.followsRepo.Follow(ctx, userId, targetId)
+}
+
+func (s *FollowsService) Unfollow(ctx context.Context, userId, targetId int) error {
+	deleted, err := s.followsRepo.Unfollow(ctx, userId, targetId)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// Followers returns followers of user from the latest
+func (s *FollowsService) Followers(ctx context.Context, userId, before, limit int) (model.FollowList, error) {
+	return s.followsRepo.Followers(ctx, userId, before, clampLimit(limit))
+}
+
+// Following returns users followed by user from the latest
+func (s *FollowsService) Following(ctx context.Context, userId, before, limit int) (model.FollowList, error) {
+	return s.followsRepo.Following(ctx, userId, before, clampLimit(limit))
+}
+
+func (s *FollowsService) SubscribeCommunity(
+	ctx context.Context, userId, communityId int) (model.CommunitySubscription, error) {
+
+	return s.followsRepo.SubscribeCommunity(ctx, userId, communityId)
+}
+
+func (s *FollowsService) UnsubscribeCommunity(ctx context.Context, userId, communityId int) error {
+	deleted, err := s.followsRepo.UnsubscribeCommunity(ctx, userId, communityId)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+func (s *FollowsService) CommunitySubscriptions(ctx context.Context, userId int) ([]model.CommunitySubscription, error) {
+	return s.followsRepo.CommunitySubscriptions(ctx, userId)
+}
+
+// Feed returns threads and posts of followed users and new threads of subscribed communities,
+// the newest first. Cursor is opaque string from previous page, empty for the first page.
+func (s *FollowsService) Feed(ctx context.Context, userId int, cursor string, limit int) (model.FeedList, error) {
+	var feedCursor *model.FeedCursor
+	if cursor != "" {
+		c, err := ParseCursor(cursor)
+		if err != nil {
+			return model.FeedList{}, err
+		}
+		feedCursor = &c
+	}
+	return s.followsRepo.Feed(ctx, userId, feedCursor, clampLimit(limit))
+}
+
+// FormatCursor encodes feed position as "<unix microseconds>.<kind>.<id>"
+func FormatCursor(cursor model.FeedCursor) string {
+	return fmt.Sprintf("%d.%s.%d", cursor.CreatedAt.UnixMicro(), cursor.Kind, cursor.ID)
+}
+
+// ParseCursor decodes feed position made by FormatCursor
+func ParseCursor(cursor string) (model.FeedCursor, error) {
+	parts := strings.Split(cursor, ".")
+	if len(parts) != 3 || (parts[1] != model.FeedThread && parts[1] != model.FeedPost) {
+		return model.FeedCursor{}, ErrInvalidCursor
+	}
+	micro, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return model.FeedCursor{}, ErrInvalidCursor
+	}
+	id, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return model.FeedCursor{}, ErrInvalidCursor
+	}
+	return model.FeedCursor{CreatedAt: time.UnixMicro(micro), Kind: parts[1], ID: id}, nil
+}
+
+func clampLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	return min(limit, MaxLimit)
+}
@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package live
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+// Subscription receives events of one thread (or all threads for ThreadID 0).
+// Events channel is closed when subscriber does not keep up with events,
+// client should reconnect and resume from last received event.
+type Subscription struct {
+	ThreadID int
+	// id of last event published before subscription
+	StartID int64
+	Events  chan model.LiveEvent
+}
+
+const (
+	liveChannel = "forum_live_events"
+	// events are delivered with postgres NOTIFY, its payload is limited to 8000 bytes
+	maxEventSize = 7900
+)
+
+// PubSub delivers events to live services of all forum instances. Messages are received
+// in order of their ids, replay of events after known id relies on it.
+type PubSub interface {
+	Publish(ctx context.Context, channel string, payload []byte) (int64, error)
+	Listen(ctx context.Context, channel string, handle func(model.PubSubMessage), reconnected func()) error
+}
+
+// LiveService distributes thread events to subscribers and keeps recent events
+// so reconnected clients can resume without gaps. Events published on any instance
+// are received through pubsub, so subscribers of every instance get all events.
+type LiveService struct {
+	pubsub      PubSub
+	mu          sync.Mutex
+	lastID      int64
+	history     []model.LiveEvent // ring buffer of last events
+	historyNext int
+	lost        bool // events were lost after the last event in history
+	subscribers map[*Subscription]struct{}
+	bufferSize  int
+}
+
+func NewLiveService(pubsub PubSub, historySize, bufferSize int) *LiveService {
+	return &LiveService{
+		pubsub:      pubsub,
+		history:     make([]model.LiveEvent, 0, historySize),
+		subscribers: make(map[*Subscription]struct{}),
+		bufferSize:  bufferSize,
+	}
+}
+
+// Publish sends event to subscribers of all instances. Too large event is sent
+// without thread and post content, clients load it by themselves. Callers only log
+// failed publishing: the change is already stored and readers reload thread after reconnect.
+func (s *LiveService) Publish(ctx context.Context, event model.LiveEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if len(payload) > maxEventSize {
+		event.Partial = true
+		if event.Thread != nil {
+			thread := *event.Thread
+			thread.Content = ""
+			thread.ContentHTML = ""
+			event.Thread = &thread
+		}
+		if event.Post != nil {
+			post := *event.Post
+			post.Content = ""
+			post.ContentHTML = ""
+			event.Post = &post
+		}
+		if payload, err = json.Marshal(event); err != nil {
+			return err
+		}
+	}
+	_, err = s.pubsub.Publish(ctx, liveChannel, payload)
+	return err
+}
+
+// Run receives events from pubsub until ctx is done, then disconnects subscribers
+func (s *LiveService) Run(ctx context.Context) error {
+	err := s.pubsub.Listen(ctx, liveChannel, s.receive, s.reset)
+	s.reset()
+	return err
+}
+
+func (s *LiveService) receive(message model.PubSubMessage) {
+	var event model.LiveEvent
+	if err := json.Unmarshal(message.Payload, &event); err != nil {
+		log.Printf("live: skipped malformed event %d: %v", message.ID, err)
+		return
+	}
+	event.ID = message.ID
+	s.dispatch(event)
+}
+
+// reset forgets history and disconnects subscribers after events were lost,
+// reconnected clients get reset event and reload data
+func (s *LiveService) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.history = s.history[:0]
+	s.historyNext = 0
+	s.lost = true
+	for sub := range s.subscribers {
+		delete(s.subscribers, sub)
+		close(sub.Events)
+	}
+}
+
+// dispatch stores event in history and sends it to matching subscribers. dispatch never blocks:
+// subscriber with full buffer is dropped instead of slowing down event delivery.
+func (s *LiveService) dispatch(event model.LiveEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastID = max(s.lastID, event.ID)
+	s.lost = false
+	if len(s.history) < cap(s.history) {
+		s.history = append(s.history, event)
+	} else if cap(s.history) > 0 {
+		s.history[s.historyNext] = event
+		s.historyNext = (s.historyNext + 1) % cap(s.history)
+	}
+
+	for sub := range s.subscribers {
+		if sub.ThreadID != 0 && sub.ThreadID != event.ThreadID {
+			continue
+		}
+		select {
+		case sub.Events <- event:
+		default:
+			delete(s.subscribers, sub)
+			close(sub.Events)
+		}
+	}
+}
+
+// Subscribe registers subscriber for thread events (threadId 0 - events of all threads).
+// Events after lastEventId are returned for replay. complete is false when some of them
+// are not kept anymore (or lastEventId is unknown), then client should reload current state
+// and nothing is replayed.
+func (s *LiveService) Subscribe(threadId int, lastEventId int64) (sub *Subscription, replay []model.LiveEvent, complete bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub = &Subscription{
+		ThreadID: threadId,
+		StartID:  s.lastID,
+		Events:   make(chan model.LiveEvent, s.bufferSize),
+	}
+	s.subscribers[sub] = struct{}{}
+
+	if lastEventId == 0 {
+		return sub, nil, true
+	}
+	events := s.orderedHistory()
+	if len(events) > 0 {
+		complete = lastEventId <= s.lastID && events[0].ID <= lastEventId+1
+	} else {
+		complete = !s.lost && lastEventId == s.lastID
+	}
+	if !complete {
+		return sub, nil, false
+	}
+	for _, event := range events {
+		if event.ID > lastEventId && (threadId == 0 || event.ThreadID == threadId) {
+			replay = append(replay, event)
+		}
+	}
+	return sub, replay, complete
+}
+
+func (s *LiveService) Unsubscribe(sub *Subscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subscribers, sub)
+}
+
+// history from oldest to newest event
+func (s *LiveService) orderedHistory() []model.LiveEvent {
+	if len(s.history) < cap(s.history) {
+		return s.history
+	}
+	events := make([]model.LiveEvent, 0, len(s.history))
+	events = append(events, s.history[s.historyNext:]...)
+	return append(events, s.history[:s.historyNext]...)
+}
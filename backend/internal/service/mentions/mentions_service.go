@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package mentions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/mention"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const (
+	DefaultLimit = 10
+	MaxLimit     = 50
+)
+
+type MentionsRepo interface {
+	Create(ctx context.Context, target model.MentionTarget, users []model.MentionedUser) error
+	ListByTargets(ctx context.Context, targetType string, targetIds []int) (map[int][]model.MentionedUser, error)
+}
+type UserRepo interface {
+	IdsByNames(ctx context.Context, names []string) (map[string]int, error)
+	ListMentionableByPrefix(ctx context.Context, prefix string, limit int) ([]model.Author, error)
+}
+
+type MentionsService struct {
+	mentionsRepo MentionsRepo
+	userRepo     UserRepo
+}
+
+func NewMentionsService(mentionsRepo MentionsRepo, userRepo UserRepo) *MentionsService {
+	return &MentionsService{mentionsRepo: mentionsRepo, userRepo: userRepo}
+}
+
+// Resolve finds @name mentions of existing users in text, unknown names are skipped
+func (s *MentionsService) Resolve(ctx context.Context, text string) ([]model.MentionedUser, error) {
+	names := mention.Names(text)
+	userIds, err := s.userRepo.IdsByNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	var users []model.MentionedUser
+	for _, name := range names {
+		if userId, ok := userIds[name]; ok {
+			users = append(users, model.MentionedUser{UserID: userId, Name: name})
+		}
+	}
+	return users, nil
+}
+
+func (s *MentionsService) Save(ctx context.Context, target model.MentionTarget, users []model.MentionedUser) error {
+	return s.mentionsRepo.Create(ctx, target, users)
+}
+
+// Entities returns positions of stored mentions in contents of threads or posts (by target id)
+func (s *MentionsService) Entities(
+	ctx context.Context, targetType string, contents map[int]string) (map[int][]model.MentionEntity, error) {
+
+	targetIds := make([]int, 0, len(contents))
+	for targetId := range contents {
+		targetIds = append(targetIds, targetId)
+	}
+	mentioned, err := s.mentionsRepo.ListByTargets(ctx, targetType, targetIds)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int][]model.MentionEntity, len(contents))
+	for targetId, content := range contents {
+		res[targetId] = s.Locate(content, mentioned[targetId])
+	}
+	return res, nil
+}
+
+// Locate returns positions of mentions of given users in content.
+// Users are matched by name as it was written, so mentions survive user rename.
+func (s *MentionsService) Locate(content string, users []model.MentionedUser) []model.MentionEntity {
+	entities := []model.MentionEntity{}
+	if len(users) == 0 {
+		return entities
+	}
+	byName := make(map[string]int, len(users))
+	for _, user := range users {
+		byName[user.Name] = user.UserID
+	}
+	for _, span := range mention.Find(content) {
+		if userId, ok := byName[span.Name]; ok {
+			entities = append(entities, model.MentionEntity{
+				UserID: userId,
+				Name:   span.Name,
+				Offset: span.Offset,
+				Length: span.Length,
+			})
+		}
+	}
+	return entities
+}
+
+// Autocomplete returns users which can be mentioned with name starting with prefix
+func (s *MentionsService) Autocomplete(ctx context.Context, prefix string, limit int) ([]model.Author, error) {
+	prefix = strings.TrimPrefix(strings.TrimSpace(prefix), "@")
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+	return s.userRepo.ListMentionableByPrefix(ctx, prefix, limit)
+}
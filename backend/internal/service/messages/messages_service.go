@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package messages
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+	// members of conversation including creator
+	maxMembers       = 10
+	maxTitleLength   = 200
+	maxMessageLength = 10000
+)
+
+var (
+	ErrNoMembers      = errors.New("conversation must have other members")
+	ErrTooManyMembers = errors.New("conversation has too many members")
+	ErrUnknownMembers = errors.New("some members do not exist")
+	ErrTitleTooLong   = errors.New("conversation title is too long")
+	ErrEmptyMessage   = errors.New("message is empty")
+	ErrMessageTooLong = errors.New("message is too long")
+)
+
+type MessagesRepo interface {
+	UsersExist(ctx context.Context, userIds []int) (map[int]bool, error)
+	FindDirect(ctx context.Context, userId, otherId int) (int, error)
+	Create(ctx context.Context, conversation model.ConversationCreate) (model.DirectMessage, error)
+	Send(ctx context.Context, conversationId, userId int, content, contentHTML string) (model.DirectMessage, error)
+	// Members returns members of conversation if user is its member, otherwise model.ErrNotFound
+	Members(ctx context.Context, conversationId, userId int) ([]model.ConversationMember, error)
+	List(ctx context.Context, userId, before, limit int) (model.ConversationList, error)
+	Messages(ctx context.Context, conversationId, before, limit int) (model.DirectMessageList, error)
+	MarkRead(ctx context.Context, conversationId, userId, messageId int) error
+	Unread(ctx context.Context, userId int) (model.MessagesUnread, error)
+}
+
+type UserRepo interface {
+	SuspendedUntil(ctx context.Context, userId int) (*time.Time, error)
+}
+
+// FloodControl limits private messages rate, errors are *model.RateLimitError for too frequent messages
+type FloodControl interface {
+	AllowMessage(ctx context.Context, userId int) error
+	AllowConversation(ctx context.Context, userId int) error
+}
+
+// Relations are block lists of users
+type Relations interface {
+	// BlockedBy returns which of given users blocked user
+	BlockedBy(ctx context.Context, userId int, byIds []int) (map[int]bool, error)
+}
+
+// Renderer converts markdown source to sanitized HTML
+type Renderer interface {
+	Render(source string) string
+}
+
+// MessagesService manages private conversations. Conversations and their messages are accessible
+// only by members (moderators too), for others they do not exist.
+type MessagesService struct {
+	messagesRepo MessagesRepo
+	userRepo     UserRepo
+	flood        FloodControl
+	relations    Relations
+	renderer     Renderer
+}
+
+func NewMessagesService(
+	messagesRepo MessagesRepo, userRepo UserRepo, flood FloodControl, relations Relations, renderer Renderer,
+) *MessagesService {
+
+	return &MessagesService{
+		messagesRepo: messagesRepo, userRepo: userRepo, flood: flood, relations: relations, renderer: renderer,
+	}
+}
+
+// Create starts conversation with the first message. Message to one user without title goes to
+// existing conversation of the two users if there is one.
+func (s *MessagesService) Create(ctx context.Context, conversation model.ConversationCreate) (model.DirectMessage, error) {
+	conversation.MemberIDs = slices.DeleteFunc(slices.Compact(slices.Sorted(slices.Values(conversation.MemberIDs))),
+		func(id int) bool { return id == conversation.CreatorID })
+	if len(conversation.MemberIDs) == 0 {
+		return model.DirectMessage{}, ErrNoMembers
+	}
+	if len(conversation.MemberIDs)+1 > maxMembers {
+		return model.DirectMessage{}, fmt.Errorf("%w, max %d members", ErrTooManyMembers, maxMembers)
+	}
+	conversation.Title = strings.TrimSpace(conversation.Title)
+	if utf8.RuneCountInString(conversation.Title) > maxTitleLength {
+		return model.DirectMessage{}, fmt.Errorf("%w, max %d characters", ErrTitleTooLong, maxTitleLength)
+	}
+	if err := checkContent(conversation.Content); err != nil {
+		return model.DirectMessage{}, err
+	}
+	if err := s.checkNotSuspended(ctx, conversation.CreatorID); err != nil {
+		return model.DirectMessage{}, err
+	}
+	exist, err := s.messagesRepo.UsersExist(ctx, conversation.MemberIDs)
+	if err != nil {
+		return model.DirectMessage{}, err
+	}
+	if len(exist) != len(conversation.MemberIDs) {
+		return model.DirectMessage{}, ErrUnknownMembers
+	}
+	if err := s.checkNotBlocked(ctx, conversation.CreatorID, conversation.MemberIDs); err != nil {
+		return model.DirectMessage{}, err
+	}
+
+	if len(conversation.MemberIDs) == 1 && conversation.Title == "" {
+		conversationId, err := s.messagesRepo.FindDirect(ctx, conversation.CreatorID, conversation.MemberIDs[0])
+		if err == nil {
+			return s.send(ctx, conversationId, conversation.CreatorID, conversation.Content)
+		}
+		if !errors.Is(err, model.ErrNotFound) {
+			return model.DirectMessage{}, err
+		}
+	}
+	if err := s.flood.AllowConversation(ctx, conversation.CreatorID); err != nil {
+		return model.DirectMessage{}, err
+	}
+	conversation.ContentHTML = s.renderer.Render(conversation.Content)
+	return s.messagesRepo.Create(ctx, conversation)
+}
+
+// Send adds message of member to conversation
+func (s *MessagesService) Send(ctx context.Context, userId, conversationId int, content string) (model.DirectMessage, error) {
+	members, err := s.messagesRepo.Members(ctx, conversationId, userId)
+	if err != nil {
+		return model.DirectMessage{}, err
+	}
+	if err := checkContent(content); err != nil {
+		return model.DirectMessage{}, err
+	}
+	if err := s.checkNotSuspended(ctx, userId); err != nil {
+		return model.DirectMessage{}, err
+	}
+	others := make([]int, 0, len(members)-1)
+	for _, member := range members {
+		if member.UserID != userId {
+			others = append(others, member.UserID)
+		}
+	}
+	if err := s.checkNotBlocked(ctx, userId, others); err != nil {
+		return model.DirectMessage{}, err
+	}
+	return s.send(ctx, conversationId, userId, content)
+}
+
+func (s *MessagesService) send(ctx context.Context, conversationId, userId int, content string) (model.DirectMessage, error) {
+	if err := s.flood.AllowMessage(ctx, userId); err != nil {
+		return model.DirectMessage{}, err
+	}
+	return s.messagesRepo.Send(ctx, conversationId, userId, content, s.renderer.Render(content))
+}
+
+// List returns conversations of user from the latest message
+func (s *MessagesService) List(ctx context.Context, userId, before, limit int) (model.ConversationList, error) {
+	return s.messagesRepo.List(ctx, userId, before, clampLimit(limit))
+}
+
+// Messages returns messages of conversation from the latest one, only for members
+func (s *MessagesService) Messages(
+	ctx context.Context, userId, conversationId, before, limit int) (model.DirectMessageList, error) {
+
+	if _, err := s.messagesRepo.Members(ctx, conversationId, userId); err != nil {
+		return model.DirectMessageList{}, err
+	}
+	return s.messagesRepo.Messages(ctx, conversationId, before, clampLimit(limit))
+}
+
+// MarkRead marks messages of conversation up to messageId (0 - all) as read by member
+func (s *MessagesService) MarkRead(ctx context.Context, userId, conversationId, messageId int) error {
+	if _, err := s.messagesRepo.Members(ctx, conversationId, userId); err != nil {
+		return err
+	}
+	return s.messagesRepo.MarkRead(ctx, conversationId, userId, messageId)
+}
+
+func (s *MessagesService) Unread(ctx context.Context, userId int) (model.MessagesUnread, error) {
+	return s.messagesRepo.Unread(ctx, userId)
+}
+
+func (s *MessagesService) checkNotSuspended(ctx context.Context, userId int) error {
+	until, err := s.userRepo.SuspendedUntil(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if until != nil && time.Now().Before(*until) {
+		return model.ErrUserSuspended
+	}
+	return nil
+}
+
+// user blocked by any other member can not write to conversation
+func (s *MessagesService) checkNotBlocked(ctx context.Context, userId int, memberIds []int) error {
+	blocked, err := s.relations.BlockedBy(ctx, userId, memberIds)
+	if err != nil {
+		return err
+	}
+	if len(blocked) > 0 {
+		return model.ErrBlockedByUser
+	}
+	return nil
+}
+
+func checkContent(content string) error {
+	if strings.TrimSpace(content) == "" {
+		return ErrEmptyMessage
+	}
+	if utf8.RuneCountInString(content) > maxMessageLength {
+		return fmt.Errorf("%w, max %d characters", ErrMessageTooLong, maxMessageLength)
+	}
+	return nil
+}
+
+func clampLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	return min(limit, MaxLimit)
+}
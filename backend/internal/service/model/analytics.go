@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "time"
+
+// kinds of interaction of user with other user
+const (
+	InteractionReply   = "reply"   // post replies to thread or post of target
+	InteractionMention = "mention" // thread or post mentions target
+	InteractionVote    = "vote"    // vote for thread or post of target
+)
+
+// formats of graph export
+const (
+	GraphFormatGraphML = "graphml"
+	GraphFormatGEXF    = "gexf"
+	GraphFormatCSV     = "csv" // zip archive with nodes.csv and edges.csv
+)
+
+// Interaction is directed action of source user to target user
+type Interaction struct {
+	SourceID int
+	TargetID int
+	Kind     string
+	// thread of interaction, graph is filtered by its community
+	ThreadID int
+	At       time.Time
+}
+
+// GraphFilter selects interactions of graph. Time window precision is one day (UTC).
+type GraphFilter struct {
+	// nil - from the first interaction
+	From *time.Time
+	// nil - up to now
+	To *time.Time
+	// empty - all kinds
+	Kinds []string
+	// nil - all communities, 0 - threads without community
+	CommunityID *int
+	// edges with smaller total weight are skipped
+	MinWeight int
+	// max number of edges, the heaviest edges are returned
+	Limit int
+}
+
+// GraphEdge is sum of interactions of source user with target user
+type GraphEdge struct {
+	SourceID int
+	TargetID int
+	Weight   int
+	Replies  int
+	Mentions int
+	Votes    int
+}
+
+// GraphUser is user attributes of graph node
+type GraphUser struct {
+	Name  string
+	Karma int
+	// visible posts of user
+	PostsCount int
+}
+
+// GraphNode is user with at least one edge in graph
+type GraphNode struct {
+	UserID int
+	GraphUser
+	InDegree  int
+	OutDegree int
+	InWeight  int
+	OutWeight int
+}
+
+// InteractionGraph is directed weighted graph of user interactions
+type InteractionGraph struct {
+	Nodes []GraphNode
+	Edges []GraphEdge
+	// some edges are not returned because of limit
+	Truncated bool
+}
+
+// GraphExport is number of exported nodes and edges of interaction graph
+type GraphExport struct {
+	Nodes int
+	Edges int
+}
+
+// GraphRebuild is result of full rebuild of interaction graph
+type GraphRebuild struct {
+	// number of stored edges (by source, target, kind and day)
+	Edges    int
+	Duration time.Duration
+}
+
+// metrics of influence leaderboard
+const (
+	MetricPageRank       = "pagerank"
+	MetricBetweenness    = "betweenness"
+	MetricInDegree       = "in_degree"
+	MetricOutDegree      = "out_degree"
+	MetricWeightedDegree = "weighted_degree" // in and out weight
+)
+
+// InfluenceSnapshot is influence metrics of users computed for graph of forum or community
+type InfluenceSnapshot struct {
+	ID int
+	// nil - graph of whole forum
+	CommunityID *int
+	// start of graph time window, nil - all interactions
+	From       *time.Time
+	UsersCount int
+	EdgesCount int
+	Duration   time.Duration
+	CreatedAt  time.Time
+}
+
+// InfluenceScore is influence metrics of user in snapshot
+type InfluenceScore struct {
+	UserID int
+	Name   string
+	// position by PageRank, from 1
+	Rank        int
+	PageRank    float64
+	Betweenness float64 // normalized, 0..1
+	InDegree    int
+	OutDegree   int
+	InWeight    int
+	OutWeight   int
+}
+
+// Leaderboard is users of snapshot ordered by metric
+type Leaderboard struct {
+	Snapshot InfluenceSnapshot
+	Metric   string
+	Scores   []InfluenceScore
+}
+
+// UserInfluence is score of user in the latest snapshot of forum or community
+type UserInfluence struct {
+	Snapshot InfluenceSnapshot
+	Score    InfluenceScore
+}
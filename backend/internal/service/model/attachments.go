@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "time"
+
+// processing status of attachment, only images are processed, other files are ready after upload
+const (
+	AttachmentPending    = "pending"
+	AttachmentProcessing = "processing"
+	AttachmentReady      = "ready"
+	AttachmentFailed     = "failed"
+)
+
+type AttachmentCreate struct {
+	UserID      int
+	StorageKey  string // key of file in blob store
+	FileName    string
+	ContentType string
+	Size        int64
+	Status      string
+}
+
+// Attachment is uploaded file. It is unlinked (ThreadID is nil) until thread or post with it
+// is created, unlinked attachments are visible only to uploader and removed after a while.
+type Attachment struct {
+	ID          int
+	UserID      int
+	ThreadID    *int // thread of attachment (thread of post for post attachments)
+	PostID      *int
+	StorageKey  string
+	FileName    string
+	ContentType string
+	Size        int64
+	CreatedAt   time.Time
+	Status      string
+
+	// image dimensions and blurhash placeholder, set when image is processed
+	Width    int
+	Height   int
+	Blurhash string
+	Variants []AttachmentVariant
+
+	// signed download url, set by service
+	URL          string
+	URLExpiresAt time.Time
+}
+
+// AttachmentVariant is resized copy (thumbnail) of image attachment
+type AttachmentVariant struct {
+	Name        string // max width and height, e.g. "320"
+	StorageKey  string
+	ContentType string
+	Width       int
+	Height      int
+	Size        int64
+
+	// signed download url, set by service
+	URL string
+}
+
+// AttachmentProcessed is result of image processing, file without metadata replaces uploaded one
+type AttachmentProcessed struct {
+	Size     int64
+	Width    int
+	Height   int
+	Blurhash string
+	Variants []AttachmentVariant
+}
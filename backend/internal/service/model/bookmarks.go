@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "time"
+
+const (
+	BookmarkTargetThread = "thread"
+	BookmarkTargetPost   = "post"
+)
+
+type BookmarkCreate struct {
+	UserID       int
+	TargetType   string
+	TargetID     int
+	CollectionID *int
+}
+
+type Bookmark struct {
+	ID           int
+	UserID       int
+	TargetType   string
+	TargetID     int
+	ThreadID     int
+	CollectionID *int
+	CreatedAt    time.Time
+}
+
+type BookmarkRepoItem struct {
+	Bookmark
+	Thread ThreadRepoInfo
+}
+
+type BookmarkListRepo struct {
+	Bookmarks []BookmarkRepoItem
+	HaveNext  bool
+}
+
+type BookmarkListItem struct {
+	Bookmark
+	Thread ThreadInfoResponse
+}
+
+type BookmarkListResponse struct {
+	Bookmarks []BookmarkListItem
+	HaveNext  bool
+}
+
+type BookmarkCollection struct {
+	ID        int
+	UserID    int
+	Name      string
+	CreatedAt time.Time
+}
@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "errors"
+
+// ErrNotFound is returned by repositories when requested entity does not exist
+var ErrNotFound = errors.New("not found")
+
+// ErrThreadLocked is returned on posting to thread locked by moderator
+var ErrThreadLocked = errors.New("thread is locked, new posts are not accepted")
+
+// ErrThreadArchived is returned on changing content of archived (read-only) thread
+var ErrThreadArchived = errors.New("thread is archived and read-only")
+
+// ErrUserSuspended is returned on writing by user suspended by moderator
+var ErrUserSuspended = errors.New("user is suspended by moderator")
+
+// ErrContentRejected is returned on creating content rejected by content filter
+var ErrContentRejected = errors.New("content rejected by filter")
+
+// ErrBlockedByUser is returned on replying to user who blocked the author
+var ErrBlockedByUser = errors.New("user blocked you")
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "time"
+
+// content filter verdicts from the weakest to the strongest
+const (
+	FilterAllow  = "allow"  // content is published
+	FilterHold   = "hold"   // content is stored, but shown only after moderator approval
+	FilterReject = "reject" // content is not stored, user gets error
+)
+
+// FilterContent is thread or post checked by content filters before storing,
+// filters may change text (e.g. censor blocked words)
+type FilterContent struct {
+	UserID int
+	// empty for post
+	Title   string
+	Content string
+}
+
+// Text is all text of content checked by filters
+func (c *FilterContent) Text() string {
+	if c.Title == "" {
+		return c.Content
+	}
+	return c.Title + "\n" + c.Content
+}
+
+type FilterResult struct {
+	Verdict string
+	// why content is held or rejected, shown to moderator (and user on reject)
+	Reason string
+}
+
+// spam classifier classes
+const (
+	SpamClassSpam = "spam"
+	SpamClassHam  = "ham"
+)
+
+// TokenCount is number of trained spam and ham documents with token
+type TokenCount struct {
+	Spam int
+	Ham  int
+}
+
+// HeldContent is thread or post held by content filter until moderator decision
+type HeldContent struct {
+	ID         int
+	TargetType string
+	TargetID   int
+	ThreadID   int
+	UserID     int
+	UserName   string
+	// thread title, empty for post
+	Title     string
+	Content   string
+	Reason    string
+	CreatedAt time.Time
+	// held edit of visible thread or post, title and content are edited version
+	Edit bool
+}
+
+type HeldContentList struct {
+	Items    []HeldContent
+	HaveNext bool
+}
+
+// moderator decisions on held content
+const (
+	HeldApprove = "approve" // content is published
+	HeldReject  = "reject"  // content is hidden
+)
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ErrRateLimited is returned on posting too often, actual error is *RateLimitError
+var ErrRateLimited = errors.New("posting too often")
+
+// RateLimitError tells user when the next post is allowed
+type RateLimitError struct {
+	Reason     string
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s, next post is allowed in %d seconds", e.Reason, e.RetryAfterSeconds())
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return ErrRateLimited
+}
+
+// RetryAfterSeconds is wait time rounded up to whole seconds
+func (e *RateLimitError) RetryAfterSeconds() int {
+	return int(math.Ceil(e.RetryAfter.Seconds()))
+}
+
+// actions of user limited by flood control
+const (
+	FloodActionMessage      = "message"
+	FloodActionConversation = "conversation"
+)
+
+// PostingSlot is minimal interval between posts of user in thread, ThreadID 0 - in any thread
+type PostingSlot struct {
+	ThreadID int
+	Interval time.Duration
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "time"
+
+// Follow is followed or following user in list of user follows
+type Follow struct {
+	// id of follow relation, cursor of list
+	ID         int
+	User       Author
+	FollowedAt time.Time
+}
+
+type FollowList struct {
+	Follows []Follow
+	// number of all followers or followed users
+	Total    int
+	HaveNext bool
+}
+
+// CommunitySubscription of user, new threads of community are in feed of user
+type CommunitySubscription struct {
+	CommunityID   int
+	CommunityName string
+	CreatedAt     time.Time
+}
+
+// feed item kinds
+const (
+	FeedThread = "thread"
+	FeedPost   = "post"
+)
+
+// FeedCursor is position in feed, items are ordered by creation time, kind and id descending
+type FeedCursor struct {
+	CreatedAt time.Time
+	Kind      string
+	ID        int
+}
+
+// FeedItem is thread or post in feed of user
+type FeedItem struct {
+	Kind string
+	// thread or post id
+	ID          int
+	ThreadID    int
+	ThreadTitle string
+	CommunityID *int
+	ContentHTML string
+	Author      Author
+	CreatedAt   time.Time
+}
+
+type FeedList struct {
+	Items []FeedItem
+	// nil on the last page
+	Next *FeedCursor
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+const (
+	LiveEventThreadCreated = "thread_created"
+	LiveEventThreadDeleted = "thread_deleted"
+	LiveEventPostCreated   = "post_created"
+	LiveEventPostUpdated   = "post_updated"
+	LiveEventPostDeleted   = "post_deleted"
+	LiveEventVoteChanged   = "vote_changed"
+)
+
+// LiveEvent is change of thread pushed to readers of thread or threads list
+type LiveEvent struct {
+	ID       int64 // assigned on publish, increases with every event
+	Type     string
+	ThreadID int
+	Thread   *ThreadInfo // thread_created
+	Post     *PostInfo   // post_created, post_updated
+	PostID   int         // post_deleted, vote_changed for post
+	Score    int         // vote_changed, new score of thread or post
+	Partial  bool        // thread or post content is omitted because of event size limit
+}
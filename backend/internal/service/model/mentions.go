@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+const (
+	MentionTargetThread = "thread"
+	MentionTargetPost   = "post"
+)
+
+// MentionedUser is user mentioned in thread or post by name as it was written
+type MentionedUser struct {
+	UserID int
+	Name   string
+}
+
+// MentionTarget is thread or post with mentions
+type MentionTarget struct {
+	Type     string
+	ID       int
+	ThreadID int
+	AuthorID int
+}
+
+// MentionEntity is position of mention in content, Offset and Length are in unicode code points
+type MentionEntity struct {
+	UserID int
+	Name   string
+	Offset int
+	Length int
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "time"
+
+// ConversationCreate starts private conversation of creator and members with the first message
+type ConversationCreate struct {
+	CreatorID int
+	// other members, without creator
+	MemberIDs   []int
+	Title       string
+	Content     string
+	ContentHTML string // rendered and sanitized content
+}
+
+type ConversationMember struct {
+	UserID   int
+	UserName string
+}
+
+type DirectMessage struct {
+	ID             int
+	ConversationID int
+	UserID         int
+	UserName       string
+	Content        string
+	ContentHTML    string
+	CreatedAt      time.Time
+}
+
+type Conversation struct {
+	ID    int
+	Title string
+	// all members including viewer
+	Members     []ConversationMember
+	LastMessage DirectMessage
+	// messages of other members unread by viewer
+	UnreadCount int
+	CreatedAt   time.Time
+}
+
+type ConversationList struct {
+	Conversations []Conversation
+	HaveNext      bool
+}
+
+type DirectMessageList struct {
+	Messages []DirectMessage
+	HaveNext bool
+}
+
+// MessagesUnread is unread private messages of user
+type MessagesUnread struct {
+	Conversations int
+	Messages      int
+}
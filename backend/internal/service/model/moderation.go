@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "time"
+
+// reported content types
+const (
+	ReportTargetThread = "thread"
+	ReportTargetPost   = "post"
+	ReportTargetUser   = "user"
+)
+
+// ReportReasons is list of report reason categories
+var ReportReasons = []string{"spam", "abuse", "off_topic", "illegal", "other"}
+
+// ReportReasonOther requires free text description
+const ReportReasonOther = "other"
+
+// moderator actions resolving reports
+const (
+	ModerationDismiss = "dismiss" // reports are unfounded, nothing changes
+	ModerationHide    = "hide"    // reported thread or post is hidden
+	ModerationWarn    = "warn"    // author of content (or reported user) is warned by notification
+	ModerationSuspend = "suspend" // author of content (or reported user) can not write for some time
+	ModerationApprove = "approve" // content held by content filter is published
+	ModerationReject  = "reject"  // edit held by content filter is discarded, content is not changed
+)
+
+type ReportCreate struct {
+	ReporterID int
+	TargetType string
+	TargetID   int
+	Reason     string
+	Text       string
+}
+
+type Report struct {
+	ID           int
+	ReporterID   int
+	ReporterName string
+	Reason       string
+	Text         string
+	CreatedAt    time.Time
+}
+
+// ReasonCount is number of open reports of target with reason
+type ReasonCount struct {
+	Reason string
+	Count  int
+}
+
+// ReportGroup is open reports of one target in moderation queue
+type ReportGroup struct {
+	TargetType string
+	TargetID   int
+	// author of thread or post, reported user
+	TargetUserID   int
+	TargetUserName string
+	// thread of reported thread or post, nil for user
+	ThreadID *int
+	// thread title, post content or user name
+	Excerpt      string
+	Hidden       bool
+	ReportsCount int
+	Reasons      []ReasonCount
+	// the latest reports
+	Reports []Report
+	// the latest report id is cursor of queue
+	LastReportID int
+	LastReportAt time.Time
+}
+
+type ReportQueue struct {
+	Groups   []ReportGroup
+	HaveNext bool
+}
+
+// ModerationAction resolves all open reports of target
+type ModerationAction struct {
+	ModeratorID int
+	Action      string
+	TargetType  string
+	TargetID    int
+	// set by service for content author or reported user
+	TargetUserID int
+	Comment      string
+	// for suspend action
+	SuspendedUntil *time.Time
+}
+
+type ModerationLogEntry struct {
+	ID             int
+	ModeratorID    int
+	ModeratorName  string
+	Action         string
+	TargetType     string
+	TargetID       int
+	TargetUserID   int
+	TargetUserName string
+	ReportsCount   int
+	Comment        string
+	SuspendedUntil *time.Time
+	// thread of hidden thread or post, set by hide action
+	ThreadID  int
+	CreatedAt time.Time
+}
+
+type ModerationLog struct {
+	Entries  []ModerationLogEntry
+	HaveNext bool
+}
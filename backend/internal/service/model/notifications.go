@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "time"
+
+const (
+	NotificationThreadReply = "thread_reply" // post added to user thread
+	NotificationPostReply   = "post_reply"   // reply to user post
+	NotificationMention     = "mention"      // user @mentioned in thread or post
+	NotificationVote        = "vote"         // vote for user thread or post
+	NotificationModeration  = "moderation"   // moderator action on user content
+	NotificationThreadPost  = "thread_post"  // post added to watched thread
+)
+
+// NotificationTypes is list of all notification types in the order shown to user
+var NotificationTypes = []string{
+	NotificationThreadReply,
+	NotificationPostReply,
+	NotificationMention,
+	NotificationVote,
+	NotificationModeration,
+	NotificationThreadPost,
+}
+
+type NotificationCreate struct {
+	UserID    int // recipient
+	Type      string
+	ActorID   int // user who caused notification, 0 for system
+	ThreadID  *int
+	PostID    *int
+	VoteValue int
+	Message   string
+}
+
+type Notification struct {
+	ID        int
+	UserID    int
+	Type      string
+	ActorID   *int
+	ActorName string
+	ThreadID  *int
+	PostID    *int
+	VoteValue int
+	Message   string
+	IsRead    bool
+	CreatedAt time.Time
+}
+
+type NotificationList struct {
+	Notifications []Notification
+	HaveNext      bool
+}
+
+type NotificationPreference struct {
+	Type    string
+	Enabled bool
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "time"
+
+// PollCreate is poll created with thread
+type PollCreate struct {
+	Question string
+	Options  []string
+	// user can choose several options
+	Multiple bool
+	// voters are not shown, only counts
+	Anonymous bool
+	// nil for poll open forever
+	ClosesAt *time.Time
+}
+
+type Poll struct {
+	ID        int
+	ThreadID  int
+	Question  string
+	Multiple  bool
+	Anonymous bool
+	ClosesAt  *time.Time
+	CreatedAt time.Time
+	Options   []PollOption
+	// users voted for any option
+	VotersCount int
+	// poll of archived thread does not accept votes
+	ThreadArchived bool
+}
+
+// IsClosed reports if voting is finished
+func (p *Poll) IsClosed(now time.Time) bool {
+	return p.ClosesAt != nil && !now.Before(*p.ClosesAt)
+}
+
+type PollOption struct {
+	ID         int
+	Text       string
+	VotesCount int
+	// voters of public poll
+	Voters []PollVoter
+}
+
+type PollVoter struct {
+	UserID int
+	Name   string
+}
+
+// PollView is poll as seen by user, results are hidden until user votes or poll is closed
+// unless results before vote are enabled by config
+type PollView struct {
+	Poll
+	IsClosed       bool
+	ResultsVisible bool
+	// options chosen by current user, empty if not voted
+	VotedOptionIDs []int
+}
@@ -6,32 +6,57 @@ package model
 import "time"
 
 type Post struct {
-	ID        int
-	ThreadID  int
-	UserID    int
-	Content   string
-	CreatedAt time.Time
+	ID          int
+	ThreadID    int
+	UserID      int
+	ReplyToID   *int
+	Content     string
+	ContentHTML string
+	Score       int
+	CreatedAt   time.Time
 }
 type PostInfo struct {
-	ID        int
-	ThreadID  int
-	UserID    int
-	UserName  string
-	Content   string
+	ID          int
+	ThreadID    int
+	UserID      int
+	UserName    string
+	UserRank    string
+	ReplyToID   *int
+	Content     string
+	ContentHTML string
+	Mentions    []MentionEntity
+	Attachments []Attachment
+	Score       int
+	// held by content filter, shown to others after moderator approval
+	Held      bool
 	CreatedAt time.Time
 }
 type PostListItem struct {
-	ID        int
-	UserID    int
-	UserName  string
-	Content   string
-	CreatedAt time.Time
+	ID          int
+	UserID      int
+	UserName    string
+	UserRank    string
+	ReplyToID   *int
+	Content     string
+	ContentHTML string
+	Mentions    []MentionEntity
+	Attachments []Attachment
+	Score       int
+	// author is blocked or ignored by viewer, post is collapsed
+	AuthorIgnored bool
+	CreatedAt     time.Time
 }
 
 type PostCreate struct {
-	ThreadID int
-	UserID   int
-	Content  string
+	ThreadID    int
+	UserID      int
+	ReplyToID   *int // post of the same thread this post replies to
+	Content     string
+	ContentHTML string // rendered and sanitized content
+	// uploaded by user and not attached yet
+	AttachmentIDs []int
+	// set when post is held by content filter for moderator review
+	HeldReason string
 }
 
 // type PostListItem struct {
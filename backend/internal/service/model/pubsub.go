@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+// PubSubMessage is message delivered to subscribers of all forum instances
+type PubSubMessage struct {
+	ID      int64 // increases with every published message across all instances
+	Payload []byte
+}
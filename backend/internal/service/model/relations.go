@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "time"
+
+// relation kinds of user to other user
+const (
+	RelationBlock  = "block"  // target can not reply to or mention user, target content is collapsed for user
+	RelationIgnore = "ignore" // target content and notifications are collapsed or filtered for user
+)
+
+// UserRelation is block or ignore of target user by user
+type UserRelation struct {
+	TargetID   int
+	TargetName string
+	Kind       string
+	CreatedAt  time.Time
+}
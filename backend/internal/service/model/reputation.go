@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "time"
+
+// RankRule is reputation level, user gets it when all minimums are reached
+type RankRule struct {
+	Name               string
+	MinKarma           int
+	MinPosts           int
+	MinAcceptedAnswers int
+	MinAccountAge      time.Duration
+}
+
+// UserStats is user activity used to calculate rank
+type UserStats struct {
+	UserID          int
+	Karma           int
+	PostsCount      int
+	AcceptedAnswers int
+	Rank            string
+	CreatedAt       time.Time
+}
+
+type RankChange struct {
+	UserID    int
+	OldRank   string
+	NewRank   string
+	ChangedAt time.Time
+}
+
+// Author is short user info shown next to threads and posts
+type Author struct {
+	ID   int
+	Name string
+	Rank string
+}
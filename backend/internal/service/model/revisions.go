@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "time"
+
+const (
+	RevisionTargetThread = "thread"
+	RevisionTargetPost   = "post"
+)
+
+// RevisionCreate is edit of thread (title and content) or post (content only)
+type RevisionCreate struct {
+	TargetType  string
+	TargetID    int
+	EditorID    int
+	Title       string // thread only
+	Content     string
+	ContentHTML string // rendered and sanitized content
+	// number of restored revision, nil for usual edit
+	RestoredFrom *int
+	// reason to hold edited content for moderator review, empty if edit is accepted by content filters
+	HeldReason string
+}
+
+// Revision is one version of thread or post content, revisions are never changed or removed.
+// Revision 1 is the original content, it is stored on the first edit.
+type Revision struct {
+	ID           int
+	TargetType   string
+	TargetID     int
+	Revision     int
+	Title        string // thread only
+	Content      string
+	EditorID     int
+	EditorName   string
+	RestoredFrom *int
+	// edit is held for moderator review, it gets revision number when approved. Set on edit.
+	Held      bool
+	CreatedAt time.Time
+}
+
+// RevisionDiff is difference between two revisions
+type RevisionDiff struct {
+	From    int
+	To      int
+	Mode    string   // line or word
+	Title   []DiffOp // thread only
+	Content []DiffOp
+}
+
+type DiffOp struct {
+	Op   string // equal, insert or delete
+	Text string
+}
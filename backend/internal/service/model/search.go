@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "time"
+
+const (
+	SearchKindThread = "thread"
+	SearchKindPost   = "post"
+)
+
+// markers around matched words in repo snippets, private use unicode characters can not
+// be typed by users, so service can safely escape snippet and replace them with html tags
+const (
+	SearchHighlightStart = "\ue000"
+	SearchHighlightStop  = "\ue001"
+)
+
+type SearchQuery struct {
+	Query       string
+	AuthorID    *int
+	CommunityID *int
+	From        *time.Time
+	To          *time.Time
+	Cursor      *SearchCursor
+	Limit       int
+}
+
+// SearchCursor points to the last returned hit, results are ordered by (rank, kind, id) descending
+type SearchCursor struct {
+	Rank float32
+	Kind string
+	ID   int
+}
+
+type SearchHitRepo struct {
+	Kind        string
+	ThreadID    int
+	PostID      int // 0 for thread hits
+	Title       string
+	Snippet     string
+	UserID      int
+	CommunityID *int
+	CreatedAt   time.Time
+	Rank        float32
+}
+
+type SearchHit struct {
+	Kind        string
+	ThreadID    int
+	PostID      int
+	Title       string
+	Snippet     string
+	AuthorID    int
+	AuthorName  string
+	CommunityID *int
+	CreatedAt   time.Time
+	Rank        float32
+}
+
+type SearchResult struct {
+	Hits       []SearchHit
+	NextCursor *SearchCursor
+}
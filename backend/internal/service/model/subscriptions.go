@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "time"
+
+// thread subscription levels
+const (
+	SubscriptionWatching = "watching" // notified about every post of thread
+	SubscriptionTracking = "tracking" // thread is followed, notified only about replies and mentions
+	SubscriptionMuted    = "muted"    // no notifications about thread at all
+)
+
+type ThreadSubscription struct {
+	UserID    int
+	ThreadID  int
+	Level     string
+	CreatedAt time.Time
+}
+
+type ThreadSubscriptionRepoItem struct {
+	ThreadSubscription
+	Thread ThreadRepoInfo
+}
+
+type ThreadSubscriptionListRepo struct {
+	Subscriptions []ThreadSubscriptionRepoItem
+	HaveNext      bool
+}
+
+type ThreadSubscriptionListItem struct {
+	ThreadSubscription
+	Thread ThreadInfoResponse
+}
+
+type ThreadSubscriptionListResponse struct {
+	Subscriptions []ThreadSubscriptionListItem
+	HaveNext      bool
+}
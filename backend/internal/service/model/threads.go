@@ -5,29 +5,74 @@ package model
 
 import "time"
 
+const (
+	ThreadPinnedGlobal    = "global"
+	ThreadPinnedCommunity = "community"
+)
+
+// ThreadState is moderation state of thread
+type ThreadState struct {
+	// "" - not pinned, ThreadPinnedGlobal or ThreadPinnedCommunity
+	Pinned   string
+	Locked   bool
+	Archived bool
+	// user can post to thread once in this many seconds, 0 - slow mode is off
+	SlowModeSeconds int
+}
+
+// ThreadStateChange changes given parts of thread state, nil keeps current value
+type ThreadStateChange struct {
+	Pinned          *string
+	Locked          *bool
+	Archived        *bool
+	SlowModeSeconds *int
+}
+
 type ThreadWithPosts struct {
-	ID         int
-	AuthorID   int
-	AuthorName string
-	Title      string
-	Content    string
-	PostsCount int
-	CreatedAt  time.Time
-	Posts      []PostListItem
+	ID             int
+	AuthorID       int
+	AuthorName     string
+	AuthorRank     string
+	Title          string
+	Content        string
+	ContentHTML    string
+	Mentions       []MentionEntity
+	Attachments    []Attachment
+	Poll           *PollView // nil if thread has no poll
+	PostsCount     int
+	Score          int
+	AcceptedPostID *int
+	IsBookmarked   bool
+	State          ThreadState
+	CreatedAt      time.Time
+	Posts          []PostListItem
 }
 
 type ThreadCreate struct {
-	Title   string
-	Content string
-	UserID  int
+	Title       string
+	Content     string
+	ContentHTML string // rendered and sanitized content
+	UserID      int
+	CommunityID *int
+	// uploaded by user and not attached yet
+	AttachmentIDs []int
+	// optional poll of thread
+	Poll *PollCreate
+	// set when thread is held by content filter for moderator review
+	HeldReason string
 }
 type ThreadRepoInfo struct {
-	ID         int
-	Title      string
-	Content    string
-	UserID     int
-	PostsCount int
-	CreatedAt  time.Time
+	ID             int
+	Title          string
+	Content        string
+	ContentHTML    string
+	UserID         int
+	CommunityID    *int
+	PostsCount     int
+	Score          int
+	AcceptedPostID *int
+	State          ThreadState
+	CreatedAt      time.Time
 }
 type ThreadListRepo struct {
 	Threads []ThreadRepoInfo
@@ -37,13 +82,21 @@ type ThreadListRepo struct {
 	HaveNext            bool
 }
 type ThreadInfoResponse struct {
-	ID         int
-	Title      string
-	Content    string
-	AuthorID   int
-	AuthorName string
-	PostsCount int
-	CreatedAt  time.Time
+	ID           int
+	Title        string
+	Content      string
+	ContentHTML  string
+	AuthorID     int
+	AuthorName   string
+	AuthorRank   string
+	CommunityID  *int
+	PostsCount   int
+	Score        int
+	IsBookmarked bool
+	State        ThreadState
+	// author is blocked or ignored by viewer, thread is collapsed
+	AuthorIgnored bool
+	CreatedAt     time.Time
 }
 
 type ThreadListResponse struct {
@@ -55,13 +108,25 @@ type ThreadListResponse struct {
 }
 
 type ThreadInfo struct {
-	ID         int
-	Title      string
-	Content    string
-	UserID     int
-	UserName   string
-	PostsCount int
-	CreatedAt  time.Time
+	ID          int
+	Title       string
+	Content     string
+	ContentHTML string
+	UserID      int
+	UserName    string
+	UserRank    string
+	CommunityID *int
+	PostsCount  int
+	Score       int
+	// held by content filter, shown to others after moderator approval
+	Held      bool
+	CreatedAt time.Time
+}
+
+// AcceptedAnswerChange has authors of previously and newly accepted posts, 0 if there is no such post
+type AcceptedAnswerChange struct {
+	OldAuthorID int
+	NewAuthorID int
 }
 
 // type ThreadListItem struct {
@@ -3,8 +3,80 @@
 
 package model
 
+import "time"
+
+// user roles, moderators and admins manage content of other users
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+// IsModerator reports if user with role can moderate content of other users
+func IsModerator(role string) bool {
+	return role == RoleModerator || role == RoleAdmin
+}
+
 type User struct {
-	ID    int64
-	Name  string
+	ID   int64
+	Name string
+	// private, shown only to user and admins
 	Email string
+	Karma int
+	Rank  string
+	Role  string
+	UserProfile
+	CreatedAt time.Time
+	// nil if user was never seen after registration
+	LastSeenAt *time.Time
+	// visible threads and posts of user
+	ThreadsCount   int
+	PostsCount     int
+	FollowersCount int
+	FollowingCount int
+}
+
+// UserProfile is public information about user filled by user
+type UserProfile struct {
+	AvatarURL string
+	Bio       string
+	Location  string
+	Website   string
+}
+
+// UserUpdate replaces name, email and profile of user
+type UserUpdate struct {
+	UserID int
+	Name   string
+	Email  string
+	UserProfile
+}
+
+// UserThread is thread in list of user activity
+type UserThread struct {
+	ID         int
+	Title      string
+	PostsCount int
+	Score      int
+	CreatedAt  time.Time
+}
+
+type UserThreadList struct {
+	Threads  []UserThread
+	HaveNext bool
+}
+
+// UserPost is post in list of user activity
+type UserPost struct {
+	ID          int
+	ThreadID    int
+	ThreadTitle string
+	ContentHTML string
+	Score       int
+	CreatedAt   time.Time
+}
+
+type UserPostList struct {
+	Posts    []UserPost
+	HaveNext bool
 }
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package model
+
+import "time"
+
+const (
+	VoteTargetThread = "thread"
+	VoteTargetPost   = "post"
+)
+
+type Vote struct {
+	UserID     int
+	TargetType string
+	TargetID   int
+	Value      int // 1 up, -1 down, 0 removes vote
+}
+
+type VoteResult struct {
+	TargetType string
+	TargetID   int
+	ThreadID   int // voted thread or thread of voted post
+	AuthorID   int
+	Score      int
+	MyVote     int
+	OldVote    int  // vote of user before change, 0 - no vote
+	Changed    bool // false if the same vote was already set
+	// time of the first vote of user, kept while vote is changed, zero if there was no vote
+	OldVotedAt time.Time
+}
@@ -0,0 +1,330 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package moderation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const (
+	DefaultLimit   = 20
+	MaxLimit       = 100
+	maxReportText  = 1000
+	maxSuspendDays = 365
+)
+
+var (
+	ErrUnknownTarget     = errors.New("report target must be thread, post or user")
+	ErrUnknownReason     = errors.New("unknown report reason")
+	ErrEmptyText         = errors.New("report with reason other must have text")
+	ErrTextTooLong       = errors.New("report text is too long")
+	ErrReportOwn         = errors.New("user can not report own content")
+	ErrAlreadyReported   = errors.New("user already reported this")
+	ErrNotModerator      = errors.New("only moderator can resolve reports")
+	ErrUnknownAction     = errors.New("action must be dismiss, hide, warn or suspend")
+	ErrHideUser          = errors.New("only thread or post can be hidden")
+	ErrInvalidSuspension = errors.New("suspension must be 1-365 days")
+	ErrModerateModerator = errors.New("moderator can not be warned or suspended")
+	ErrUnknownDecision   = errors.New("decision must be approve or reject")
+)
+
+type ModerationRepo interface {
+	TargetUser(ctx context.Context, targetType string, targetId int) (int, error)
+	Report(ctx context.Context, report model.ReportCreate) (bool, error)
+	Queue(ctx context.Context, before, limit int) (model.ReportQueue, error)
+	Resolve(ctx context.Context, action model.ModerationAction) (model.ModerationLogEntry, error)
+	Log(ctx context.Context, before, limit int) (model.ModerationLog, error)
+	HeldList(ctx context.Context, before, limit int) (model.HeldContentList, error)
+	DecideHeld(ctx context.Context, heldId, moderatorId int, decision, comment string) (
+		model.HeldContent, model.ModerationLogEntry, error)
+}
+
+type UserRepo interface {
+	GetRole(ctx context.Context, userId int) (string, error)
+}
+
+// Notifier tells users about moderator actions on their content
+type Notifier interface {
+	Notify(ctx context.Context, n model.NotificationCreate) error
+}
+
+// SpamTrainer learns from moderator decisions on held content
+type SpamTrainer interface {
+	Train(ctx context.Context, text string, spam bool) error
+}
+
+// EventPublisher pushes thread changes to live readers
+type EventPublisher interface {
+	Publish(ctx context.Context, event model.LiveEvent) error
+}
+
+// Announcer notifies users and live readers about approved threads and posts, which were not
+// announced while held
+type Announcer interface {
+	AnnounceThread(ctx context.Context, threadId int) error
+	AnnouncePost(ctx context.Context, postId int) error
+}
+
+// EditAnnouncer pushes approved edits of threads and posts to live readers
+type EditAnnouncer interface {
+	AnnounceEdit(ctx context.Context, targetType string, targetId int)
+}
+
+// ModerationService handles reports, moderator actions and content held by filter. Notifications,
+// announcements, live events and spam training follow the recorded decision: their failures are
+// logged and do not fail moderator request.
+type ModerationService struct {
+	moderationRepo ModerationRepo
+	userRepo       UserRepo
+	notifier       Notifier
+	spamTrainer    SpamTrainer
+	publisher      EventPublisher
+	announcer      Announcer
+	editAnnouncer  EditAnnouncer
+}
+
+func NewModerationService(
+	moderationRepo ModerationRepo,
+	userRepo UserRepo,
+	notifier Notifier,
+	spamTrainer SpamTrainer,
+	publisher EventPublisher,
+	announcer Announcer,
+	editAnnouncer EditAnnouncer) *ModerationService {
+
+	return &ModerationService{
+		moderationRepo: moderationRepo,
+		userRepo:       userRepo,
+		notifier:       notifier,
+		spamTrainer:    spamTrainer,
+		publisher:      publisher,
+		announcer:      announcer,
+		editAnnouncer:  editAnnouncer,
+	}
+}
+
+// Report stores report of user about thread, post or user. User has one open report of target.
+func (s *ModerationService) Report(ctx context.Context, report model.ReportCreate) error {
+	if !isTarget(report.TargetType) {
+		return ErrUnknownTarget
+	}
+	if !slices.Contains(model.ReportReasons, report.Reason) {
+		return ErrUnknownReason
+	}
+	report.Text = strings.TrimSpace(report.Text)
+	if report.Reason == model.ReportReasonOther && report.Text == "" {
+		return ErrEmptyText
+	}
+	if utf8.RuneCountInString(report.Text) > maxReportText {
+		return fmt.Errorf("%w, max %d characters", ErrTextTooLong, maxReportText)
+	}
+	targetUserId, err := s.moderationRepo.TargetUser(ctx, report.TargetType, report.TargetID)
+	if err != nil {
+		return err
+	}
+	if targetUserId == report.ReporterID {
+		return ErrReportOwn
+	}
+	created, err := s.moderationRepo.Report(ctx, report)
+	if err != nil {
+		return err
+	}
+	if !created {
+		return ErrAlreadyReported
+	}
+	return nil
+}
+
+// Queue returns open reports grouped by target for moderator
+func (s *ModerationService) Queue(ctx context.Context, moderatorId, before, limit int) (model.ReportQueue, error) {
+	if err := s.checkModerator(ctx, moderatorId); err != nil {
+		return model.ReportQueue{}, err
+	}
+	return s.moderationRepo.Queue(ctx, before, clampLimit(limit))
+}
+
+// Log returns moderator actions from the latest one
+func (s *ModerationService) Log(ctx context.Context, moderatorId, before, limit int) (model.ModerationLog, error) {
+	if err := s.checkModerator(ctx, moderatorId); err != nil {
+		return model.ModerationLog{}, err
+	}
+	return s.moderationRepo.Log(ctx, before, clampLimit(limit))
+}
+
+// Resolve applies action to target and resolves all its open reports. Author of content
+// (or reported user) is notified about every action except dismiss. Live readers of thread
+// are told about hidden thread or post.
+func (s *ModerationService) Resolve(
+	ctx context.Context, action model.ModerationAction, suspendDays int) (model.ModerationLogEntry, error) {
+
+	if err := s.checkModerator(ctx, action.ModeratorID); err != nil {
+		return model.ModerationLogEntry{}, err
+	}
+	if !isTarget(action.TargetType) {
+		return model.ModerationLogEntry{}, ErrUnknownTarget
+	}
+	action.Comment = strings.TrimSpace(action.Comment)
+	switch action.Action {
+	case model.ModerationDismiss, model.ModerationWarn:
+	case model.ModerationHide:
+		if action.TargetType == model.ReportTargetUser {
+			return model.ModerationLogEntry{}, ErrHideUser
+		}
+	case model.ModerationSuspend:
+		if suspendDays < 1 || suspendDays > maxSuspendDays {
+			return model.ModerationLogEntry{}, ErrInvalidSuspension
+		}
+		until := time.Now().Add(time.Duration(suspendDays) * 24 * time.Hour)
+		action.SuspendedUntil = &until
+	default:
+		return model.ModerationLogEntry{}, ErrUnknownAction
+	}
+
+	targetUserId, err := s.moderationRepo.TargetUser(ctx, action.TargetType, action.TargetID)
+	if err != nil {
+		return model.ModerationLogEntry{}, err
+	}
+	action.TargetUserID = targetUserId
+	if action.Action == model.ModerationWarn || action.Action == model.ModerationSuspend {
+		role, err := s.userRepo.GetRole(ctx, targetUserId)
+		if err != nil {
+			return model.ModerationLogEntry{}, err
+		}
+		if model.IsModerator(role) {
+			return model.ModerationLogEntry{}, ErrModerateModerator
+		}
+	}
+
+	entry, err := s.moderationRepo.Resolve(ctx, action)
+	if err != nil {
+		return model.ModerationLogEntry{}, err
+	}
+	if entry.Action == model.ModerationHide {
+		s.publishHidden(ctx, entry)
+	}
+	if entry.Action != model.ModerationDismiss {
+		s.notify(ctx, entry)
+	}
+	return entry, nil
+}
+
+// Held returns content held by content filter from the latest one
+func (s *ModerationService) Held(ctx context.Context, moderatorId, before, limit int) (model.HeldContentList, error) {
+	if err := s.checkModerator(ctx, moderatorId); err != nil {
+		return model.HeldContentList{}, err
+	}
+	return s.moderationRepo.HeldList(ctx, before, clampLimit(limit))
+}
+
+// DecideHeld publishes (approve) or hides (reject) held content, held edit is applied or discarded.
+// Spam classifier is trained by decision. Approved content is announced as new one, author is
+// notified about rejection.
+func (s *ModerationService) DecideHeld(
+	ctx context.Context, moderatorId, heldId int, decision, comment string) (model.ModerationLogEntry, error) {
+
+	if err := s.checkModerator(ctx, moderatorId); err != nil {
+		return model.ModerationLogEntry{}, err
+	}
+	if decision != model.HeldApprove && decision != model.HeldReject {
+		return model.ModerationLogEntry{}, ErrUnknownDecision
+	}
+	held, entry, err := s.moderationRepo.DecideHeld(ctx, heldId, moderatorId, decision, strings.TrimSpace(comment))
+	if err != nil {
+		return model.ModerationLogEntry{}, err
+	}
+	text := (&model.FilterContent{Title: held.Title, Content: held.Content}).Text()
+	if err := s.spamTrainer.Train(ctx, text, decision == model.HeldReject); err != nil {
+		log.Printf("failed to train spam classifier by held content %d: %v", held.ID, err)
+	}
+	switch {
+	case decision == model.HeldReject:
+		s.notify(ctx, entry)
+	case held.Edit:
+		s.editAnnouncer.AnnounceEdit(ctx, held.TargetType, held.TargetID)
+	default:
+		s.announce(ctx, held)
+	}
+	return entry, nil
+}
+
+func (s *ModerationService) checkModerator(ctx context.Context, userId int) error {
+	role, err := s.userRepo.GetRole(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if !model.IsModerator(role) {
+		return ErrNotModerator
+	}
+	return nil
+}
+
+func (s *ModerationService) notify(ctx context.Context, entry model.ModerationLogEntry) {
+	var message string
+	switch entry.Action {
+	case model.ModerationHide:
+		message = fmt.Sprintf("your %s was hidden by moderator", entry.TargetType)
+	case model.ModerationReject:
+		message = fmt.Sprintf("your edit of %s was rejected by moderator", entry.TargetType)
+	case model.ModerationWarn:
+		message = "you were warned by moderator"
+	case model.ModerationSuspend:
+		message = fmt.Sprintf("you are suspended by moderator until %s", entry.SuspendedUntil.Format(time.RFC3339))
+	}
+	if entry.Comment != "" {
+		message += ": " + entry.Comment
+	}
+	err := s.notifier.Notify(ctx, model.NotificationCreate{
+		UserID:  entry.TargetUserID,
+		Type:    model.NotificationModeration,
+		ActorID: entry.ModeratorID,
+		Message: message,
+	})
+	if err != nil {
+		log.Printf("failed to notify user %d about moderation %d: %v", entry.TargetUserID, entry.ID, err)
+	}
+}
+
+func (s *ModerationService) publishHidden(ctx context.Context, entry model.ModerationLogEntry) {
+	event := model.LiveEvent{Type: model.LiveEventThreadDeleted, ThreadID: entry.ThreadID}
+	if entry.TargetType == model.ReportTargetPost {
+		event.Type = model.LiveEventPostDeleted
+		event.PostID = entry.TargetID
+	}
+	if err := s.publisher.Publish(ctx, event); err != nil {
+		log.Printf("failed to publish %s event of thread %d: %v", event.Type, event.ThreadID, err)
+	}
+}
+
+func (s *ModerationService) announce(ctx context.Context, held model.HeldContent) {
+	var err error
+	if held.TargetType == model.ReportTargetPost {
+		err = s.announcer.AnnouncePost(ctx, held.TargetID)
+	} else {
+		err = s.announcer.AnnounceThread(ctx, held.TargetID)
+	}
+	if err != nil {
+		log.Printf("failed to announce approved %s %d: %v", held.TargetType, held.TargetID, err)
+	}
+}
+
+func isTarget(targetType string) bool {
+	return targetType == model.ReportTargetThread || targetType == model.ReportTargetPost ||
+		targetType == model.ReportTargetUser
+}
+
+func clampLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	return min(limit, MaxLimit)
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package notifications
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var (
+	ErrUnknownType = errors.New("unknown notification type")
+	ErrNoThread    = errors.New("notification for thread watchers has no thread")
+)
+
+type NotificationsRepo interface {
+	Create(ctx context.Context, n model.NotificationCreate) (bool, error)
+	CreateForWatchers(ctx context.Context, n model.NotificationCreate, excludeUserIds []int) (int, error)
+	List(ctx context.Context, userId int, unreadOnly bool, before, limit int) (model.NotificationList, error)
+	MarkRead(ctx context.Context, userId int, ids []int) error
+	MarkAllRead(ctx context.Context, userId int) error
+	UnreadCount(ctx context.Context, userId int) (int, error)
+	Preferences(ctx context.Context, userId int) (map[string]bool, error)
+	SetPreferences(ctx context.Context, userId int, preferences []model.NotificationPreference) error
+}
+
+type NotificationsService struct {
+	notificationsRepo NotificationsRepo
+}
+
+func NewNotificationsService(notificationsRepo NotificationsRepo) *NotificationsService {
+	return &NotificationsService{notificationsRepo: notificationsRepo}
+}
+
+// Notify creates notification for user. Users are not notified about own actions,
+// notifications of types disabled in user preferences are dropped.
+func (s *NotificationsService) Notify(ctx context.Context, n model.NotificationCreate) error {
+	if !slices.Contains(model.NotificationTypes, n.Type) {
+		return ErrUnknownType
+	}
+	if n.UserID == 0 || n.UserID == n.ActorID {
+		return nil
+	}
+	_, err := s.notificationsRepo.Create(ctx, n)
+	return err
+}
+
+// NotifyWatchers creates notification (UserID is ignored) for users watching thread of notification.
+// Actor and excluded users (e.g. already notified about the same action) are not notified.
+func (s *NotificationsService) NotifyWatchers(
+	ctx context.Context, n model.NotificationCreate, excludeUserIds []int) error {
+
+	if !slices.Contains(model.NotificationTypes, n.Type) {
+		return ErrUnknownType
+	}
+	if n.ThreadID == nil {
+		return ErrNoThread
+	}
+	exclude := append(slices.Clone(excludeUserIds), n.ActorID)
+	_, err := s.notificationsRepo.CreateForWatchers(ctx, n, exclude)
+	return err
+}
+
+func (s *NotificationsService) List(
+	ctx context.Context, userId int, unreadOnly bool, before, limit int) (model.NotificationList, error) {
+
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+	return s.notificationsRepo.List(ctx, userId, unreadOnly, before, limit)
+}
+
+func (s *NotificationsService) MarkRead(ctx context.Context, userId int, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return s.notificationsRepo.MarkRead(ctx, userId, ids)
+}
+
+func (s *NotificationsService) MarkAllRead(ctx context.Context, userId int) error {
+	return s.notificationsRepo.MarkAllRead(ctx, userId)
+}
+
+func (s *NotificationsService) UnreadCount(ctx context.Context, userId int) (int, error) {
+	return s.notificationsRepo.UnreadCount(ctx, userId)
+}
+
+// Preferences returns delivery setting for every notification type, enabled by default
+func (s *NotificationsService) Preferences(ctx context.Context, userId int) ([]model.NotificationPreference, error) {
+	stored, err := s.notificationsRepo.Preferences(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	preferences := make([]model.NotificationPreference, len(model.NotificationTypes))
+	for i, notificationType := range model.NotificationTypes {
+		enabled, ok := stored[notificationType]
+		preferences[i] = model.NotificationPreference{Type: notificationType, Enabled: enabled || !ok}
+	}
+	return preferences, nil
+}
+
+// SetPreferences changes given types only and returns settings for all types
+func (s *NotificationsService) SetPreferences(
+	ctx context.Context, userId int, preferences []model.NotificationPreference) ([]model.NotificationPreference, error) {
+
+	for _, preference := range preferences {
+		if !slices.Contains(model.NotificationTypes, preference.Type) {
+			return nil, ErrUnknownType
+		}
+	}
+	if err := s.notificationsRepo.SetPreferences(ctx, userId, preferences); err != nil {
+		return nil, err
+	}
+	return s.Preferences(ctx, userId)
+}
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package polls
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const minOptions = 2
+
+var (
+	ErrEmptyQuestion  = errors.New("poll question must not be empty")
+	ErrInvalidOptions = errors.New("poll options must be non-empty and distinct")
+	ErrClosesInPast   = errors.New("poll closing time must be in future")
+	ErrPollClosed     = errors.New("poll is closed")
+	ErrAlreadyVoted   = errors.New("user already voted")
+	ErrInvalidChoice  = errors.New("chosen options do not belong to poll")
+	ErrSingleChoice   = errors.New("poll allows exactly one option")
+)
+
+type PollsRepo interface {
+	Create(ctx context.Context, threadId int, poll model.PollCreate) (int, error)
+	GetByThread(ctx context.Context, threadId int) (model.Poll, error)
+	Voters(ctx context.Context, pollId int) (map[int][]model.PollVoter, error)
+	UserVotes(ctx context.Context, pollId, userId int) ([]int, error)
+	Vote(ctx context.Context, pollId, userId int, optionIds []int) (bool, error)
+}
+
+type Options struct {
+	MaxOptions int
+	// results of open poll are shown to users who did not vote yet
+	ResultsBeforeVote bool
+}
+
+type PollsService struct {
+	pollsRepo PollsRepo
+	options   Options
+}
+
+func NewPollsService(pollsRepo PollsRepo, options Options) *PollsService {
+	return &PollsService{pollsRepo: pollsRepo, options: options}
+}
+
+// Validate checks poll before thread creation, question and options are trimmed
+func (s *PollsService) Validate(poll *model.PollCreate) error {
+	poll.Question = strings.TrimSpace(poll.Question)
+	if poll.Question == "" {
+		return ErrEmptyQuestion
+	}
+	if len(poll.Options) < minOptions || len(poll.Options) > s.options.MaxOptions {
+		return fmt.Errorf("%w, poll must have %d-%d options", ErrInvalidOptions, minOptions, s.options.MaxOptions)
+	}
+	seen := make(map[string]bool, len(poll.Options))
+	for i, option := range poll.Options {
+		option = strings.TrimSpace(option)
+		if option == "" || seen[option] {
+			return ErrInvalidOptions
+		}
+		seen[option] = true
+		poll.Options[i] = option
+	}
+	if poll.ClosesAt != nil && !poll.ClosesAt.After(time.Now()) {
+		return ErrClosesInPast
+	}
+	return nil
+}
+
+// Create adds validated poll to thread
+func (s *PollsService) Create(ctx context.Context, threadId int, poll model.PollCreate) error {
+	_, err := s.pollsRepo.Create(ctx, threadId, poll)
+	return err
+}
+
+// ThreadPoll returns poll of thread as seen by viewer (0 for anonymous), nil if thread has no poll
+func (s *PollsService) ThreadPoll(ctx context.Context, viewerId, threadId int) (*model.PollView, error) {
+	poll, err := s.pollsRepo.GetByThread(ctx, threadId)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	view, err := s.view(ctx, viewerId, poll)
+	if err != nil {
+		return nil, err
+	}
+	return &view, nil
+}
+
+// Vote stores choice of user in poll of thread, user votes once
+func (s *PollsService) Vote(ctx context.Context, userId, threadId int, optionIds []int) (model.PollView, error) {
+	poll, err := s.pollsRepo.GetByThread(ctx, threadId)
+	if err != nil {
+		return model.PollView{}, err
+	}
+	if poll.ThreadArchived {
+		return model.PollView{}, model.ErrThreadArchived
+	}
+	if poll.IsClosed(time.Now()) {
+		return model.PollView{}, ErrPollClosed
+	}
+	optionIds = slices.Compact(slices.Sorted(slices.Values(optionIds)))
+	if len(optionIds) == 0 || !poll.Multiple && len(optionIds) > 1 {
+		return model.PollView{}, ErrSingleChoice
+	}
+	for _, optionId := range optionIds {
+		if !slices.ContainsFunc(poll.Options, func(o model.PollOption) bool { return o.ID == optionId }) {
+			return model.PollView{}, ErrInvalidChoice
+		}
+	}
+	voted, err := s.pollsRepo.Vote(ctx, poll.ID, userId, optionIds)
+	if err != nil {
+		return model.PollView{}, err
+	}
+	if !voted {
+		return model.PollView{}, ErrAlreadyVoted
+	}
+
+	// counts are reloaded with the new vote
+	poll, err = s.pollsRepo.GetByThread(ctx, threadId)
+	if err != nil {
+		return model.PollView{}, err
+	}
+	return s.view(ctx, userId, poll)
+}
+
+// view hides results from viewer who has not voted in open poll unless config allows results before vote
+func (s *PollsService) view(ctx context.Context, viewerId int, poll model.Poll) (model.PollView, error) {
+	view := model.PollView{Poll: poll, IsClosed: poll.IsClosed(time.Now())}
+	if viewerId != 0 {
+		votes, err := s.pollsRepo.UserVotes(ctx, poll.ID, viewerId)
+		if err != nil {
+			return model.PollView{}, err
+		}
+		view.VotedOptionIDs = votes
+	}
+	view.ResultsVisible = s.options.ResultsBeforeVote || view.IsClosed || len(view.VotedOptionIDs) > 0
+	if !view.ResultsVisible {
+		for i := range view.Options {
+			view.Options[i].VotesCount = 0
+		}
+		return view, nil
+	}
+	if !poll.Anonymous {
+		voters, err := s.pollsRepo.Voters(ctx, poll.ID)
+		if err != nil {
+			return model.PollView{}, err
+		}
+		for i := range view.Options {
+			view.Options[i].Voters = voters[view.Options[i].ID]
+		}
+	}
+	return view, nil
+}
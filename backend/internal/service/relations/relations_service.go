@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package relations
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+var (
+	ErrUnknownKind    = errors.New("relation must be block or ignore")
+	ErrRelateSelf     = errors.New("user can not block or ignore own account")
+	ErrBlockModerator = errors.New("moderator can not be blocked")
+)
+
+type RelationsRepo interface {
+	Set(ctx context.Context, userId, targetId int, kind string) (model.UserRelation, error)
+	Delete(ctx context.Context, userId, targetId int) (bool, error)
+	List(ctx context.Context, userId int, kind string) ([]model.UserRelation, error)
+	Targets(ctx context.Context, userId int) (map[int]bool, error)
+	BlockedBy(ctx context.Context, userId int, byIds []int) (map[int]bool, error)
+}
+
+type UserRepo interface {
+	GetRole(ctx context.Context, userId int) (string, error)
+}
+
+// RelationsService manages block and ignore lists of users
+type RelationsService struct {
+	relationsRepo RelationsRepo
+	userRepo      UserRepo
+}
+
+func NewRelationsService(relationsRepo RelationsRepo, userRepo UserRepo) *RelationsService {
+	return &RelationsService{relationsRepo: relationsRepo, userRepo: userRepo}
+}
+
+// Set blocks or ignores target user, previous relation to target is replaced.
+// Moderators can be ignored, but not blocked: they must be able to answer anybody.
+func (s *RelationsService) Set(ctx context.Context, userId, targetId int, kind string) (model.UserRelation, error) {
+	if kind != model.RelationBlock && kind != model.RelationIgnore {
+		return model.UserRelation{}, ErrUnknownKind
+	}
+	if userId == targetId {
+		return model.UserRelation{}, ErrRelateSelf
+	}
+	if kind == model.RelationBlock {
+		role, err := s.userRepo.GetRole(ctx, targetId)
+		if err != nil {
+			return model.UserRelation{}, err
+		}
+		if model.IsModerator(role) {
+			return model.UserRelation{}, ErrBlockModerator
+		}
+	}
+	return s.relationsRepo.Set(ctx, userId, targetId, kind)
+}
+
+// Delete unblocks or unignores target user
+func (s *RelationsService) Delete(ctx context.Context, userId, targetId int) error {
+	deleted, err := s.relationsRepo.Delete(ctx, userId, targetId)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// List returns relations of user of given kind ("" - all kinds)
+func (s *RelationsService) List(ctx context.Context, userId int, kind string) ([]model.UserRelation, error) {
+	if kind != "" && kind != model.RelationBlock && kind != model.RelationIgnore {
+		return nil, ErrUnknownKind
+	}
+	return s.relationsRepo.List(ctx, userId, kind)
+}
+
+// Ignored returns users whose content is collapsed for viewer (blocked or ignored),
+// nothing for anonymous viewer (0)
+func (s *RelationsService) Ignored(ctx context.Context, viewerId int) (map[int]bool, error) {
+	if viewerId == 0 {
+		return map[int]bool{}, nil
+	}
+	return s.relationsRepo.Targets(ctx, viewerId)
+}
+
+// BlockedBy returns which of given users blocked user
+func (s *RelationsService) BlockedBy(ctx context.Context, userId int, byIds []int) (map[int]bool, error) {
+	return s.relationsRepo.BlockedBy(ctx, userId, byIds)
+}
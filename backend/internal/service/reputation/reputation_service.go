@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package reputation
+
+import (
+	"context"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+type ReputationRepo interface {
+	Stats(ctx context.Context, userId int) (model.UserStats, error)
+	SetRank(ctx context.Context, userId int, rank string) (bool, error)
+	History(ctx context.Context, userId int) ([]model.RankChange, error)
+}
+
+type ReputationService struct {
+	reputationRepo ReputationRepo
+	rules          []model.RankRule
+}
+
+// NewReputationService creates rank engine, rules must be ordered from lowest to highest rank
+func NewReputationService(reputationRepo ReputationRepo, rules []model.RankRule) *ReputationService {
+	return &ReputationService{reputationRepo: reputationRepo, rules: rules}
+}
+
+// Recalculate updates rank of one user from his current stats. It is called after
+// activity which changes stats of this user, so ranks never need full users scan.
+func (s *ReputationService) Recalculate(ctx context.Context, userId int) error {
+	stats, err := s.reputationRepo.Stats(ctx, userId)
+	if err != nil {
+		return err
+	}
+	rank := s.Rank(stats, time.Now())
+	if rank == stats.Rank {
+		return nil
+	}
+	_, err = s.reputationRepo.SetRank(ctx, userId, rank)
+	return err
+}
+
+// Rank returns the highest rank with all minimums reached by user stats
+func (s *ReputationService) Rank(stats model.UserStats, now time.Time) string {
+	rank := ""
+	accountAge := now.Sub(stats.CreatedAt)
+	for _, rule := range s.rules {
+		if stats.Karma >= rule.MinKarma &&
+			stats.PostsCount >= rule.MinPosts &&
+			stats.AcceptedAnswers >= rule.MinAcceptedAnswers &&
+			accountAge >= rule.MinAccountAge {
+			rank = rule.Name
+		}
+	}
+	return rank
+}
+
+func (s *ReputationService) History(ctx context.Context, userId int) ([]model.RankChange, error) {
+	return s.reputationRepo.History(ctx, userId)
+}
@@ -0,0 +1,434 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package revisions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/lib/textdiff"
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const (
+	DiffModeLine = "line"
+	DiffModeWord = "word"
+)
+
+var (
+	ErrNotEditor     = errors.New("only author or moderator can edit")
+	ErrNotModerator  = errors.New("only moderator can restore revision")
+	ErrEmptyContent  = errors.New("title and content must not be empty")
+	ErrNotChanged    = errors.New("nothing is changed")
+	ErrUnknownMode   = errors.New("diff mode must be line or word")
+	ErrNoSuchVersion = errors.New("revision not found")
+)
+
+type RevisionsRepo interface {
+	Edit(ctx context.Context, edit model.RevisionCreate) (model.Revision, error)
+	List(ctx context.Context, targetType string, targetId int) ([]model.Revision, error)
+}
+type ThreadsRepo interface {
+	Get(ctx context.Context, threadId int) (*model.ThreadRepoInfo, error)
+}
+type PostsRepo interface {
+	Get(ctx context.Context, postId int) (model.Post, error)
+}
+type UserRepo interface {
+	GetAuthor(ctx context.Context, userId int) (model.Author, error)
+	GetRole(ctx context.Context, userId int) (string, error)
+	SuspendedUntil(ctx context.Context, userId int) (*time.Time, error)
+}
+
+// ContentFilter checks edited threads and posts before they are stored, it may censor content
+type ContentFilter interface {
+	Check(ctx context.Context, content *model.FilterContent) model.FilterResult
+}
+
+// Renderer converts markdown source of threads and posts to sanitized HTML
+type Renderer interface {
+	Render(source string) string
+}
+
+// EventPublisher pushes thread changes to live readers
+type EventPublisher interface {
+	Publish(ctx context.Context, event model.LiveEvent) error
+}
+
+type RevisionsService struct {
+	revisionsRepo RevisionsRepo
+	threadsRepo   ThreadsRepo
+	postsRepo     PostsRepo
+	userRepo      UserRepo
+	renderer      Renderer
+	filter        ContentFilter
+	publisher     EventPublisher
+}
+
+func NewRevisionsService(
+	revisionsRepo RevisionsRepo,
+	threadsRepo ThreadsRepo,
+	postsRepo PostsRepo,
+	userRepo UserRepo,
+	renderer Renderer,
+	filter ContentFilter,
+	publisher EventPublisher) *RevisionsService {
+
+	return &RevisionsService{
+		revisionsRepo: revisionsRepo,
+		threadsRepo:   threadsRepo,
+		postsRepo:     postsRepo,
+		userRepo:      userRepo,
+		renderer:      renderer,
+		filter:        filter,
+		publisher:     publisher,
+	}
+}
+
+// EditThread changes title and content of thread, nil keeps current value.
+// Thread author and moderators can edit. Edit is checked by content filters as new thread,
+// held edit is applied after moderator approval.
+func (s *RevisionsService) EditThread(
+	ctx context.Context, editorId, threadId int, title, content *string) (model.Revision, error) {
+
+	thread, err := s.threadsRepo.Get(ctx, threadId)
+	if err != nil {
+		return model.Revision{}, err
+	}
+	if err := s.checkEditor(ctx, editorId, thread.UserID); err != nil {
+		return model.Revision{}, err
+	}
+	if err := s.checkNotSuspended(ctx, editorId); err != nil {
+		return model.Revision{}, err
+	}
+	if thread.State.Archived {
+		return model.Revision{}, model.ErrThreadArchived
+	}
+	edit := model.RevisionCreate{
+		TargetType: model.RevisionTargetThread,
+		TargetID:   threadId,
+		EditorID:   editorId,
+		Title:      thread.Title,
+		Content:    thread.Content,
+	}
+	if title != nil {
+		edit.Title = strings.TrimSpace(*title)
+	}
+	if content != nil {
+		edit.Content = *content
+	}
+	if edit.Title == "" || strings.TrimSpace(edit.Content) == "" {
+		return model.Revision{}, ErrEmptyContent
+	}
+	if edit.Title == thread.Title && edit.Content == thread.Content {
+		return model.Revision{}, ErrNotChanged
+	}
+	filtered := model.FilterContent{UserID: editorId, Title: edit.Title, Content: edit.Content}
+	edit.HeldReason, err = s.checkContent(ctx, &filtered)
+	if err != nil {
+		return model.Revision{}, err
+	}
+	edit.Title, edit.Content = filtered.Title, filtered.Content
+	edit.ContentHTML = s.renderer.Render(edit.Content)
+	return s.revisionsRepo.Edit(ctx, edit)
+}
+
+// EditPost changes content of post, post author and moderators can edit.
+// Edit is checked by content filters as new post, held edit is applied after moderator approval.
+func (s *RevisionsService) EditPost(ctx context.Context, editorId, postId int, content string) (model.Revision, error) {
+	post, err := s.postsRepo.Get(ctx, postId)
+	if err != nil {
+		return model.Revision{}, err
+	}
+	if err := s.checkEditor(ctx, editorId, post.UserID); err != nil {
+		return model.Revision{}, err
+	}
+	if err := s.checkNotSuspended(ctx, editorId); err != nil {
+		return model.Revision{}, err
+	}
+	if err := s.checkNotArchived(ctx, post.ThreadID); err != nil {
+		return model.Revision{}, err
+	}
+	if strings.TrimSpace(content) == "" {
+		return model.Revision{}, ErrEmptyContent
+	}
+	if content == post.Content {
+		return model.Revision{}, ErrNotChanged
+	}
+	filtered := model.FilterContent{UserID: editorId, Content: content}
+	heldReason, err := s.checkContent(ctx, &filtered)
+	if err != nil {
+		return model.Revision{}, err
+	}
+	revision, err := s.revisionsRepo.Edit(ctx, model.RevisionCreate{
+		TargetType:  model.RevisionTargetPost,
+		TargetID:    postId,
+		EditorID:    editorId,
+		Content:     filtered.Content,
+		ContentHTML: s.renderer.Render(filtered.Content),
+		HeldReason:  heldReason,
+	})
+	if err != nil {
+		return model.Revision{}, err
+	}
+	if !revision.Held {
+		s.publishPostUpdated(ctx, postId)
+	}
+	return revision, nil
+}
+
+// List returns revisions of thread or post from the oldest. Never edited target has
+// only revision 1 with current content. History of hidden and held targets is not found.
+func (s *RevisionsService) List(ctx context.Context, targetType string, targetId int) ([]model.Revision, error) {
+	original := model.Revision{TargetType: targetType, TargetID: targetId, Revision: 1}
+	switch targetType {
+	case model.RevisionTargetThread:
+		thread, err := s.threadsRepo.Get(ctx, targetId)
+		if err != nil {
+			return nil, err
+		}
+		original.Title = thread.Title
+		original.Content = thread.Content
+		original.EditorID = thread.UserID
+		original.CreatedAt = thread.CreatedAt
+	case model.RevisionTargetPost:
+		post, err := s.postsRepo.Get(ctx, targetId)
+		if err != nil {
+			return nil, err
+		}
+		// post of hidden thread is hidden too
+		if _, err := s.threadsRepo.Get(ctx, post.ThreadID); err != nil {
+			return nil, err
+		}
+		original.Content = post.Content
+		original.EditorID = post.UserID
+		original.CreatedAt = post.CreatedAt
+	default:
+		return nil, errors.New("unknown revision target type " + targetType)
+	}
+
+	revisions, err := s.revisionsRepo.List(ctx, targetType, targetId)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) > 0 {
+		return revisions, nil
+	}
+	author, err := s.userRepo.GetAuthor(ctx, original.EditorID)
+	if err != nil {
+		return nil, err
+	}
+	original.EditorName = author.Name
+	return []model.Revision{original}, nil
+}
+
+// Diff compares two revisions of thread or post by lines or words
+func (s *RevisionsService) Diff(
+	ctx context.Context, targetType string, targetId, from, to int, mode string) (model.RevisionDiff, error) {
+
+	var compare func(oldText, newText string) []textdiff.Op
+	switch mode {
+	case DiffModeLine:
+		compare = textdiff.Lines
+	case DiffModeWord:
+		compare = textdiff.Words
+	default:
+		return model.RevisionDiff{}, ErrUnknownMode
+	}
+	revisions, err := s.List(ctx, targetType, targetId)
+	if err != nil {
+		return model.RevisionDiff{}, err
+	}
+	fromRevision, ok := findRevision(revisions, from)
+	if !ok {
+		return model.RevisionDiff{}, ErrNoSuchVersion
+	}
+	toRevision, ok := findRevision(revisions, to)
+	if !ok {
+		return model.RevisionDiff{}, ErrNoSuchVersion
+	}
+
+	res := model.RevisionDiff{
+		From:    from,
+		To:      to,
+		Mode:    mode,
+		Content: convertOps(compare(fromRevision.Content, toRevision.Content)),
+	}
+	if targetType == model.RevisionTargetThread {
+		// title is one line, it is compared by words in both modes
+		res.Title = convertOps(textdiff.Words(fromRevision.Title, toRevision.Title))
+	}
+	return res, nil
+}
+
+// Restore makes content of older revision current as new revision, only moderators can restore.
+// Restored content is checked by content filters as edit.
+func (s *RevisionsService) Restore(
+	ctx context.Context, moderatorId int, targetType string, targetId, revisionNumber int) (model.Revision, error) {
+
+	role, err := s.userRepo.GetRole(ctx, moderatorId)
+	if err != nil {
+		return model.Revision{}, err
+	}
+	if !model.IsModerator(role) {
+		return model.Revision{}, ErrNotModerator
+	}
+	if err := s.checkNotSuspended(ctx, moderatorId); err != nil {
+		return model.Revision{}, err
+	}
+	revisions, err := s.List(ctx, targetType, targetId)
+	if err != nil {
+		return model.Revision{}, err
+	}
+	restored, ok := findRevision(revisions, revisionNumber)
+	if !ok {
+		return model.Revision{}, ErrNoSuchVersion
+	}
+	threadId := targetId
+	if targetType == model.RevisionTargetPost {
+		post, err := s.postsRepo.Get(ctx, targetId)
+		if err != nil {
+			return model.Revision{}, err
+		}
+		threadId = post.ThreadID
+	}
+	if err := s.checkNotArchived(ctx, threadId); err != nil {
+		return model.Revision{}, err
+	}
+	current := revisions[len(revisions)-1]
+	if restored.Title == current.Title && restored.Content == current.Content {
+		return model.Revision{}, ErrNotChanged
+	}
+	filtered := model.FilterContent{UserID: moderatorId, Title: restored.Title, Content: restored.Content}
+	heldReason, err := s.checkContent(ctx, &filtered)
+	if err != nil {
+		return model.Revision{}, err
+	}
+	revision, err := s.revisionsRepo.Edit(ctx, model.RevisionCreate{
+		TargetType:   targetType,
+		TargetID:     targetId,
+		EditorID:     moderatorId,
+		Title:        filtered.Title,
+		Content:      filtered.Content,
+		ContentHTML:  s.renderer.Render(filtered.Content),
+		RestoredFrom: &revisionNumber,
+		HeldReason:   heldReason,
+	})
+	if err != nil {
+		return model.Revision{}, err
+	}
+	if !revision.Held && targetType == model.RevisionTargetPost {
+		s.publishPostUpdated(ctx, targetId)
+	}
+	return revision, nil
+}
+
+func (s *RevisionsService) checkEditor(ctx context.Context, editorId, authorId int) error {
+	if editorId == authorId {
+		return nil
+	}
+	role, err := s.userRepo.GetRole(ctx, editorId)
+	if err != nil {
+		return err
+	}
+	if !model.IsModerator(role) {
+		return ErrNotEditor
+	}
+	return nil
+}
+
+func (s *RevisionsService) checkNotSuspended(ctx context.Context, userId int) error {
+	until, err := s.userRepo.SuspendedUntil(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if until != nil && time.Now().Before(*until) {
+		return model.ErrUserSuspended
+	}
+	return nil
+}
+
+// checkContent runs content filters, content may be censored by them.
+// Returns reason to hold edit for moderator review, empty if edit is accepted.
+func (s *RevisionsService) checkContent(ctx context.Context, content *model.FilterContent) (string, error) {
+	verdict := s.filter.Check(ctx, content)
+	switch verdict.Verdict {
+	case model.FilterReject:
+		return "", fmt.Errorf("%w: %s", model.ErrContentRejected, verdict.Reason)
+	case model.FilterHold:
+		return verdict.Reason, nil
+	}
+	return "", nil
+}
+
+func (s *RevisionsService) checkNotArchived(ctx context.Context, threadId int) error {
+	thread, err := s.threadsRepo.Get(ctx, threadId)
+	if err != nil {
+		return err
+	}
+	if thread.State.Archived {
+		return model.ErrThreadArchived
+	}
+	return nil
+}
+
+func (s *RevisionsService) publishPostUpdated(ctx context.Context, postId int) {
+	post, err := s.postsRepo.Get(ctx, postId)
+	if err != nil {
+		log.Printf("failed to get edited post %d: %v", postId, err)
+		return
+	}
+	author, err := s.userRepo.GetAuthor(ctx, post.UserID)
+	if err != nil {
+		log.Printf("failed to get author of edited post %d: %v", postId, err)
+		return
+	}
+	event := model.LiveEvent{
+		Type:     model.LiveEventPostUpdated,
+		ThreadID: post.ThreadID,
+		Post: &model.PostInfo{
+			ID:          post.ID,
+			ThreadID:    post.ThreadID,
+			UserID:      post.UserID,
+			UserName:    author.Name,
+			UserRank:    author.Rank,
+			ReplyToID:   post.ReplyToID,
+			Content:     post.Content,
+			ContentHTML: post.ContentHTML,
+			Score:       post.Score,
+			CreatedAt:   post.CreatedAt,
+		},
+	}
+	if err := s.publisher.Publish(ctx, event); err != nil {
+		log.Printf("failed to publish %s event of thread %d: %v", event.Type, event.ThreadID, err)
+	}
+}
+
+// AnnounceEdit tells live readers about edit approved by moderator, thread edits are not pushed
+func (s *RevisionsService) AnnounceEdit(ctx context.Context, targetType string, targetId int) {
+	if targetType == model.RevisionTargetPost {
+		s.publishPostUpdated(ctx, targetId)
+	}
+}
+
+func findRevision(revisions []model.Revision, number int) (model.Revision, bool) {
+	for _, revision := range revisions {
+		if revision.Revision == number {
+			return revision, true
+		}
+	}
+	return model.Revision{}, false
+}
+
+func convertOps(ops []textdiff.Op) []model.DiffOp {
+	res := make([]model.DiffOp, len(ops))
+	for i, op := range ops {
+		res[i] = model.DiffOp{Op: op.Kind, Text: op.Text}
+	}
+	return res
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package search
+
+import (
+	"context"
+	"errors"
+	"html"
+	"strings"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var ErrEmptyQuery = errors.New("search query is empty")
+
+type SearchRepo interface {
+	Search(ctx context.Context, query model.SearchQuery) ([]model.SearchHitRepo, error)
+}
+type UserRepo interface {
+	GetNameById(ctx context.Context, userId int) (string, error)
+}
+
+type SearchService struct {
+	searchRepo SearchRepo
+	userRepo   UserRepo
+}
+
+func NewSearchService(searchRepo SearchRepo, userRepo UserRepo) *SearchService {
+	return &SearchService{searchRepo: searchRepo, userRepo: userRepo}
+}
+
+func (s *SearchService) Search(ctx context.Context, query model.SearchQuery) (model.SearchResult, error) {
+	query.Query = strings.TrimSpace(query.Query)
+	if query.Query == "" {
+		return model.SearchResult{}, ErrEmptyQuery
+	}
+	if query.Limit <= 0 {
+		query.Limit = DefaultLimit
+	}
+	if query.Limit > MaxLimit {
+		query.Limit = MaxLimit
+	}
+	limit := query.Limit
+	query.Limit++ // one more to know if there is next page
+
+	hits, err := s.searchRepo.Search(ctx, query)
+	if err != nil {
+		return model.SearchResult{}, err
+	}
+
+	var res model.SearchResult
+	if len(hits) > limit {
+		hits = hits[:limit]
+		last := hits[limit-1]
+		id := last.ThreadID
+		if last.Kind == model.SearchKindPost {
+			id = last.PostID
+		}
+		res.NextCursor = &model.SearchCursor{Rank: last.Rank, Kind: last.Kind, ID: id}
+	}
+	res.Hits = make([]model.SearchHit, 0, len(hits))
+	for _, hit := range hits {
+		userName, err := s.userRepo.GetNameById(ctx, hit.UserID)
+		if err != nil {
+			return model.SearchResult{}, err
+		}
+		res.Hits = append(res.Hits, model.SearchHit{
+			Kind:        hit.Kind,
+			ThreadID:    hit.ThreadID,
+			PostID:      hit.PostID,
+			Title:       hit.Title,
+			Snippet:     highlightSnippet(hit.Snippet),
+			AuthorID:    hit.UserID,
+			AuthorName:  userName,
+			CommunityID: hit.CommunityID,
+			CreatedAt:   hit.CreatedAt,
+			Rank:        hit.Rank,
+		})
+	}
+	return res, nil
+}
+
+// highlightSnippet escapes user content and wraps matched words with <mark> tags,
+// so snippet is safe to render as html
+func highlightSnippet(snippet string) string {
+	snippet = html.EscapeString(snippet)
+	snippet = strings.ReplaceAll(snippet, model.SearchHighlightStart, "<mark>")
+	snippet = strings.ReplaceAll(snippet, model.SearchHighlightStop, "</mark>")
+	return snippet
+}
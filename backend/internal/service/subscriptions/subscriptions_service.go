@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package subscriptions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var ErrUnknownLevel = errors.New("subscription level must be watching, tracking or muted")
+
+type SubscriptionsRepo interface {
+	Set(ctx context.Context, userId, threadId int, level string) (model.ThreadSubscription, error)
+	SetIfAbsent(ctx context.Context, userId, threadId int, level string) error
+	Get(ctx context.Context, userId, threadId int) (model.ThreadSubscription, error)
+	Delete(ctx context.Context, userId, threadId int) error
+	List(ctx context.Context, userId int, level string, before, limit int) (model.ThreadSubscriptionListRepo, error)
+}
+type UserRepo interface {
+	GetAuthor(ctx context.Context, userId int) (model.Author, error)
+}
+
+type SubscriptionsService struct {
+	subscriptionsRepo SubscriptionsRepo
+	userRepo          UserRepo
+}
+
+func NewSubscriptionsService(subscriptionsRepo SubscriptionsRepo, userRepo UserRepo) *SubscriptionsService {
+	return &SubscriptionsService{subscriptionsRepo: subscriptionsRepo, userRepo: userRepo}
+}
+
+// Subscribe sets subscription level of user for thread
+func (s *SubscriptionsService) Subscribe(
+	ctx context.Context, userId, threadId int, level string) (model.ThreadSubscription, error) {
+
+	if !validLevel(level) {
+		return model.ThreadSubscription{}, ErrUnknownLevel
+	}
+	return s.subscriptionsRepo.Set(ctx, userId, threadId, level)
+}
+
+// AutoSubscribe subscribes author of thread or post to thread as watching,
+// level chosen by user before (e.g. muted) is kept
+func (s *SubscriptionsService) AutoSubscribe(ctx context.Context, userId, threadId int) error {
+	return s.subscriptionsRepo.SetIfAbsent(ctx, userId, threadId, model.SubscriptionWatching)
+}
+
+func (s *SubscriptionsService) Get(ctx context.Context, userId, threadId int) (model.ThreadSubscription, error) {
+	return s.subscriptionsRepo.Get(ctx, userId, threadId)
+}
+
+func (s *SubscriptionsService) Unsubscribe(ctx context.Context, userId, threadId int) error {
+	return s.subscriptionsRepo.Delete(ctx, userId, threadId)
+}
+
+// List user subscriptions with current info of threads, empty level - subscriptions of all levels
+func (s *SubscriptionsService) List(
+	ctx context.Context, userId int, level string, before, limit int) (model.ThreadSubscriptionListResponse, error) {
+
+	if level != "" && !validLevel(level) {
+		return model.ThreadSubscriptionListResponse{}, ErrUnknownLevel
+	}
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+	list, err := s.subscriptionsRepo.List(ctx, userId, level, before, limit)
+	if err != nil {
+		return model.ThreadSubscriptionListResponse{}, err
+	}
+
+	res := model.ThreadSubscriptionListResponse{
+		Subscriptions: make([]model.ThreadSubscriptionListItem, 0, len(list.Subscriptions)),
+		HaveNext:      list.HaveNext,
+	}
+	for _, item := range list.Subscriptions {
+		author, err := s.userRepo.GetAuthor(ctx, item.Thread.UserID)
+		if err != nil {
+			return model.ThreadSubscriptionListResponse{}, err
+		}
+		res.Subscriptions = append(res.Subscriptions, model.ThreadSubscriptionListItem{
+			ThreadSubscription: item.ThreadSubscription,
+			Thread: model.ThreadInfoResponse{
+				ID:          item.Thread.ID,
+				Title:       item.Thread.Title,
+				Content:     item.Thread.Content,
+				ContentHTML: item.Thread.ContentHTML,
+				AuthorID:    item.Thread.UserID,
+				AuthorName:  author.Name,
+				AuthorRank:  author.Rank,
+				CommunityID: item.Thread.CommunityID,
+				PostsCount:  item.Thread.PostsCount,
+				Score:       item.Thread.Score,
+				CreatedAt:   item.Thread.CreatedAt,
+			},
+		})
+	}
+	return res, nil
+}
+
+func validLevel(level string) bool {
+	return level == model.SubscriptionWatching || level == model.SubscriptionTracking ||
+		level == model.SubscriptionMuted
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package threads
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// threads archived by one query, big backlog is archived by several queries of one run
+const archiveBatchSize = 500
+
+type ArchiveRepo interface {
+	ArchiveInactive(ctx context.Context, before time.Time, limit int) (int, error)
+}
+
+// AutoArchiver archives threads without new posts, pinned threads are not archived.
+// It is safe to run on every instance, threads are archived once.
+type AutoArchiver struct {
+	repo        ArchiveRepo
+	inactiveFor time.Duration
+}
+
+func NewAutoArchiver(repo ArchiveRepo, inactiveFor time.Duration) *AutoArchiver {
+	return &AutoArchiver{repo: repo, inactiveFor: inactiveFor}
+}
+
+// Run archives inactive threads every interval until context is done
+func (a *AutoArchiver) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		a.archive(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *AutoArchiver) archive(ctx context.Context) {
+	before := time.Now().Add(-a.inactiveFor)
+	archived := 0
+	for {
+		n, err := a.repo.ArchiveInactive(ctx, before, archiveBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("failed to archive inactive threads: %v", err)
+			}
+			break
+		}
+		archived += n
+		if n < archiveBatchSize {
+			break
+		}
+	}
+	if archived > 0 {
+		log.Printf("archived %d inactive threads", archived)
+	}
+}
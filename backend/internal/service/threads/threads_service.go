@@ -5,73 +5,701 @@ package threads
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
 
 	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
 )
 
+var (
+	ErrNotThreadAuthor = errors.New("only thread author can accept answer")
+	ErrPostNotInThread = errors.New("post does not belong to thread")
+	ErrAcceptOwnPost   = errors.New("thread author can not accept own post")
+	ErrNotModerator    = errors.New("only moderator can change thread state")
+	ErrUnknownPinned   = errors.New("pinned must be none, global or community")
+	ErrNoCommunity     = errors.New("thread without community can not be pinned in community")
+	ErrInvalidSlowMode = errors.New("slow mode must be 0-86400 seconds")
+)
+
+// maxSlowMode is the longest interval between posts of user in thread with slow mode
+const maxSlowMode = 24 * time.Hour
+
 type ThreadsRepo interface {
 	Create(ctx context.Context, thread model.ThreadCreate) (model.ThreadRepoInfo, error)
 	Get(ctx context.Context, threadId int) (*model.ThreadRepoInfo, error)
-	PageByPageID(ctx context.Context, page, limit int) (model.ThreadListRepo, error)
-	PageByOffset(ctx context.Context, threadId, limit int, before bool) (model.ThreadListRepo, error)
+	PageByPageID(ctx context.Context, communityId *int, page, limit int) (model.ThreadListRepo, error)
+	PageByOffset(ctx context.Context, communityId *int, threadId, limit int, before bool) (model.ThreadListRepo, error)
+	Pinned(ctx context.Context, communityId *int) ([]model.ThreadRepoInfo, error)
+	AcceptAnswer(ctx context.Context, threadId, postId int) (model.AcceptedAnswerChange, error)
+	SetState(ctx context.Context, threadId int, change model.ThreadStateChange) (model.ThreadState, error)
 }
 type PostsRepo interface {
 	Create(ctx context.Context, post model.PostCreate) (model.Post, error)
+	Get(ctx context.Context, postId int) (model.Post, error)
 	List(ctx context.Context, threadId int) ([]model.Post, error)
 }
 type UserRepo interface {
-	GetNameById(ctx context.Context, userId int) (string, error)
+	GetAuthor(ctx context.Context, userId int) (model.Author, error)
+	GetRole(ctx context.Context, userId int) (string, error)
+	SuspendedUntil(ctx context.Context, userId int) (*time.Time, error)
+}
+
+type BookmarksRepo interface {
+	ThreadsBookmarked(ctx context.Context, userId int, threadIds []int) (map[int]bool, error)
+}
+
+// Mentions resolves and stores @name mentions of threads and posts
+type Mentions interface {
+	Resolve(ctx context.Context, text string) ([]model.MentionedUser, error)
+	Save(ctx context.Context, target model.MentionTarget, users []model.MentionedUser) error
+	Entities(ctx context.Context, targetType string, contents map[int]string) (map[int][]model.MentionEntity, error)
+	Locate(content string, users []model.MentionedUser) []model.MentionEntity
+}
+
+// Attachments links uploaded files to threads and posts
+type Attachments interface {
+	Validate(ctx context.Context, userId int, attachmentIds []int) error
+	Link(ctx context.Context, userId int, attachmentIds []int, threadId int, postId *int) ([]model.Attachment, error)
+	ThreadAttachments(ctx context.Context, threadId int) ([]model.Attachment, map[int][]model.Attachment, error)
+}
+
+// Polls stores polls created with threads
+type Polls interface {
+	Validate(poll *model.PollCreate) error
+	Create(ctx context.Context, threadId int, poll model.PollCreate) error
+	ThreadPoll(ctx context.Context, viewerId, threadId int) (*model.PollView, error)
+}
+
+// Subscriptions subscribes authors of threads and posts to threads
+type Subscriptions interface {
+	AutoSubscribe(ctx context.Context, userId, threadId int) error
+}
+
+// Relations are block and ignore lists of users
+type Relations interface {
+	// Ignored returns users blocked or ignored by viewer
+	Ignored(ctx context.Context, viewerId int) (map[int]bool, error)
+	// BlockedBy returns which of given users blocked user
+	BlockedBy(ctx context.Context, userId int, byIds []int) (map[int]bool, error)
+}
+
+// FloodControl limits posting rate of users, errors are *model.RateLimitError for too frequent posts
+type FloodControl interface {
+	AllowPost(ctx context.Context, userId, threadId int, slowMode time.Duration) error
+	AllowThread(ctx context.Context, userId int) error
+}
+
+// ContentFilter checks threads and posts before they are stored, it may censor content
+type ContentFilter interface {
+	Check(ctx context.Context, content *model.FilterContent) model.FilterResult
+}
+
+// Renderer converts markdown source of threads and posts to sanitized HTML
+type Renderer interface {
+	Render(source string) string
 }
 
+// RankUpdater recalculates user rank after activity which changes user stats
+type RankUpdater interface {
+	Recalculate(ctx context.Context, userId int) error
+}
+
+// InteractionRecorder adds replies and mentions to interaction graph of users,
+// admins can rebuild the graph from posts, mentions and votes
+type InteractionRecorder interface {
+	Record(ctx context.Context, interactions []model.Interaction) error
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, n model.NotificationCreate) error
+	NotifyWatchers(ctx context.Context, n model.NotificationCreate, excludeUserIds []int) error
+}
+
+// EventPublisher pushes thread changes to live readers
+type EventPublisher interface {
+	Publish(ctx context.Context, event model.LiveEvent) error
+}
+
+// ThreadsService creates and reads threads and posts. Rank, mentions, notifications, subscriptions,
+// interaction graph and live events are secondary data: their failures are logged and do not fail
+// user request.
 type ThreadsService struct {
-	threadsRepo ThreadsRepo
-	postsRepo   PostsRepo
-	userRepo    UserRepo
+	threadsRepo   ThreadsRepo
+	postsRepo     PostsRepo
+	userRepo      UserRepo
+	bookmarksRepo BookmarksRepo
+	mentions      Mentions
+	attachments   Attachments
+	polls         Polls
+	subscriptions Subscriptions
+	filter        ContentFilter
+	flood         FloodControl
+	relations     Relations
+	renderer      Renderer
+	rankUpdater   RankUpdater
+	interactions  InteractionRecorder
+	notifier      Notifier
+	publisher     EventPublisher
 }
 
-func NewThreadsService(threadsRepo ThreadsRepo, postsRepo PostsRepo, userRepo UserRepo) *ThreadsService {
-	return &ThreadsService{threadsRepo: threadsRepo, postsRepo: postsRepo, userRepo: userRepo}
+func NewThreadsService(
+	threadsRepo ThreadsRepo,
+	postsRepo PostsRepo,
+	userRepo UserRepo,
+	bookmarksRepo BookmarksRepo,
+	mentions Mentions,
+	attachments Attachments,
+	polls Polls,
+	subscriptions Subscriptions,
+	filter ContentFilter,
+	flood FloodControl,
+	relations Relations,
+	renderer Renderer,
+	rankUpdater RankUpdater,
+	interactions InteractionRecorder,
+	notifier Notifier,
+	publisher EventPublisher) *ThreadsService {
+
+	return &ThreadsService{
+		threadsRepo:   threadsRepo,
+		postsRepo:     postsRepo,
+		userRepo:      userRepo,
+		bookmarksRepo: bookmarksRepo,
+		mentions:      mentions,
+		attachments:   attachments,
+		polls:         polls,
+		subscriptions: subscriptions,
+		filter:        filter,
+		flood:         flood,
+		relations:     relations,
+		renderer:      renderer,
+		rankUpdater:   rankUpdater,
+		interactions:  interactions,
+		notifier:      notifier,
+		publisher:     publisher,
+	}
 }
 
 func (s *ThreadsService) AddPost(ctx context.Context, post model.PostCreate) (model.PostInfo, error) {
+	if err := s.checkNotSuspended(ctx, post.UserID); err != nil {
+		return model.PostInfo{}, err
+	}
+	thread, err := s.threadsRepo.Get(ctx, post.ThreadID)
+	if err != nil {
+		return model.PostInfo{}, err
+	}
+	if thread.State.Archived {
+		return model.PostInfo{}, model.ErrThreadArchived
+	}
+	if thread.State.Locked {
+		return model.PostInfo{}, model.ErrThreadLocked
+	}
+	var replyTo model.Post
+	if post.ReplyToID != nil {
+		replyTo, err = s.postsRepo.Get(ctx, *post.ReplyToID)
+		if err != nil {
+			return model.PostInfo{}, err
+		}
+		if replyTo.ThreadID != post.ThreadID {
+			return model.PostInfo{}, ErrPostNotInThread
+		}
+	}
+	// post replies to thread author and to author of parent post, both can block the poster
+	repliedIds := []int{thread.UserID}
+	if post.ReplyToID != nil {
+		repliedIds = append(repliedIds, replyTo.UserID)
+	}
+	blocked, err := s.relations.BlockedBy(ctx, post.UserID, repliedIds)
+	if err != nil {
+		return model.PostInfo{}, err
+	}
+	for _, id := range repliedIds {
+		if blocked[id] {
+			return model.PostInfo{}, model.ErrBlockedByUser
+		}
+	}
+	if err := s.attachments.Validate(ctx, post.UserID, post.AttachmentIDs); err != nil {
+		return model.PostInfo{}, err
+	}
+	slowMode := time.Duration(thread.State.SlowModeSeconds) * time.Second
+	if err := s.flood.AllowPost(ctx, post.UserID, post.ThreadID, slowMode); err != nil {
+		return model.PostInfo{}, err
+	}
+	filtered := model.FilterContent{UserID: post.UserID, Content: post.Content}
+	post.HeldReason, err = s.checkContent(ctx, &filtered)
+	if err != nil {
+		return model.PostInfo{}, err
+	}
+	post.Content = filtered.Content
+	post.ContentHTML = s.renderer.Render(post.Content)
 	createdPost, err := s.postsRepo.Create(ctx, post)
 	if err != nil {
 		return model.PostInfo{}, err
 	}
-	userName, err := s.userRepo.GetNameById(ctx, createdPost.UserID)
+	attachments, err := s.attachments.Link(
+		ctx, createdPost.UserID, post.AttachmentIDs, createdPost.ThreadID, &createdPost.ID)
 	if err != nil {
 		return model.PostInfo{}, err
 	}
-	return model.PostInfo{
-		ID:        createdPost.ID,
-		ThreadID:  createdPost.ThreadID,
-		UserID:    createdPost.UserID,
-		UserName:  userName,
-		Content:   createdPost.Content,
-		CreatedAt: createdPost.CreatedAt,
-	}, nil
+	s.recalculateRank(ctx, createdPost.UserID)
+	// poster follows thread from the first reply
+	s.subscribe(ctx, createdPost.UserID, thread.ID)
+	author, err := s.userRepo.GetAuthor(ctx, createdPost.UserID)
+	if err != nil {
+		return model.PostInfo{}, err
+	}
+	postInfo := model.PostInfo{
+		ID:          createdPost.ID,
+		ThreadID:    createdPost.ThreadID,
+		UserID:      createdPost.UserID,
+		UserName:    author.Name,
+		UserRank:    author.Rank,
+		ReplyToID:   createdPost.ReplyToID,
+		Content:     createdPost.Content,
+		ContentHTML: createdPost.ContentHTML,
+		Attachments: attachments,
+		Score:       createdPost.Score,
+		Held:        post.HeldReason != "",
+		CreatedAt:   createdPost.CreatedAt,
+	}
+	// held post is announced after approval, see AnnouncePost
+	if !postInfo.Held {
+		s.announcePost(ctx, thread, replyTo.UserID, &postInfo)
+	}
+	return postInfo, nil
+}
+
+// AnnouncePost tells users about post approved by moderator as if it was just created
+func (s *ThreadsService) AnnouncePost(ctx context.Context, postId int) error {
+	post, err := s.postsRepo.Get(ctx, postId)
+	if err != nil {
+		return err
+	}
+	thread, err := s.threadsRepo.Get(ctx, post.ThreadID)
+	if err != nil {
+		return err
+	}
+	// parent post hidden meanwhile is not replied anymore
+	var repliedUserId int
+	if post.ReplyToID != nil {
+		replyTo, err := s.postsRepo.Get(ctx, *post.ReplyToID)
+		if err != nil && !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+		repliedUserId = replyTo.UserID
+	}
+	_, postAttachments, err := s.attachments.ThreadAttachments(ctx, thread.ID)
+	if err != nil {
+		return err
+	}
+	// approved post is counted in user stats
+	s.recalculateRank(ctx, post.UserID)
+	author, err := s.userRepo.GetAuthor(ctx, post.UserID)
+	if err != nil {
+		return err
+	}
+	s.announcePost(ctx, thread, repliedUserId, &model.PostInfo{
+		ID:          post.ID,
+		ThreadID:    post.ThreadID,
+		UserID:      post.UserID,
+		UserName:    author.Name,
+		UserRank:    author.Rank,
+		ReplyToID:   post.ReplyToID,
+		Content:     post.Content,
+		ContentHTML: s.contentHTML(post.Content, post.ContentHTML),
+		Attachments: postAttachments[post.ID],
+		Score:       post.Score,
+		CreatedAt:   post.CreatedAt,
+	})
+	return nil
+}
+
+// announcePost saves mentions of visible post, notifies users, records interactions and
+// pushes post to live readers. repliedUserId is author of parent post, 0 for reply to thread.
+func (s *ThreadsService) announcePost(
+	ctx context.Context, thread *model.ThreadRepoInfo, repliedUserId int, postInfo *model.PostInfo) {
+
+	mentioned := s.saveMentions(ctx, model.MentionTarget{
+		Type:     model.MentionTargetPost,
+		ID:       postInfo.ID,
+		ThreadID: postInfo.ThreadID,
+		AuthorID: postInfo.UserID,
+	}, postInfo.Content)
+	postInfo.Mentions = s.mentions.Locate(postInfo.Content, mentioned)
+
+	// every user gets at most one notification about post, the most specific one
+	postID := postInfo.ID
+	notified := make(map[int]bool)
+	if repliedUserId != 0 {
+		s.notify(ctx, notified, model.NotificationCreate{
+			UserID:   repliedUserId,
+			Type:     model.NotificationPostReply,
+			ActorID:  postInfo.UserID,
+			ThreadID: &thread.ID,
+			PostID:   &postID,
+		})
+	}
+	s.notifyMentions(ctx, notified, postInfo.UserID, thread.ID, &postID, mentioned)
+	s.notify(ctx, notified, model.NotificationCreate{
+		UserID:   thread.UserID,
+		Type:     model.NotificationThreadReply,
+		ActorID:  postInfo.UserID,
+		ThreadID: &thread.ID,
+		PostID:   &postID,
+	})
+	s.notifyWatchers(ctx, notified, model.NotificationCreate{
+		Type:     model.NotificationThreadPost,
+		ActorID:  postInfo.UserID,
+		ThreadID: &thread.ID,
+		PostID:   &postID,
+	})
+
+	// post replies to parent post or to thread
+	if repliedUserId == 0 {
+		repliedUserId = thread.UserID
+	}
+	interactions := mentionInteractions(postInfo.UserID, thread.ID, mentioned, postInfo.CreatedAt)
+	s.recordInteractions(ctx, append(interactions, model.Interaction{
+		SourceID: postInfo.UserID,
+		TargetID: repliedUserId,
+		Kind:     model.InteractionReply,
+		ThreadID: thread.ID,
+		At:       postInfo.CreatedAt,
+	}))
+	s.publish(ctx, model.LiveEvent{
+		Type:     model.LiveEventPostCreated,
+		ThreadID: postInfo.ThreadID,
+		Post:     postInfo,
+	})
 }
+
 func (s *ThreadsService) Create(ctx context.Context, thread model.ThreadCreate) (model.ThreadInfo, error) {
+	if err := s.checkNotSuspended(ctx, thread.UserID); err != nil {
+		return model.ThreadInfo{}, err
+	}
+	if err := s.attachments.Validate(ctx, thread.UserID, thread.AttachmentIDs); err != nil {
+		return model.ThreadInfo{}, err
+	}
+	if thread.Poll != nil {
+		if err := s.polls.Validate(thread.Poll); err != nil {
+			return model.ThreadInfo{}, err
+		}
+	}
+	if err := s.flood.AllowThread(ctx, thread.UserID); err != nil {
+		return model.ThreadInfo{}, err
+	}
+	filtered := model.FilterContent{UserID: thread.UserID, Title: thread.Title, Content: thread.Content}
+	heldReason, err := s.checkContent(ctx, &filtered)
+	if err != nil {
+		return model.ThreadInfo{}, err
+	}
+	thread.Title, thread.Content, thread.HeldReason = filtered.Title, filtered.Content, heldReason
+	thread.ContentHTML = s.renderer.Render(thread.Content)
 	createdThread, err := s.threadsRepo.Create(ctx, thread)
 	if err != nil {
 		return model.ThreadInfo{}, err
 	}
-	userName, err := s.userRepo.GetNameById(ctx, createdThread.UserID)
+	_, err = s.attachments.Link(ctx, createdThread.UserID, thread.AttachmentIDs, createdThread.ID, nil)
 	if err != nil {
 		return model.ThreadInfo{}, err
 	}
-	return model.ThreadInfo{
-		ID:         createdThread.ID,
-		Title:      createdThread.Title,
-		Content:    createdThread.Content,
-		UserID:     createdThread.UserID,
-		UserName:   userName,
-		PostsCount: createdThread.PostsCount,
-		CreatedAt:  createdThread.CreatedAt,
-	}, nil
+	if thread.Poll != nil {
+		if err := s.polls.Create(ctx, createdThread.ID, *thread.Poll); err != nil {
+			return model.ThreadInfo{}, err
+		}
+	}
+	s.recalculateRank(ctx, createdThread.UserID)
+	s.subscribe(ctx, createdThread.UserID, createdThread.ID)
+	author, err := s.userRepo.GetAuthor(ctx, createdThread.UserID)
+	if err != nil {
+		return model.ThreadInfo{}, err
+	}
+	threadInfo := model.ThreadInfo{
+		ID:          createdThread.ID,
+		Title:       createdThread.Title,
+		Content:     createdThread.Content,
+		ContentHTML: createdThread.ContentHTML,
+		UserID:      createdThread.UserID,
+		UserName:    author.Name,
+		UserRank:    author.Rank,
+		CommunityID: createdThread.CommunityID,
+		PostsCount:  createdThread.PostsCount,
+		Score:       createdThread.Score,
+		Held:        thread.HeldReason != "",
+		CreatedAt:   createdThread.CreatedAt,
+	}
+	// held thread is announced after approval, see AnnounceThread
+	if !threadInfo.Held {
+		s.announceThread(ctx, &threadInfo)
+	}
+	return threadInfo, nil
+}
+
+// AnnounceThread tells users about thread approved by moderator as if it was just created
+func (s *ThreadsService) AnnounceThread(ctx context.Context, threadId int) error {
+	thread, err := s.threadsRepo.Get(ctx, threadId)
+	if err != nil {
+		return err
+	}
+	// approved thread is counted in user stats
+	s.recalculateRank(ctx, thread.UserID)
+	author, err := s.userRepo.GetAuthor(ctx, thread.UserID)
+	if err != nil {
+		return err
+	}
+	s.announceThread(ctx, &model.ThreadInfo{
+		ID:          thread.ID,
+		Title:       thread.Title,
+		Content:     thread.Content,
+		ContentHTML: s.contentHTML(thread.Content, thread.ContentHTML),
+		UserID:      thread.UserID,
+		UserName:    author.Name,
+		UserRank:    author.Rank,
+		CommunityID: thread.CommunityID,
+		PostsCount:  thread.PostsCount,
+		Score:       thread.Score,
+		CreatedAt:   thread.CreatedAt,
+	})
+	return nil
+}
+
+// announceThread saves mentions of visible thread, notifies mentioned users, records interactions
+// and pushes thread to live readers
+func (s *ThreadsService) announceThread(ctx context.Context, threadInfo *model.ThreadInfo) {
+	mentioned := s.saveMentions(ctx, model.MentionTarget{
+		Type:     model.MentionTargetThread,
+		ID:       threadInfo.ID,
+		ThreadID: threadInfo.ID,
+		AuthorID: threadInfo.UserID,
+	}, threadInfo.Content)
+	s.notifyMentions(ctx, make(map[int]bool), threadInfo.UserID, threadInfo.ID, nil, mentioned)
+	s.recordInteractions(ctx, mentionInteractions(threadInfo.UserID, threadInfo.ID, mentioned, threadInfo.CreatedAt))
+	s.publish(ctx, model.LiveEvent{
+		Type:     model.LiveEventThreadCreated,
+		ThreadID: threadInfo.ID,
+		Thread:   threadInfo,
+	})
+}
+
+// checkContent runs content filters, content may be censored by them.
+// Returns reason to hold content for moderator review, empty if content is accepted.
+func (s *ThreadsService) checkContent(ctx context.Context, content *model.FilterContent) (string, error) {
+	verdict := s.filter.Check(ctx, content)
+	switch verdict.Verdict {
+	case model.FilterReject:
+		return "", fmt.Errorf("%w: %s", model.ErrContentRejected, verdict.Reason)
+	case model.FilterHold:
+		return verdict.Reason, nil
+	}
+	return "", nil
+}
+
+// AcceptAnswer marks post as accepted answer of thread, only thread author can do it.
+// Previously accepted post of thread is unaccepted.
+func (s *ThreadsService) AcceptAnswer(ctx context.Context, userId, threadId, postId int) error {
+	thread, err := s.threadsRepo.Get(ctx, threadId)
+	if err != nil {
+		return err
+	}
+	if thread.UserID != userId {
+		return ErrNotThreadAuthor
+	}
+	if thread.State.Archived {
+		return model.ErrThreadArchived
+	}
+	post, err := s.postsRepo.Get(ctx, postId)
+	if err != nil {
+		return err
+	}
+	if post.ThreadID != threadId {
+		return ErrPostNotInThread
+	}
+	if post.UserID == userId {
+		return ErrAcceptOwnPost
+	}
+
+	change, err := s.threadsRepo.AcceptAnswer(ctx, threadId, postId)
+	if err != nil {
+		return err
+	}
+	if change.OldAuthorID != 0 {
+		s.recalculateRank(ctx, change.OldAuthorID)
+	}
+	if change.NewAuthorID != 0 {
+		s.recalculateRank(ctx, change.NewAuthorID)
+	}
+	return nil
+}
+
+// SetState pins, locks, archives thread or sets its slow mode, only moderators can do it.
+// Pinned "" unpins thread.
+func (s *ThreadsService) SetState(
+	ctx context.Context, moderatorId, threadId int, change model.ThreadStateChange) (model.ThreadState, error) {
+
+	role, err := s.userRepo.GetRole(ctx, moderatorId)
+	if err != nil {
+		return model.ThreadState{}, err
+	}
+	if !model.IsModerator(role) {
+		return model.ThreadState{}, ErrNotModerator
+	}
+	if change.Pinned != nil {
+		switch *change.Pinned {
+		case "", model.ThreadPinnedGlobal:
+		case model.ThreadPinnedCommunity:
+			thread, err := s.threadsRepo.Get(ctx, threadId)
+			if err != nil {
+				return model.ThreadState{}, err
+			}
+			if thread.CommunityID == nil {
+				return model.ThreadState{}, ErrNoCommunity
+			}
+		default:
+			return model.ThreadState{}, ErrUnknownPinned
+		}
+	}
+	if change.SlowModeSeconds != nil {
+		slowMode := time.Duration(*change.SlowModeSeconds) * time.Second
+		if slowMode < 0 || slowMode > maxSlowMode {
+			return model.ThreadState{}, ErrInvalidSlowMode
+		}
+	}
+	return s.threadsRepo.SetState(ctx, threadId, change)
+}
+
+func (s *ThreadsService) checkNotSuspended(ctx context.Context, userId int) error {
+	until, err := s.userRepo.SuspendedUntil(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if until != nil && time.Now().Before(*until) {
+		return model.ErrUserSuspended
+	}
+	return nil
+}
+
+func (s *ThreadsService) recalculateRank(ctx context.Context, userId int) {
+	if err := s.rankUpdater.Recalculate(ctx, userId); err != nil {
+		log.Printf("failed to recalculate rank of user %d: %v", userId, err)
+	}
+}
+
+func (s *ThreadsService) publish(ctx context.Context, event model.LiveEvent) {
+	if err := s.publisher.Publish(ctx, event); err != nil {
+		log.Printf("failed to publish %s event of thread %d: %v", event.Type, event.ThreadID, err)
+	}
+}
+
+func (s *ThreadsService) saveMentions(
+	ctx context.Context, target model.MentionTarget, content string) []model.MentionedUser {
+
+	users, err := s.mentions.Resolve(ctx, content)
+	if err != nil {
+		log.Printf("failed to resolve mentions of %s %d: %v", target.Type, target.ID, err)
+		return nil
+	}
+	// users who blocked author are not mentioned
+	ids := make([]int, len(users))
+	for i, user := range users {
+		ids[i] = user.UserID
+	}
+	blocked, err := s.relations.BlockedBy(ctx, target.AuthorID, ids)
+	if err != nil {
+		log.Printf("failed to check blocks of mentions of %s %d: %v", target.Type, target.ID, err)
+		return nil
+	}
+	users = slices.DeleteFunc(users, func(user model.MentionedUser) bool {
+		return blocked[user.UserID]
+	})
+	if err := s.mentions.Save(ctx, target, users); err != nil {
+		log.Printf("failed to save mentions of %s %d: %v", target.Type, target.ID, err)
+		return nil
+	}
+	return users
+}
+
+func (s *ThreadsService) notifyMentions(
+	ctx context.Context, notified map[int]bool, actorId, threadId int, postId *int, users []model.MentionedUser) {
+
+	for _, user := range users {
+		s.notify(ctx, notified, model.NotificationCreate{
+			UserID:   user.UserID,
+			Type:     model.NotificationMention,
+			ActorID:  actorId,
+			ThreadID: &threadId,
+			PostID:   postId,
+		})
+	}
+}
+
+// notified prevents several notifications of one user about the same action.
+func (s *ThreadsService) notify(ctx context.Context, notified map[int]bool, n model.NotificationCreate) {
+	if notified[n.UserID] {
+		return
+	}
+	notified[n.UserID] = true
+	if err := s.notifier.Notify(ctx, n); err != nil {
+		log.Printf("failed to notify user %d about %s: %v", n.UserID, n.Type, err)
+	}
+}
+
+// notifyWatchers notifies users watching thread who are not notified about the action yet
+func (s *ThreadsService) notifyWatchers(ctx context.Context, notified map[int]bool, n model.NotificationCreate) {
+	exclude := make([]int, 0, len(notified))
+	for userId := range notified {
+		exclude = append(exclude, userId)
+	}
+	if err := s.notifier.NotifyWatchers(ctx, n, exclude); err != nil {
+		log.Printf("failed to notify watchers of thread %d about %s: %v", *n.ThreadID, n.Type, err)
+	}
+}
+
+func (s *ThreadsService) subscribe(ctx context.Context, userId, threadId int) {
+	if err := s.subscriptions.AutoSubscribe(ctx, userId, threadId); err != nil {
+		log.Printf("failed to subscribe user %d to thread %d: %v", userId, threadId, err)
+	}
+}
+
+func (s *ThreadsService) recordInteractions(ctx context.Context, interactions []model.Interaction) {
+	if len(interactions) == 0 {
+		return
+	}
+	if err := s.interactions.Record(ctx, interactions); err != nil {
+		log.Printf("failed to record %d interactions of user %d: %v",
+			len(interactions), interactions[0].SourceID, err)
+	}
+}
+
+func mentionInteractions(
+	authorId, threadId int, mentioned []model.MentionedUser, at time.Time) []model.Interaction {
+
+	interactions := make([]model.Interaction, 0, len(mentioned)+1)
+	for _, user := range mentioned {
+		interactions = append(interactions, model.Interaction{
+			SourceID: authorId,
+			TargetID: user.UserID,
+			Kind:     model.InteractionMention,
+			ThreadID: threadId,
+			At:       at,
+		})
+	}
+	return interactions
 }
 
-func (s *ThreadsService) GetThreadWithPosts(ctx context.Context, threadId int) (model.ThreadWithPosts, error) {
+// contentHTML returns stored rendered content, content created before rendering
+// was introduced has empty html and is rendered on read
+func (s *ThreadsService) contentHTML(content, contentHTML string) string {
+	if contentHTML == "" && content != "" {
+		return s.renderer.Render(content)
+	}
+	return contentHTML
+}
+
+// GetThreadWithPosts returns thread with all posts, viewerId is current user (0 for anonymous)
+func (s *ThreadsService) GetThreadWithPosts(ctx context.Context, viewerId, threadId int) (model.ThreadWithPosts, error) {
 	threadInfo, err := s.threadsRepo.Get(ctx, threadId)
 	if err != nil {
 		return model.ThreadWithPosts{}, err
@@ -80,66 +708,145 @@ func (s *ThreadsService) GetThreadWithPosts(ctx context.Context, threadId int) (
 	if err != nil {
 		return model.ThreadWithPosts{}, err
 	}
+	postContents := make(map[int]string, len(posts))
+	for _, post := range posts {
+		postContents[post.ID] = post.Content
+	}
+	postMentions, err := s.mentions.Entities(ctx, model.MentionTargetPost, postContents)
+	if err != nil {
+		return model.ThreadWithPosts{}, err
+	}
+	threadMentions, err := s.mentions.Entities(ctx, model.MentionTargetThread,
+		map[int]string{threadInfo.ID: threadInfo.Content})
+	if err != nil {
+		return model.ThreadWithPosts{}, err
+	}
+	threadAttachments, postAttachments, err := s.attachments.ThreadAttachments(ctx, threadInfo.ID)
+	if err != nil {
+		return model.ThreadWithPosts{}, err
+	}
+	poll, err := s.polls.ThreadPoll(ctx, viewerId, threadInfo.ID)
+	if err != nil {
+		return model.ThreadWithPosts{}, err
+	}
+	ignored, err := s.relations.Ignored(ctx, viewerId)
+	if err != nil {
+		return model.ThreadWithPosts{}, err
+	}
 	var postListItems []model.PostListItem
 	for _, post := range posts {
-		userName, err := s.userRepo.GetNameById(ctx, post.UserID)
+		author, err := s.userRepo.GetAuthor(ctx, post.UserID)
 		if err != nil {
 			return model.ThreadWithPosts{}, err
 		}
 		postListItems = append(postListItems, model.PostListItem{
-			ID:        post.ID,
-			UserID:    post.UserID,
-			UserName:  userName,
-			Content:   post.Content,
-			CreatedAt: post.CreatedAt,
+			ID:            post.ID,
+			UserID:        post.UserID,
+			UserName:      author.Name,
+			UserRank:      author.Rank,
+			ReplyToID:     post.ReplyToID,
+			Content:       post.Content,
+			ContentHTML:   s.contentHTML(post.Content, post.ContentHTML),
+			Mentions:      postMentions[post.ID],
+			Attachments:   postAttachments[post.ID],
+			Score:         post.Score,
+			AuthorIgnored: ignored[post.UserID],
+			CreatedAt:     post.CreatedAt,
 		})
 	}
-	userName, err := s.userRepo.GetNameById(ctx, threadInfo.UserID)
+	author, err := s.userRepo.GetAuthor(ctx, threadInfo.UserID)
+	if err != nil {
+		return model.ThreadWithPosts{}, err
+	}
+	bookmarked, err := s.bookmarksRepo.ThreadsBookmarked(ctx, viewerId, []int{threadInfo.ID})
 	if err != nil {
 		return model.ThreadWithPosts{}, err
 	}
 	return model.ThreadWithPosts{
-		ID:         threadInfo.ID,
-		AuthorID:   threadInfo.UserID,
-		AuthorName: userName,
-		Title:      threadInfo.Title,
-		Content:    threadInfo.Content,
-		PostsCount: threadInfo.PostsCount,
-		CreatedAt:  threadInfo.CreatedAt,
-		Posts:      postListItems,
+		ID:             threadInfo.ID,
+		AuthorID:       threadInfo.UserID,
+		AuthorName:     author.Name,
+		AuthorRank:     author.Rank,
+		Title:          threadInfo.Title,
+		Content:        threadInfo.Content,
+		ContentHTML:    s.contentHTML(threadInfo.Content, threadInfo.ContentHTML),
+		Mentions:       threadMentions[threadInfo.ID],
+		Attachments:    threadAttachments,
+		Poll:           poll,
+		PostsCount:     threadInfo.PostsCount,
+		Score:          threadInfo.Score,
+		AcceptedPostID: threadInfo.AcceptedPostID,
+		IsBookmarked:   bookmarked[threadInfo.ID],
+		State:          threadInfo.State,
+		CreatedAt:      threadInfo.CreatedAt,
+		Posts:          postListItems,
 	}, nil
 }
-func (s *ThreadsService) GetThreadListByPage(ctx context.Context, page, limit int) (model.ThreadListResponse, error) {
-	threadListRepo, err := s.threadsRepo.PageByPageID(ctx, page, limit)
+
+// GetThreadListByPage returns page of threads of community (all communities if nil),
+// the first page starts with pinned threads
+func (s *ThreadsService) GetThreadListByPage(
+	ctx context.Context, viewerId int, communityId *int, page, limit int) (model.ThreadListResponse, error) {
+
+	threadListRepo, err := s.threadsRepo.PageByPageID(ctx, communityId, page, limit)
 	if err != nil {
 		return model.ThreadListResponse{}, err
 	}
-	return s.convertThreadListRepoToResponse(ctx, threadListRepo)
+	if page == 1 {
+		pinned, err := s.threadsRepo.Pinned(ctx, communityId)
+		if err != nil {
+			return model.ThreadListResponse{}, err
+		}
+		threadListRepo.Threads = append(pinned, threadListRepo.Threads...)
+	}
+	return s.convertThreadListRepoToResponse(ctx, viewerId, threadListRepo)
 }
-func (s *ThreadsService) GetThreadListByOffset(ctx context.Context, threadId, limit int, before bool) (model.ThreadListResponse, error) {
-	threadListRepo, err := s.threadsRepo.PageByOffset(ctx, threadId, limit, before)
+func (s *ThreadsService) GetThreadListByOffset(ctx context.Context,
+	viewerId int, communityId *int, threadId, limit int, before bool) (model.ThreadListResponse, error) {
+
+	threadListRepo, err := s.threadsRepo.PageByOffset(ctx, communityId, threadId, limit, before)
 	if err != nil {
 		return model.ThreadListResponse{}, err
 	}
-	return s.convertThreadListRepoToResponse(ctx, threadListRepo)
+	return s.convertThreadListRepoToResponse(ctx, viewerId, threadListRepo)
 }
 func (s *ThreadsService) convertThreadListRepoToResponse(
-	ctx context.Context, threadListRepo model.ThreadListRepo) (model.ThreadListResponse, error) {
+	ctx context.Context, viewerId int, threadListRepo model.ThreadListRepo) (model.ThreadListResponse, error) {
+
+	threadIds := make([]int, len(threadListRepo.Threads))
+	for i, thread := range threadListRepo.Threads {
+		threadIds[i] = thread.ID
+	}
+	bookmarked, err := s.bookmarksRepo.ThreadsBookmarked(ctx, viewerId, threadIds)
+	if err != nil {
+		return model.ThreadListResponse{}, err
+	}
+	ignored, err := s.relations.Ignored(ctx, viewerId)
+	if err != nil {
+		return model.ThreadListResponse{}, err
+	}
 
 	var threadList []model.ThreadInfoResponse
 	for _, thread := range threadListRepo.Threads {
-		userName, err := s.userRepo.GetNameById(ctx, thread.UserID)
+		author, err := s.userRepo.GetAuthor(ctx, thread.UserID)
 		if err != nil {
 			return model.ThreadListResponse{}, err
 		}
 		threadList = append(threadList, model.ThreadInfoResponse{
-			ID:         thread.ID,
-			Title:      thread.Title,
-			Content:    thread.Content,
-			AuthorID:   thread.UserID,
-			AuthorName: userName,
-			PostsCount: thread.PostsCount,
-			CreatedAt:  thread.CreatedAt,
+			ID:            thread.ID,
+			Title:         thread.Title,
+			Content:       thread.Content,
+			ContentHTML:   s.contentHTML(thread.Content, thread.ContentHTML),
+			AuthorID:      thread.UserID,
+			AuthorName:    author.Name,
+			AuthorRank:    author.Rank,
+			CommunityID:   thread.CommunityID,
+			PostsCount:    thread.PostsCount,
+			Score:         thread.Score,
+			IsBookmarked:  bookmarked[thread.ID],
+			State:         thread.State,
+			AuthorIgnored: ignored[thread.UserID],
+			CreatedAt:     thread.CreatedAt,
 		})
 	}
 	return model.ThreadListResponse{
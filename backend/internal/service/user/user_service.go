@@ -5,15 +5,48 @@ package user
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
 )
 
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+	// last seen time is stored not more often than this
+	seenInterval = 5 * time.Minute
+	// seen times kept in memory before stale ones are dropped (not more often than seenInterval)
+	maxSeenEntries = 10000
+
+	maxBioLength      = 1000
+	maxLocationLength = 100
+	maxURLLength      = 500
+)
+
+var (
+	ErrNotAllowed      = errors.New("only the user and admins can change user profile")
+	ErrBioTooLong      = errors.New("bio is too long")
+	ErrLocationTooLong = errors.New("location is too long")
+	ErrInvalidURL      = errors.New("invalid url, absolute http or https url is expected")
+)
+
 type UserRepo interface {
+	// Get returns model.ErrNotFound if user does not exist
 	Get(ctx context.Context, userId int) (*model.User, error)
+	GetRole(ctx context.Context, userId int) (string, error)
 	Create(ctx context.Context, name, email string) (*model.User, error)
-	Update(ctx context.Context, userId int, name, email string) (*model.User, error)
+	Update(ctx context.Context, update model.UserUpdate) error
 	Delete(ctx context.Context, userId int) error
+	SetLastSeen(ctx context.Context, userId int, seenAt time.Time) error
+	Threads(ctx context.Context, userId, before, limit int) (model.UserThreadList, error)
+	Posts(ctx context.Context, userId, before, limit int) (model.UserPostList, error)
 }
 
 type AuthRepo interface {
@@ -21,23 +54,64 @@ type AuthRepo interface {
 	AuthUpdatePassword(ctx context.Context, user_id int64, password string) error
 }
 
+type ReputationService interface {
+	Recalculate(ctx context.Context, userId int) error
+	History(ctx context.Context, userId int) ([]model.RankChange, error)
+}
+
 type UserService struct {
-	userRepo UserRepo
-	authRepo AuthRepo
+	userRepo          UserRepo
+	authRepo          AuthRepo
+	reputationService ReputationService
+
+	seenMu sync.Mutex
+	// when last seen time of user was stored
+	seen map[int]time.Time
+	// when stale seen times were dropped last time
+	seenSweptAt time.Time
 }
 
-func NewUserService(userRepo UserRepo, authRepo AuthRepo) *UserService {
-	return &UserService{userRepo: userRepo, authRepo: authRepo}
+func NewUserService(userRepo UserRepo, authRepo AuthRepo, reputationService ReputationService) *UserService {
+	return &UserService{
+		userRepo:          userRepo,
+		authRepo:          authRepo,
+		reputationService: reputationService,
+		seen:              make(map[int]time.Time),
+	}
 }
 
-func (r *UserService) Get(ctx context.Context, userId int) (*model.User, error) {
+// Get returns user profile seen by viewer (0 - anonymous), private fields are shown only to the user and admins
+func (r *UserService) Get(ctx context.Context, viewerId, userId int) (*model.User, error) {
 	user, err := r.userRepo.Get(ctx, userId)
 	if err != nil {
 		return nil, err
 	}
-
+	allowed, err := r.canSeePrivate(ctx, viewerId, userId)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		user.Email = ""
+	}
 	return user, nil
 }
+
+func (r *UserService) canSeePrivate(ctx context.Context, viewerId, userId int) (bool, error) {
+	if viewerId == 0 {
+		return false, nil
+	}
+	if viewerId == userId {
+		return true, nil
+	}
+	role, err := r.userRepo.GetRole(ctx, viewerId)
+	if errors.Is(err, model.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return role == model.RoleAdmin, nil
+}
 func (r *UserService) Create(ctx context.Context, name, email, password string) (*model.User, error) {
 	user, err := r.userRepo.Create(ctx, name, email)
 	if err != nil {
@@ -47,17 +121,68 @@ func (r *UserService) Create(ctx context.Context, name, email, password string)
 	if err != nil {
 		return nil, err
 	}
+	// new user gets the lowest rank
+	err = r.reputationService.Recalculate(ctx, int(user.ID))
+	if err != nil {
+		return nil, err
+	}
 
-	return user, nil
+	return r.userRepo.Get(ctx, int(user.ID))
 }
-func (r *UserService) Update(ctx context.Context, userId int, name, email string) (*model.User, error) {
-	user, err := r.userRepo.Update(ctx, userId, name, email)
+
+// Update replaces name, email and profile of user, only the user and admins can do it
+func (r *UserService) Update(ctx context.Context, viewerId int, update model.UserUpdate) (*model.User, error) {
+	allowed, err := r.canSeePrivate(ctx, viewerId, update.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, ErrNotAllowed
+	}
+	update.UserProfile, err = checkProfile(update.UserProfile)
 	if err != nil {
 		return nil, err
 	}
+	if err := r.userRepo.Update(ctx, update); err != nil {
+		return nil, err
+	}
+	return r.userRepo.Get(ctx, update.UserID)
+}
 
-	return user, nil
+func checkProfile(profile model.UserProfile) (model.UserProfile, error) {
+	profile.Bio = strings.TrimSpace(profile.Bio)
+	profile.Location = strings.TrimSpace(profile.Location)
+	profile.AvatarURL = strings.TrimSpace(profile.AvatarURL)
+	profile.Website = strings.TrimSpace(profile.Website)
+	if utf8.RuneCountInString(profile.Bio) > maxBioLength {
+		return profile, fmt.Errorf("%w, max %d characters", ErrBioTooLong, maxBioLength)
+	}
+	if utf8.RuneCountInString(profile.Location) > maxLocationLength {
+		return profile, fmt.Errorf("%w, max %d characters", ErrLocationTooLong, maxLocationLength)
+	}
+	for _, link := range []string{profile.AvatarURL, profile.Website} {
+		if err := checkURL(link); err != nil {
+			return profile, err
+		}
+	}
+	return profile, nil
 }
+
+// empty url is allowed, it clears profile field
+func checkURL(link string) error {
+	if link == "" {
+		return nil
+	}
+	if len(link) > maxURLLength {
+		return fmt.Errorf("%w, max %d characters", ErrInvalidURL, maxURLLength)
+	}
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidURL
+	}
+	return nil
+}
+
 func (r *UserService) Delete(ctx context.Context, userId int) error {
 	err := r.userRepo.Delete(ctx, userId)
 	if err != nil {
@@ -66,3 +191,54 @@ func (r *UserService) Delete(ctx context.Context, userId int) error {
 
 	return nil
 }
+
+// rank changes of user, newest first
+func (r *UserService) RankHistory(ctx context.Context, userId int) ([]model.RankChange, error) {
+	return r.reputationService.History(ctx, userId)
+}
+
+// Threads returns visible threads of user from the newest
+func (r *UserService) Threads(ctx context.Context, userId, before, limit int) (model.UserThreadList, error) {
+	return r.userRepo.Threads(ctx, userId, before, clampLimit(limit))
+}
+
+// Posts returns visible posts of user from the newest
+func (r *UserService) Posts(ctx context.Context, userId, before, limit int) (model.UserPostList, error) {
+	return r.userRepo.Posts(ctx, userId, before, clampLimit(limit))
+}
+
+// Seen records activity of authenticated user. Database is updated once in a few minutes for user,
+// failure is only logged: last seen time must not fail user request.
+// It is called for requests with valid access token: ogen api and /api/user/* routes.
+// Live updates (/api/live/*) are anonymous streams and do not update last seen time.
+// Stale entries are dropped once the map is full, at most once a seenInterval, so between sweeps
+// the map may grow beyond maxSeenEntries by users seen during the interval.
+func (r *UserService) Seen(ctx context.Context, userId int) {
+	now := time.Now()
+	r.seenMu.Lock()
+	if now.Sub(r.seen[userId]) < seenInterval {
+		r.seenMu.Unlock()
+		return
+	}
+	if len(r.seen) >= maxSeenEntries && now.Sub(r.seenSweptAt) >= seenInterval {
+		r.seenSweptAt = now
+		for id, at := range r.seen {
+			if now.Sub(at) >= seenInterval {
+				delete(r.seen, id)
+			}
+		}
+	}
+	r.seen[userId] = now
+	r.seenMu.Unlock()
+
+	if err := r.userRepo.SetLastSeen(ctx, userId, now); err != nil {
+		log.Printf("failed to store last seen time of user %d: %v", userId, err)
+	}
+}
+
+func clampLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	return min(limit, MaxLimit)
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: MIT
+// Copyright 2025 Alex Syrnikov <alex19srv@gmail.com>
+
+package votes
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/hetagdarchiev/forum-interaction-analytics/backend/internal/service/model"
+)
+
+var (
+	ErrSelfVote     = errors.New("voting for own thread or post is not allowed")
+	ErrInvalidValue = errors.New("vote value must be -1, 0 or 1")
+)
+
+type VotesRepo interface {
+	TargetAuthor(ctx context.Context, targetType string, targetId int) (int, error)
+	Vote(ctx context.Context, vote model.Vote) (model.VoteResult, error)
+}
+
+// RankUpdater recalculates user rank after karma change
+type RankUpdater interface {
+	Recalculate(ctx context.Context, userId int) error
+}
+
+// InteractionRecorder adds votes to interaction graph of users and removes withdrawn ones
+type InteractionRecorder interface {
+	Record(ctx context.Context, interactions []model.Interaction) error
+	Remove(ctx context.Context, interactions []model.Interaction) error
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, n model.NotificationCreate) error
+}
+
+// EventPublisher pushes score changes to live readers
+type EventPublisher interface {
+	Publish(ctx context.Context, event model.LiveEvent) error
+}
+
+type VotesService struct {
+	votesRepo    VotesRepo
+	rankUpdater  RankUpdater
+	interactions InteractionRecorder
+	notifier     Notifier
+	publisher    EventPublisher
+}
+
+func NewVotesService(votesRepo VotesRepo, rankUpdater RankUpdater, interactions InteractionRecorder,
+	notifier Notifier, publisher EventPublisher) *VotesService {
+
+	return &VotesService{
+		votesRepo:    votesRepo,
+		rankUpdater:  rankUpdater,
+		interactions: interactions,
+		notifier:     notifier,
+		publisher:    publisher,
+	}
+}
+
+// Vote sets user vote for thread or post. Repeating the same vote changes nothing,
+// value 0 removes previous vote.
+func (s *VotesService) Vote(ctx context.Context, vote model.Vote) (model.VoteResult, error) {
+	if vote.Value < -1 || vote.Value > 1 {
+		return model.VoteResult{}, ErrInvalidValue
+	}
+	// author of thread or post never changes, so it is safe to check outside of vote transaction
+	authorID, err := s.votesRepo.TargetAuthor(ctx, vote.TargetType, vote.TargetID)
+	if err != nil {
+		return model.VoteResult{}, err
+	}
+	if authorID == vote.UserID {
+		return model.VoteResult{}, ErrSelfVote
+	}
+	result, err := s.votesRepo.Vote(ctx, vote)
+	if err != nil {
+		return model.VoteResult{}, err
+	}
+	if err := s.rankUpdater.Recalculate(ctx, result.AuthorID); err != nil {
+		log.Printf("failed to recalculate rank of user %d: %v", result.AuthorID, err)
+	}
+	if result.Changed {
+		event := model.LiveEvent{
+			Type:     model.LiveEventVoteChanged,
+			ThreadID: result.ThreadID,
+			Score:    result.Score,
+		}
+		if vote.TargetType == model.VoteTargetPost {
+			event.PostID = result.TargetID
+		}
+		if err := s.publisher.Publish(ctx, event); err != nil {
+			log.Printf("failed to publish vote event of thread %d: %v", result.ThreadID, err)
+		}
+	}
+	// graph counts voters, not vote changes: changed vote is the same interaction,
+	// removed vote is taken back, so repeated voting does not grow the edge
+	switch {
+	case result.OldVote == 0 && vote.Value != 0:
+		err := s.interactions.Record(ctx, []model.Interaction{{
+			SourceID: vote.UserID,
+			TargetID: result.AuthorID,
+			Kind:     model.InteractionVote,
+			ThreadID: result.ThreadID,
+			At:       time.Now(),
+		}})
+		if err != nil {
+			log.Printf("failed to record vote of user %d for user %d: %v", vote.UserID, result.AuthorID, err)
+		}
+	case result.OldVote != 0 && vote.Value == 0:
+		err := s.interactions.Remove(ctx, []model.Interaction{{
+			SourceID: vote.UserID,
+			TargetID: result.AuthorID,
+			Kind:     model.InteractionVote,
+			ThreadID: result.ThreadID,
+			At:       result.OldVotedAt,
+		}})
+		if err != nil {
+			log.Printf("failed to remove vote of user %d for user %d: %v", vote.UserID, result.AuthorID, err)
+		}
+	}
+	// removed vote is not worth notification
+	if result.Changed && vote.Value != 0 {
+		n := model.NotificationCreate{
+			UserID:    result.AuthorID,
+			Type:      model.NotificationVote,
+			ActorID:   vote.UserID,
+			ThreadID:  &result.ThreadID,
+			VoteValue: vote.Value,
+		}
+		if vote.TargetType == model.VoteTargetPost {
+			n.PostID = &result.TargetID
+		}
+		if err := s.notifier.Notify(ctx, n); err != nil {
+			log.Printf("failed to notify user %d about vote: %v", result.AuthorID, err)
+		}
+	}
+	return result, nil
+}